@@ -52,3 +52,20 @@ type NetworkFilterFactory interface {
 }
 
 var registeredFilterFactories = []NetworkFilterFactory{}
+
+// RegisterFilterFactory makes a filter available to scenarios. The factory Unmarshal
+// method should return nil for any description which isn't its own.
+func RegisterFilterFactory(factory NetworkFilterFactory) {
+	registeredFilterFactories = append(registeredFilterFactories, factory)
+}
+
+// UnmarshalFilter creates a filter factory out of its JSON description, using the first
+// registered factory which accepts it. It returns nil if none does.
+func UnmarshalFilter(filterConfig []byte) NetworkFilterFactory {
+	for _, regFactory := range registeredFilterFactories {
+		if filterFactory := regFactory.Unmarshal(filterConfig); filterFactory != nil {
+			return filterFactory
+		}
+	}
+	return nil
+}
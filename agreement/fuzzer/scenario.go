@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package fuzzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/invopop/yaml"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// A Scenario is the declarative description of a fuzzer run: the network, the filters
+// every node sends and receives its messages through, and how long to run it.
+//
+// Scenarios are stored as JSON or YAML. Each filter is described by an object whose
+// Name field selects the registered filter factory, for instance:
+//
+//	{
+//	  "FuzzerName": "dropMessages",
+//	  "NodesCount": 5,
+//	  "Filters": [ { "Name": "DropMessageFilter", "UpStreamDropRate": { "0": 8 } } ],
+//	  "Validator": { "NetworkRunTicks": 50, "NetworkRecoverTicks": 50 },
+//	  "LogLevel": 4
+//	}
+type Scenario struct {
+	FuzzerName string
+	NodesCount int
+	// OnlineNodes optionally lists which of the nodes are staked; all nodes are by default.
+	OnlineNodes []bool `json:",omitempty"`
+	Filters     []json.RawMessage
+	Validator   ValidatorConfig
+	LogLevel    int
+}
+
+// ParseScenario decodes a JSON scenario.
+func ParseScenario(data []byte) (*Scenario, error) {
+	var s Scenario
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("fuzzer: unable to parse scenario: %v", err)
+	}
+	if s.NodesCount <= 0 {
+		return nil, fmt.Errorf("fuzzer: scenario %s has no nodes", s.FuzzerName)
+	}
+	return &s, nil
+}
+
+// LoadScenario reads a scenario file. Files with a .yaml or .yml extension are decoded
+// as YAML, and any other as JSON. A scenario without a name is named after its file.
+func LoadScenario(filename string) (*Scenario, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(filename))
+	if ext == ".yaml" || ext == ".yml" {
+		data, err = yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("fuzzer: unable to parse scenario %s: %v", filename, err)
+		}
+	}
+	s, err := ParseScenario(data)
+	if err != nil {
+		return nil, err
+	}
+	if s.FuzzerName == "" {
+		s.FuzzerName = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	}
+	return s, nil
+}
+
+// FuzzerConfig creates the filter factories of the scenario.
+func (s *Scenario) FuzzerConfig() (*FuzzerConfig, error) {
+	filters := make([]NetworkFilterFactory, 0, len(s.Filters))
+	for i, filterConfig := range s.Filters {
+		filterFactory := UnmarshalFilter(filterConfig)
+		if filterFactory == nil {
+			return nil, fmt.Errorf("fuzzer: scenario %s: filter %d is not a known filter: %s", s.FuzzerName, i, string(filterConfig))
+		}
+		filters = append(filters, filterFactory)
+	}
+	return &FuzzerConfig{
+		FuzzerName:  s.FuzzerName,
+		NodesCount:  s.NodesCount,
+		OnlineNodes: s.OnlineNodes,
+		Filters:     filters,
+		LogLevel:    logging.Level(s.LogLevel),
+	}, nil
+}
+
+// Run runs the scenario and checks that the network recovered from it.
+func (s *Scenario) Run() (*RunResult, error) {
+	config, err := s.FuzzerConfig()
+	if err != nil {
+		return nil, err
+	}
+	return RunValidation(&s.Validator, config)
+}
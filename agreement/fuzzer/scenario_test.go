@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package fuzzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoadScenario(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	yamlScenario := `
+NodesCount: 5
+OnlineNodes: [true, true, true, true, false]
+Filters:
+  - Name: DropMessageFilter
+    UpStreamDropRate: {"0": 8}
+  - Name: SchedulerFilter
+    Filters:
+      - Name: NullFilter
+    Schedule:
+      - Operation: 4
+        FirstTick: 10
+    ScheduleName: sched
+Validator:
+  NetworkRunTicks: 50
+  NetworkRecoverTicks: 20
+LogLevel: 4
+`
+	yamlFile := filepath.Join(dir, "dropMessages.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(yamlScenario), 0644))
+
+	scenario, err := LoadScenario(yamlFile)
+	require.NoError(t, err)
+	require.Equal(t, "dropMessages", scenario.FuzzerName)
+	require.Equal(t, 5, scenario.NodesCount)
+	require.Equal(t, ValidatorConfig{NetworkRunTicks: 50, NetworkRecoverTicks: 20}, scenario.Validator)
+
+	config, err := scenario.FuzzerConfig()
+	require.NoError(t, err)
+	require.Equal(t, logging.Level(4), config.LogLevel)
+	require.Equal(t, []bool{true, true, true, true, false}, config.OnlineNodes)
+	require.Len(t, config.Filters, 2)
+	require.IsType(t, &DropMessageFilter{}, config.Filters[0])
+	require.Equal(t, map[int]uint64{0: 8}, config.Filters[0].(*DropMessageFilter).upStreamDropRate)
+	require.IsType(t, &SchedulerFilter{}, config.Filters[1])
+
+	// the JSON scenarios used by TestFuzzer load the same way
+	scenario, err = LoadScenario(filepath.Join("testdata", "drop_message_test.json"))
+	require.NoError(t, err)
+	require.Equal(t, "dropMessagesTest", scenario.FuzzerName)
+	_, err = scenario.FuzzerConfig()
+	require.NoError(t, err)
+}
+
+func TestLoadScenarioErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	scenario, err := ParseScenario([]byte(`{"NodesCount": 3, "Filters": [{"Name": "NoSuchFilter"}]}`))
+	require.NoError(t, err)
+	_, err = scenario.FuzzerConfig()
+	require.ErrorContains(t, err, "NoSuchFilter")
+
+	_, err = ParseScenario([]byte(`{"FuzzerName": "empty"}`))
+	require.ErrorContains(t, err, "no nodes")
+
+	_, err = ParseScenario([]byte(`{"NodesCount": "three"}`))
+	require.Error(t, err)
+}
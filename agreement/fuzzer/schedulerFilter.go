@@ -212,14 +212,7 @@ func (n *SchedulerFilter) Unmarshal(b []byte) NetworkFilterFactory {
 		if err != nil {
 			return nil
 		}
-		var filterFactory NetworkFilterFactory
-		for _, regFactory := range registeredFilterFactories {
-			filterFactory = regFactory.Unmarshal(filterConfig)
-			if filterFactory != nil {
-				// we found a filter factory!
-				break
-			}
-		}
+		filterFactory := UnmarshalFilter(filterConfig)
 		if filterFactory == nil {
 			return nil
 		}
@@ -17,7 +17,6 @@
 package fuzzer
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -416,14 +415,6 @@ func TestCertVoteDrops(t *testing.T) {
 	printResults(t, runRes)
 }*/
 
-type FuzzerTestFile struct {
-	FuzzerName string
-	NodesCount int
-	Filters    []interface{}
-	Validator  ValidatorConfig
-	LogLevel   int
-}
-
 func TestFuzzer(t *testing.T) {
 	// partitiontest.PartitionTest(t)
 	// Causes double partition, so commented out on purpose
@@ -438,42 +429,16 @@ func TestFuzzer(t *testing.T) {
 	for testName := range jsonFiles {
 		t.Run(testName, func(t *testing.T) {
 			partitiontest.PartitionTest(t) // Check if this expect test should by run, may SKIP
-			jsonFilename := jsonFiles[testName]
-			jsonBytes, err := os.ReadFile(jsonFilename)
-			require.NoError(t, err)
-			var fuzzerTest FuzzerTestFile
-			err = json.Unmarshal(jsonBytes, &fuzzerTest)
+			scenario, err := LoadScenario(jsonFiles[testName])
 			if err != nil {
 				t.Skip()
 			}
-
-			filters := []NetworkFilterFactory{}
-			// generate a list of concrete filters
-			for _, fuzzerFilterData := range fuzzerTest.Filters {
-				// convert the interface into a byte-stream.
-				filterConfig, err := json.Marshal(fuzzerFilterData)
-				require.NoError(t, err)
-				var filterFactory NetworkFilterFactory
-				for _, regFactory := range registeredFilterFactories {
-					filterFactory = regFactory.Unmarshal(filterConfig)
-					if filterFactory != nil {
-						// we found a filter factory!
-						break
-					}
-				}
-				if filterFactory == nil {
-					t.Skip()
-				}
-				filters = append(filters, filterFactory)
-			}
-			config := &FuzzerConfig{
-				FuzzerName: fuzzerTest.FuzzerName,
-				NodesCount: fuzzerTest.NodesCount,
-				Filters:    filters,
-				LogLevel:   logging.Level(fuzzerTest.LogLevel),
+			config, err := scenario.FuzzerConfig()
+			if err != nil {
+				t.Skip()
 			}
 
-			validator := MakeValidator(&fuzzerTest.Validator, t)
+			validator := MakeValidator(&scenario.Validator, t)
 			validator.Go(config)
 		})
 	}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package fuzzer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidatorConfig sets how long a fuzzer network runs with its filters, and then
+// how long it is given to recover once the filters are removed.
+type ValidatorConfig struct {
+	NetworkRunTicks     int
+	NetworkRecoverTicks int
+}
+
+// ErrNetworkStalled is returned when the fuzzer network stopped making any progress.
+// The network cannot be shut down in that case.
+var ErrNetworkStalled = errors.New("fuzzer: no network activity detected, the network has stalled")
+
+// RunValidation creates the network described by netConfig, runs it, and checks that it
+// recovered once the filters were removed.
+func RunValidation(config *ValidatorConfig, netConfig *FuzzerConfig) (*RunResult, error) {
+	network := MakeFuzzer(*netConfig)
+	if network == nil {
+		return nil, fmt.Errorf("fuzzer: unable to create network %s", netConfig.FuzzerName)
+	}
+
+	network.Start()
+	_, runResult := network.Run(config.NetworkRunTicks, config.NetworkRecoverTicks, 100)
+	if runResult.NetworkStalled {
+		return runResult, ErrNetworkStalled
+	}
+	network.Shutdown()
+
+	if config.NetworkRecoverTicks <= 0 {
+		return runResult, nil
+	}
+	return runResult, runResult.checkRecovery()
+}
+
+func (r *RunResult) rounds() string {
+	return fmt.Sprintf("Initial Rounds %d-%d\nPre Recovery Rounds %d-%d\nPost Recovery Rounds %d-%d",
+		r.StartLowRound, r.StartHighRound,
+		r.PreRecoveryLowRound, r.PreRecoveryHighRound,
+		r.PostRecoveryLowRound, r.PostRecoveryHighRound,
+	)
+}
+
+// checkRecovery verifies that all the nodes agree on the round after the recovery
+// phase, and that the network made progress during it.
+func (r *RunResult) checkRecovery() error {
+	if r.PostRecoveryHighRound-r.PostRecoveryLowRound > 1 {
+		return fmt.Errorf("fuzzer: nodes did not converge after recovery\n%s", r.rounds())
+	}
+	if r.PreRecoveryHighRound == r.PostRecoveryHighRound {
+		return fmt.Errorf("fuzzer: network made no progress during recovery\n%s", r.rounds())
+	}
+	return nil
+}
+
+// Summary describes how the filters affected the network.
+func (r *RunResult) Summary() string {
+	if r.PreRecoveryHighRound != r.PreRecoveryLowRound {
+		// network got disputed by the filters.
+		return fmt.Sprintf("partitioned the network ( %d - %d ), but recovered correctly reaching round %d", r.PreRecoveryLowRound, r.PreRecoveryHighRound, r.PostRecoveryHighRound)
+	}
+	if r.PreRecoveryHighRound == r.StartLowRound {
+		return fmt.Sprintf("stalled the network, and the network reached round %d", r.PostRecoveryHighRound)
+	}
+	return fmt.Sprintf("did not partition the network, and the network reached round %d", r.PostRecoveryHighRound)
+}
@@ -177,6 +177,14 @@ func (i *networkImpl) Disconnect(h agreement.MessageHandle) {
 		return
 	}
 
+	switch metadata.raw.Tag {
+	case protocol.AgreementVoteTag:
+		i.net.ReportPeer(metadata.raw.Sender, network.PeerFaultInvalidVote)
+	case protocol.ProposalPayloadTag:
+		i.net.ReportPeer(metadata.raw.Sender, network.PeerFaultInvalidProposal)
+	case protocol.VoteBundleTag:
+		i.net.ReportPeer(metadata.raw.Sender, network.PeerFaultInvalidBundle)
+	}
 	i.net.Disconnect(metadata.raw.Sender)
 }
 
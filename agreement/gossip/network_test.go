@@ -142,6 +142,9 @@ func (w *whiteholeNetwork) Disconnect(badnode network.Peer) {
 func (w *whiteholeNetwork) DisconnectPeers() {
 	return
 }
+func (w *whiteholeNetwork) ReportPeer(badnode network.Peer, fault network.PeerFault) {
+	return
+}
 func (w *whiteholeNetwork) Ready() chan struct{} {
 	return make(chan struct{})
 }
@@ -81,6 +81,7 @@ type asyncPseudonode struct {
 	quit                   chan struct{}   // a quit signal for the verifier goroutines
 	closeWg                *sync.WaitGroup // frontend waitgroup to get notified when all the verifier goroutines are done.
 	monitor                *coserviceMonitor
+	timeline               *timelineExporter                     // optional; records block assembly
 	participationKeysRound basics.Round                          // the round to which the participationKeys matches
 	participationKeys      []account.ParticipationRecordForRound // the list of the participation keys for round participationKeysRound
 
@@ -133,6 +134,7 @@ type pseudonodeParams struct {
 	voteVerifier *AsyncVoteVerifier
 	log          serviceLogger
 	monitor      *coserviceMonitor
+	timeline     *timelineExporter
 }
 
 func makePseudonode(params pseudonodeParams) pseudonode {
@@ -145,6 +147,7 @@ func makePseudonode(params pseudonodeParams) pseudonode {
 		quit:      make(chan struct{}),
 		closeWg:   &sync.WaitGroup{},
 		monitor:   params.monitor,
+		timeline:  params.timeline,
 	}
 
 	pn.proposalsVerifier = pn.makePseudonodeVerifier(params.voteVerifier)
@@ -496,7 +499,10 @@ func (t pseudonodeProposalsTask) execute(verifier *AsyncVoteVerifier, quit chan
 		return
 	}
 
+	assembleStart := time.Now()
 	payloads, votes := t.node.makeProposals(t.round, t.period, t.participation)
+	t.node.timeline.span(timelineAssemblyTrack, "block assembly", assembleStart, time.Now(),
+		map[string]interface{}{"round": uint64(t.round), "period": uint64(t.period), "proposals": len(votes)})
 	fields := logging.Fields{
 		"Type":         logspec.ProposalAssembled.String(),
 		"ObjectRound":  t.round,
@@ -507,8 +513,7 @@ func (t pseudonodeProposalsTask) execute(verifier *AsyncVoteVerifier, quit chan
 
 	// We used to record block assembly time in timer info, but not anymore.
 	// Previously we were using the state machine tracer.timeR(), which caused a data race. (GOAL2-541)
-	// It's not immediately obvious how to log a diff, we may need to change the interface.
-	// For now, don't log at all, and revisit when the metric becomes more important.
+	// Block assembly now only shows up in the timeline, whose exporter is safe for concurrent use.
 
 	results := make(chan asyncVerifyVoteResponse, len(votes))
 	cryptoOutputs := make([]asyncVerifyVoteResponse, len(votes))
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// An AutopsyDivergence describes the first point at which replaying a cadaver
+// departed from what was recorded.
+type AutopsyDivergence struct {
+	// Run is the sequence number of the cadaver-generating process.
+	Run int
+	// Round and Period are those of the player when the divergence happened.
+	Round  uint64
+	Period uint64
+
+	// Event is the recorded event whose replay emitted different actions.
+	// It is empty if the divergence is in the player state.
+	Event string
+	// Recorded and Replayed hold the recorded and the emitted actions.
+	Recorded []string
+	Replayed []string
+
+	// StateDiff lists the player fields which differ between the recorded
+	// state and the replayed one, as "field: recorded != replayed".
+	StateDiff []string
+}
+
+// String returns a human-readable description of the divergence.
+func (d AutopsyDivergence) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "replay: diverged in run %d at (%d, %d)\n", d.Run, d.Round, d.Period)
+	if d.Event != "" {
+		fmt.Fprintf(&b, "replay: event: %s\n", d.Event)
+		for _, a := range d.Recorded {
+			fmt.Fprintf(&b, "replay: - recorded: %s\n", a)
+		}
+		for _, a := range d.Replayed {
+			fmt.Fprintf(&b, "replay: + replayed: %s\n", a)
+		}
+	}
+	for _, f := range d.StateDiff {
+		fmt.Fprintf(&b, "replay: state: %s\n", f)
+	}
+	return b.String()
+}
+
+// AutopsyReplayResult summarizes the replay of a cadaver.
+type AutopsyReplayResult struct {
+	// Runs and Events count the cadaver-generating processes and the events replayed.
+	Runs   int
+	Events int
+	// Version is the commit hash of the binary which recorded the last run replayed.
+	Version string
+	// Divergence is nil if the replay matched the cadaver.
+	Divergence *AutopsyDivergence
+}
+
+// Replay feeds the recorded events back through the player and router state
+// machines, checking after every event that the emitted actions match the
+// recorded ones, and at the start of every recorded round and period that the
+// player reached the recorded state. It stops at the first divergence.
+//
+// Outside of the rounds selected by filter, events are replayed but not checked.
+// As with the other autopsy functions, the router state is not recorded, so a
+// run starting from a crash recovery may diverge early.
+func (a *Autopsy) Replay(filter AutopsyFilter) (res AutopsyReplayResult) {
+	var playerTracer tracer
+	playerTracer.log = serviceLogger{logging.Base()}
+	playerTracer.w = io.Discard
+
+	// after a divergence, let the decoder run to completion
+	var cdv cdvInstance
+	var tr autopsyTrace
+	defer func() {
+		if tr.p != nil {
+			for range tr.p {
+			}
+		}
+		if cdv != nil {
+			drainCdv(cdv)
+		}
+		for cdv := range a.cdvs {
+			drainCdv(cdv)
+		}
+	}()
+
+	for run := 0; ; run++ {
+		var ok bool
+		cdv, ok = <-a.cdvs
+		if !ok {
+			cdv = nil
+			return
+		}
+
+		var router rootRouter
+		var player player
+		first := true
+		for tr = range cdv {
+			if first {
+				first = false
+				res.Runs++
+				res.Version = tr.m.VersionCommitHash
+			} else if checked(filter, tr.x) {
+				diff := playerDiff(tr.x, player)
+				if len(diff) > 0 {
+					res.Divergence = &AutopsyDivergence{Run: run, Round: uint64(tr.x.Round), Period: uint64(tr.x.Period), StateDiff: diff}
+					return
+				}
+			}
+
+			player = tr.x
+			router.root = checkedActor{actor: &player, actorContract: playerContract{}}
+
+			for pair := range tr.p {
+				before := player
+				var replayed []action
+				player, replayed = router.submitTop(&playerTracer, player, pair.e)
+				res.Events++
+				if !pair.aok || !checked(filter, before) {
+					continue
+				}
+				if !actionsEqual(pair.a, replayed) {
+					res.Divergence = &AutopsyDivergence{
+						Run:       run,
+						Round:     uint64(before.Round),
+						Period:    uint64(before.Period),
+						Event:     pair.e.String(),
+						Recorded:  actionStrings(pair.a),
+						Replayed:  actionStrings(replayed),
+						StateDiff: playerDiff(before, player),
+					}
+					return
+				}
+			}
+		}
+		tr = autopsyTrace{}
+	}
+}
+
+func drainCdv(cdv cdvInstance) {
+	for tr := range cdv {
+		for range tr.p {
+		}
+	}
+}
+
+func checked(filter AutopsyFilter, p player) bool {
+	return !filter.Enabled || (p.Round >= filter.First && p.Round <= filter.Last)
+}
+
+func actionsEqual(recorded []action, replayed []action) bool {
+	if len(recorded) != len(replayed) {
+		return false
+	}
+	for i := range recorded {
+		if recorded[i].t() != replayed[i].t() {
+			return false
+		}
+		if !bytes.Equal(protocol.EncodeReflect(recorded[i]), protocol.EncodeReflect(replayed[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func actionStrings(as []action) []string {
+	res := make([]string, len(as))
+	for i, a := range as {
+		res[i] = a.String()
+	}
+	return res
+}
+
+// playerDiff lists the fields of the player state which differ from x to y.
+// For an action divergence, it shows how the event changed the player state.
+func playerDiff(x player, y player) (diff []string) {
+	vx, vy := reflect.ValueOf(x), reflect.ValueOf(y)
+	for i := 0; i < vx.NumField(); i++ {
+		field := vx.Type().Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			// unexported
+			continue
+		}
+		fx, fy := vx.Field(i).Interface(), vy.Field(i).Interface()
+		if bytes.Equal(protocol.EncodeReflect(fx), protocol.EncodeReflect(fy)) {
+			continue
+		}
+		if field.Name == "Pending" {
+			diff = append(diff, fmt.Sprintf("%s: %d pending != %d pending", field.Name, len(x.Pending.Pending), len(y.Pending.Pending)))
+			continue
+		}
+		diff = append(diff, fmt.Sprintf("%s: %+v != %+v", field.Name, fx, fy))
+	}
+	return
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// recordCadaver drives the player through a few synchronous rounds, recording them
+// into a cadaver. It returns the cadaver writer, so that more entries can be appended,
+// and the final player state.
+func recordCadaver(t *testing.T, buf *bytes.Buffer) (cadaver, player) {
+	c := cadaver{overrideSetup: true, out: &cadaverHandle{WriteCloser: nopWriteCloser{buf}}}
+	protocol.EncodeStream(c.out, cadaverMetaEntry)
+	protocol.EncodeStream(c.out, CadaverMetadata{VersionCommitHash: "replay-test"})
+
+	saved := playerTracer.cadaver
+	playerTracer.cadaver = c
+	defer func() {
+		playerTracer.cadaver = saved
+	}()
+
+	player, router, accs, f, ledger := testPlayerSetup()
+	for i := 0; i < 3; i++ {
+		simulateSingleSynchronousRound(t, &router, &player, accs, f, ledger)
+	}
+	return playerTracer.cadaver, player
+}
+
+func replayCadaver(t *testing.T, data []byte) AutopsyReplayResult {
+	var runs int
+	autopsy, err := PrepareAutopsyFromStream(io.NopCloser(bytes.NewReader(data)), func(int, AutopsyBounds) {}, func(n int, err error) {
+		require.NoError(t, err)
+		runs = n
+	})
+	require.NoError(t, err)
+	res := autopsy.Replay(AutopsyFilter{})
+	require.Equal(t, runs, res.Runs)
+	return res
+}
+
+func TestAutopsyReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	_, _ = recordCadaver(t, &buf)
+	res := replayCadaver(t, buf.Bytes())
+	require.Nil(t, res.Divergence)
+	require.Equal(t, 1, res.Runs)
+	require.Equal(t, "replay-test", res.Version)
+	require.NotZero(t, res.Events)
+}
+
+func TestAutopsyReplayActionDivergence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	c, p := recordCadaver(t, &buf)
+	// record an action the player never emits for this event
+	e := makeTimeoutEvent()
+	c.traceInput(p.Round, p.Period, p, e)
+	c.traceOutput(p.Round, p.Period, p, []action{noopAction{}})
+
+	res := replayCadaver(t, buf.Bytes())
+	require.NotNil(t, res.Divergence)
+	require.Equal(t, uint64(p.Round), res.Divergence.Round)
+	require.Equal(t, e.String(), res.Divergence.Event)
+	require.Equal(t, []string{noopAction{}.String()}, res.Divergence.Recorded)
+	require.NotEqual(t, res.Divergence.Recorded, res.Divergence.Replayed)
+	require.Contains(t, res.Divergence.String(), "recorded: noop")
+}
+
+func TestAutopsyReplayStateDivergence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	c, p := recordCadaver(t, &buf)
+	// record a player state the replay does not reach
+	skipped := p
+	skipped.Round += 5
+	skipped.Napping = !p.Napping
+	c.traceInput(skipped.Round, skipped.Period, skipped, makeTimeoutEvent())
+
+	res := replayCadaver(t, buf.Bytes())
+	require.NotNil(t, res.Divergence)
+	require.Empty(t, res.Divergence.Event)
+	require.Equal(t, uint64(skipped.Round), res.Divergence.Round)
+	require.Len(t, res.Divergence.StateDiff, 2)
+	require.Contains(t, res.Divergence.StateDiff[0], "Round: ")
+	require.Contains(t, res.Divergence.StateDiff[1], "Napping: ")
+}
@@ -46,8 +46,9 @@ type Service struct {
 	demux    *demux
 	loopback pseudonode
 
-	log    serviceLogger
-	tracer *tracer
+	log      serviceLogger
+	tracer   *tracer
+	timeline *timelineExporter
 
 	voteVerifier    *AsyncVoteVerifier
 	persistenceLoop *asyncPersistenceLoop
@@ -103,6 +104,13 @@ func MakeService(p Parameters) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Local.AgreementTimelineFile != "" {
+		s.timeline, err = makeTimelineExporter(s.Local)
+		if err != nil {
+			return nil, err
+		}
+		s.tracer.timeline = s.timeline
+	}
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
 
@@ -141,6 +149,7 @@ func (s *Service) Start() {
 		voteVerifier: s.voteVerifier,
 		log:          s.log,
 		monitor:      s.monitor,
+		timeline:     s.timeline,
 	})
 
 	s.persistenceLoop.Start()
@@ -176,6 +185,7 @@ func (s *Service) demuxLoop(ctx context.Context, input chan<- externalEvent, out
 	s.demux.quit()
 	s.loopback.Quit()
 	s.voteVerifier.Quit()
+	s.timeline.close()
 	close(s.done)
 }
 
@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+)
+
+// The timeline tracks, rendered as threads of a single process in Perfetto.
+const (
+	timelineRoundTrack = iota + 1
+	timelineStepTrack
+	timelineProposalTrack
+	timelinePayloadTrack
+	timelineValidationTrack
+	timelineAssemblyTrack
+	// vote tracks are numbered timelineVoteTrack + step
+	timelineVoteTrack
+)
+
+const timelinePid = 1
+
+// A timelineEvent is a single entry of the Chrome trace-event format.
+// Timestamps and durations are in microseconds.
+type timelineEvent struct {
+	Name  string                 `json:"name"`
+	Cat   string                 `json:"cat,omitempty"`
+	Ph    string                 `json:"ph"`
+	Ts    int64                  `json:"ts"`
+	Dur   *int64                 `json:"dur,omitempty"`
+	Scope string                 `json:"s,omitempty"`
+	Pid   int                    `json:"pid"`
+	Tid   int                    `json:"tid"`
+	Args  map[string]interface{} `json:"args,omitempty"`
+}
+
+// A timelineExporter writes per-round agreement spans in the Chrome trace-event
+// JSON array format, which can be loaded into Perfetto or chrome://tracing.
+//
+// The closing bracket of the array is optional in this format, so the file
+// remains loadable even if the node stops without closing the exporter.
+//
+// The events are buffered, and flushed to the file once a round is exported. The file
+// is rotated after the round that gets it past its size limit, so that each file holds
+// whole rounds and loads on its own. The rotated files are named by appending .1, .2, ...
+// to the timeline filename, .1 being the most recent.
+//
+// The exporter is safe for concurrent use, and its methods do nothing on a nil exporter.
+type timelineExporter struct {
+	mu        deadlock.Mutex
+	filename  string
+	sizeLimit uint64
+	archives  int
+	f         io.WriteCloser
+	w         *bufio.Writer
+	size      uint64
+	count     int
+	closed    bool
+}
+
+// makeTimelineExporter creates the timeline file configured by AgreementTimelineFile.
+// A relative filename is taken relative to the data directory. The timeline of a
+// previous run is rotated rather than overwritten.
+func makeTimelineExporter(cfg config.Local) (*timelineExporter, error) {
+	filename := cfg.AgreementTimelineFile
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.GetCurrentVersion().DataDirectory, filename)
+	}
+	e := &timelineExporter{
+		filename:  filename,
+		sizeLimit: cfg.AgreementTimelineSizeLimit,
+		archives:  cfg.AgreementTimelineArchives,
+	}
+	if fi, err := os.Stat(filename); err == nil && fi.Size() > 0 {
+		e.rotateFilesLocked()
+	}
+	err := e.openLocked()
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// newTimelineExporter creates an exporter writing to f, which is never rotated.
+func newTimelineExporter(f io.WriteCloser) *timelineExporter {
+	e := &timelineExporter{}
+	e.startLocked(f)
+	return e
+}
+
+func (e *timelineExporter) openLocked() error {
+	f, err := os.OpenFile(e.filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("agreement: failed to create timeline file %v: %v", e.filename, err)
+	}
+	e.startLocked(f)
+	return nil
+}
+
+// rotateFilesLocked shifts the archived timelines by one, and archives the current timeline as the most recent one.
+func (e *timelineExporter) rotateFilesLocked() {
+	if e.archives <= 0 {
+		os.Remove(e.filename)
+		return
+	}
+	for i := e.archives; i > 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", e.filename, i-1), fmt.Sprintf("%s.%d", e.filename, i))
+	}
+	os.Rename(e.filename, e.filename+".1")
+}
+
+// startLocked starts the trace-event array in f with the names of the tracks.
+func (e *timelineExporter) startLocked(f io.WriteCloser) {
+	e.f = f
+	e.w = bufio.NewWriter(f)
+	e.size = 0
+	e.count = 0
+	e.writeStringLocked("[")
+
+	tracks := map[int]string{
+		timelineRoundTrack:            "round",
+		timelineStepTrack:             "step",
+		timelineProposalTrack:         "proposal",
+		timelinePayloadTrack:          "payload",
+		timelineValidationTrack:       "payload validation",
+		timelineAssemblyTrack:         "block assembly",
+		timelineVoteTrack + int(soft): "soft votes",
+		timelineVoteTrack + int(cert): "cert votes",
+		timelineVoteTrack + int(next): "next 0 votes",
+	}
+	e.writeLocked(timelineEvent{Name: "process_name", Ph: "M", Pid: timelinePid, Args: map[string]interface{}{"name": "agreement"}})
+	tids := make([]int, 0, len(tracks))
+	for tid := range tracks {
+		tids = append(tids, tid)
+	}
+	sort.Ints(tids)
+	for _, tid := range tids {
+		e.writeLocked(timelineEvent{Name: "thread_name", Ph: "M", Pid: timelinePid, Tid: tid, Args: map[string]interface{}{"name": tracks[tid]}})
+		e.writeLocked(timelineEvent{Name: "thread_sort_index", Ph: "M", Pid: timelinePid, Tid: tid, Args: map[string]interface{}{"sort_index": tid}})
+	}
+	e.w.Flush()
+}
+
+// endLocked terminates the trace-event array and closes the file.
+func (e *timelineExporter) endLocked() {
+	e.writeStringLocked("\n]\n")
+	e.w.Flush()
+	e.f.Close()
+}
+
+func timelineStepName(s step) string {
+	switch s {
+	case propose:
+		return "propose"
+	case soft:
+		return "soft"
+	case cert:
+		return "cert"
+	case late:
+		return "late"
+	case redo:
+		return "redo"
+	case down:
+		return "down"
+	default:
+		return fmt.Sprintf("next %d", s-next)
+	}
+}
+
+func timelineTimestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Microsecond)
+}
+
+func (e *timelineExporter) writeLocked(ev timelineEvent) {
+	if e.closed {
+		return
+	}
+	buf, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+	// flush ahead of an event that doesn't fit in the buffer, so that the file never ends in the middle of an event
+	if e.w.Available() < len(buf)+2 {
+		e.w.Flush()
+	}
+	if e.count > 0 {
+		e.writeStringLocked(",")
+	}
+	e.writeStringLocked("\n")
+	n, _ := e.w.Write(buf)
+	e.size += uint64(n)
+	e.count++
+}
+
+func (e *timelineExporter) writeStringLocked(str string) {
+	n, _ := e.w.WriteString(str)
+	e.size += uint64(n)
+}
+
+// flush writes the buffered events to the file, and rotates the file once it reaches its size limit.
+func (e *timelineExporter) flush() {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	e.w.Flush()
+	if e.filename != "" && e.sizeLimit > 0 && e.size >= e.sizeLimit {
+		e.endLocked()
+		e.rotateFilesLocked()
+		if e.openLocked() != nil {
+			e.closed = true
+		}
+	}
+}
+
+// span records a complete event on the given track. Spans with a zero bound are skipped.
+func (e *timelineExporter) span(tid int, name string, start, end time.Time, args map[string]interface{}) {
+	if e == nil || start.IsZero() || end.IsZero() {
+		return
+	}
+	dur := timelineTimestamp(end) - timelineTimestamp(start)
+	if dur < 0 {
+		dur = 0
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.writeLocked(timelineEvent{Name: name, Cat: "agreement", Ph: "X", Ts: timelineTimestamp(start), Dur: &dur, Pid: timelinePid, Tid: tid, Args: args})
+}
+
+// instant records an instant event on the given track.
+func (e *timelineExporter) instant(tid int, name string, at time.Time, args map[string]interface{}) {
+	if e == nil || at.IsZero() {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.writeLocked(timelineEvent{Name: name, Cat: "agreement", Ph: "i", Ts: timelineTimestamp(at), Scope: "t", Pid: timelinePid, Tid: tid, Args: args})
+}
+
+// exportRound writes the spans of a concluded round, and flushes them to the file. The round timing
+// metrics cover period 0, and the timing metrics of the later periods start with the period.
+func (e *timelineExporter) exportRound(m stagedRndTimingMetrics, periods map[period]stagedRndTimingMetrics, concludingStep step, end time.Time) {
+	if e == nil {
+		return
+	}
+	defer e.flush()
+
+	e.span(timelineRoundTrack, fmt.Sprintf("round %d", m.Round), m.LRoundStart, end,
+		map[string]interface{}{"round": m.Round, "concluding step": timelineStepName(concludingStep)})
+
+	// periods run from their start until the next period or the end of the round. The votes
+	// received for a period that the round concluded before are skipped.
+	ps := []period{0}
+	for p, pm := range periods {
+		if p > 0 && !pm.LRoundStart.IsZero() {
+			ps = append(ps, p)
+		}
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i] < ps[j] })
+	periodMetrics := func(p period) stagedRndTimingMetrics {
+		if p == 0 {
+			return m
+		}
+		return periods[p]
+	}
+	for i, p := range ps {
+		periodEnd := end
+		if i+1 < len(ps) {
+			periodEnd = periodMetrics(ps[i+1]).LRoundStart
+		}
+		pm := periodMetrics(p)
+		if p > 0 {
+			e.span(timelineRoundTrack, fmt.Sprintf("period %d", p), pm.LRoundStart, periodEnd,
+				map[string]interface{}{"round": m.Round, "period": uint64(p)})
+		}
+		e.exportPeriod(m.Round, p, pm, periodEnd)
+	}
+}
+
+// exportPeriod writes the spans of a single period from its timing metrics.
+func (e *timelineExporter) exportPeriod(rnd uint64, p period, m stagedRndTimingMetrics, end time.Time) {
+	periodArgs := func() map[string]interface{} {
+		return map[string]interface{}{"round": rnd, "period": uint64(p)}
+	}
+	senderArgs := func(sender string) map[string]interface{} {
+		args := periodArgs()
+		args["sender"] = sender
+		return args
+	}
+
+	// steps run from the time we vote in them until the next step or the end of the period
+	steps := make([]int, 0, len(m.LVotes))
+	for s, v := range m.LVotes {
+		if v.LStart != nil {
+			steps = append(steps, int(s))
+		}
+	}
+	sort.Ints(steps)
+	for i, s := range steps {
+		stepEnd := end
+		if i+1 < len(steps) {
+			stepEnd = *m.LVotes[uint64(steps[i+1])].LStart
+		}
+		e.span(timelineStepTrack, timelineStepName(step(s)), *m.LVotes[uint64(s)].LStart, stepEnd, periodArgs())
+	}
+
+	// proposal arrival: from the start of the period to the winning proposal vote and payload
+	if win := m.LVotes[uint64(propose)].LRWin; win != nil {
+		e.span(timelineProposalTrack, "proposal arrival", m.LRoundStart, win.T, senderArgs(win.Sender))
+	}
+	if m.LPayload.LRWin != nil {
+		e.span(timelinePayloadTrack, "payload arrival", m.LRoundStart, m.LPayload.LRWin.T, senderArgs(m.LPayload.LRWin.Sender))
+	}
+	if v := m.LPayloadValidation.LRWin; v != nil {
+		if m.LPayload.LRWin != nil {
+			e.span(timelineValidationTrack, "payload validation", m.LPayload.LRWin.T, v.T, senderArgs(v.Sender))
+		} else {
+			// payloads verified before they were pipelined have no arrival time
+			e.instant(timelineValidationTrack, "payload validated", v.T, senderArgs(v.Sender))
+		}
+	}
+
+	// votes: from the first verified vote of a step to its threshold
+	for s, v := range m.LVotes {
+		if step(s) == propose || v.LRFirst == nil {
+			continue
+		}
+		tid := timelineVoteTrack + int(s)
+		name := timelineStepName(step(s))
+		if v.LRThresh != nil {
+			e.span(tid, name+" votes", v.LRFirst.T, *v.LRThresh, periodArgs())
+			e.instant(tid, name+" threshold", *v.LRThresh, periodArgs())
+		} else if v.LRLast != nil {
+			e.span(tid, name+" votes", v.LRFirst.T, v.LRLast.T, periodArgs())
+		}
+	}
+}
+
+// close terminates the trace-event array and closes the file.
+func (e *timelineExporter) close() {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	e.closed = true
+	e.endLocked()
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func decodeTimeline(t *testing.T, data []byte) (spans map[string][]timelineEvent, instants map[string][]timelineEvent) {
+	var events []timelineEvent
+	require.NoError(t, json.Unmarshal(data, &events))
+	spans = make(map[string][]timelineEvent)
+	instants = make(map[string][]timelineEvent)
+	for _, ev := range events {
+		switch ev.Ph {
+		case "X":
+			require.NotNil(t, ev.Dur)
+			spans[ev.Name] = append(spans[ev.Name], ev)
+		case "i":
+			instants[ev.Name] = append(instants[ev.Name], ev)
+		}
+	}
+	return
+}
+
+func TestTimelineExportRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	e := newTimelineExporter(nopWriteCloser{&buf})
+
+	start := time.Unix(1000, 0)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	ptr := func(ms int) *time.Time { t := at(ms); return &t }
+	sender := func(ms int) *stagedTimeSender { return &stagedTimeSender{T: at(ms), Sender: "AAAAA"} }
+
+	m := stagedRndTimingMetrics{
+		Round:       7,
+		LRoundStart: start,
+		LVotes: map[uint64]stagedLclMsgTiming{
+			uint64(propose): {LStart: ptr(0), LRWin: sender(300)},
+			uint64(soft):    {LStart: ptr(2000), LRFirst: sender(2100), LRThresh: ptr(2500)},
+			uint64(cert):    {LStart: ptr(2600), LRFirst: sender(2650), LRThresh: ptr(3000)},
+		},
+		LPayload:           stagedLclMsgTiming{LRWin: sender(400)},
+		LPayloadValidation: stagedLclMsgTiming{LRWin: sender(700)},
+	}
+	e.exportRound(m, nil, cert, at(3100))
+	e.span(timelineAssemblyTrack, "block assembly", at(3100), at(3150), nil)
+	e.close()
+	// spans after close are dropped
+	e.span(timelineAssemblyTrack, "block assembly", at(3200), at(3300), nil)
+
+	spans, instants := decodeTimeline(t, buf.Bytes())
+
+	checkSpan := func(name string, tid int, startMs int, durMs int) {
+		require.Len(t, spans[name], 1, name)
+		ev := spans[name][0]
+		require.Equal(t, tid, ev.Tid, name)
+		require.Equal(t, timelineTimestamp(at(startMs)), ev.Ts, name)
+		require.Equal(t, int64(durMs)*1000, *ev.Dur, name)
+	}
+	checkSpan("round 7", timelineRoundTrack, 0, 3100)
+	checkSpan("propose", timelineStepTrack, 0, 2000)
+	checkSpan("soft", timelineStepTrack, 2000, 600)
+	checkSpan("cert", timelineStepTrack, 2600, 500)
+	checkSpan("proposal arrival", timelineProposalTrack, 0, 300)
+	checkSpan("payload arrival", timelinePayloadTrack, 0, 400)
+	checkSpan("payload validation", timelineValidationTrack, 400, 300)
+	checkSpan("soft votes", timelineVoteTrack+int(soft), 2100, 400)
+	checkSpan("cert votes", timelineVoteTrack+int(cert), 2650, 350)
+	checkSpan("block assembly", timelineAssemblyTrack, 3100, 50)
+
+	require.Len(t, instants["soft threshold"], 1)
+	require.Equal(t, timelineTimestamp(at(2500)), instants["soft threshold"][0].Ts)
+	require.Len(t, instants["cert threshold"], 1)
+	require.Equal(t, timelineTimestamp(at(3000)), instants["cert threshold"][0].Ts)
+	require.Equal(t, "cert", spans["round 7"][0].Args["concluding step"])
+}
+
+func TestTimelineExportPeriods(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	e := newTimelineExporter(nopWriteCloser{&buf})
+	header := buf.Len()
+
+	start := time.Unix(1000, 0)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	ptr := func(ms int) *time.Time { t := at(ms); return &t }
+	sender := func(ms int) *stagedTimeSender { return &stagedTimeSender{T: at(ms), Sender: "AAAAA"} }
+
+	m := stagedRndTimingMetrics{
+		Round:       7,
+		LRoundStart: start,
+		LVotes: map[uint64]stagedLclMsgTiming{
+			uint64(propose): {LStart: ptr(0)},
+			uint64(soft):    {LStart: ptr(2000)},
+			uint64(next):    {LStart: ptr(4000), LRFirst: sender(4100), LRThresh: ptr(4500)},
+		},
+	}
+	periods := map[period]stagedRndTimingMetrics{
+		1: {
+			LRoundStart: at(4500),
+			LVotes: map[uint64]stagedLclMsgTiming{
+				uint64(propose): {LRWin: sender(4600)},
+				uint64(soft):    {LStart: ptr(4700), LRFirst: sender(4750), LRThresh: ptr(4800)},
+				uint64(cert):    {LStart: ptr(4900), LRFirst: sender(4950), LRThresh: ptr(5000)},
+			},
+			LPayload: stagedLclMsgTiming{LRWin: sender(4650)},
+		},
+		// the round concluded before entering period 2
+		2: {LVotes: map[uint64]stagedLclMsgTiming{uint64(soft): {LRFirst: sender(5000)}}},
+	}
+
+	// the events are only written once the round is exported
+	e.span(timelineAssemblyTrack, "block assembly", at(0), at(100), nil)
+	require.Equal(t, header, buf.Len())
+	e.exportRound(m, periods, cert, at(5100))
+	require.Greater(t, buf.Len(), header)
+	e.close()
+
+	spans, _ := decodeTimeline(t, buf.Bytes())
+	checkSpan := func(name string, i int, tid int, startMs int, durMs int, p uint64) {
+		require.Greater(t, len(spans[name]), i, name)
+		ev := spans[name][i]
+		require.Equal(t, tid, ev.Tid, name)
+		require.Equal(t, timelineTimestamp(at(startMs)), ev.Ts, name)
+		require.Equal(t, int64(durMs)*1000, *ev.Dur, name)
+		require.EqualValues(t, p, ev.Args["period"], name)
+	}
+	require.Len(t, spans["round 7"], 1)
+	require.Len(t, spans["period 1"], 1)
+	require.Empty(t, spans["period 2"])
+	checkSpan("period 1", 0, timelineRoundTrack, 4500, 600, 1)
+	checkSpan("propose", 0, timelineStepTrack, 0, 2000, 0)
+	require.Len(t, spans["soft"], 2)
+	checkSpan("soft", 0, timelineStepTrack, 2000, 2000, 0)
+	checkSpan("next 0", 0, timelineStepTrack, 4000, 500, 0)
+	checkSpan("next 0 votes", 0, timelineVoteTrack+int(next), 4100, 400, 0)
+	checkSpan("proposal arrival", 0, timelineProposalTrack, 4500, 100, 1)
+	checkSpan("payload arrival", 0, timelinePayloadTrack, 4500, 150, 1)
+	checkSpan("soft", 1, timelineStepTrack, 4700, 200, 1)
+	checkSpan("cert", 0, timelineStepTrack, 4900, 200, 1)
+	checkSpan("soft votes", 0, timelineVoteTrack+int(soft), 4750, 50, 1)
+	checkSpan("cert votes", 0, timelineVoteTrack+int(cert), 4950, 50, 1)
+	require.Len(t, spans["block assembly"], 1)
+}
+
+func TestTimelineNilExporter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var e *timelineExporter
+	e.span(timelineRoundTrack, "round", time.Now(), time.Now(), nil)
+	e.instant(timelineRoundTrack, "threshold", time.Now(), nil)
+	e.exportRound(stagedRndTimingMetrics{}, nil, soft, time.Now())
+	e.close()
+}
+
+func TestTimelineRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.AgreementTimelineFile = filepath.Join(t.TempDir(), "timeline.json")
+	cfg.AgreementTimelineSizeLimit = 1
+	cfg.AgreementTimelineArchives = 2
+	filename := cfg.AgreementTimelineFile
+
+	// the timeline of a previous run is rotated
+	require.NoError(t, os.WriteFile(filename, []byte("[]"), 0644))
+	e, err := makeTimelineExporter(cfg)
+	require.NoError(t, err)
+	data, err := os.ReadFile(filename + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(data))
+
+	start := time.Unix(1000, 0)
+	for rnd := uint64(1); rnd <= 3; rnd++ {
+		m := stagedRndTimingMetrics{Round: rnd, LRoundStart: start}
+		e.exportRound(m, nil, cert, start.Add(time.Second))
+	}
+	e.close()
+
+	// each round got past the size limit, so each rotated file holds a whole round, and the
+	// current file is empty. Only the configured number of archives is kept.
+	_, err = os.Stat(filename + ".3")
+	require.True(t, os.IsNotExist(err))
+	for i, name := range []string{"round 2", "round 3"} {
+		data, err := os.ReadFile(fmt.Sprintf("%s.%d", filename, 2-i))
+		require.NoError(t, err)
+		spans, _ := decodeTimeline(t, data)
+		require.Len(t, spans, 1)
+		require.Len(t, spans[name], 1)
+	}
+	data, err = os.ReadFile(filename)
+	require.NoError(t, err)
+	spans, _ := decodeTimeline(t, data)
+	require.Empty(t, spans)
+}
+
+func TestAgreementTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 2
+	numRounds := 3
+	_, baseLedger, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	defer cleanupFn()
+
+	cfg := config.GetDefaultLocal()
+	cfg.AgreementTimelineFile = filepath.Join(t.TempDir(), "timeline.json")
+	filename := cfg.AgreementTimelineFile
+	var err error
+	services[0].timeline, err = makeTimelineExporter(cfg)
+	require.NoError(t, err)
+	services[0].tracer.timeline = services[0].timeline
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+	for j := 0; j < numRounds; j++ {
+		version, _ := baseLedger.ConsensusVersion(ParamsRound(baseLedger.NextRound() + round(j)))
+		zeroes = runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, version))
+	}
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	spans, instants := decodeTimeline(t, data)
+
+	// the first round starts before the timing is initialized, so it has no round span
+	require.Empty(t, spans["round 1"])
+	require.Len(t, spans["round 2"], 1)
+	require.Len(t, spans["round 3"], 1)
+	require.NotEmpty(t, spans["block assembly"])
+	require.NotEmpty(t, spans["proposal arrival"])
+	require.NotEmpty(t, append(spans["payload validation"], instants["payload validated"]...))
+	require.NotEmpty(t, spans["soft votes"])
+	require.NotEmpty(t, instants["soft threshold"])
+	require.NotEmpty(t, instants["cert threshold"])
+}
@@ -70,6 +70,9 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+
+	// if timeline is not nil, write the timing data of each round as trace events
+	timeline *timelineExporter
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -116,14 +119,14 @@ func (t *tracer) resetTimingWithPipeline(target round) {
 // when unrecoverable) just make a new timinginfogen when not already set.
 func (t *tracer) timeR() *timingInfoGenerator {
 	if t.tR == nil {
-		t.tR = makeTimingInfoGen(t.timingReports, t.log)
+		t.tR = makeTimingInfoGen(t.timingReports || t.timeline != nil, t.timeline != nil, t.log)
 	}
 	return t.tR
 }
 
 func (t *tracer) timeRPlus1() *timingInfoGenerator {
 	if t.tRPlus1 == nil {
-		t.tRPlus1 = makeTimingInfoGen(t.timingReports, t.log)
+		t.tRPlus1 = makeTimingInfoGen(t.timingReports || t.timeline != nil, t.timeline != nil, t.log)
 	}
 	return t.tRPlus1
 }
@@ -229,6 +232,9 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 		ObjectPeriod: uint64(target),
 	}
 	t.log.with(logEvent).Infof("entering non-zero period (%v - %v) with value %v", p.Period, target, prop)
+	if t.tR != nil {
+		t.tR.StartPeriod(target)
+	}
 
 	if !t.verboseReports {
 		return
@@ -252,6 +258,12 @@ func (t *tracer) logRoundStart(p player, target round) {
 		// Generate a distinct event than blockAccepted for convenience (this one is generated by player, other by service)
 		t.log.Metrics(telemetryspec.Agreement, timeInfo, nil)
 	}
+	if t.tR != nil && t.timeline != nil {
+		// the timing of the first round after startup is not started, so take the round from the player
+		timeInfo := t.tR.i
+		timeInfo.Round = uint64(p.Round)
+		t.timeline.exportRound(timeInfo, t.tR.laterPeriods(), p.Step, time.Now())
+	}
 
 }
 
@@ -26,28 +26,77 @@ import (
 // We call all of the following messages post-filtering, such that
 // any event we log is relevant to the current agreement state.
 type timingInfoGenerator struct {
-	enabled          bool
-	i                stagedRndTimingMetrics
+	enabled bool
+	i       stagedRndTimingMetrics
+	p0      periodTimingInfo
+	// the timing of the periods after period 0 is only collected for the timeline,
+	// it is not part of the round timing metrics.
+	periods map[period]*periodTimingInfo
+	log     serviceLogger
+}
+
+// periodTimingInfo tracks the timing metrics of a single period, along with the arrival
+// of its proposals and payloads until its winner is known.
+type periodTimingInfo struct {
+	i                *stagedRndTimingMetrics
 	iForPV           map[proposalValue]*stagedTimeSender // track winner
 	iForPP           map[proposalValue]*stagedTimeSender // track payload for winner
 	iForPPValidation map[proposalValue]*stagedTimeSender // track payload for winner
 	winner           proposalValue
-	log              serviceLogger
 }
 
-func makeTimingInfoGen(enabled bool, log serviceLogger) *timingInfoGenerator {
+func makePeriodTimingInfo(i *stagedRndTimingMetrics) periodTimingInfo {
+	i.LVotes = make(map[uint64]stagedLclMsgTiming)
+	return periodTimingInfo{
+		i:                i,
+		iForPP:           make(map[proposalValue]*stagedTimeSender),
+		iForPPValidation: make(map[proposalValue]*stagedTimeSender),
+		iForPV:           make(map[proposalValue]*stagedTimeSender),
+	}
+}
+
+func makeTimingInfoGen(enabled bool, allPeriods bool, log serviceLogger) *timingInfoGenerator {
 	t := new(timingInfoGenerator)
 	t.enabled = enabled
 	t.log = log
 	if enabled {
-		t.iForPP = make(map[proposalValue]*stagedTimeSender)
-		t.iForPPValidation = make(map[proposalValue]*stagedTimeSender)
-		t.iForPV = make(map[proposalValue]*stagedTimeSender)
-		t.i.LVotes = make(map[uint64]stagedLclMsgTiming)
+		t.p0 = makePeriodTimingInfo(&t.i)
+		if allPeriods {
+			t.periods = make(map[period]*periodTimingInfo)
+		}
 	}
 	return t
 }
 
+// periodTiming returns the timing info of period p, or nil if it is not collected.
+func (tG *timingInfoGenerator) periodTiming(p period) *periodTimingInfo {
+	if !tG.enabled {
+		return nil
+	}
+	if p == 0 {
+		return &tG.p0
+	}
+	if tG.periods == nil {
+		return nil
+	}
+	pt, ok := tG.periods[p]
+	if !ok {
+		pt = new(periodTimingInfo)
+		*pt = makePeriodTimingInfo(new(stagedRndTimingMetrics))
+		tG.periods[p] = pt
+	}
+	return pt
+}
+
+// laterPeriods returns the timing metrics of the periods after period 0 that were collected.
+func (tG *timingInfoGenerator) laterPeriods() map[period]stagedRndTimingMetrics {
+	periods := make(map[period]stagedRndTimingMetrics, len(tG.periods))
+	for p, pt := range tG.periods {
+		periods[p] = *pt.i
+	}
+	return periods
+}
+
 // StartRound should be called before logging other relevant items.
 func (tG *timingInfoGenerator) StartRound(r round) {
 	if !tG.enabled {
@@ -57,42 +106,53 @@ func (tG *timingInfoGenerator) StartRound(r round) {
 	tG.i.LRoundStart = time.Now()
 }
 
+// StartPeriod records the start of a period after period 0, as the LRoundStart of its timing metrics.
+func (tG *timingInfoGenerator) StartPeriod(p period) {
+	pt := tG.periodTiming(p)
+	if p == 0 || pt == nil {
+		return
+	}
+	pt.i.LRoundStart = time.Now()
+}
+
 // RecStep records the "beginning" of a step, corresponding to the time when
 // we send the corresponding vote for that step.
 func (tG *timingInfoGenerator) RecStep(p period, s step, winner proposalValue) {
-	if !tG.enabled || s > next || p > 0 {
+	pt := tG.periodTiming(p)
+	if pt == nil || s > next {
 		return
 	}
-	if tG.i.LVotes == nil {
+	if pt.i.LVotes == nil {
 		// if this happens, then .enabled is somehow inconsistent.
 		// This should never happen, but for now make it fail less badly.
 		tG.log.Warn("agreement: trace time metrics not initialized properly; tried to write nil map")
 		return
 	}
 	t := time.Now()
-	localInfo := tG.i.LVotes[uint64(s)]
+	localInfo := pt.i.LVotes[uint64(s)]
 	localInfo.LStart = &t
 
 	switch s {
 	case soft:
 		// write timing for winning proposal
-		proposeInfo := tG.i.LVotes[0]
-		proposeInfo.LRWin = tG.iForPV[winner] // winner should always be non bottom for soft
-		tG.i.LVotes[0] = proposeInfo
+		proposeInfo := pt.i.LVotes[0]
+		proposeInfo.LRWin = pt.iForPV[winner] // winner should always be non bottom for soft
+		pt.i.LVotes[0] = proposeInfo
 		// write timing for winning payload (or, if not yet seen, cache the winner)
-		tG.i.LPayload.LRWin = tG.iForPP[winner]
-		tG.i.LPayloadValidation.LRWin = tG.iForPPValidation[winner]
-		tG.winner = winner
+		pt.i.LPayload.LRWin = pt.iForPP[winner]
+		pt.i.LPayloadValidation.LRWin = pt.iForPPValidation[winner]
+		pt.winner = winner
 	}
-	tG.i.LVotes[uint64(s)] = localInfo
+	pt.i.LVotes[uint64(s)] = localInfo
 }
 
 // note: we currently *do* log proposal votes received after the freeze timer (2\lambda) (but before cert)
 func (tG *timingInfoGenerator) RecVoteReceived(v vote) {
-	if !tG.enabled || v.R.Step > next || v.R.Period > 0 {
+	pt := tG.periodTiming(v.R.Period)
+	if pt == nil || v.R.Step > next {
 		return
 	}
-	if tG.iForPV == nil || tG.i.LVotes == nil {
+	if pt.iForPV == nil || pt.i.LVotes == nil {
 		tG.log.Warn("agreement: trace time metrics not initialized properly; tried to write nil map")
 		return
 	}
@@ -101,39 +161,41 @@ func (tG *timingInfoGenerator) RecVoteReceived(v vote) {
 		Sender: truncate(v.R.Sender),
 	}
 
-	localInfo := tG.i.LVotes[uint64(v.R.Step)]
+	localInfo := pt.i.LVotes[uint64(v.R.Step)]
 	if localInfo.LRFirst == nil {
 		localInfo.LRFirst = x
 	}
 	localInfo.LRLast = x
-	tG.i.LVotes[uint64(v.R.Step)] = localInfo
+	pt.i.LVotes[uint64(v.R.Step)] = localInfo
 
 	switch v.R.Step {
 	case propose:
 		// cache timing so we can pull it out for winning proposal
-		tG.iForPV[v.R.Proposal] = x
+		pt.iForPV[v.R.Proposal] = x
 	}
 }
 
 func (tG *timingInfoGenerator) RecThreshold(e thresholdEvent) {
-	if !tG.enabled || e.Step > next || e.Period > 0 {
+	pt := tG.periodTiming(e.Period)
+	if pt == nil || e.Step > next {
 		return
 	}
-	if tG.i.LVotes == nil {
+	if pt.i.LVotes == nil {
 		tG.log.Warn("agreement: trace time metrics not initialized properly; tried to write nil map")
 		return
 	}
 	t := time.Now()
-	localInfo := tG.i.LVotes[uint64(e.Step)]
+	localInfo := pt.i.LVotes[uint64(e.Step)]
 	localInfo.LRThresh = &t
-	tG.i.LVotes[uint64(e.Step)] = localInfo
+	pt.i.LVotes[uint64(e.Step)] = localInfo
 }
 
 func (tG *timingInfoGenerator) RecPayload(p period, s step, pV proposalValue) {
-	if !tG.enabled || s > next || p > 0 {
+	pt := tG.periodTiming(p)
+	if pt == nil || s > next {
 		return
 	}
-	if tG.iForPP == nil {
+	if pt.iForPP == nil {
 		tG.log.Warn("agreement: trace time metrics not initialized properly; tried to write nil payload map")
 		return
 	}
@@ -141,21 +203,22 @@ func (tG *timingInfoGenerator) RecPayload(p period, s step, pV proposalValue) {
 		T:      time.Now(),
 		Sender: truncate(pV.OriginalProposer),
 	}
-	tG.iForPP[pV] = x // cache timing so we can pull it out for winning proposal
-	if tG.i.LPayload.LRFirst == nil {
-		tG.i.LPayload.LRFirst = x
+	pt.iForPP[pV] = x // cache timing so we can pull it out for winning proposal
+	if pt.i.LPayload.LRFirst == nil {
+		pt.i.LPayload.LRFirst = x
 	}
-	if pV == tG.winner { // pV should never be bottom
-		tG.i.LPayload.LRWin = x
+	if pV == pt.winner { // pV should never be bottom
+		pt.i.LPayload.LRWin = x
 	}
-	tG.i.LPayload.LRLast = x
+	pt.i.LPayload.LRLast = x
 }
 
 func (tG *timingInfoGenerator) RecPayloadValidation(p period, s step, pV proposalValue) {
-	if !tG.enabled || s > next || p > 0 {
+	pt := tG.periodTiming(p)
+	if pt == nil || s > next {
 		return
 	}
-	if tG.iForPPValidation == nil {
+	if pt.iForPPValidation == nil {
 		tG.log.Warn("agreement: trace time metrics not initialized properly; tried to write nil payload map")
 		return
 	}
@@ -163,14 +226,14 @@ func (tG *timingInfoGenerator) RecPayloadValidation(p period, s step, pV proposa
 		T:      time.Now(),
 		Sender: truncate(pV.OriginalProposer),
 	}
-	tG.iForPPValidation[pV] = x // cache timing so we can pull it out for winning proposal
-	if tG.i.LPayloadValidation.LRFirst == nil {
-		tG.i.LPayloadValidation.LRFirst = x
+	pt.iForPPValidation[pV] = x // cache timing so we can pull it out for winning proposal
+	if pt.i.LPayloadValidation.LRFirst == nil {
+		pt.i.LPayloadValidation.LRFirst = x
 	}
-	if pV == tG.winner { // pV should never be bottom
-		tG.i.LPayloadValidation.LRWin = x
+	if pV == pt.winner { // pV should never be bottom
+		pt.i.LPayloadValidation.LRWin = x
 	}
-	tG.i.LPayloadValidation.LRLast = x
+	pt.i.LPayloadValidation.LRLast = x
 }
 
 func (tG *timingInfoGenerator) RecBlockAssembled() {
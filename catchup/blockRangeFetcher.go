@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+var errBlockNotInRange = errors.New("the peer did not return the block in its block range")
+
+// blockRangeDownload is the download of a range of consecutive blocks from a single peer, which is shared
+// by the pipelined fetches of the rounds it covers.
+type blockRangeDownload struct {
+	done chan struct{}
+
+	// set before done is closed
+	psp    *peerSelectorPeer
+	blocks []rpcs.EncodedBlockCert
+	err    error
+
+	// taken is the number of rounds of the range whose block was taken, guarded by the blockRangeFetcher lock.
+	taken uint64
+}
+
+// blockRangeFetcher splits the rounds of a pipelined fetch into ranges of rangeSize rounds, starting at
+// the first round of the fetch, and downloads each range once from a peer picked by its throughput.
+type blockRangeFetcher struct {
+	s            *Service
+	peerSelector *peerSelector
+	from         basics.Round
+	rangeSize    uint64
+
+	mu        deadlock.Mutex
+	downloads map[basics.Round]*blockRangeDownload
+}
+
+// makeBlockRangeFetcher returns a fetcher of the ranges of rounds from round from, or nil if the service
+// is not configured to download blocks in ranges.
+func (s *Service) makeBlockRangeFetcher(from basics.Round, peerSelector *peerSelector) *blockRangeFetcher {
+	rangeSize := s.cfg.CatchupBlockRangeSize
+	if rangeSize > rpcs.MaxBlockRangeCount {
+		rangeSize = rpcs.MaxBlockRangeCount
+	}
+	if rangeSize <= 1 {
+		return nil
+	}
+	return &blockRangeFetcher{
+		s:            s,
+		peerSelector: peerSelector,
+		from:         from,
+		rangeSize:    rangeSize,
+		downloads:    make(map[basics.Round]*blockRangeDownload),
+	}
+}
+
+// take returns the block and certificate of round r, along with the peer they were downloaded from. The
+// first caller for a range downloads it, while callers for its other rounds wait for that download.
+func (rf *blockRangeFetcher) take(r basics.Round) (blk *bookkeeping.Block, cert *agreement.Certificate, psp *peerSelectorPeer, err error) {
+	start := r - basics.Round(uint64(r-rf.from)%rf.rangeSize)
+
+	rf.mu.Lock()
+	download, has := rf.downloads[start]
+	if !has {
+		download = &blockRangeDownload{done: make(chan struct{})}
+		rf.downloads[start] = download
+	}
+	rf.mu.Unlock()
+
+	if !has {
+		rf.download(start, download)
+	} else {
+		select {
+		case <-download.done:
+		case <-rf.s.ctx.Done():
+			return nil, nil, nil, rf.s.ctx.Err()
+		}
+	}
+
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	download.taken++
+	if download.taken == rf.rangeSize {
+		delete(rf.downloads, start)
+	}
+	if download.err != nil {
+		return nil, nil, download.psp, download.err
+	}
+	idx := int(r - start)
+	if idx >= len(download.blocks) {
+		return nil, nil, download.psp, errBlockNotInRange
+	}
+	// release the block as it's taken, rather than once the whole range is.
+	entry := download.blocks[idx]
+	download.blocks[idx] = rpcs.EncodedBlockCert{}
+	return &entry.Block, &entry.Certificate, download.psp, nil
+}
+
+// download downloads the range starting at round start, and ranks the peer it was downloaded from.
+func (rf *blockRangeFetcher) download(start basics.Round, download *blockRangeDownload) {
+	defer close(download.done)
+
+	count := rf.rangeSize
+	if dontSyncRound := basics.Round(rf.s.GetDisableSyncRound()); dontSyncRound != 0 && start+basics.Round(count) > dontSyncRound {
+		count = uint64(dontSyncRound.SubSaturate(start))
+	}
+
+	psp, err := rf.peerSelector.getNextRangePeer()
+	if err != nil {
+		download.err = err
+		return
+	}
+	download.psp = psp
+
+	fetcher := makeUniversalBlockFetcher(rf.s.log, rf.s.net, rf.s.cfg)
+	blocks, downloadDuration, err := fetcher.fetchBlockRange(rf.s.ctx, start, count, psp.Peer)
+	if err != nil {
+		rf.s.log.Debugf("blockRangeFetcher: could not fetch %d blocks from %d: %v", count, start, err)
+		rf.peerSelector.rangeDownloadComplete(psp, 0, downloadDuration)
+		rf.peerSelector.rankPeer(psp, peerRankDownloadFailed)
+		download.err = err
+		return
+	}
+	rf.peerSelector.rangeDownloadComplete(psp, len(blocks), downloadDuration)
+	// rank the peer by its download time per block, as blocks fetched on their own are.
+	peerRank := rf.peerSelector.peerDownloadDurationToRank(psp, downloadDuration/time.Duration(len(blocks)))
+	r1, r2 := rf.peerSelector.rankPeer(psp, peerRank)
+	rf.s.log.Debugf("blockRangeFetcher: fetched %d blocks from %d, ranked peer with %d from %d to %d", len(blocks), start, peerRank, r1, r2)
+	download.blocks = blocks
+}
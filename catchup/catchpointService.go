@@ -19,6 +19,9 @@ package catchup
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -33,6 +36,8 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 const (
@@ -65,6 +70,49 @@ type CatchpointCatchupStats struct {
 	StartTime          time.Time
 }
 
+// CatchpointCatchupSource describes local data the catchpoint catchup service would use instead of
+// retrieving it from the network. An empty source means that everything is retrieved from the network.
+type CatchpointCatchupSource struct {
+	// CatchpointFile is the path of a local catchpoint file ( either tar or tar.gz ) matching the catchpoint label.
+	CatchpointFile string
+	// DeltaFiles are the paths of local incremental catchpoint files, applied in order on top of CatchpointFile. The first one applies to
+	// CatchpointFile, each of the others to the one before it, and the last one has to match the catchpoint label.
+	DeltaFiles []string
+	// BlocksDir is the path of a local directory holding msgpack encoded blocks, one file per round named after the round number,
+	// in the format served by the block service or by the /v2/blocks/{round}?format=msgpack endpoint.
+	// Blocks missing from the directory are retrieved from the network.
+	BlocksDir string
+}
+
+// Validate verifies that the local catchpoint file, incremental catchpoint files and blocks directory exist.
+func (source CatchpointCatchupSource) Validate() error {
+	if source.CatchpointFile == "" && len(source.DeltaFiles) > 0 {
+		return fmt.Errorf("incremental catchpoint files require a catchpoint file to apply to")
+	}
+	for _, file := range append([]string{source.CatchpointFile}, source.DeltaFiles...) {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("unable to access catchpoint file : %w", err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("catchpoint file %s is a directory", file)
+		}
+	}
+	if source.BlocksDir != "" {
+		info, err := os.Stat(source.BlocksDir)
+		if err != nil {
+			return fmt.Errorf("unable to access blocks directory : %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("blocks directory %s is not a directory", source.BlocksDir)
+		}
+	}
+	return nil
+}
+
 // CatchpointCatchupService represents the catchpoint catchup service.
 type CatchpointCatchupService struct {
 	// stats is the statistics object, updated async while downloading the ledger
@@ -99,6 +147,8 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector *peerSelector
+	// source is the local data used instead of retrieving it from the network.
+	source CatchpointCatchupSource
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -129,10 +179,13 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 }
 
 // MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode
-func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
+func MakeNewCatchpointCatchupService(catchpoint string, source CatchpointCatchupSource, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
 	if catchpoint == "" {
 		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: catchpoint is invalid")
 	}
+	if err = source.Validate(); err != nil {
+		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: %w", err)
+	}
 	service = &CatchpointCatchupService{
 		stats: CatchpointCatchupStats{
 			CatchpointLabel: catchpoint,
@@ -146,6 +199,7 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		net:            net,
 		ledger:         accessor.Ledger(),
 		config:         cfg,
+		source:         source,
 	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
@@ -242,6 +296,11 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	cs.stats.CatchpointLabel = label
 	cs.statsMu.Unlock()
 
+	cs.source.CatchpointFile, cs.source.DeltaFiles, cs.source.BlocksDir, err = cs.ledgerAccessor.GetSource(ctx)
+	if err != nil {
+		return err
+	}
+
 	cs.stage, err = cs.ledgerAccessor.GetState(ctx)
 	if err != nil {
 		return err
@@ -259,6 +318,10 @@ func (cs *CatchpointCatchupService) processStageInactive() (err error) {
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint label : %v", err))
 	}
+	err = cs.ledgerAccessor.SetSource(cs.ctx, cs.source.CatchpointFile, cs.source.DeltaFiles, cs.source.BlocksDir)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageInactive failed to set the catchpoint catchup source : %v", err))
+	}
 	err = cs.updateStage(ledger.CatchpointCatchupStateLedgerDownload)
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to update stage : %v", err))
@@ -281,9 +344,13 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to parse label : %v", err0))
 	}
 
+	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	if cs.source.CatchpointFile != "" {
+		return cs.processStageLedgerLoad(ledgerFetcher)
+	}
+
 	// download balances file.
 	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
-	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	attemptsCount := 0
 
 	for {
@@ -339,6 +406,42 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 	return nil
 }
 
+// processStageLedgerLoad is the alternative to downloading the ledger in processStageLedgerDownload, loading it from the local catchpoint file instead.
+// Since retrying wouldn't yield a different outcome for the same file, any failure aborts the catchup.
+func (cs *CatchpointCatchupService) processStageLedgerLoad(ledgerFetcher *ledgerFetcher) (err error) {
+	err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to reset staging balances : %v", err))
+	}
+	start := time.Now()
+	err = ledgerFetcher.loadLedger(cs.ctx, cs.source.CatchpointFile, cs.source.DeltaFiles...)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to load catchpoint file %s : %v", cs.source.CatchpointFile, err))
+	}
+	cs.log.Infof("ledger loaded from %s and %d incremental catchpoint files in %d seconds", cs.source.CatchpointFile, len(cs.source.DeltaFiles), time.Since(start)/time.Second)
+	start = time.Now()
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to build the merkle trie for catchpoint file %s : %v", cs.source.CatchpointFile, err))
+	}
+	cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+
+	err = cs.updateStage(ledger.CatchpointCatchupStateLatestBlockDownload)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to update stage to CatchpointCatchupStateLatestBlockDownload : %v", err))
+	}
+	return nil
+}
+
 // updateVerifiedCounts update the user's statistics for the given verified hashes
 func (cs *CatchpointCatchupService) updateVerifiedCounts(accountCount, kvCount uint64) {
 	cs.statsMu.Lock()
@@ -641,7 +744,18 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 // fetchBlock uses the internal peer selector blocksDownloadPeerSelector to pick a peer and then attempt to fetch the block requested from that peer.
 // The method return stop=true if the caller should exit the current operation
 // If the method return a nil block, the caller is expected to retry the operation, increasing the retry counter as needed.
+// Blocks found in the local blocks directory are returned with a nil peer.
 func (cs *CatchpointCatchupService) fetchBlock(round basics.Round, retryCount uint64) (blk *bookkeeping.Block, downloadDuration time.Duration, psp *peerSelectorPeer, stop bool, err error) {
+	if cs.source.BlocksDir != "" {
+		blk, err = loadLocalBlock(cs.source.BlocksDir, round)
+		if err == nil {
+			return blk, time.Duration(0), nil, false, nil
+		}
+		if !os.IsNotExist(err) {
+			cs.log.Warnf("fetchBlock: unable to load block %d from %s, retrieving it from the network instead : %v", round, cs.source.BlocksDir, err)
+		}
+	}
+
 	psp, err = cs.blocksDownloadPeerSelector.getNextPeer()
 	if err != nil {
 		if err == errPeerSelectorNoPeerPoolsAvailable {
@@ -683,6 +797,24 @@ func (cs *CatchpointCatchupService) fetchBlock(round basics.Round, retryCount ui
 	return blk, downloadDuration, psp, false, nil
 }
 
+// loadLocalBlock reads the block of the given round from the blocks directory.
+// The returned error satisfies os.IsNotExist when the directory has no file for that round.
+func loadLocalBlock(blocksDir string, round basics.Round) (*bookkeeping.Block, error) {
+	data, err := os.ReadFile(filepath.Join(blocksDir, strconv.FormatUint(uint64(round), 10)))
+	if err != nil {
+		return nil, err
+	}
+	var decoded rpcs.EncodedBlockCert
+	err = protocol.Decode(data, &decoded)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Block.Round() != round {
+		return nil, fmt.Errorf("block file for round %d holds block %d", round, decoded.Block.Round())
+	}
+	return &decoded.Block, nil
+}
+
 // processStageLedgerDownload is the fifth catchpoint catchup stage. It completes the catchup process, swap the new tables and restart the node functionality.
 func (cs *CatchpointCatchupService) processStageSwitch() (err error) {
 	err = cs.ledgerAccessor.CompleteCatchup(cs.ctx)
@@ -18,6 +18,8 @@ package catchup
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -89,3 +92,52 @@ func TestCatchpointServicePeerRank(t *testing.T) {
 	err := cs.processStageLatestBlockDownload()
 	require.NoError(t, err)
 }
+
+// TestCatchpointServiceLocalBlocks ensures CatchpointService reads blocks from the local blocks directory
+func TestCatchpointServiceLocalBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	blocksDir := t.TempDir()
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = 5
+	blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+	require.NoError(t, os.WriteFile(filepath.Join(blocksDir, "5"), protocol.Encode(&rpcs.EncodedBlockCert{Block: blk}), 0644))
+	// a file holding a block of another round
+	require.NoError(t, os.WriteFile(filepath.Join(blocksDir, "6"), protocol.Encode(&rpcs.EncodedBlockCert{Block: blk}), 0644))
+
+	l := catchpointCatchupLedger{}
+	a := catchpointCatchupAccessorMock{l: &l}
+	cs := CatchpointCatchupService{ledgerAccessor: &a, ledger: &l, source: CatchpointCatchupSource{BlocksDir: blocksDir}}
+	cs.initDownloadPeerSelector()
+
+	fetched, _, psp, stop, err := cs.fetchBlock(5, 1)
+	require.NoError(t, err)
+	require.False(t, stop)
+	require.Nil(t, psp)
+	require.Equal(t, blk.Hash(), fetched.Hash())
+
+	_, err = loadLocalBlock(blocksDir, 6)
+	require.Error(t, err)
+	require.False(t, os.IsNotExist(err))
+
+	_, err = loadLocalBlock(blocksDir, 7)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestCatchpointCatchupSourceValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "catchpoint.tar")
+	require.NoError(t, os.WriteFile(file, nil, 0644))
+
+	require.NoError(t, CatchpointCatchupSource{}.Validate())
+	require.NoError(t, CatchpointCatchupSource{CatchpointFile: file, BlocksDir: dir}.Validate())
+	require.Error(t, CatchpointCatchupSource{CatchpointFile: filepath.Join(dir, "missing.tar")}.Validate())
+	require.Error(t, CatchpointCatchupSource{CatchpointFile: dir}.Validate())
+	require.Error(t, CatchpointCatchupSource{BlocksDir: file}.Validate())
+	require.Error(t, CatchpointCatchupSource{BlocksDir: filepath.Join(dir, "missing")}.Validate())
+	require.NoError(t, CatchpointCatchupSource{CatchpointFile: file, DeltaFiles: []string{file}}.Validate())
+	require.Error(t, CatchpointCatchupSource{DeltaFiles: []string{file}}.Validate())
+	require.Error(t, CatchpointCatchupSource{CatchpointFile: file, DeltaFiles: []string{filepath.Join(dir, "missing.tar")}}.Validate())
+}
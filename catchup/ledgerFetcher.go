@@ -18,11 +18,14 @@ package catchup
 
 import (
 	"archive/tar"
+	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path"
 	"strconv"
 	"time"
@@ -138,8 +141,49 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 
 	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
 	defer watchdogReader.Close()
-	tarReader := tar.NewReader(watchdogReader)
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
+	return lf.processLedgerStream(ctx, watchdogReader, &downloadProgress, watchdogReader.Reset)
+}
+
+// loadLedger loads the catchpoint file at the given path into the staging balances, followed by the
+// incremental catchpoint files that apply on top of it, if any. The files could be either plain tar
+// files or gzip compressed ones, as produced by the catchpoint generation and by catchpointdump.
+func (lf *ledgerFetcher) loadLedger(ctx context.Context, filename string, deltaFilenames ...string) error {
+	var downloadProgress ledger.CatchpointCatchupAccessorProgress
+	for _, name := range append([]string{filename}, deltaFilenames...) {
+		err := lf.loadLedgerFile(ctx, name, &downloadProgress)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lf *ledgerFetcher) loadLedgerFile(ctx context.Context, filename string, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	bufReader := bufio.NewReader(file)
+	var source io.Reader = bufReader
+	if magic, err := bufReader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(bufReader)
+		if err != nil {
+			return fmt.Errorf("loadLedger : unable to decompress catchpoint file %s : %w", filename, err)
+		}
+		defer gzipReader.Close()
+		source = gzipReader
+	}
+	return lf.processLedgerStream(ctx, source, downloadProgress, nil)
+}
+
+// processLedgerStream reads the catchpoint tar stream from source and writes each of its chunks into the
+// staging balances, tracking them in downloadProgress. The optional afterChunk function is called after
+// each chunk is processed; an io.EOF returned from it ends the stream.
+func (lf *ledgerFetcher) processLedgerStream(ctx context.Context, source io.Reader, downloadProgress *ledger.CatchpointCatchupAccessorProgress, afterChunk func() error) error {
+	tarReader := tar.NewReader(source)
 	var writeDuration time.Duration
 
 	printLogsFunc := func() {
@@ -166,7 +210,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 			return err
 		}
 		if header.Size > maxCatchpointFileChunkSize || header.Size < 1 {
-			return fmt.Errorf("getPeerLedger received a tar header with data size of %d", header.Size)
+			return fmt.Errorf("processLedgerStream received a tar header with data size of %d", header.Size)
 		}
 		balancesBlockBytes := make([]byte, header.Size)
 		_, err = io.ReadFull(tarReader, balancesBlockBytes)
@@ -174,20 +218,23 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 			return err
 		}
 		start := time.Now()
-		err = lf.processBalancesBlock(ctx, header.Name, balancesBlockBytes, &downloadProgress)
+		err = lf.processBalancesBlock(ctx, header.Name, balancesBlockBytes, downloadProgress)
 		if err != nil {
 			return err
 		}
 		writeDuration += time.Since(start)
 		if lf.reporter != nil {
-			lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
+			lf.reporter.updateLedgerFetcherProgress(downloadProgress)
+		}
+		if afterChunk == nil {
+			continue
 		}
-		if err = watchdogReader.Reset(); err != nil {
+		if err = afterChunk(); err != nil {
 			if err == io.EOF {
 				printLogsFunc()
 				return nil
 			}
-			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
+			err = fmt.Errorf("processLedgerStream received the following error while reading the catchpoint file : %v", err)
 			return err
 		}
 	}
@@ -17,10 +17,15 @@
 package catchup
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -102,3 +107,61 @@ func TestLedgerFetcherErrorResponseHandling(t *testing.T) {
 	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
 	require.Equal(t, fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0]), err)
 }
+
+type stagingBalancesRecorder struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections   []string
+	progresses map[*ledger.CatchpointCatchupAccessorProgress]bool
+}
+
+func (r *stagingBalancesRecorder) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	r.sections = append(r.sections, sectionName)
+	if r.progresses == nil {
+		r.progresses = make(map[*ledger.CatchpointCatchupAccessorProgress]bool)
+	}
+	r.progresses[progress] = true
+	return nil
+}
+
+func TestLedgerFetcherLoadLedger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var tarBuffer bytes.Buffer
+	tarWriter := tar.NewWriter(&tarBuffer)
+	for _, name := range []string{"content.msgpack", "balances.1.msgpack"} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: 4}))
+		_, err := tarWriter.Write([]byte("data"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+
+	var gzipBuffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&gzipBuffer)
+	_, err := gzipWriter.Write(tarBuffer.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	dir := t.TempDir()
+	tarFile := filepath.Join(dir, "catchpoint.tar")
+	require.NoError(t, os.WriteFile(tarFile, tarBuffer.Bytes(), 0644))
+	gzipFile := filepath.Join(dir, "catchpoint.tar.gz")
+	require.NoError(t, os.WriteFile(gzipFile, gzipBuffer.Bytes(), 0644))
+
+	for _, file := range []string{tarFile, gzipFile} {
+		accessor := &stagingBalancesRecorder{}
+		lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+		require.NoError(t, lf.loadLedger(context.Background(), file))
+		require.Equal(t, []string{"content.msgpack", "balances.1.msgpack"}, accessor.sections)
+	}
+
+	// the incremental catchpoint files are loaded after the catchpoint file, sharing its progress.
+	accessor := &stagingBalancesRecorder{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	require.NoError(t, lf.loadLedger(context.Background(), tarFile, gzipFile))
+	require.Equal(t, []string{"content.msgpack", "balances.1.msgpack", "content.msgpack", "balances.1.msgpack"}, accessor.sections)
+	require.Len(t, accessor.progresses, 1)
+
+	lf = makeLedgerFetcher(&mocks.MockNetwork{}, &stagingBalancesRecorder{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	require.Error(t, lf.loadLedger(context.Background(), filepath.Join(dir, "missing.tar")))
+	require.Error(t, lf.loadLedger(context.Background(), tarFile, filepath.Join(dir, "missing.tar")))
+}
@@ -61,6 +61,12 @@ const (
 
 	// Is the lookback window size of peer usage statistics
 	peerHistoryWindowSize = 100
+
+	// peerThroughputAlpha is the weight of the latest range download in the measured throughput of a peer
+	peerThroughputAlpha = 0.3
+	// minPeerThroughputShare is the least share of the fastest throughput a peer is weighted by when selecting
+	// a range peer, so that slow or failing peers still get an occasional chance to improve their measurement.
+	minPeerThroughputShare = 0.05
 )
 
 var errPeerSelectorNoPeerPoolsAvailable = errors.New("no peer pools available")
@@ -128,6 +134,12 @@ type historicStats struct {
 	gapSum           float64
 	counter          uint64
 	downloadFailures int
+
+	// throughput is the moving average of the blocks per second of the range downloads from the peer, which is
+	// measured once throughputMeasured is set. rangeDownloads is the number of range downloads in progress.
+	throughput         float64
+	throughputMeasured bool
+	rangeDownloads     int
 }
 
 func makeHistoricStatus(windowSize int, class peerClass) *historicStats {
@@ -298,6 +310,105 @@ func (ps *peerSelector) getNextPeer() (psp *peerSelectorPeer, err error) {
 	return nil, errPeerSelectorNoPeerPoolsAvailable
 }
 
+// getNextRangePeer returns the peer to download the next block range from. It picks one of the peers of the
+// best peer class that have not been ranked as failing their downloads, with a probability proportional to
+// the throughput measured on their range downloads divided by the number of ranges they are downloading, so
+// that concurrent range downloads are spread across peers by how fast they serve them. Peers which were not
+// measured yet are treated as the fastest ones. The outcome of the download is reported to
+// rangeDownloadComplete.
+func (ps *peerSelector) getNextRangePeer() (psp *peerSelectorPeer, err error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.refreshAvailablePeers()
+	var candidates []*peerPoolEntry
+	for i := range ps.pools {
+		pool := &ps.pools[i]
+		if pool.rank >= peerRankDownloadFailed {
+			break
+		}
+		for j := range pool.peers {
+			if len(candidates) == 0 || pool.peers[j].class.initialRank == candidates[0].class.initialRank {
+				candidates = append(candidates, &pool.peers[j])
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		// every peer failed its downloads; fall back to the lowest ranked one.
+		for i := range ps.pools {
+			if len(ps.pools[i].peers) > 0 {
+				candidates = append(candidates, &ps.pools[i].peers[crypto.RandUint64()%uint64(len(ps.pools[i].peers))])
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, errPeerSelectorNoPeerPoolsAvailable
+		}
+	}
+
+	fastest := 0.0
+	for _, entry := range candidates {
+		if entry.history.throughputMeasured && entry.history.throughput > fastest {
+			fastest = entry.history.throughput
+		}
+	}
+	if fastest == 0 {
+		fastest = 1
+	}
+	weights := make([]float64, len(candidates))
+	total := 0.0
+	for i, entry := range candidates {
+		throughput := fastest
+		if entry.history.throughputMeasured {
+			throughput = math.Max(entry.history.throughput, fastest*minPeerThroughputShare)
+		}
+		weights[i] = throughput / float64(1+entry.history.rangeDownloads)
+		total += weights[i]
+	}
+	pick := float64(crypto.RandUint63()) / float64(math.MaxInt64) * total
+	selected := candidates[len(candidates)-1]
+	for i, weight := range weights {
+		if pick < weight {
+			selected = candidates[i]
+			break
+		}
+		pick -= weight
+	}
+	selected.history.rangeDownloads++
+	return &peerSelectorPeer{selected.peer, selected.class.peerClass}, nil
+}
+
+// rangeDownloadComplete reports that a range download from a peer returned by getNextRangePeer has completed,
+// having downloaded the given number of blocks. A failed download, of no blocks, is measured as no throughput.
+func (ps *peerSelector) rangeDownloadComplete(psp *peerSelectorPeer, blocks int, downloadDuration time.Duration) {
+	if psp == nil {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	poolIdx, peerIdx := ps.findPeer(psp)
+	if poolIdx < 0 || peerIdx < 0 {
+		return
+	}
+	history := ps.pools[poolIdx].peers[peerIdx].history
+	if history.rangeDownloads > 0 {
+		history.rangeDownloads--
+	}
+	throughput := 0.0
+	if blocks > 0 {
+		if downloadDuration < time.Millisecond {
+			downloadDuration = time.Millisecond
+		}
+		throughput = float64(blocks) / downloadDuration.Seconds()
+	}
+	if history.throughputMeasured {
+		history.throughput = peerThroughputAlpha*throughput + (1-peerThroughputAlpha)*history.throughput
+	} else {
+		history.throughput = throughput
+		history.throughputMeasured = true
+	}
+}
+
 // rankPeer ranks a given peer.
 // return the old value and the new updated value.
 // updated value could be different from the input rank.
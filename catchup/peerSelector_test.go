@@ -624,3 +624,60 @@ func TestEvictionAndUpgrade(t *testing.T) {
 	psp, err := peerSelector.getNextPeer()
 	require.Equal(t, psp.peerClass, network.PeersPhonebookRelays)
 }
+
+// TestPeerSelectorRangePeer tests that range downloads are spread across peers by their measured throughput
+func TestPeerSelectorRangePeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	peers1 := []network.Peer{&mockHTTPPeer{address: "fast"}, &mockHTTPPeer{address: "slow"}}
+	peers2 := []network.Peer{&mockHTTPPeer{address: "relay"}}
+	peerSelector := makePeerSelector(
+		makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer {
+			if options[0] == network.PeersPhonebookArchivers {
+				return peers1
+			}
+			return peers2
+		}), []peerClass{
+			{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookArchivers},
+			{initialRank: peerRankInitialSecondPriority, peerClass: network.PeersPhonebookRelays},
+		},
+	)
+
+	// unmeasured peers of the best class are equally likely, and the ranges in progress are spread across them
+	psp1, err := peerSelector.getNextRangePeer()
+	require.NoError(t, err)
+	psp2, err := peerSelector.getNextRangePeer()
+	require.NoError(t, err)
+	require.Contains(t, []string{"fast", "slow"}, peerAddress(psp1.Peer))
+	require.Contains(t, []string{"fast", "slow"}, peerAddress(psp2.Peer))
+
+	fast := &peerSelectorPeer{peers1[0], network.PeersPhonebookArchivers}
+	slow := &peerSelectorPeer{peers1[1], network.PeersPhonebookArchivers}
+	peerSelector.rangeDownloadComplete(psp1, 0, 0)
+	peerSelector.rangeDownloadComplete(psp2, 0, 0)
+	peerSelector.rangeDownloadComplete(fast, 16, 100*time.Millisecond)
+	peerSelector.rangeDownloadComplete(slow, 16, 1600*time.Millisecond)
+
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		psp, err := peerSelector.getNextRangePeer()
+		require.NoError(t, err)
+		counts[peerAddress(psp.Peer)]++
+		peerSelector.rangeDownloadComplete(psp, 16, 100*time.Millisecond*time.Duration(map[string]int{"fast": 1, "slow": 16}[peerAddress(psp.Peer)]))
+	}
+	require.Zero(t, counts["relay"])
+	require.Greater(t, counts["fast"], 5*counts["slow"])
+	require.NotZero(t, counts["slow"])
+
+	// peers which failed their downloads are no longer picked
+	for _, rank := peerSelector.rankPeer(fast, peerRankDownloadFailed); rank != peerRankDownloadFailed; {
+		_, rank = peerSelector.rankPeer(fast, peerRankDownloadFailed)
+	}
+	peerSelector.rankPeer(slow, peerRankInvalidDownload)
+	for i := 0; i < 10; i++ {
+		psp, err := peerSelector.getNextRangePeer()
+		require.NoError(t, err)
+		require.Equal(t, "relay", peerAddress(psp.Peer))
+		peerSelector.rangeDownloadComplete(psp, 16, time.Second)
+	}
+}
@@ -227,7 +227,10 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 //  - If we couldn't fetch the block (e.g. if there are no peers available or we've reached the catchupRetryLimit)
 //  - If the block is already in the ledger (e.g. if agreement service has already written it)
 //  - If the retrieval of the previous block was unsuccessful
-func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool, lookbackComplete chan bool, peerSelector *peerSelector) bool {
+//
+// The first attempt takes the block from its range download when a rangeFetcher is given, and any retries
+// fetch the block on its own.
+func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool, lookbackComplete chan bool, peerSelector *peerSelector, rangeFetcher *blockRangeFetcher) bool {
 	// If sync-ing this round is not intended, don't fetch it
 	if dontSyncRound := s.GetDisableSyncRound(); dontSyncRound != 0 && r >= basics.Round(dontSyncRound) {
 		return false
@@ -264,15 +267,30 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			return false
 		}
 
-		psp, getPeerErr := peerSelector.getNextPeer()
-		if getPeerErr != nil {
-			s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
-			break
-		}
-		peer := psp.Peer
+		var psp *peerSelectorPeer
+		var block *bookkeeping.Block
+		var cert *agreement.Certificate
+		var blockDownloadDuration time.Duration
+		var err error
+		fromRange := rangeFetcher != nil && i == 1
+		if fromRange {
+			block, cert, psp, err = s.innerRangeFetch(r, rangeFetcher)
+			if err != nil && err != errLedgerAlreadyHasBlock {
+				// the range download ranked its peer already; retry fetching the block on its own.
+				s.log.Debugf("fetchAndWrite(%v): Could not fetch from block range: %v", r, err)
+				continue
+			}
+		} else {
+			var getPeerErr error
+			psp, getPeerErr = peerSelector.getNextPeer()
+			if getPeerErr != nil {
+				s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
+				break
+			}
 
-		// Try to fetch, timing out after retryInterval
-		block, cert, blockDownloadDuration, err := s.innerFetch(r, peer)
+			// Try to fetch, timing out after retryInterval
+			block, cert, blockDownloadDuration, err = s.innerFetch(r, psp.Peer)
+		}
 
 		if err != nil {
 			if err == errLedgerAlreadyHasBlock {
@@ -316,6 +334,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 				}
 
 				s.log.Warnf("fetchAndWrite(%v): block contents do not match header (attempt %d)", r, i)
+				s.net.ReportPeer(psp.Peer, network.PeerFaultInvalidBlock)
 				continue // retry the fetch
 			}
 		}
@@ -338,13 +357,16 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			if err != nil {
 				s.log.Warnf("fetchAndWrite(%v): cert did not authenticate block (attempt %d): %v", r, i, err)
 				peerSelector.rankPeer(psp, peerRankInvalidDownload)
+				s.net.ReportPeer(psp.Peer, network.PeerFaultInvalidBlock)
 				continue // retry the fetch
 			}
 		}
 
-		peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
-		r1, r2 := peerSelector.rankPeer(psp, peerRank)
-		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		if !fromRange {
+			peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
+			r1, r2 := peerSelector.rankPeer(psp, peerRank)
+			s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		}
 
 		// Write to ledger, noting that ledger writes must be in order
 		select {
@@ -421,11 +443,22 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 	return false
 }
 
+// innerRangeFetch takes the block of round r from its range download, unless the ledger already has it.
+func (s *Service) innerRangeFetch(r basics.Round, rangeFetcher *blockRangeFetcher) (blk *bookkeeping.Block, cert *agreement.Certificate, psp *peerSelectorPeer, err error) {
+	select {
+	case <-s.ledger.Wait(r):
+		// if our ledger already have this block, no need to attempt to fetch it.
+		return nil, nil, nil, errLedgerAlreadyHasBlock
+	default:
+	}
+	return rangeFetcher.take(r)
+}
+
 type task func() basics.Round
 
-func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool, prevFetchCompleteChan chan bool, lookbackChan chan bool, peerSelector *peerSelector) func() basics.Round {
+func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool, prevFetchCompleteChan chan bool, lookbackChan chan bool, peerSelector *peerSelector, rangeFetcher *blockRangeFetcher) func() basics.Round {
 	return func() basics.Round {
-		fetchResult := s.fetchAndWrite(r, prevFetchCompleteChan, lookbackChan, peerSelector)
+		fetchResult := s.fetchAndWrite(r, prevFetchCompleteChan, lookbackChan, peerSelector, rangeFetcher)
 
 		// the fetch result will be read at most twice (once as the lookback block and once as the prev block, so we write the result twice)
 		thisFetchComplete <- fetchResult
@@ -485,6 +518,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 
 	from := s.ledger.NextRound()
 	nextRound := from
+	rangeFetcher := s.makeBlockRangeFetcher(from, peerSelector)
 	for ; nextRound < from+basics.Round(parallelRequests); nextRound++ {
 		// If the next round is not supported
 		if s.nextRoundIsNotSupported(nextRound) {
@@ -509,7 +543,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 
 		currentRoundComplete := make(chan bool, 2)
 		// len(taskCh) + (# pending writes to completed) increases by 1
-		taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[len(recentReqs)-int(seedLookback)], peerSelector)
+		taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[len(recentReqs)-int(seedLookback)], peerSelector, rangeFetcher)
 		recentReqs = append(recentReqs[1:], currentRoundComplete)
 	}
 
@@ -541,7 +575,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 
 				currentRoundComplete := make(chan bool, 2)
 				// len(taskCh) + (# pending writes to completed) increases by 1
-				taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[0], peerSelector)
+				taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[0], peerSelector, rangeFetcher)
 				recentReqs = append(recentReqs[1:], currentRoundComplete)
 				nextRound++
 			}
@@ -716,6 +750,7 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 		// Otherwise, fetcher gave us the wrong block
 		logging.Base().Warnf("fetcher gave us bad/wrong block (for round %d): fetched hash %v; want hash %v", cert.Round, block.Hash(), blockHash)
 		peerSelector.rankPeer(psp, peerRankInvalidDownload)
+		s.net.ReportPeer(psp.Peer, network.PeerFaultInvalidBlock)
 
 		// As a failsafe, if the cert we fetched is valid but for the wrong block, panic as loudly as possible
 		if cert.Round == fetchedCert.Round &&
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -165,6 +166,60 @@ func TestServiceFetchBlocksSameRange(t *testing.T) {
 	require.Equal(t, rr, lr)
 }
 
+// TestServiceFetchBlocksInRanges tests that the blocks are fetched in ranges spread across the peers
+func TestServiceFetchBlocksInRanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Make Ledgers
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	addBlocks(t, remote, blk, 40)
+
+	// Create a network and block services
+	blockServiceConfig := config.GetDefaultLocal()
+	blockServiceConfig.EnableBlockServiceFallbackToArchiver = false
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, remote, net, "test genesisID")
+
+	var mu deadlock.Mutex
+	requests := make(map[string]int)
+	for i := 0; i < 2; i++ {
+		node := &basicRPCNode{}
+		node.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			mu.Lock()
+			requests[request.URL.Query().Get(rpcs.BlockRangeCountParam)]++
+			mu.Unlock()
+			ls.ServeHTTP(response, request)
+		}))
+		node.start()
+		defer node.stop()
+		net.addPeer(node.rootURL())
+	}
+
+	// Make Service
+	cfg := defaultConfig
+	cfg.CatchupBlockRangeSize = 8
+	syncer := MakeService(logging.Base(), cfg, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+
+	syncer.testStart()
+	syncer.sync()
+	rr, lr := remote.LastRound(), local.LastRound()
+	require.Equal(t, rr, lr)
+
+	mu.Lock()
+	defer mu.Unlock()
+	// the rounds are downloaded in ranges of 8, and the rounds past them are retried on their own
+	ranges := int(rr+7) / 8
+	require.GreaterOrEqual(t, requests["8"], ranges)
+	require.LessOrEqual(t, requests["8"], ranges+2)
+}
+
 type periodicSyncLogger struct {
 	logging.Logger
 	WarnfCallback func(string, ...interface{})
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -93,6 +95,48 @@ func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Ro
 	return block, cert, downloadDuration, err
 }
 
+// fetchBlockRange returns up to count consecutive blocks starting at round from the peer, along with their
+// certificates. The peer may return fewer blocks than requested, but returns at least the block of round.
+// Peers that do not support range requests are asked for the single block of round.
+func (uf *universalBlockFetcher) fetchBlockRange(ctx context.Context, round basics.Round, count uint64, peer network.Peer) (blocks []rpcs.EncodedBlockCert, downloadDuration time.Duration, err error) {
+	var fetchedBuf []byte
+	var address string
+	blockDownloadStartTime := time.Now()
+	if wsPeer, validWSPeer := peer.(network.UnicastPeer); validWSPeer {
+		fetcherClient := &wsFetcherClient{
+			target: wsPeer,
+			config: &uf.config,
+		}
+		fetchedBuf, err = fetcherClient.getBlockRangeBytes(ctx, round, count)
+		if err != nil {
+			return nil, time.Duration(0), err
+		}
+		address = fetcherClient.address()
+	} else if httpPeer, validHTTPPeer := peer.(network.HTTPPeer); validHTTPPeer {
+		fetcherClient := &HTTPFetcher{
+			peer:    httpPeer,
+			rootURL: httpPeer.GetAddress(),
+			net:     uf.net,
+			client:  httpPeer.GetHTTPClient(),
+			log:     uf.log,
+			config:  &uf.config}
+		fetchedBuf, err = fetcherClient.getBlockRangeBytes(ctx, round, count)
+		if err != nil {
+			return nil, time.Duration(0), err
+		}
+		address = fetcherClient.address()
+	} else {
+		return nil, time.Duration(0), fmt.Errorf("fetchBlockRange: UniversalFetcher only supports HTTPPeer and UnicastPeer")
+	}
+	downloadDuration = time.Now().Sub(blockDownloadStartTime)
+	blocks, err = processBlockRangeBytes(fetchedBuf, round, count, address)
+	if err != nil {
+		return nil, time.Duration(0), err
+	}
+	uf.log.Debugf("fetchBlockRange: downloaded %d blocks from %d in %d from %s", len(blocks), uint64(round), downloadDuration, address)
+	return blocks, downloadDuration, err
+}
+
 func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk *bookkeeping.Block, cert *agreement.Certificate, err error) {
 	var decodedEntry rpcs.EncodedBlockCert
 	err = protocol.Decode(fetchedBuf, &decodedEntry)
@@ -113,6 +157,36 @@ func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk
 	return &decodedEntry.Block, &decodedEntry.Certificate, nil
 }
 
+func processBlockRangeBytes(fetchedBuf []byte, r basics.Round, count uint64, peerAddr string) (blocks []rpcs.EncodedBlockCert, err error) {
+	var decodedRange rpcs.PreEncodedBlockCertRange
+	err = protocol.DecodeReflect(fetchedBuf, &decodedRange)
+	if err != nil {
+		return nil, makeErrCannotDecodeBlock(r, peerAddr, err)
+	}
+	if len(decodedRange.Blocks) == 0 || uint64(len(decodedRange.Blocks)) > count {
+		return nil, makeErrWrongBlockRangeFromPeer(r, count, len(decodedRange.Blocks), peerAddr)
+	}
+
+	blocks = make([]rpcs.EncodedBlockCert, len(decodedRange.Blocks))
+	for i, encoded := range decodedRange.Blocks {
+		round := r + basics.Round(i)
+		err = protocol.Decode(encoded.Block, &blocks[i].Block)
+		if err == nil {
+			err = protocol.Decode(encoded.Certificate, &blocks[i].Certificate)
+		}
+		if err != nil {
+			return nil, makeErrCannotDecodeBlock(round, peerAddr, err)
+		}
+		if blocks[i].Block.Round() != round {
+			return nil, makeErrWrongBlockFromPeer(round, blocks[i].Block.Round(), peerAddr)
+		}
+		if blocks[i].Certificate.Round != round {
+			return nil, makeErrWrongCertFromPeer(round, blocks[i].Certificate.Round, peerAddr)
+		}
+	}
+	return blocks, nil
+}
+
 // a stub fetcherClient to satisfy the NetworkFetcher interface
 type wsFetcherClient struct {
 	target network.UnicastPeer // the peer where we're going to send the request.
@@ -146,6 +220,29 @@ func (w *wsFetcherClient) getBlockBytes(ctx context.Context, r basics.Round) ([]
 	return blockBytes, nil
 }
 
+// getBlockRangeBytes returns the encoded range of up to count blocks starting at round r
+func (w *wsFetcherClient) getBlockRangeBytes(ctx context.Context, r basics.Round, count uint64) ([]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	childCtx, cancelFunc := context.WithTimeout(ctx, time.Duration(w.config.CatchupGossipBlockFetchTimeoutSec)*time.Second)
+	w.mu.Unlock()
+
+	defer func() {
+		cancelFunc()
+		w.mu.Lock()
+	}()
+
+	rangeBytes, err := w.requestBlockRange(childCtx, r, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(rangeBytes) == 0 {
+		return nil, fmt.Errorf("wsFetcherClient(%d): empty response", r)
+	}
+	return rangeBytes, nil
+}
+
 // Address implements FetcherClient
 func (w *wsFetcherClient) address() string {
 	return fmt.Sprintf("[ws] (%s)", w.target.GetAddress())
@@ -187,9 +284,58 @@ func (w *wsFetcherClient) requestBlock(ctx context.Context, round basics.Round)
 	return blockCertBytes, nil
 }
 
+// requestBlockRange send a request for up to count blocks starting at <round> and wait until it receives a response
+// or a context expires. Peers that do not support range requests are asked for the block of round alone.
+func (w *wsFetcherClient) requestBlockRange(ctx context.Context, round basics.Round, count uint64) ([]byte, error) {
+	roundBin := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(roundBin, uint64(round))
+	countBin := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(countBin, count)
+	topics := network.Topics{
+		network.MakeTopic(rpcs.RequestDataTypeKey,
+			[]byte(rpcs.BlockRangeValue)),
+		network.MakeTopic(
+			rpcs.RoundKey,
+			roundBin),
+		network.MakeTopic(
+			rpcs.CountKey,
+			countBin),
+	}
+	resp, err := w.target.Request(ctx, protocol.UniEnsBlockReqTag, topics)
+	if err != nil {
+		return nil, makeErrWsFetcherRequestFailed(round, w.target.GetAddress(), err.Error())
+	}
+
+	if errMsg, found := resp.Topics.GetValue(network.ErrorKey); found {
+		if string(errMsg) == rpcs.DatatypeUnsupportedErrMsg {
+			// the peer predates range requests.
+			blockCertBytes, err := w.requestBlock(ctx, round)
+			if err != nil {
+				return nil, err
+			}
+			var blockCert rpcs.PreEncodedBlockCert
+			err = protocol.DecodeReflect(blockCertBytes, &blockCert)
+			if err != nil {
+				return nil, makeErrWsFetcherRequestFailed(round, w.target.GetAddress(), err.Error())
+			}
+			return protocol.EncodeReflect(rpcs.PreEncodedBlockCertRange{Blocks: []rpcs.PreEncodedBlockCert{blockCert}}), nil
+		}
+		return nil, makeErrWsFetcherRequestFailed(round, w.target.GetAddress(), string(errMsg))
+	}
+
+	blocks, found := resp.Topics.GetValue(rpcs.BlockRangeDataKey)
+	if !found {
+		return nil, makeErrWsFetcherRequestFailed(round, w.target.GetAddress(), "Block range data not found")
+	}
+	return blocks, nil
+}
+
 // set max fetcher size to 10MB, this is enough to fit the block and certificate
 const fetcherMaxBlockBytes = 10 << 20
 
+// set max range fetcher size to 48MB, this is enough to fit the ranges served over http
+const fetcherMaxBlockRangeBytes = 48 << 20
+
 var errNoBlockForRound = errors.New("No block available for given round")
 
 // HTTPFetcher implements FetcherClient doing an HTTP GET of the block
@@ -213,11 +359,42 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	}
 
 	parsedURL.Path = rpcs.FormatBlockQuery(uint64(r), parsedURL.Path, hf.net)
-	blockURL := parsedURL.String()
+	_, data, err = hf.get(ctx, parsedURL.String(), fetcherMaxBlockBytes)
+	return data, err
+}
+
+// getBlockRangeBytes gets the encoded range of up to count blocks starting at round r.
+func (hf *HTTPFetcher) getBlockRangeBytes(ctx context.Context, r basics.Round, count uint64) (data []byte, err error) {
+	parsedURL, err := network.ParseHostOrURL(hf.rootURL)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedURL.Path = rpcs.FormatBlockQuery(uint64(r), parsedURL.Path, hf.net)
+	parsedURL.RawQuery = url.Values{rpcs.BlockRangeCountParam: []string{strconv.FormatUint(count, 10)}}.Encode()
+	contentType, data, err := hf.get(ctx, parsedURL.String(), fetcherMaxBlockRangeBytes, rpcs.BlockRangeResponseContentType)
+	if err != nil {
+		return nil, err
+	}
+	if contentType == rpcs.BlockRangeResponseContentType {
+		return data, nil
+	}
+	// the peer predates range requests, and ignored the count.
+	var blockCert rpcs.PreEncodedBlockCert
+	err = protocol.DecodeReflect(data, &blockCert)
+	if err != nil {
+		return nil, makeErrCannotDecodeBlock(r, hf.address(), err)
+	}
+	return protocol.EncodeReflect(rpcs.PreEncodedBlockCertRange{Blocks: []rpcs.PreEncodedBlockCert{blockCert}}), nil
+}
+
+// get performs a block request, and returns the content type and body of a successful response.
+// Block responses are accepted, along with any of the extra content types given.
+func (hf *HTTPFetcher) get(ctx context.Context, blockURL string, maxBytes uint64, contentTypes ...string) (contentType string, data []byte, err error) {
 	hf.log.Debugf("block GET %#v peer %#v %T", blockURL, hf.peer, hf.peer)
 	request, err := http.NewRequest("GET", blockURL, nil)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 	requestCtx, requestCancel := context.WithTimeout(ctx, time.Duration(hf.config.CatchupHTTPBlockFetchTimeoutSec)*time.Second)
 	defer requestCancel()
@@ -226,7 +403,7 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	response, err := hf.client.Do(request)
 	if err != nil {
 		hf.log.Debugf("GET %#v : %s", blockURL, err)
-		return nil, err
+		return "", nil, err
 	}
 
 	// check to see that we had no errors.
@@ -234,7 +411,7 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	case http.StatusOK:
 	case http.StatusNotFound: // server could not find a block with that round numbers.
 		response.Body.Close()
-		return nil, errNoBlockForRound
+		return "", nil, errNoBlockForRound
 	default:
 		bodyBytes, err := rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
 		hf.log.Warnf("HTTPFetcher.getBlockBytes: response status code %d from '%s'. Response body '%s' ", response.StatusCode, blockURL, string(bodyBytes))
@@ -243,29 +420,35 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 		} else {
 			err = makeErrHTTPResponse(response.StatusCode, blockURL, err.Error())
 		}
-		return nil, err
+		return "", nil, err
 	}
 
 	// at this point, we've already receieved the response headers. ensure that the
 	// response content type is what we'd like it to be.
-	contentTypes := response.Header["Content-Type"]
-	if len(contentTypes) != 1 {
-		err = errHTTPResponseContentType{contentTypeCount: len(contentTypes)}
+	responseContentTypes := response.Header["Content-Type"]
+	if len(responseContentTypes) != 1 {
+		err = errHTTPResponseContentType{contentTypeCount: len(responseContentTypes)}
 		hf.log.Warn(err)
 		response.Body.Close()
-		return nil, err
+		return "", nil, err
 	}
+	contentType = responseContentTypes[0]
 
 	// TODO: Temporarily allow old and new content types so we have time for lazy upgrades
 	// Remove this 'old' string after next release.
 	const blockResponseContentTypeOld = "application/algorand-block-v1"
-	if contentTypes[0] != rpcs.BlockResponseContentType && contentTypes[0] != blockResponseContentTypeOld {
-		hf.log.Warnf("http block fetcher response has an invalid content type : %s", contentTypes[0])
+	validContentType := contentType == rpcs.BlockResponseContentType || contentType == blockResponseContentTypeOld
+	for _, extra := range contentTypes {
+		validContentType = validContentType || contentType == extra
+	}
+	if !validContentType {
+		hf.log.Warnf("http block fetcher response has an invalid content type : %s", contentType)
 		response.Body.Close()
-		return nil, errHTTPResponseContentType{contentTypeCount: 1, contentType: contentTypes[0]}
+		return "", nil, errHTTPResponseContentType{contentTypeCount: 1, contentType: contentType}
 	}
 
-	return rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+	data, err = rpcs.ResponseBytes(response, hf.log, maxBytes)
+	return contentType, data, err
 }
 
 // Address is part of FetcherClient interface.
@@ -332,6 +515,26 @@ func (cdbe errCannotDecodeBlock) Unwrap() error {
 	return cdbe.err
 }
 
+type errWrongBlockRangeFromPeer struct {
+	round  basics.Round
+	peer   string
+	count  uint64
+	blocks int
+}
+
+func makeErrWrongBlockRangeFromPeer(round basics.Round, count uint64, blocks int, peer string) errWrongBlockRangeFromPeer {
+	return errWrongBlockRangeFromPeer{
+		round:  round,
+		peer:   peer,
+		count:  count,
+		blocks: blocks}
+}
+
+func (wbrfpe errWrongBlockRangeFromPeer) Error() string {
+	return fmt.Sprintf("processBlockRangeBytes: got wrong block range from peer %s: wanted up to %d blocks from %d, got %d",
+		wbrfpe.peer, wbrfpe.count, wbrfpe.round, wbrfpe.blocks)
+}
+
 type errWsFetcherRequestFailed struct {
 	round basics.Round
 	peer  string
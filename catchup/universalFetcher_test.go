@@ -125,6 +125,76 @@ func TestUGetBlockHTTP(t *testing.T) {
 	require.Equal(t, int64(duration), int64(0))
 }
 
+// TestUGetBlockRange tests the universal fetcher block ranges from http and ws peers
+func TestUGetBlockRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+
+	ledger, next, b, err := buildTestLedger(t, bookkeeping.Block{})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	addBlocks(t, ledger, b, 3)
+
+	blockServiceConfig := config.GetDefaultLocal()
+	blockServiceConfig.EnableBlockService = true
+	blockServiceConfig.EnableBlockServiceFallbackToArchiver = false
+
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, ledger, net, "test genesisID")
+	ls.Start()
+	defer ls.Stop()
+
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	net.addPeer(nodeA.rootURL())
+
+	// a block service which predates range requests
+	nodeB := basicRPCNode{}
+	nodeB.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		data, err := rpcs.RawBlockBytes(ledger, next)
+		require.NoError(t, err)
+		response.Header().Set("Content-Type", rpcs.BlockResponseContentType)
+		response.Write(data)
+	}))
+	nodeB.start()
+	defer nodeB.stop()
+	oldPeer := testHTTPPeer(nodeB.rootURL())
+
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
+	rounds := func(blocks []rpcs.EncodedBlockCert) (rounds []basics.Round) {
+		for _, blockCert := range blocks {
+			require.Equal(t, blockCert.Block.Round(), blockCert.Certificate.Round)
+			rounds = append(rounds, blockCert.Block.Round())
+		}
+		return
+	}
+
+	for _, peer := range []network.Peer{net.GetPeers()[0], makeTestUnicastPeer(net, t)} {
+		blocks, duration, err := fetcher.fetchBlockRange(context.Background(), next, 3, peer)
+		require.NoError(t, err)
+		require.Equal(t, []basics.Round{next, next + 1, next + 2}, rounds(blocks))
+		require.Equal(t, b, blocks[0].Block)
+		require.GreaterOrEqual(t, int64(duration), int64(0))
+
+		blocks, _, err = fetcher.fetchBlockRange(context.Background(), next+2, 8, peer)
+		require.NoError(t, err)
+		require.Equal(t, []basics.Round{next + 2, next + 3}, rounds(blocks))
+
+		blocks, _, err = fetcher.fetchBlockRange(context.Background(), next+4, 8, peer)
+		require.Error(t, err)
+		require.Nil(t, blocks)
+	}
+
+	blocks, _, err := fetcher.fetchBlockRange(context.Background(), next, 3, &oldPeer)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{next}, rounds(blocks))
+}
+
 // TestUGetBlockUnsupported tests the handling of an unsupported peer
 func TestUGetBlockUnsupported(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/ledger"
+)
+
+var deltaBaseFile string
+var deltaTargetFile string
+var deltaOutFileName string
+
+func init() {
+	deltaCmd.Flags().StringVarP(&deltaBaseFile, "base", "b", "", "Specify the catchpoint file (either .tar or .tar.gz) the incremental catchpoint file applies to")
+	deltaCmd.Flags().StringVarP(&deltaTargetFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) the incremental catchpoint file leads to")
+	deltaCmd.Flags().StringVarP(&deltaOutFileName, "output", "o", "", "Specify the incremental catchpoint file to create")
+}
+
+var deltaCmd = &cobra.Command{
+	Use:   "delta",
+	Short: "Create an incremental catchpoint file",
+	Long:  "Create an incremental catchpoint file holding the changes between two catchpoint files, which catchup could apply on top of the base catchpoint file instead of loading the other one",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if deltaBaseFile == "" || deltaTargetFile == "" || deltaOutFileName == "" {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+		header, err := ledger.MakeCatchpointDeltaFile(context.Background(), deltaBaseFile, deltaTargetFile, deltaOutFileName)
+		if err != nil {
+			reportErrorf("Unable to create incremental catchpoint file '%s' : %v", deltaOutFileName, err)
+		}
+		reportInfof("Created incremental catchpoint file '%s' from '%s' to '%s' with %d chunks", deltaOutFileName, header.BaseCatchpoint, header.Catchpoint, header.TotalChunks)
+	},
+}
@@ -46,11 +46,13 @@ import (
 )
 
 var catchpointFile string
+var catchpointDeltaFiles []string
 var outFileName string
 var excludedFields *cmdutil.CobraStringSliceValue = cmdutil.MakeCobraStringSliceValue(nil, []string{"version", "catchpoint"})
 
 func init() {
 	fileCmd.Flags().StringVarP(&catchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to process")
+	fileCmd.Flags().StringSliceVar(&catchpointDeltaFiles, "delta", nil, "Specify an incremental catchpoint file to apply on top of the catchpoint file; repeat to apply a chain of incremental files in order")
 	fileCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the dump ( i.e. tracker.dump.txt )")
 	fileCmd.Flags().BoolVarP(&loadOnly, "load", "l", false, "Load only, do not dump")
 	fileCmd.Flags().VarP(excludedFields, "exclude-fields", "e", "List of fields to exclude from the dump: ["+excludedFields.AllowedString()+"]")
@@ -106,6 +108,7 @@ var fileCmd = &cobra.Command{
 			reportErrorf("Unable to initialize catchup database : %v", err)
 		}
 		var fileHeader ledger.CatchpointFileHeader
+		var downloadProgress ledger.CatchpointCatchupAccessorProgress
 
 		reader, err := os.Open(catchpointFile)
 		if err != nil {
@@ -113,11 +116,18 @@ var fileCmd = &cobra.Command{
 		}
 		defer reader.Close()
 
-		fileHeader, err = loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, catchpointSize)
+		fileHeader, err = loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, catchpointSize, &downloadProgress)
 		if err != nil {
 			reportErrorf("Unable to load catchpoint file into in-memory database : %v", err)
 		}
 
+		for _, deltaFile := range catchpointDeltaFiles {
+			fileHeader, err = loadCatchpointDeltaIntoDatabase(context.Background(), catchupAccessor, deltaFile, &downloadProgress)
+			if err != nil {
+				reportErrorf("Unable to load incremental catchpoint file '%s' into in-memory database : %v", deltaFile, err)
+			}
+		}
+
 		if !loadOnly {
 			outFile := os.Stdout
 			if outFileName != "" {
@@ -180,7 +190,22 @@ func getCatchpointTarReader(catchpointReader *bufio.Reader, catchpointFileSize i
 	return tar.NewReader(catchpointReader), nil
 }
 
-func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.CatchpointCatchupAccessor, catchpointFile io.Reader, catchpointFileSize int64) (fileHeader ledger.CatchpointFileHeader, err error) {
+// loadCatchpointDeltaIntoDatabase applies the incremental catchpoint file at the given path on top of the catchpoint
+// previously loaded with the same downloadProgress.
+func loadCatchpointDeltaIntoDatabase(ctx context.Context, catchupAccessor ledger.CatchpointCatchupAccessor, deltaFile string, downloadProgress *ledger.CatchpointCatchupAccessorProgress) (fileHeader ledger.CatchpointFileHeader, err error) {
+	stats, err := os.Stat(deltaFile)
+	if err != nil {
+		return fileHeader, err
+	}
+	reader, err := os.Open(deltaFile)
+	if err != nil {
+		return fileHeader, err
+	}
+	defer reader.Close()
+	return loadCatchpointIntoDatabase(ctx, catchupAccessor, reader, stats.Size(), downloadProgress)
+}
+
+func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.CatchpointCatchupAccessor, catchpointFile io.Reader, catchpointFileSize int64, downloadProgress *ledger.CatchpointCatchupAccessorProgress) (fileHeader ledger.CatchpointFileHeader, err error) {
 	fmt.Printf("\n")
 	printLoadCatchpointProgressLine(0, 50, 0)
 	lastProgressUpdate := time.Now()
@@ -193,7 +218,6 @@ func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.Catc
 		return fileHeader, err
 	}
 
-	var downloadProgress ledger.CatchpointCatchupAccessorProgress
 	for {
 		header, err := tarReader.Next()
 		if err != nil {
@@ -219,7 +243,7 @@ func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.Catc
 				return fileHeader, err
 			}
 		}
-		err = catchupAccessor.ProcessStagingBalances(ctx, header.Name, balancesBlockBytes, &downloadProgress)
+		err = catchupAccessor.ProcessStagingBalances(ctx, header.Name, balancesBlockBytes, downloadProgress)
 		if err != nil {
 			return fileHeader, err
 		}
@@ -340,7 +340,8 @@ func loadAndDump(addr string, tarFile string, genesisInitState ledgercore.InitSt
 	defer reader.Close()
 
 	var fileHeader ledger.CatchpointFileHeader
-	fileHeader, err = loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, tarSize)
+	var downloadProgress ledger.CatchpointCatchupAccessorProgress
+	fileHeader, err = loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, tarSize, &downloadProgress)
 	if err != nil {
 		reportErrorf("Unable to load catchpoint file into in-memory database : %v", err)
 		return err
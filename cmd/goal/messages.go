@@ -90,6 +90,13 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorCatchpointLabelForLocalSource      = "A catchpoint argument is needed when catching up from a local catchpoint file or blocks directory"
+	errorCatchpointGeneration               = "Catchpoint generation failed: %v"
+	infoCatchpointGenerationRequested       = "Catchpoint generation requested at %s"
+	infoCatchpointGenerationStage           = "Stage: %s"
+	infoCatchpointGenerationAccountsRound   = ", accounts round: %d"
+	infoCatchpointGenerationRound           = ", catchpoint round: %d"
+	infoCatchpointGenerationWritten         = ", written accounts: %d, written KVs: %d"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -59,6 +59,10 @@ var newNodeRelay string
 var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
+var catchupCatchpointFile string
+var catchupCatchpointDeltas []string
+var catchupBlocksDir string
+var generateCatchpointNoWait bool
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -74,6 +78,7 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(generateCatchpointCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -107,6 +112,11 @@ func init() {
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
+	catchupCmd.Flags().StringVar(&catchupCatchpointFile, "catchpoint-file", "", "Load the catchpoint from this local catchpoint file (tar or tar.gz) instead of downloading it")
+	catchupCmd.Flags().StringSliceVar(&catchupCatchpointDeltas, "catchpoint-delta", nil, "Apply this local incremental catchpoint file on top of the catchpoint file; repeat to apply a chain of incremental files in order")
+	catchupCmd.Flags().StringVar(&catchupBlocksDir, "blocks-dir", "", "Load the blocks from this local directory, holding one msgpack encoded block per file named after its round, before downloading them")
+
+	generateCatchpointCmd.Flags().BoolVar(&generateCatchpointNoWait, "no-wait", false, "Request the catchpoint and return without waiting for its label")
 
 }
 
@@ -149,11 +159,14 @@ var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
 	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. If no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --catchpoint-file 6500000.tar --blocks-dir blocks\tStart catching up from a local catchpoint file and blocks directory\ngoal node catchup 6600000#1234567890ABCDEF01234567890ABCDEF0 --catchpoint-file 6500000.tar --catchpoint-delta 6600000.delta.tar\tStart catching up from a local catchpoint file and an incremental catchpoint file on top of it\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		onDataDirs(func(dataDir string) {
 			if !abortCatchup && len(args) == 0 {
+				if catchupCatchpointFile != "" || len(catchupCatchpointDeltas) > 0 || catchupBlocksDir != "" {
+					reportErrorf(errorCatchpointLabelForLocalSource)
+				}
 				client := ensureAlgodClient(dataDir)
 				vers, err := client.AlgodVersions()
 				if err != nil {
@@ -194,6 +207,65 @@ func catchpointCmdArgument(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var generateCatchpointCmd = &cobra.Command{
+	Use:   "generatecatchpoint",
+	Short: "Generate a catchpoint for the node's current state",
+	Long:  "Generate a catchpoint label and file on the node, regardless of its catchpoint interval. The accounts are snapshot at the next accounts commit, and the label is created once the catchpoint lookback rounds that follow are committed. The node must track catchpoints (CatchpointTracking and CatchpointInterval). The command reports the progress of the generation and prints the resulting label, which also becomes the node's last catchpoint.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		client := ensureAlgodClient(ensureSingleDataDir())
+		status, err := client.StartCatchpointGeneration()
+		if err != nil {
+			reportErrorf(errorCatchpointGeneration, err)
+		}
+		reportInfof(infoCatchpointGenerationRequested, time.Unix(int64(status.RequestedAt), 0).String())
+		if generateCatchpointNoWait {
+			return
+		}
+		var last string
+		for {
+			progress := catchpointGenerationProgress(status)
+			if progress != last {
+				reportInfoln(progress)
+				last = progress
+			}
+			switch status.Stage {
+			case "done":
+				fmt.Println(*status.Label)
+				return
+			case "failed":
+				reportErrorf(errorCatchpointGeneration, *status.Error)
+			}
+			time.Sleep(time.Second)
+			status, err = client.GetCatchpointGeneration()
+			if err != nil {
+				reportErrorf(errorCatchpointGeneration, err)
+			}
+		}
+	},
+}
+
+func catchpointGenerationProgress(status model.CatchpointGenerationResponse) string {
+	progress := fmt.Sprintf(infoCatchpointGenerationStage, status.Stage)
+	if status.AccountsRound != nil {
+		progress += fmt.Sprintf(infoCatchpointGenerationAccountsRound, *status.AccountsRound)
+	}
+	if status.Round != nil {
+		progress += fmt.Sprintf(infoCatchpointGenerationRound, *status.Round)
+	}
+	if status.TotalAccounts != nil || status.TotalKvs != nil {
+		var accounts, kvs uint64
+		if status.TotalAccounts != nil {
+			accounts = *status.TotalAccounts
+		}
+		if status.TotalKvs != nil {
+			kvs = *status.TotalKvs
+		}
+		progress += fmt.Sprintf(infoCatchpointGenerationWritten, accounts, kvs)
+	}
+	return progress
+}
+
 var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Initialize the specified Algorand node",
@@ -706,7 +778,33 @@ func catchup(dataDir string, args []string) {
 		}
 		return
 	}
-	err := client.Catchup(args[0])
+	if catchupCatchpointFile == "" && len(catchupCatchpointDeltas) == 0 && catchupBlocksDir == "" {
+		err := client.Catchup(args[0])
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		return
+	}
+	// the paths are resolved by the node, so make relative paths absolute first.
+	catchpointFile, blocksDir := catchupCatchpointFile, catchupBlocksDir
+	var err error
+	if catchpointFile != "" {
+		if catchpointFile, err = filepath.Abs(catchpointFile); err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+	}
+	deltaFiles := make([]string, len(catchupCatchpointDeltas))
+	for i, deltaFile := range catchupCatchpointDeltas {
+		if deltaFiles[i], err = filepath.Abs(deltaFile); err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+	}
+	if blocksDir != "" {
+		if blocksDir, err = filepath.Abs(blocksDir); err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+	}
+	err = client.CatchupFromLocalSource(args[0], catchpointFile, deltaFiles, blocksDir)
 	if err != nil {
 		reportErrorf(errorNodeStatus, err)
 	}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
+)
+
+var algodURL string
+var algodToken string
+var stateFile string
+var strengthTarget uint64
+
+var versionCheck bool
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&algodURL, "algod", "a", "http://127.0.0.1:8080", "URL of the algod REST API to fetch the proofs from")
+	rootCmd.PersistentFlags().StringVarP(&algodToken, "token", "t", "", "API token of the algod REST API")
+	rootCmd.PersistentFlags().StringVarP(&stateFile, "state", "s", "lightclient.json", "File holding the state trusted by the light client")
+	rootCmd.PersistentFlags().Uint64Var(&strengthTarget, "strength-target", config.Consensus[protocol.ConsensusCurrentVersion].StateProofStrengthTarget, "Security strength target of the state proofs")
+	rootCmd.Flags().BoolVarP(&versionCheck, "version", "v", false, "Display and write current build version and exit")
+
+	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(followCmd)
+	rootCmd.AddCommand(verifyTxnCmd)
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "lightclient",
+	Short: "State proof light client",
+	Long:  "Follow the chain through its state proofs, starting from a trusted voters commitment, and verify transactions without trusting the node serving the proofs",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if versionCheck {
+			fmt.Println(config.FormatVersionAndLicense())
+			return
+		}
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+// Write commands to exercise all subcommands with `-h`
+// Can be used to check that there are no conflicts in arguments between inner and outer commands.
+func runAllHelps(c *cobra.Command, out io.Writer) (err error) {
+	if c.Runnable() {
+		cmd := c.CommandPath() + " -h\n"
+		_, err = out.Write([]byte(cmd))
+		if err != nil {
+			return
+		}
+	}
+	for _, sub := range c.Commands() {
+		err = runAllHelps(sub, out)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func main() {
+	// Hidden command to generate docs in a given directory
+	// lightclient generate-docs [path]
+	if len(os.Args) == 3 && os.Args[1] == "generate-docs" {
+		err := doc.GenMarkdownTree(rootCmd, os.Args[2])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	} else if len(os.Args) == 2 && os.Args[1] == "helptest" {
+		// test that subcommands don't have arg conflicts:
+		// lightclient helptest | bash -x -e
+		runAllHelps(rootCmd, os.Stdout)
+		os.Exit(0)
+	}
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func makeClient() client.RestClient {
+	u, err := url.Parse(algodURL)
+	if err != nil {
+		reportErrorf("Invalid algod URL %s: %v", algodURL, err)
+	}
+	return client.MakeRestClient(*u, algodToken)
+}
+
+// fetchBlock fetches the block of the given round. The block is not trusted: the light client
+// only uses it after verifying it against the verified state proofs.
+func fetchBlock(restClient client.RestClient, round uint64) (bookkeeping.Block, error) {
+	raw, err := restClient.RawBlock(round)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	var blockCert rpcs.EncodedBlockCert
+	err = protocol.Decode(raw, &blockCert)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("unable to decode block %d: %w", round, err)
+	}
+	return blockCert.Block, nil
+}
+
+func loadState() (lightclient.State, error) {
+	var state lightclient.State
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		return state, err
+	}
+	err = protocol.DecodeJSON(data, &state)
+	return state, err
+}
+
+func saveState(state lightclient.State) error {
+	return os.WriteFile(stateFile, protocol.EncodeJSON(state), 0600)
+}
+
+func reportInfof(format string, args ...interface{}) {
+	fmt.Printf(format+"\n", args...)
+}
+
+func reportErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// validateNoPosArgsFn is a reusable cobra positional argument validation function
+// for generating proper error messages when commands see unexpected arguments when they expect no args.
+// We don't use cobra.NoArgs directly, in case we want to customize behavior later.
+var validateNoPosArgsFn = cobra.NoArgs
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
+)
+
+var followCount uint64
+
+func init() {
+	followCmd.Flags().Uint64VarP(&followCount, "count", "c", 0, "Maximal number of state proofs to verify, 0 verifies all the available ones")
+}
+
+var followCmd = &cobra.Command{
+	Use:          "follow",
+	Short:        "Verify the state proofs following the trusted round",
+	Long:         "Fetch and verify the state proofs following the trusted round, and save the light client state after each verified proof. Stops at the first state proof the node can't provide",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) error {
+		state, err := loadState()
+		if err != nil {
+			return err
+		}
+		lc := lightclient.MakeLightClient(makeClient(), strengthTarget, state)
+		for i := uint64(0); followCount == 0 || i < followCount; i++ {
+			msg, err := lc.Advance()
+			if err != nil {
+				if i == 0 || !stateProofUnavailable(err) {
+					return err
+				}
+				reportInfof("Stopped following: %v", err)
+				break
+			}
+			err = saveState(lc.State())
+			if err != nil {
+				return err
+			}
+			reportInfof("Verified state proof for rounds %d-%d", msg.FirstAttestedRound, msg.LastAttestedRound)
+		}
+		return nil
+	},
+}
+
+// stateProofUnavailable returns true if err is the response of a node that can't provide the requested
+// state proof, rather than a failure to verify it.
+func stateProofUnavailable(err error) bool {
+	var httpErr client.HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	switch httpErr.StatusCode {
+	case http.StatusNotFound, http.StatusRequestTimeout, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
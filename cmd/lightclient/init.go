@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
+)
+
+var initRound uint64
+var initVotersCommitment string
+var initLnProvenWeight uint64
+var initFromNode bool
+
+func init() {
+	initCmd.Flags().Uint64VarP(&initRound, "round", "r", 0, "Round of the trusted voters, the light client verifies the state proofs of the following rounds")
+	initCmd.Flags().StringVar(&initVotersCommitment, "voters-commitment", "", "Base64 encoded commitment to the trusted voters")
+	initCmd.Flags().Uint64Var(&initLnProvenWeight, "ln-proven-weight", 0, "Approximation of the natural log of the proven weight of the trusted voters")
+	initCmd.Flags().BoolVar(&initFromNode, "from-node", false, "Trust the voters of the block header of the given round as served by the node")
+}
+
+var initCmd = &cobra.Command{
+	Use:          "init",
+	Short:        "Create the light client state from a trusted voters commitment",
+	Long:         "Create the light client state from a trusted voters commitment, given explicitly or taken from the block header served by a node trusted for that round only",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if initRound == 0 {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("round not set")
+		}
+
+		var checkpoint lightclient.Checkpoint
+		if initFromNode {
+			block, err := fetchBlock(makeClient(), initRound)
+			if err != nil {
+				return err
+			}
+			checkpoint, err = lightclient.CheckpointFromBlockHeader(&block.BlockHeader)
+			if err != nil {
+				return err
+			}
+		} else {
+			if initVotersCommitment == "" || initLnProvenWeight == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("voters commitment and ln proven weight must be set unless --from-node is used")
+			}
+			commitment, err := base64.StdEncoding.DecodeString(initVotersCommitment)
+			if err != nil {
+				return fmt.Errorf("invalid voters commitment: %w", err)
+			}
+			checkpoint = lightclient.Checkpoint{
+				Round:            basics.Round(initRound),
+				VotersCommitment: crypto.GenericDigest(commitment),
+				LnProvenWeight:   initLnProvenWeight,
+			}
+		}
+
+		err := saveState(lightclient.State{Trusted: checkpoint})
+		if err != nil {
+			return err
+		}
+		reportInfof("Light client trusts the voters of round %d", checkpoint.Round)
+		return nil
+	},
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
+)
+
+var verifyTxID string
+var verifyRound uint64
+
+func init() {
+	verifyTxnCmd.Flags().StringVarP(&verifyTxID, "txid", "i", "", "ID of the transaction to verify")
+	verifyTxnCmd.Flags().Uint64VarP(&verifyRound, "round", "r", 0, "Round the transaction was confirmed in")
+}
+
+var verifyTxnCmd = &cobra.Command{
+	Use:          "verify-txn",
+	Short:        "Verify a transaction was confirmed in a round",
+	Long:         "Verify a transaction was confirmed in a round, following the state proofs up to that round if needed",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if verifyTxID == "" || verifyRound == 0 {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("txid or round not set")
+		}
+
+		state, err := loadState()
+		if err != nil {
+			return err
+		}
+		restClient := makeClient()
+		lc := lightclient.MakeLightClient(restClient, strengthTarget, state)
+		err = lc.AdvanceTo(basics.Round(verifyRound))
+		if err != nil {
+			return err
+		}
+		err = saveState(lc.State())
+		if err != nil {
+			return err
+		}
+
+		block, err := fetchBlock(restClient, verifyRound)
+		if err != nil {
+			return err
+		}
+		for _, stib := range block.Payset {
+			stxn, _, err := block.DecodeSignedTxn(stib)
+			if err != nil {
+				return err
+			}
+			if stxn.ID().String() != verifyTxID {
+				continue
+			}
+			hdr := block.ToLightBlockHeader()
+			err = lc.VerifyTransaction(&hdr, &stxn.Txn)
+			if err != nil {
+				return err
+			}
+			reportInfof("Transaction %s is confirmed in round %d", verifyTxID, verifyRound)
+			return nil
+		}
+		return fmt.Errorf("transaction %s not found in round %d", verifyTxID, verifyRound)
+	},
+}
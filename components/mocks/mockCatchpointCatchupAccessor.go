@@ -47,6 +47,16 @@ func (m *MockCatchpointCatchupAccessor) SetLabel(ctx context.Context, label stri
 	return nil
 }
 
+// GetSource returns the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+func (m *MockCatchpointCatchupAccessor) GetSource(ctx context.Context) (catchpointFile string, deltaFiles []string, blocksDir string, err error) {
+	return "", nil, "", nil
+}
+
+// SetSource set the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+func (m *MockCatchpointCatchupAccessor) SetSource(ctx context.Context, catchpointFile string, deltaFiles []string, blocksDir string) (err error) {
+	return nil
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (m *MockCatchpointCatchupAccessor) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	return nil
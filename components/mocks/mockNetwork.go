@@ -20,6 +20,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
@@ -65,6 +66,30 @@ func (network *MockNetwork) Disconnect(badpeer network.Peer) {
 func (network *MockNetwork) DisconnectPeers() {
 }
 
+// ReportPeer - unused function
+func (network *MockNetwork) ReportPeer(peer network.Peer, fault network.PeerFault) {
+}
+
+// PeerTraffic - unused function
+func (network *MockNetwork) PeerTraffic() []network.PeerTraffic {
+	return nil
+}
+
+// GetPeerBans - unused function
+func (network *MockNetwork) GetPeerBans() []network.PeerBan {
+	return nil
+}
+
+// BanPeer - unused function
+func (network *MockNetwork) BanPeer(address string, duration time.Duration) (ban network.PeerBan) {
+	return
+}
+
+// UnbanPeer - unused function
+func (network *MockNetwork) UnbanPeer(address string) bool {
+	return false
+}
+
 // RegisterRPCName - unused function
 func (network *MockNetwork) RegisterRPCName(name string, rcvr interface{}) {
 }
@@ -72,6 +72,14 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// PeerBansFilename is the name of the file where the bans of misbehaving peer addresses are saved.
+// It is used to keep the bans across restarts.
+const PeerBansFilename = "peerbans.json"
+
+// KnownPeersFilename is the name of the file where the phonebook of known relays is saved.
+// It is used to connect to historically good relays after a restart, without waiting for DNS.
+const KnownPeersFilename = "knownpeers.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -151,6 +151,19 @@ type Local struct {
 	// enable agreement timing metrics flag
 	EnableAgreementTimeMetrics bool `version[3]:"false"`
 
+	// AgreementTimelineFile, when set, is the file to which agreement writes a timeline of each round
+	// (proposal arrival, vote verification, soft and cert thresholds, and block assembly) in the
+	// Chrome trace-event JSON format, which can be loaded into Perfetto. A relative path is taken
+	// relative to the data directory. The timeline of a previous run is rotated when the node starts.
+	AgreementTimelineFile string `version[27]:""`
+
+	// AgreementTimelineSizeLimit is the size in bytes past which the agreement timeline file is rotated,
+	// once the round being written is complete.
+	AgreementTimelineSizeLimit uint64 `version[27]:"67108864"`
+
+	// AgreementTimelineArchives is the number of rotated agreement timeline files to keep.
+	AgreementTimelineArchives int `version[27]:"3"`
+
 	// The path to the node exporter.
 	NodeExporterPath string `version[0]:"./node_exporter"`
 
@@ -219,6 +232,11 @@ type Local struct {
 	// Setting this variable to 0 would disable the catchup
 	CatchupParallelBlocks uint64 `version[3]:"50" version[5]:"16"`
 
+	// CatchupBlockRangeSize is the number of consecutive blocks catchup requests from a peer at once. The rounds
+	// fetched in parallel are split into ranges of this size, which are spread across peers by their measured
+	// throughput. Setting this variable to 0 or 1 fetches each block with its own request.
+	CatchupBlockRangeSize uint64 `version[27]:"4"`
+
 	// Generate AssembleBlockMetrics telemetry event
 	EnableAssembleStats bool `version[0]:""`
 
@@ -295,6 +313,18 @@ type Local struct {
 	// NetworkProtocolVersion overrides network protocol version ( if present )
 	NetworkProtocolVersion string `version[6]:""`
 
+	// GossipCompressionTags is a comma-separated list of the message tags this node offers to exchange
+	// zstd compressed with the peers supporting it. Supported tags are PP, AV and TX.
+	GossipCompressionTags string `version[27]:"AV,PP,TX"`
+
+	// PeerBanThreshold is the score at which a peer address gets banned. Peers are scored by the invalid
+	// transactions, agreement messages and catchup blocks they send, and the score decays over time.
+	// Setting this to 0 disables the automatic banning of peers.
+	PeerBanThreshold uint64 `version[27]:"100"`
+
+	// PeerBanDurationSeconds is the duration of the first ban of a peer address, which doubles with each repeated ban.
+	PeerBanDurationSeconds uint64 `version[27]:"3600"`
+
 	// CatchpointInterval sets the interval at which catchpoint are being generated. Setting this to 0 disables the catchpoint from being generated.
 	// See CatchpointTracking for more details.
 	CatchpointInterval uint64 `version[7]:"10000"`
@@ -369,6 +399,17 @@ type Local struct {
 	// TraceServer is a host:port to report graph propagation trace info to.
 	NetworkMessageTraceServer string `version[13]:""`
 
+	// NetworkMessageCaptureFile, when set, is the file to which the network records every incoming and outgoing
+	// tagged message with its timestamp, peer and payload, so that the messages can be replayed offline with the
+	// netreplay tool. A relative path is taken relative to the data directory.
+	NetworkMessageCaptureFile string `version[27]:""`
+
+	// NetworkMessageCaptureSizeLimit is the size in bytes at which the network message capture file is rotated.
+	NetworkMessageCaptureSizeLimit uint64 `version[27]:"268435456"`
+
+	// NetworkMessageCaptureArchives is the number of rotated network message capture files to keep.
+	NetworkMessageCaptureArchives int `version[27]:"3"`
+
 	// VerifiedTranscationsCacheSize defines the number of transactions that the verified transactions cache would hold before cycling the cache storage in a round-robin fashion.
 	VerifiedTranscationsCacheSize int `version[14]:"30000" version[23]:"150000"`
 
@@ -472,6 +513,12 @@ type Local struct {
 	// i.e. the ledger can answer account states questions for the range Latest-MaxAcctLookback...Latest
 	MaxAcctLookback uint64 `version[23]:"4"`
 
+	// EnableAccountHistory enables an index of the per-round account, resource and box changes, which allows the
+	// ledger to answer account states questions for any round since the index was started, rather than only within
+	// the MaxAcctLookback range. The index is only maintained by Archival nodes, and is restarted from the current
+	// round whenever it falls out of sync with the ledger ( e.g. after a fast catchup ).
+	EnableAccountHistory bool `version[27]:"false"`
+
 	// EnableUsageLog enables 10Hz log of CPU and RAM usage.
 	// Also adds 'algod_ram_usage` (number of bytes in use) to /metrics
 	EnableUsageLog bool `version[24]:"false"`
@@ -490,6 +537,17 @@ type Local struct {
 	// EnableExperimentalAPI enables experimental API endpoint. Note that these endpoints have no
 	// guarantees in terms of functionality or future support.
 	EnableExperimentalAPI bool `version[26]:"false"`
+
+	// EnableFollowMode starts the node in follower mode: the ledger is kept current by catchup only,
+	// agreement is never run, transactions are never relayed and participation keys are never held.
+	// The ledger sync round starts at the next round, so that state deltas are kept until a
+	// consumer advances it.
+	EnableFollowMode bool `version[27]:"false"`
+
+	// BlockArchiveRounds, when non-zero on an archival node, moves blocks older than this many rounds out of
+	// the blocks database into compressed, immutable segment files stored next to it. The ledger keeps at least
+	// the blocks needed by the trackers in the database, regardless of this value.
+	BlockArchiveRounds uint64 `version[27]:"0"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
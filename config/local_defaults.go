@@ -1,4 +1,4 @@
-// Copyright (C) 2019-2023 Algorand, Inc.
+// Copyright (C) 2019-2026 Algorand, Inc.
 // This file is part of go-algorand
 //
 // go-algorand is free software: you can redistribute it and/or modify
@@ -26,9 +26,13 @@ var defaultLocal = Local{
 	AgreementIncomingBundlesQueueLength:        15,
 	AgreementIncomingProposalsQueueLength:      50,
 	AgreementIncomingVotesQueueLength:          20000,
+	AgreementTimelineArchives:                  3,
+	AgreementTimelineFile:                      "",
+	AgreementTimelineSizeLimit:                 67108864,
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
+	BlockArchiveRounds:                         0,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverDirectory:                           "",
@@ -37,6 +41,7 @@ var defaultLocal = Local{
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
 	CatchupBlockDownloadRetryAttempts:          1000,
+	CatchupBlockRangeSize:                      4,
 	CatchupBlockValidateMode:                   0,
 	CatchupFailurePeerRefreshRate:              10,
 	CatchupGossipBlockFetchTimeoutSec:          4,
@@ -52,6 +57,7 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAccountHistory:                       false,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
@@ -61,6 +67,7 @@ var defaultLocal = Local{
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
+	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
@@ -79,6 +86,7 @@ var defaultLocal = Local{
 	FallbackDNSResolverAddress:                 "",
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
+	GossipCompressionTags:                      "AV,PP,TX",
 	GossipFanout:                               4,
 	HeartbeatUpdateInterval:                    600,
 	IncomingConnectionsLimit:                   2400,
@@ -96,6 +104,9 @@ var defaultLocal = Local{
 	MaxConnectionsPerIP:                        15,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
 	NetAddress:                                 "",
+	NetworkMessageCaptureArchives:              3,
+	NetworkMessageCaptureFile:                  "",
+	NetworkMessageCaptureSizeLimit:             268435456,
 	NetworkMessageTraceServer:                  "",
 	NetworkProtocolVersion:                     "",
 	NodeExporterListenAddress:                  ":9100",
@@ -104,6 +115,8 @@ var defaultLocal = Local{
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
 	ParticipationKeysRefreshInterval:           60000000000,
+	PeerBanDurationSeconds:                     3600,
+	PeerBanThreshold:                           100,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
 	PriorityPeers:                              map[string]bool{},
@@ -138,6 +138,11 @@ func (b *Builder) SignedWeight() uint64 {
 	return b.signedWeight
 }
 
+// ProvenWeight returns the weight the signed weight must exceed for the state proof to be built.
+func (b *Builder) ProvenWeight() uint64 {
+	return b.provenWeight
+}
+
 // coinIndex returns the position pos in the sigs array such that the sum
 // of all signature weights before pos is less than or equal to coinWeight,
 // but the sum of all signature weights up to and including pos exceeds
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 
@@ -364,6 +365,10 @@ type proofParams struct {
 	HashType string `url:"hashtype"`
 }
 
+type pendingStateProofsParams struct {
+	MaxMissingSigners uint64 `url:"max-missing-signers"`
+}
+
 type accountInformationParams struct {
 	Format  string `url:"format"`
 	Exclude string `url:"exclude"`
@@ -554,6 +559,60 @@ func (client RestClient) Catchup(catchpointLabel string) (response model.Catchpo
 	return
 }
 
+type catchupParams struct {
+	CatchpointFile   string   `url:"catchpoint-file,omitempty"`
+	CatchpointDeltas []string `url:"catchpoint-deltas,comma,omitempty"`
+	BlocksDir        string   `url:"blocks-dir,omitempty"`
+}
+
+// CatchupFromLocalSource start catching up to the give catchpoint label, loading the catchpoint file, the incremental
+// catchpoint files applied on top of it and the blocks from the given paths on the node's filesystem. Either the catchpoint
+// file or the blocks directory could be left empty to retrieve that data from the network.
+func (client RestClient) CatchupFromLocalSource(catchpointLabel string, catchpointFile string, deltaFiles []string, blocksDir string) (response model.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), catchupParams{CatchpointFile: catchpointFile, CatchpointDeltas: deltaFiles, BlocksDir: blocksDir}, "POST", false, true, false)
+	return
+}
+
+// StartCatchpointGeneration requests the generation of a catchpoint label and file for the next accounts commit
+func (client RestClient) StartCatchpointGeneration() (response model.CatchpointGenerationResponse, err error) {
+	err = client.submitForm(&response, "/v2/catchpoint", nil, "POST", false, true, false)
+	return
+}
+
+// GetCatchpointGeneration returns the status of the last requested catchpoint generation
+func (client RestClient) GetCatchpointGeneration() (response model.CatchpointGenerationResponse, err error) {
+	err = client.get(&response, "/v2/catchpoint", nil)
+	return
+}
+
+// GetNetworkPeers returns the connected peers and their traffic by message tag
+func (client RestClient) GetNetworkPeers() (response model.NetworkPeersResponse, err error) {
+	err = client.get(&response, "/v2/debug/network/peers", nil)
+	return
+}
+
+// GetPeerBans returns the banned peer addresses
+func (client RestClient) GetPeerBans() (response model.PeerBansResponse, err error) {
+	err = client.get(&response, "/v2/peers/bans", nil)
+	return
+}
+
+type banPeerParams struct {
+	Duration uint64 `url:"duration,omitempty"`
+}
+
+// BanPeer bans a peer address for the given duration, or for the node's configured duration if zero
+func (client RestClient) BanPeer(address string, duration time.Duration) (response model.PeerBanResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/peers/bans/%s", address), banPeerParams{Duration: uint64(duration / time.Second)}, "POST", false, true, false)
+	return
+}
+
+// UnbanPeer lifts the ban of a peer address
+func (client RestClient) UnbanPeer(address string) (err error) {
+	err = client.delete(nil, fmt.Sprintf("/v2/peers/bans/%s", address), nil, true)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -648,6 +707,19 @@ func (client RestClient) TransactionProof(txid string, round uint64, hashType cr
 	return
 }
 
+// PendingStateProofs gets the state proofs the node collects signatures for, with at most
+// maxMissingSigners of the heaviest voters that haven't signed each of them.
+func (client RestClient) PendingStateProofs(maxMissingSigners uint64) (response model.PendingStateProofsResponse, err error) {
+	err = client.get(&response, "/v2/stateproofs/pending", pendingStateProofsParams{MaxMissingSigners: maxMissingSigners})
+	return
+}
+
+// PendingStateProofVoters gets the voters of a state proof the node collects signatures for.
+func (client RestClient) PendingStateProofVoters(round uint64) (response model.PendingStateProofVotersResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/stateproofs/pending/%d", round), nil)
+	return
+}
+
 // PostParticipationKey sends a key file to the node.
 func (client RestClient) PostParticipationKey(file []byte) (response model.PostParticipationResponse, err error) {
 	err = client.post(&response, "/v2/participation", file)
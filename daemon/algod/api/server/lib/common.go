@@ -21,8 +21,8 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/logging"
-	"github.com/algorand/go-algorand/node"
 )
 
 // GenesisJSONText is initialized when the node starts.
@@ -42,10 +42,16 @@ type Route struct {
 // Routes contains all routes
 type Routes []Route
 
+// NodeInterface is the subset of the node used by the common handlers.
+type NodeInterface interface {
+	GenesisID() string
+	GenesisHash() crypto.Digest
+}
+
 // ReqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with the node
 type ReqContext struct {
-	Node     *node.AlgorandFullNode
+	Node     NodeInterface
 	Log      logging.Logger
 	Context  echo.Context
 	Shutdown <-chan struct{}
@@ -22,8 +22,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
 
@@ -81,6 +83,34 @@ func (s *TestSuite) TestGetTransactionV1Sunset() {
 
 }
 
+// routerTestNode only provides the config, which is all NewRouter reads while registering routes.
+type routerTestNode struct {
+	APINodeInterface
+	cfg config.Local
+}
+
+func (n routerTestNode) Config() config.Local {
+	return n.cfg
+}
+
+func TestNewRouterDataRoutes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, follower := range []bool{false, true} {
+		cfg := config.GetDefaultLocal()
+		cfg.EnableFollowMode = follower
+		e := NewRouter(logging.TestingLog(t), routerTestNode{cfg: cfg}, nil, "", "", nil, 0)
+
+		paths := make(map[string]bool)
+		for _, route := range e.Routes() {
+			paths[route.Method+" "+route.Path] = true
+		}
+		require.True(t, paths["GET /v2/deltas/stream"], "follower %v", follower)
+		require.True(t, paths["GET /v2/deltas/:round"], "follower %v", follower)
+		require.Equal(t, !follower, paths["GET /v2/participation"], "follower %v", follower)
+	}
+}
+
 func TestTestSuite(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	suite.Run(t, new(TestSuite))
@@ -23,9 +23,43 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 )
 
+// PreEncodedLedgerStateDeltaStreamEntry mirrors model.LedgerStateDeltaStreamEntry
+type PreEncodedLedgerStateDeltaStreamEntry struct {
+	Round uint64                 `codec:"round"`
+	Block bookkeeping.Block      `codec:"block"`
+	Delta model.LedgerStateDelta `codec:"delta"`
+}
+
+// ledgerStateDeltaStreamEntry reads the block of a round and the state delta
+// it produced from the ledger.
+func ledgerStateDeltaStreamEntry(ledger LedgerForAPI, round basics.Round) (PreEncodedLedgerStateDeltaStreamEntry, error) {
+	sDelta, err := ledger.GetStateDeltaForRound(round)
+	if err != nil {
+		return PreEncodedLedgerStateDeltaStreamEntry{}, err
+	}
+	blk, err := ledger.Block(round)
+	if err != nil {
+		return PreEncodedLedgerStateDeltaStreamEntry{}, err
+	}
+	consensusParams, err := ledger.ConsensusParams(round)
+	if err != nil {
+		return PreEncodedLedgerStateDeltaStreamEntry{}, err
+	}
+	delta, err := stateDeltaToLedgerDelta(sDelta, consensusParams, blk.RewardsLevel, uint64(round))
+	if err != nil {
+		return PreEncodedLedgerStateDeltaStreamEntry{}, err
+	}
+	return PreEncodedLedgerStateDeltaStreamEntry{
+		Round: uint64(round),
+		Block: blk,
+		Delta: delta,
+	}, nil
+}
+
 // convertAppResourceRecordToGenerated takes ledgercore.AppResourceRecord and converts it to v2.model.AppResourceRecord
 func convertAppResourceRecordToGenerated(app ledgercore.AppResourceRecord) model.AppResourceRecord {
 	var appLocalState *model.ApplicationLocalState = nil
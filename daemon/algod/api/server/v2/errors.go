@@ -45,7 +45,14 @@ var (
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
+	errFailedToStartCatchpointGeneration       = "failed to start catchpoint generation : %v"
+	errNoCatchpointGenerationRequested         = "no catchpoint generation was requested"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errRequestedMaxMissingSignersTooHigh       = "max-missing-signers is too high"
+	errRoundNotAvailable                       = "given round is no longer available in the ledger"
+	errNoPeerAddressSpecified                  = "no peer address was specified"
+	errInvalidBanDuration                      = "invalid ban duration"
+	errPeerAddressNotBanned                    = "peer address is not banned"
 )
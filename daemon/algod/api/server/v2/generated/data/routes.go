@@ -21,6 +21,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Stream blocks and their LedgerStateDelta objects, starting at a given round.
+	// (GET /v2/deltas/stream)
+	StreamLedgerStateDeltas(ctx echo.Context, params StreamLedgerStateDeltasParams) error
 	// Get a LedgerStateDelta object for a given round
 	// (GET /v2/deltas/{round})
 	GetLedgerStateDelta(ctx echo.Context, round uint64) error
@@ -40,6 +43,40 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// StreamLedgerStateDeltas converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamLedgerStateDeltas(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamLedgerStateDeltasParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "sync" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sync", ctx.QueryParams(), &params.Sync)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sync: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamLedgerStateDeltas(ctx, params)
+	return err
+}
+
 // GetLedgerStateDelta converts echo context to params.
 func (w *ServerInterfaceWrapper) GetLedgerStateDelta(ctx echo.Context) error {
 	var err error
@@ -126,6 +163,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/deltas/stream", wrapper.StreamLedgerStateDeltas, m...)
 	router.GET(baseURL+"/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
 	router.DELETE(baseURL+"/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
 	router.GET(baseURL+"/v2/ledger/sync", wrapper.GetSyncRound, m...)
@@ -136,178 +174,245 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PcNrLoX0HNOVV+3KEkP5Jdqyp1rmInWd3YWZelZO85lm+CIXtmsOIAXACUZuLr",
-	"/34KDYAESYBDPeLsnsonW0M8Go1Go9HPj7NcbCrBgWs1O/44q6ikG9Ag8S+a56LmOmOF+asAlUtWaSb4",
-	"7Nh/I0pLxlez+YyZXyuq17P5jNMNtG1M//lMwj9qJqGYHWtZw3ym8jVsqBlY7yrTuhlpm61E5oY4sUOc",
-	"vpp9GvlAi0KCUkMo/8rLHWE8L+sCiJaUK5qbT4pcM70mes0UcZ0J40RwIGJJ9LrTmCwZlIU68Iv8Rw1y",
-	"F6zSTZ5e0qcWxEyKEoZwvhSbBePgoYIGqGZDiBakgCU2WlNNzAwGVt9QC6KAynxNlkLuAdUCEcILvN7M",
-	"jt/PFPACJO5WDuwK/7uUAL9CpqlcgZ59mMcWt9QgM802kaWdOuxLUHWpFcG2uMYVuwJOTK8D8qZWmiyA",
-	"UE7effuSPHv27IVZyIZqDYUjsuSq2tnDNdnus+NZQTX4z0Nao+VKSMqLrGn/7tuXOP+ZW+DUVlQpiB+W",
-	"E/OFnL5KLcB3jJAQ4xpWuA8d6jc9Ioei/XkBSyFh4p7Yxve6KeH8v+uu5FTn60owriP7QvArsZ+jPCzo",
-	"PsbDGgA67SuDKWkGfX+Uvfjw8cn8ydGnf3t/kv2X+/OLZ58mLv9lM+4eDEQb5rWUwPNdtpJA8bSsKR/i",
-	"452jB7UWdVmQNb3CzacbZPWuLzF9Leu8omVt6ITlUpyUK6EIdWRUwJLWpSZ+YlLz0rApM5qjdsIUqaS4",
-	"YgUUc8N9r9csX5OcKjsEtiPXrCwNDdYKihStxVc3cpg+hSgxcN0KH7igf15ktOvagwnYIjfI8lIoyLTY",
-	"cz35G4fygoQXSntXqZtdVuR8DQQnNx/sZYu444amy3JHNO5rQagilPiraU7YkuxETa5xc0p2if3dagzW",
-	"NsQgDTenc4+aw5tC3wAZEeQthCiBckSeP3dDlPElW9USFLleg167O0+CqgRXQMTi75Brs+3/5+yvPxAh",
-	"yRtQiq7gLc0vCfBcFOk9dpPGbvC/K2E2fKNWFc0v49d1yTYsAvIbumWbekN4vVmANPvl7wctiARdS54C",
-	"yI64h842dDuc9FzWPMfNbaftCGqGlJiqSro7IKdLsqHbr47mDhxFaFmSCnjB+IroLU8KaWbu/eBlUtS8",
-	"mCDDaLNhwa2pKsjZkkFBmlFGIHHT7IOH8ZvB00pWATh+kCQ4zSx7wOGwjdCMObrmC6noCgKSOSA/Os6F",
-	"X7W4BN4wOLLY4adKwhUTtWo6JWDEqcfFay40ZJWEJYvQ2JlDh+Eeto1jrxsn4OSCa8o4FIbzItBCg+VE",
-	"SZiCCccfM8MrekEVfPk8dYG3Xyfu/lL0d310xyftNjbK7JGM3IvmqzuwcbGp03/C4y+cW7FVZn8ebCRb",
-	"nZurZMlKvGb+bvbPo6FWyAQ6iPAXj2IrTnUt4fiCPzZ/kYycacoLKgvzy8b+9KYuNTtjK/NTaX96LVYs",
-	"P2OrBDIbWKOvKey2sf+Y8eLsWG+jj4bXQlzWVbigvPMqXezI6avUJtsxb0qYJ81TNnxVnG/9S+OmPfS2",
-	"2cgEkEncVdQ0vISdBAMtzZf4z3aJ9ESX8lfzT1WVpreuljHUGjp29y3qBpzO4KSqSpZTg8R37rP5apgA",
-	"2FcCbVsc4oV6/DEAsZKiAqmZHZRWVVaKnJaZ0lTjSP8uYTk7nv3bYatcObTd1WEw+WvT6ww7GXnUyjgZ",
-	"raobjPHWyDVqhFkYBo2fkE1YtocSEeN2Ew0pMcOCS7iiXB+075EOP2gO8Hs3U4tvK8pYfPfeV0mEE9tw",
-	"AcqKt7bhA0UC1BNEK0G0orS5KsWi+eHhSVW1GMTvJ1Vl8YGiITCUumDLlFaPcPm0PUnhPKevDsh34dgo",
-	"Zwte7szlYEUNczcs3a3lbrFGceTW0I74QBHcTiEPzNZ4NBgZ/j4oDt8Ma1EaqWcvrZjGf3FtQzIzv0/q",
-	"/K9BYiFu08SFryiHOfuAwV+Cl8vDHuUMCcfpcg7ISb/v7cjGjBInmFvRyuh+2nFH8Nig8FrSygLovti7",
-	"lHF8gdlGFtY7ctOJjC4Kc3CGA1pDqG591vaehygkSAo9GL4uRX75F6rW93DmF36s4fHDacgaaAGSrKla",
-	"H8xiUkZ4vNrRphwx0xBf72QRTHXQLPG+lrdnaQXVNFiagzculljUYz9keiAjb5e/4n9oScxnc7YN67fD",
-	"HpBzZGDKHmdnQSjMU94+EOxMpgGqGATZ2Nc7Ma/uG0H5sp08vk+T9ugbqzBwO+QWgTsktvd+DL4W2xgM",
-	"X4vt4AiILaj7oA8zDoqRGjZqAnyvHGQC99+hj0pJd0Mk49hTkGwWaERXhaeBhze+maXVvJ4shLwd9+mx",
-	"FU5afTKhZtSA+c57SMKmdZU5UozopGyD3kCtCW+cafSHj2Gsg4UzTX8DLCgz6n1goTvQfWNBbCpWwj2Q",
-	"/jrK9BdUwbOn5OwvJ188efrz0y++NCRZSbGSdEMWOw2KPHRvM6L0roRHw5Xh66gudXz0L597LWR33Ng4",
-	"StQyhw2thkNZ7aYVgWwzYtoNsdZFM666AXDK4TwHw8kt2olV3BvQXjFlJKzN4l42I4Wwop2lIA6SAvYS",
-	"002X106zC5cod7K+j6csSClkRL+GR0yLXJTZFUjFRMRU8ta1IK6FF2+r/u8WWnJNFTFzo+q35ihQRChL",
-	"b/l0vm+HPt/yFjejnN+uN7I6N++Ufeki32sSFalAZnrLSQGLetV5CS2l2BBKCuyId/R3oM92PEet2n0Q",
-	"afqZtmEcVfxqx/PgzWY2qoRi1dmEu7/N+ljx+jk71QMVAceg4zV+xmf9Kyg1vXf5pT9BDPaXfiMtsKQw",
-	"DfEV/Jqt1joQMN9KIZb3D2Nslhig+MGK56XpMxTSfxAFmMXW6h4u43awltbNnoYUThei1oQSLgpAjUqt",
-	"4td0wiyP9kA0Y+rw5tdrK3EvwBBSTmuz2roiaKQbcI62Y0ZzS70ZokYlrBiN+cm2stNZk28pgRbmVQ+c",
-	"iIUzFTgjBi6SooVR+4vOCQmRs9SBq5IiB6WgyJyKYi9ovp1lInoETwg4AtzMQpQgSyrvDOzl1V44L2GX",
-	"oT1ckYff/6Qe/Q7waqFpuQex2CaG3ubB5+xBQ6inTT9GcP3JQ7KjEojnueZ1aRhECRpSKLwRTpL714do",
-	"sIt3R8sVSLTM/KYU7ye5GwE1oP7G9H5XaOsq4eXlHjrnbIN6O065UJALXqjoYCVVOtvHlk2jzmvMrCDg",
-	"hDFOjAMnhJLXVGlrTWS8QCWIvU5wHiugmCnSACcFUjPyT14WHY6dm3uQq1o1gqmqq0pIDUVsDRy2I3P9",
-	"ANtmLrEMxm6kXy1IrWDfyCksBeM7ZNmVWARR3Sjdnbl9uDhUTZt7fhdFZQeIFhFjgJz5VgF2Q0+XBCBM",
-	"tYi2hMNUj3Ia95r5TGlRVYZb6KzmTb8Ums5s6xP9Y9t2SFxUt/d2IcDMrj1MDvJri1nr47Sm5gmNI5MN",
-	"vTSyBz6IrdlzCLM5jJliPIdsjPLNsTwzrcIjsPeQ1tVK0gKyAkq6Gw76o/1M7OexAXDH24eP0JBZf5b4",
-	"preU7N0HRoYWOJ6KCY8Ev5DcHEHz8mgJxPXeM3IBOHaMOTk6etAMhXNFt8iPh8u2Wx0ZEW/DK6HNjlty",
-	"QIgdQ58CbwINzci3xwR2ztpnWX+K/wTlJmjEiJtPsgOVWkI7/o0WkFCmOTfg4Lj0uHuPAUe5ZpKL7WEj",
-	"qROb0Oy9pVKznFX41Pkedvf+8utPELU3kQI0ZSUUJPhgX4FV2J9YR4z+mLd7CU5SwgzBH2hhIsspmUKJ",
-	"pwv8Jezwyf3WevidB36B9/CUjYxqrifKCQLq/YaMBB42gS3Ndbkzcppew45cgwSi6sWGaW1dNrsvXS2q",
-	"LBwgquAemdFZc6x3nN+BKealMxwqWN5wK+Yz+yQYh++89y7ooMM9BSohygnKowEyohBMMvyTSphdZ85D",
-	"2LuRekrqAOmYNprymtv/geqgGVdA/lPUJKccX1y1hkakERLlBJQfzQxGAmvmdCb+FkNQwgbsQxK/PH7c",
-	"X/jjx27PmSJLuPZu9aZhHx2PH6Ma561QunO47kFVaI7baeT6QM0/3nvOeaHHU/abmN3IU3bybW/wxlxg",
-	"zpRSjnDN8u/MAHoncztl7SGNTDOv47iTlPrB0LF1476fsU1d3teGLykrawlp69jFxfvl5uLiA/nWtvSG",
-	"7bkn8hAd121YxNLdRrVE1xpSMvO+lYIWRkCI6vZxkXyVNc6ZKgrORhlw/ubOIeW7XiDfVBjIAnJaW69k",
-	"x7UdBK17qDqIyIu93e2jMLqQierxutT20g6xupKirohqtt1SgaYafhtVczt0DMrhxIFvUPsx5R5knonl",
-	"7h5uazsQkVBJUMhbQ/WKsl/FMoy/ccxX7ZSGzVADbbv+nHifvUu+cwQvGYdsIzjsoiGnjMMb/Bjrbfl7",
-	"ojPetKm+feG5A38PrO48U6jxrvjF3Q4Y2tvGL+4eNr8/bs/4EEYeoXINyopQkpcMVW+CKy3rXF9wio/7",
-	"4LBF/Af8Myat7nnpm8T1SxH1jxvqglP0HWme/FG+uIQIX/4WwGt9VL1agdI9KXEJcMFdK8ZJzZnGuTZm",
-	"vzK7YRVINOIf2JYbuiNLWqJ26leQgixq3WWuGCChNCtLZwkx0xCxvOBUkxIMV33D+PkWh/OWRE8zHPS1",
-	"kJcNFg6i52EFHBRTWdzP4Tv7FV3Q3PLXzh0No1XtZ6s7N+O3URQ7fPu3EZj/7+F/HL8/yf6LZr8eZS/+",
-	"1+GHj88/PXo8+PHpp6+++v/dn559+urRf/x7bKc87DH3fQf56Sv3pjh9hYJjqzwfwP7ZFKcbxrMokYUm",
-	"4h5tkYdG/PUE9KirVtBruOB6yw0hXdGSFVTfjhz6LG5wFu3p6FFNZyN6agS/1huKY3fgMiTCZHqs8dbX",
-	"+NA1KB4og9YcF/uC52VZc7uVtXIWJfQD9y4aYjlvgqFsEoRjgpEya+r9i9yfT7/4cjZvI1ya77P5zH39",
-	"EKFkVmxjcUwFbGNStjsgeDAeKFLRnQId5x4Ie9QbxRrFw2E3YJ5nas2qz88plGaLOIfz3rXutb7lp9y6",
-	"vZrzg7ahnVM5i+Xnh1tLgAIqvY4FR3ckBWzV7iZAz15fSXEFfE7YARz0X8vFCpT3iymBLjFIF+0bYkq0",
-	"QHMOLKF5qgiwHi5k0pM0Rj8o3Dpu/Wk+c5e/und53A0cg6s/Z2MI8n9rQR589805OXQMUz2wIXV26CAI",
-	"KqKFcn7+HU8Ow81sSggbU3jBL/grWDLOzPfjC15QTQ8XVLFcHdYK5Ne0pDyHg5Ugxz504BXV9IIPJK1k",
-	"1pYgaINU9aJkObkMJeKWPG0kfvTZSMuVMA/HvlF7KL+6qaL8xU6QXTO9FrXOXKhxJuGaypjRQDWhpjiy",
-	"TRQwNuucuLEtK3ahzG78OM+jVaX6IWfD5VdVaZYfkKFyAVVmy4jSQnpZxAgoFhrc3x+EuxgkvfZx6rUC",
-	"RX7Z0Oo94/oDyS7qo6NnQDoxWL+4K9/Q5K6Cjr7yViFxfV0lLty+a2CrJc0qukooDTTQCncf5eUNPrLL",
-	"kmC3TuyX923FodoFeHykN8DCceM4Flzcme3lc8bEl4CfcAuxjRE3WovpbfcriAa79Xb1IsoGu1TrdWbO",
-	"dnRVypC435kmlcTKCFnejK3YCl0FXdaNBZB8DfklFJgAADaV3s073b2nhBM0PetgyibKsLEcGM2Nqt0F",
-	"kLoqqBPFewolg2EFWntfxXdwCbtz0QaD3ySOthvWqVIHFSk1kC4NsYbH1o3R33znjoO6rqry0ZEYJuPJ",
-	"4rihC98nfZCtyHsPhzhGFJ2wwxQiqIwgwhJ/AgW3WKgZ706kH1ueeWUs7M0XyavheT9xTdrHk/OcCVeD",
-	"0ZT2+wYw6464VmRBjdwuXMIYG7oYcLFa0RUkJORQuz4xQLCjkcdB9t170ZtOLPsX2uC+iYJsG2dmzVFK",
-	"AfPFkAo+Znr+Un4ma8CxClSCeeAcwhYlikmNY5llOlR2rBw2sVUKtDgBg+StwOHB6GIklGzWVPlcNpjy",
-	"x5/lSTLAbxiKO5aA4TRw9Qny+jSKb89z++d08Lp0aRh87gWfcCF8Wk5InmAkfPQujm2H4CgAFVDCyi7c",
-	"NvaE0oYFtxtk4PjrclkyDiSLeQ1RpUTObDKi9ppxc4CRjx8TYlXAZPIIMTIOwEbDJA5MfhDh2eSrmwDJ",
-	"XVgz9WOjSTP4G+IRGNaP1og8ojIsnPGEx7bnANS5mjX3V8/hEYchjM+JYXNXtDRszr342kEGeQBQbO1F",
-	"/TvT+KOUODuigbcXy43WZK+i26wmlJk80HGBbgTihdhmNgQrKvEutgtD71HXYgwIix1Mm3HhgSILsUV3",
-	"C7xarCvrHljScHgwghf+limkV+yXus0tMGPTjktTMSpUSDJOndeQS0qcmDJ1QoJJkcvDIInCrQDoKTva",
-	"dKPu8bv3kdoVT4aXeXurzdvkQD5qI3b8U0couksJ/A21ME3aA6dCeAe5kEVaT2EIlekmf+tQveCyzxq+",
-	"MTkxwkgu2ZPua8M/IYY7l/AK6MDTzjOCiFc25mgAyTfbShjp1sYk2QQVDilWTpRgQy2V1VkpxlclNJ6b",
-	"UTTFFux9kjzG7ZLbhFN+wGmyc2xzE4/8MViqKg7HTV4q7xx+RqBInPIWDpTD7wiJS1IxCsunNH287Yv2",
-	"0YPSda/ppkYJ3lqx28GQz9CaObSZKigBX89Z57WRXcZs3BcX7xWgaHbmuwVaPkzAQvnuUeCzJWHFlIbW",
-	"2mQkWI/pz63Hp5j3TYhlenW6kkuzvndCNPKcTSyEHTvL/OwrQJ/nJZNKZ2iqiy7BNPpWofbpW9M0/qjo",
-	"eoXZFKisiF+iOO0l7LKClXWcXt28378y0/7QyA6qXqBgwjgBmq/JAlP2Rn1FR6a27sSjC35tF/ya3tt6",
-	"p50G09RMLA25dOf4FzkXvZtujB1ECDBGHMNdS6J05AINQnyH3DF4YNjDidfpwZiZYnCYCj/2Xv8qH2ic",
-	"EubsSCNrQdegpHNuxCHH+pFZpt5m648G43Khs47yI4KuRsGjNL20AWXdDearRqcSd5uy7+pJQ7u2ewbk",
-	"08fj+4dzQnBWwhWU+52gKWLcK3DQM8KOgK43BMMJvI/Hfql+uAMtwpqV9mGMUstAuhkz3LZPI5c/r31b",
-	"I8Ea3LnI98nWOyOheXpr6XtouquqrIASonFmfwsCyWhVYbYI3zgW0GMGY7yAbRwc+2key6k/VN7XjGub",
-	"f/W+Ujv2xpm+7DAB4hQUVDZV383TR6bfmMEuhWhOLypBlI1xYJQR4+DNyy6oRtKnvsQ1TquKFdue3dOO",
-	"mtSO3wvG8IJyg+3BQEAbsQhGCaqb+LJV5tn06528UweTMHPeTU8ZyjThVEz54iFDRDURzvtwdQ60/B52",
-	"P5m2uJzZp/nsbmbSGK7diHtw/bbZ3iie0Q3Pms06Xg83RDmtKimuaJk5Y3KKNKW4cqSJzb3t+TNLa3Gu",
-	"d/7Nyeu3DvxP81leApVZ89pJrgrbVf8yq7I5NhMHxBcnWFPd6OfsazjY/CYxYGiAvl6DSwQfPKgHGWtb",
-	"54LgKDqD9DLuDbzXvOz8IOwSR/whoGrcIVpTnfWG6HpA0CvKSm8j89AmPHdxcdPuxihXCAe4sydFeBfd",
-	"K7sZnO746Wipaw9PCucaSVW/sdUYFBG87y5nXsFoekNS3VDMN2stIEPmxOsNWg0yVbI8bk/lCwyx4dZP",
-	"xjQm2DjxnjYj1izhdsVrFoxlmqkJSu0ekMEcUWT63MUp3C2EK6NVc/aPGggrgGvzSeKp7B1U1J86y/rw",
-	"Oo1LlW5ga41vh7+LjBHmWu7feE7mGhMwQq+cAbivGq2fX2hjfTI/BO4HN3DuC2ccXIkjjnmOPhw120CF",
-	"dde7ZrKEvrfklte/uaTPiTmiJbSYypZS/ApxVRVq+CLRoT67NEOP1l+BTwgpay05bSWwdvbkdqekm9Di",
-	"1HVITFA97nzggoNpbr01mnK71baiTcevPU4wYQTJoR2/JRgH8yDqpqTXCxrLAWyEDANTYH7p2M21IL6z",
-	"x72z0TCX8PuABH5jTVtmE39UINvA7WESsVsKDHbayaJCKxkg1YYywdz6+pRKRIap+TXltjASWiPwKLne",
-	"5oHvFULXQmLaHhU38ReQs01UuXRx8b7Ih+bcgq2YLQtUKwjqzriBbD01S0Wudo91p2tRc7okR/OgspXb",
-	"jYJdMcUWJWCLJ7bFgiqwShXvueG7mOUB12uFzZ9OaL6ueSGh0GtlEasEaYQ6fN40jioL0NcAnBxhuycv",
-	"yEN00VHsCh4ZLLr7eXb85AUaWO0fR7ELwNX/GuMmxTIMco3TMfoo2TEM43ajHkS1AbZoY5pxjZwm23XK",
-	"WcKWjtftP0sbyukK4l6hmz0w2b64m2gL6OGFF7bimNJS7AhLhBuDpoY/JSLNDPuzYJBcbDZMb5wjhxIb",
-	"Q09tURk7qR/Oli9z+cA9XP4j+kNV3h2k94j8vHYfe7/FVo1eaz/QDXTROifU5moqWeup6KsUkFOfCg4T",
-	"pDd50S1uzFxm6SjmoOPiklSScY0Pi1ovsz+TfE0lzQ37O0iBmy2+fB5JCt9NTsxvBvhnx7sEBfIqjnqZ",
-	"IHsvQ7i+5CEXPNsYjlI8aiM7g1OZdNyKu+ik/ITGh54qlJlRsiS51R1yowGnvhPh8ZEB70iKzXpuRI83",
-	"Xtlnp8xaxsmD1maHfnz32kkZGyFj+V3b4+4kDglaMrhCP/34Jpkx77gXspy0C3eB/vc1nnqRMxDL/FlO",
-	"PgRuYvEJ3gZo8wk9E29j7elaejoyV9Tsgy+caRYQW/N0n93jLtWQOp1vApXn0NOgSygROgGwPYzd7AV8",
-	"dxVDYPLp7FAKR92lxSjzaxFZsi+h0dh4XMRkRG+VukDMB8OgFm6oOemWK/j8HjXeLDL07DBfPKz4Rx/Y",
-	"35nZIJL9ChKbGJRSiW5n0XwPnMso+Vpsp25qj3f7jf0nQE0UJTUri5/a3CC9SjWS8nwddRZZmI4/tzU1",
-	"m8XZwxxN8LumnFtvhKFuAl8pP/vXTOS99XcxdZ4N4xPb9ovn2OX2FtcC3gXTA+UnNOhlujQThFjtpl1o",
-	"wvrKlSgIztNmk23v9WHRpaA0xj9qUDp2L+IHG1qAGvWloWJboQJ4gXqMA/KdrYm/BtLJFYj6A5ulCQpf",
-	"J8CaeuqqFLSYEzPO+Tcnr4md1faxleFsZYiVvXY7q0j7597E0XbMt/Y+IvrMqpXG1J1K000VS1FiWpz7",
-	"BpgHJbQu4cM6xM4BeWV1Gsq/mO0khh6WTG6gIM10TqpGmjD/0Zrma1QWdFhqmuSnlzTxVKmCMsJNOcAm",
-	"ezSeOwO3q2pii5rMiTCSwzVTthQ6XEE3K0qTIsiJAT5LSnd5subcUkpUKh5LYXUbtHvgrBekN0BFIesh",
-	"/obSi3NTv2GFlzPsFc1m2S8XM6gfbHNsNGXe3vgK0JQLznLMJRm7ml1Z9SnW2QlpN+ORAc7fRs0ihyta",
-	"pKYJ1nBYTJat8YzQIW5oHgq+mk211GH/1Fi/e001WYFWjrNBMfe1lpyGmnEFLhs4VtgP+KSQHYs3csio",
-	"E0UrJ9+QjDA4O6Fy+NZ8+8EppDBq8ZJxfHr6GAkbIGl1yFj1WZv3KtNkJTCCwh2KcE3vTZ8DTNZSwPbD",
-	"ga8SjWNYg7FZtvWOGA514n0lnG+CafvStLUJ9dqfO3FwdtKTqnKTpitxReUBveVJBEds3o2jV4DcZvxw",
-	"tBFyG3VywvvUEBpcoYsEVMSFxiSqUvWCYIzQaikKWxDrHx3NoxV1E33NOLQ1zCMXRB69EnBj8Lwm+qlc",
-	"Um1FwEk87RxoiX4RMYamtDOK3XWo3gY7f9Iqn/k50tvYFtRKMI6mQSu4Ub5rSqcb6g6EiZe0bJyEIuWx",
-	"UKpyQpQLrukWzIoxDsO4fULO7gUwPAZDmch215Lak3OTmyiVqmRRFyvQGS2KmD7ha/xK8KtPVwpbyOsm",
-	"i3dVkRwz83VTFQ6pzU2UC67qzchcvsEdpwsq0EWoIayC53cYHa8XO/w3lsI6vTPOPejGPvbeF6howudu",
-	"Ijd3RxpIvYamM8VW2XRM4J1yd3S0U9+O0Nv+90rppVh1AfnMCcrGuFy4RzH+9o25OML8XYO87PZqadJr",
-	"oTuo8HWD8dnYJIbpciUfdTqYM8i8PK6ASFcYnePll4hrCXS91N6v1q6dim7Jk8FYVLv8CZqSURaUjEm3",
-	"fmU2+hyhiOv0U75k1pXMfB70niYZDuRsHHsUod5JcQjQ994DmlSUOaeNllkMMevCvdLqwrFD125wfxEu",
-	"iCqpsfv+KhXw5OOAbWRHrybjJbikSpWEKyZq7w7h/eX8k9D+6mriB3HFyfUP/WZwqt9XDZpU2p67+j92",
-	"me5N/v1P1ruSANdy90+gwh1s+qCiZSxncaeepROuovomPfWufNUUxby8yjaiGAuY/v4n8srblibdO56Q",
-	"Y+mWROGqyEWDxV+7EhC+mZE+J0/7xnU6qarxqRMR4sPJbcObTp9KNWXO55jW7a0/v7YOaKhCiLxVgnBm",
-	"DludKP7Uj4a9BgLbCjDXbRDYnM6eMZWgXJAjvlazEqiCEQyHWdtc24lIPt++Nu2nBdvHK7GmU862aWaR",
-	"eVZCsbY4T6xE60SX43OsshpYDIdjeX+/K8g1VmRq/ZgkwE0S6JrJgvLff6SeTShKGs9sT/8jaWbns5C3",
-	"RAMV3fGibYoctKqhyTWSqt62iTB715mZQ1LD3A9hfljSUsWroiWdXXuZTwKHlUii5/jCTosJ2b7dcuaB",
-	"DwQrxhEZjwSwzt//M5Fp/drvF52Dml3jr4pB4oUgeYgtrXRwAweSxosaJUPcrxVwVxl+GUPN/qio5RJy",
-	"za72JLr42xp4kERh7jXBCMsyyHvBmigbTCh6cztHC9BYHopReILE/ncGJxUjegm7B4p0qCFa62nuhfvb",
-	"5JJEDOCtZQSPSqiYl6I1XTnHMaYaykAseK9g2x3arNzJKrGBnHPLuTxJdiWekSnjZSonzWW63igTGAaM",
-	"pHJhDMvcpTUer7CqoGoquPtclKFekJxGCkG5XJaYlqSx1vqslqD8bz4HkZ2lZJcQ1rFF2zimUHAtospe",
-	"r0fORuSkQfR3tHoV5s7yM7M2hmMY7xvJAY3eT3kpsPJTKtypGzbRuHk9UNY5FMUUrESFcC1BunrfeDOU",
-	"QkGmhXetG4NjDBXWA/ZWSFDJugsWuGQ21HdtulesP2OTZVDn+BoukEjYUAOdDJKypuccQ/ZL+90HuPqc",
-	"XHt12g29ZnuzqvroHaYGSAypfkncbbk/cPY26m3GOcjM27r7PoXcoDK0v1ZSFHXuEsEEB6MxAUxOWDbC",
-	"SqKa4Xy4yoGSr8Rs4K+DNASXsDu0+pd8TfkqSK8WQm9Fe7uGIHNZb7fvVfMfV3KWK7uA1b3A+Xtqz+ez",
-	"SogySxhcT4eJZvtn4JLll0bMrlu/90ShTfIQ7XyNR831eucTq1YVcCgeHRBywm2kkXeu6VY66k3OH+ix",
-	"+bc4a1Hb3M9OsX9wweMhG5jUR96Rv/lhxrmaAsP87jiVHWRPGtNtIsmtpNeRsrNDf7rJ7i79UqAtUVko",
-	"YlLKLVN1TTrfQ+V+hPSDKojjr58wk1/rxSytjQilpbYyZFd4edOafqbVY/Qd9oAXKmuCioyeGzlwfmdX",
-	"4zcNUoKlJCmhs/x9+h+3wJYvBVukMGrSLNMmILZuat19CZR76mWjM4vjeahaw7R9gmPO36FKTqHN0KZh",
-	"DQjHnEt5RcvPr1bDfI4niA8o3qUFnvD9GyLZolLdzt/vNZ00d/DWvb+p+VtUA/4NzB5Fjb1uKGf8aSph",
-	"ehMZprinJSlFWxcZhyTXOKa1Dj/5kixcFF0lIWeK9QKMr31Vk+a5h0W+nI/lVu95X+5b509C34GM3QNB",
-	"VOSHtkKCFng/tBC2R/R3ZiqJkxul8hj1Dcgigr8YjwrT2ey5Li47ZmNbcabnDykk3LP5OHAEu6H5eJio",
-	"Z+ryrInUXDq1guE6J9/WHdxGLup2bVN9H4bIHUujP8VlIV4dw3RHnwmLECwtQxBU8suTX4iEJdaOFOTx",
-	"Y5zg8eO5a/rL0+5nc5wfP46KcZ/NW8LiyI3h5o1SjDOmDUJhYFsxmUj6984xd3dho/mOYAeIZ+csIVoN",
-	"Bqf2fqOfORU0ytx7Ffx2aa7xPn4WoMwvuZkohvufUrEL1j8/ESbTOws1K4t9h7IT9NRWvsWwnp9dQO7v",
-	"Unv3Z6vLHrJJV//wJj5y/QOAiImstTN5MFUQzjQhksl1i8QtIXHltWR6h3nCvOqT/Rz1qfmusZY4K3CT",
-	"WcbJHVpcQpNprrWt1MpLNt8JWqIsYN4z6KGohSgPyDdbuqlKcEzqqweLP8GzPz8vjp49+dPiz0dfHOXw",
-	"/IsXR0f0xXP65MWzJ/D0z188P4Inyy9fLJ4WT58/XTx/+vzLL17kz54/WTz/8sWfHpg7wIBsAZ35rBSz",
-	"/4sFqrOTt6fZuQG2xQmt2Pews7UwDRn7Kps0Ry4IG8rK2bH/6X977naQi007vP915oLeZ2utK3V8eHh9",
-	"fX0QdjlcoTI106LO14d+nkEZzpO3p014mPWFwh21kT+GFHBTHSmc4Ld335ydk5O3pwctwcyOZ0cHRwdP",
-	"MJdxBZxWbHY8e4Y/4elZ474f+iTCxx8/zWeHa6Al2sTNHxvQkuX+k7qmqxXIA1du1Px09fTQi3GHH50i",
-	"+dPYt8Owcs/hx46+vdjTEx1dDj/6JFbjrTtZopydIegwEYqxZocLjECe2hRU0Di9FHzcqcOP+DxJ/n7o",
-	"wjLjH/GZaM/AoTdKxVt2sPRRbw2svR451fm6rg4/4n+QJgOwrBN0AO5sFbOYfwfae4aFVUVa376Gtk8L",
-	"23zgcubS09l8vcfvp5UmAz+deaUXoJjLYYhcwhyB9hD7aKeWRaM5PsgtO5aF6dMHTMWCymo8Vk+Pju6t",
-	"Yu8AF5HSvX0HvKLxnXt+9OTeIOl6NEfAOOVofDasiFhWixA8/3wQvMT3LxeaLBkvbPkxTZEq7BYjQH/+",
-	"fABptvFKY46lF0Ehz//iHilkwr4YWYmWBFva6Z99vunPQF6xHMg5bCohqWTljvzIm7jRIIvZkHf8yC+5",
-	"uOYeciO91JsNlTvHVyjpnw9fpdbymKC+tLk26Uqh1hhLX8zm1pP+wyfHz+zpOcQkOruWzfmfd9xFbZUQ",
-	"M7//yBX4F4cN197xPMXksPHZjufvGs4z4B9Iq5+RTM4aePEEoX32n4KF/HFY7n5Y3sFGXIEi7h4LiJNI",
-	"MI8Wa+xCb8WWhg9GDs08eds7zflwJm81aAcfXP17zsT0Xeg+REes75Pg3OMuY4efUv2/qa7fi5GwUz2I",
-	"bdDsD0bwByO4R0aga8mTRzS4v9CFDCqXvCun+RoOpl+iO56HL4NKxJKknI0wC5caIsUrzrq84l/wffC5",
-	"j/VLyv157uy49VmgsmQgGyqgfJit4w8u8D9Hdka52L3B50RDWarw7GuBZ99q0Z1nMLfuCBP5QL82fOzn",
-	"w4/dkmsdZYha17oQ10FfNF5ay/tQR9JU6+78fXhNmc6WQjqvYMwnPeysgZaHLulI79c2znfwBYOXgx8D",
-	"fUr818Mml170Y19RFfvqFDWJRj5llP/cKqpDxS9yyEbl+/6D4U+YDNYxz1aPeXx4iJ52a6H04ezT/GNP",
-	"xxl+/NCQhM/FNqsku8LQ7g+f/jsAAP//TBVyWA7OAAA=",
+	"H4sIAAAAAAAC/+y9/3PcNpIo/q+g5q7KiT8zku04uY2rtu6j2EnWFydxWUr23Yv9NhiyZwYrDsAlQEkT",
+	"P/3vr7oBkCAJcDjS2Nlc7U+2hvjS3Wg0Go3+8n6WqW2pJEijZ8/ez0pe8S0YqOgvnmWqlmYhcvwrB51V",
+	"ojRCydkz/41pUwm5ns1nAn8tudnM5jPJtzB7Fvafzyr4Ry0qyGfPTFXDfKazDWw5Dmx2JbZuRrpZrNXC",
+	"DXFmh3j5YnY78oHneQVaD6H8URY7JmRW1DkwU3GpeYafNLsWZsPMRmjmOjMhmZLA1IqZTacxWwkocn3i",
+	"kfxHDdUuwNJNnkbptgVxUakChnA+V9ulkOChggaoZkGYUSyHFTXacMNwBoTVNzSKaeBVtmErVe0B1QIR",
+	"wguy3s6e/TLTIHOoaLUyEFf031UF8BssDK/WYGbv5jHkVgaqhRHbCGovHfUr0HVhNKO2hONaXIFk2OuE",
+	"fV9rw5bAuGRvvnnOPvvssy8RkS03BnLHZEms2tlDnGz32bNZzg34z0Ne48VaVVzmi6b9m2+e0/znDsGp",
+	"rbjWEN8sZ/iFvXyRQsB3jLCQkAbWtA4d7scekU3R/ryElapg4prYxkddlHD+33VVMm6yTamENJF1YfSV",
+	"2c9RGRZ0H5NhDQCd9iVSqsJBf3m0+PLd+8fzx49u/+2Xs8X/dn9+/tntRPSfN+PuoUC0YVZXFchst1hX",
+	"wGm3bLgc0uON4we9UXWRsw2/osXnWxL1ri/DvlZ0XvGiRj4RWaXOirXSjDs2ymHF68IwPzGrZQFa02iO",
+	"25nQrKzUlcghnzMh2fVGZBuWcW2HoHbsWhQF8mCtIU/xWhy7kc10G5IE4boTPQihf15itHjtoQTckDRY",
+	"ZIXSsDBqz/HkTxwucxYeKO1ZpQ87rNjFBhhNjh/sYUu0k8jTRbFjhtY1Z1wzzvzRNGdixXaqZte0OIW4",
+	"pP4OG6TaliHRaHE65yhu3hT5BsSIEG+pVAFcEvH8vhuSTK7Euq5As+sNmI078yrQpZIamFr+HTKDy/5f",
+	"5z/+wFTFvget+Rpe8+ySgcxUnl5jN2nsBP+7VrjgW70ueXYZP64LsRURkL/nN2Jbb5mst0uocL38+WAU",
+	"q8DUlUwBZEfcw2dbfjOc9KKqZUaL207bUdSQlYQuC747YS9XbMtv/vxo7sDRjBcFK0HmQq6ZuZFJJQ3n",
+	"3g/eolK1zCfoMAYXLDg1dQmZWAnIWTPKCCRumn3wCHkYPK1mFYAj5B5whJwGjoSbCM/g1sUvrORrCFjm",
+	"hP3kJBd9NeoSZCPg2HJHn8oKroSqddMpASNNPa5eS2VgUVawEhEeO3fk0Iwz28aJ161TcDIlDRcSciak",
+	"BVoZsJIoCVMw4fhlZnhEL7mGL57Obvd9nbj6K9Vf9dEVn7Ta1Ghht2TkXMSvbsPG1aZO/wmXv3BuLdYL",
+	"+/NgIcX6Ao+SlSjomPk7rp8nQ61JCHQI4Q8eLdaSm7qCZ2/lQ/yLLdi54TLnVY6/bO1P39eFEedijT8V",
+	"9qdXai2yc7FOELOBNXqbom5b+w+OFxfH5iZ6aXil1GVdhghlnVvpcsdevkgtsh3zUMY8a66y4a3i4sbf",
+	"NA7tYW6ahUwAmaRdybHhJewqQGh5tqJ/blbET3xV/Yb/lGWBvU25ipEW+didt2QbcDaDs7IsRMaRiG/c",
+	"Z/yKQgDsLYG3LU7pQH32PgCxrFQJlRF2UF6Wi0JlvFhoww2N9O8VrGbPZv922hpXTm13fRpM/gp7nVMn",
+	"1EetjrPgZXnAGK9Rr9EjwgIFNH0iMWHFHmlEQtpFRFYSKIILuOLSnMzmsT3ZbuBf3Ewtva0qY+ndu18l",
+	"Cc5swyVoq97ahg80C0jPiKyMyEra5rpQy+aHT87KsqUgfT8rS0sPUg1BkNYFN0Ib/Smhz9udFM7z8sUJ",
+	"+zYcm/RshbajJThVA8+GlTu13CnWGI4cDu2IDzSj5URLzO28IYPWYI7BcXRn2KgCtZ69vIKN/+LahmyG",
+	"v0/q/MdgsZC2aebCVsxRzl5g6Jfg5vJJj3OGjONsOSfsrN/3bmyDo8QZ5k68MrqedtwROjYkvK54aQF0",
+	"X+xZKiTdwGwjC+s9pelEQReFuf0c8hpBdee9tnc/RCHBD30YvipUdvkXrjdH2PNLP9Zw+9E0bAM8h4pt",
+	"uN6czGJaRri92tGmbDFsSLd3tgymOmlQPBZ6e1DLueEnsz68cbXEkp76kdCDKnJ3+ZH+wwuGn3Fvc+Pv",
+	"5WiTELRFVfCCkONV3l4Q7EzYABfeKLa1t3eGt+6DoHzeTh5fp0lr9LU1GLgVckjQCqmbo2+Dr9RNDIav",
+	"1M1gC6gb0MfgD3Vj/yMMbPUE+F44yBStvyMfryq+GxKZxp5CZEQQVVdNu0GGJz7O0lpez5aqupv06YkV",
+	"yVp7MuM4aiB85z0iUdO6XDhWjNikbIPeQO0T3rjQ6A8fo1iHCt+ChOpoarY9hfRirxLiHvB8B6YlL/VG",
+	"RdWO+QyqSlWJ4YBrJa3kW3FRQM7WDUoRITufFXwJRXywYB2p1ZwpmQFz6lh0NCQ+aFLWEpYXI7bu1aaB",
+	"i11zzZqeZErWkCmZa6aFzGxzKFW2idNjhLwBCtRq7nQ9obsEp4+sLGr7e4i5UpfLroAMptYmyrUXXfyo",
+	"1bMQxetKGCHXCzwg5uya279Wqlo4MHN6163cIkZpbZThxcKjEAeiNVA2qOLUBiQT0qg+sggOWiqAacVW",
+	"vIojbSe+vNo75yXsFvTEwEouqvtP3de4Q2bzazFFKCKU2nBTW6kYghGsWjM8U5LlsOVon+pIi3PDP4DM",
+	"1IYHou4eMrM70LFlptqWooAjiMlNVEVEk+JnT9j5X84+f/zkb08+/wKXqqzUuuJbttwZ0OwTZ8lh2uwK",
+	"+DQuj9DQFh/9i6f+zaI7bmwcreoqgy0vh0PZtxArwG0zhu2GVOuSmbBuAJzEtYB6nyU7s898CNoLobnW",
+	"sF0eZTFSBMvbWXLmIMlhLzMdil47zS5EsdpV9TFO5ObkHCxwWSmjMlUsrqDSQkUeVl+7Fsy18GdI2f/d",
+	"QkuHGs5ND0W1pOtHTIrfyOlaoh364ka2tBnVEy2+EezcvFPWpUt8/+6gWYmP1jcoGZf1umM3WVVqyzjL",
+	"qSOJzG/BnO9kRjb4YzBp+sDfCkkPgnons8DCgwtVQL6G6qiWnD5VvDXfTvVAR8BBcryiz2QEfAGF4Ue/",
+	"7fQniMH+3C+kBZbl2FDHwDs3FfDtBwfSTvO1NNUuaq/AAwz4FmUtXRntc77ZgKg8DtYUajEhxnsl1hsT",
+	"3KxfV0qtjo9JbJYYDvTB6hwF9hlaJ34Ac62qy9cA1TEuoSVANV28BJPvFS125KnaVqakhAwlIfULVs5U",
+	"fLUSmUVe5XBOetkRlKp2sFZm4d4MJRVfqtowzqTKvUYYV7cSzliEGjmvmFCDMxtrZ1kCCoSM17jU+C6m",
+	"YidA23HBM0vghWXwffq1bWWns44+RQU8R1suSKaW7oHYPV0Tkpz8SoxXWJyyF1XzA7jKSmWgNWrah143",
+	"6DAwI3QiwAngZhZ3Bbg3sIdcUTT75Luf9ae/A7xT7nHUJkbexswnZALqadOPMVx/8pDteAXMiwVmFOmn",
+	"BRhIkfAgmiTXrw/RYBXvT5YrqOg9/oNyvJ/kfgzUgPqB+f2+0NZlwrfXXVgvxJZeaySXyhmAooMVXJvF",
+	"PrGMjUJcNGIQSMK4LUyblLHuFdfG+pAImZPp2x4nNA/1oSnSACcvFjjyz/5OMRw7U1KD1LVuLhi6LktV",
+	"GchjOKDjUXquH+CmmUutgrGbW4xRrNawb+QUlYLxHbEsJpZA3DRPrc7JaogcPUjiOb+LkrIDREuIMUDO",
+	"fauAuqF/YwIQoVtCW8YRusc5jVPlfKaNKkuyRC1q2fRLkenctj4zP7Vth8zFTXtu5wpwduNhcpBfW8pa",
+	"hWrDNXNwsC2/RN2DDBvW2WUIM27GBVlYF2Ocj9vyHFuFW2DvJq3LdcVzWORQ8N1w0J/sZ2Y/jw1AK95e",
+	"YJWBhfVijC96y8neaWxkaEXjRYTmD4rRF5bhFsQbZMsgrveekXOgsWPCyfHRg2Yomiu6RH48QtsudWRE",
+	"Og2vFNqQGbWxEDuBPgXeBBmake9OCeq8aG8O/Sn+G7SbwLe5wyQ70CkU2vEPQiBhFHXBH8F26Un3ngCO",
+	"Ss2kFNsjRlI7NmGhfc0rIzJR0lXnO9gd/drbnyB+a8/B2Ieo4IO9Apdhf2bd7/pj3u0mOOm2OwR/cOWN",
+	"oFMITRpPF/hL2JHpBK/OX/Hje5W4cVPX6yX3JAWovLfOSQDQUR60+QGWygbgfS/ZXE63IvDMiCvClh5u",
+	"QmTBo0t+9WRUImPLz8ocx5IyYnMMVEBuDOhGYQBnkCoRkvhb2pUyh1hoeojtJa8XHG6aqYS2zS1LBSi0",
+	"6kimigIyo1svZW2DOGNrcAzyExQLguIQJuxBspdenWkOeUp0FNIHkeiidY3++grwPfGDGFoTs+0zsjYx",
+	"Km0/BthRJ3A4htUuMioTNuwMF8w7xkPejbiBG56ZYsc4LcCOXUMFTNfLrTDOVaHLTkaVi3CA6JvsyIzO",
+	"XcmGf3hWnOI/dU5DBegNWdI/sI/Dd9EzgXTI4awepVLFhPeOATGiEEzybGWlwlUXLgTOx0n5Q7MDpNNP",
+	"i50H1ynFIZkJA/bfqmYZl2Rcqg00tzdV0ZUI+9IMQgdzOh/WlkJQwBaszYy+PHzYR/zhQ7fmQrMVXPu4",
+	"0YcPh+R4+NBuAqVNR484hs2eV+Zl5Kihx2pS8S1mffVpvw+lG3nKSr7uDe4npT2ltWNcRP/eAqC3M2+m",
+	"4B7yyDT/UXMzEfMAnyjetO7nYlsX3BzjxR2ueLFQV1BVIof9CoCdmGQ4L35sulFMLGTIoxksMorknDgW",
+	"XGAfG/y5zwrWuqyJ7RZywQ0UO1ZWkIE9K/ByqxsYT5iNdMg2XK7JplGpeu1c7e04JKkxOJgiNms5GGIo",
+	"v+KStRbS2Bg0cyMX60rVZUysu9grH8yK90HgaJIKlp06WwPMNW+Agbwj7SdS1g/6LY6Zerafz5IWO1LH",
+	"WoudpVw3IjeuWVKI8ULXWQYQjciL2cIaVHuZR9pYcjcg3ufqyoYkMJ6ZmhfhHsGwVy533ZQkXBQaZbbQ",
+	"jNph51Y7mlvcfLz4ihcaAszCAOZwX3eu4sHKtyTtk2Liwz4xCSrAQ84IuROFQaNfHl11C1XXIZTDiYMY",
+	"iPZjKgwCDaPF7ghKmx2IVVBWoOmIDR8UtP2qVmGeAXcG6502sB2+udquf0tIoTdJy56ShZCw2CoJu2hq",
+	"HSHhe/qYdmxMdCaFK9W3by7qwN8DqzvPFG68L31ptQNZ9LqJ/znC4vfH7T23hxkW6DkJipJxlhUCYc+U",
+	"1KaqM/NWcjJnB5st4vnoDXfpB47nvkn8RSXy4OGGeis5XXgbI3fUW2sFEYvuNwD+nUPX67U1AHQkH8Bb",
+	"6VoJyWopDM21xfVa2AUroSL3wxPbcst3KALpPeY3qBRb1qYrkykQXBsUl/btH6dhavVWcsMK4Nqw7wX6",
+	"iuFw3gfK84y0nh4NFeJHyBokaKEXcQ/Nb+1XCrVx6G9c2I3379ZC29diHL+NFt8Z6GSa+T+f/OczzDDD",
+	"F789Wnz5/52+e//09tOHgx+f3P75z/+3+9Nnt3/+9D//PbZSHnaRJyF/+cJdLV++oPtD+1w8gP2jPRVu",
+	"hVxEmSx0buvxFvtEKtMw0KddQ7rZwFuJfnpGYboXkXNzN3boi7jBXrS7o8c1nYXoGc49rgdq5feQMiwi",
+	"ZHqi8c7H+NCpOZ4QABfSx/hjK7aqpV1KrwXbAAvvXKpW8ybpg0329oxRRoAN957R7s8nn38xm7eR/M33",
+	"2Xzmvr6LcLLIb6LaIdzELltug9DGeKBZyXcaEgoowR71o7U+cOGwW8Bbut6I8uNLCm3EMi7hfBShM9rc",
+	"yJfShvfh/iFviJ17ZFWrjw+3qVAPL80mlgSqoylQq3Y1AXoeahjnC3LOxAmc9I0mOd7bnEdvAXzFXOhG",
+	"pdSUqOhmH1hG81wRUD1EZJJlIsY/pNw6aX07n7nDXx9dH3cDx+Dqz9m4Pvi/jWIPvv36gp06gakfELXc",
+	"0EGyh8it1X7o+i4axl3qO5s75a18K1/ASkiB35+9lTk3/HTJtcj0aa3xPabgMoOTtWLPfIj0C274WznQ",
+	"tJLZKYOoKVbWy0Jk+PYVY0+bcWw4wtu3v+Dl/e3bdwM3rqH+6qaKyhc7wQITfKnaLJy5elHBNa9iz+S6",
+	"SalDI1Pv0VnnzI1NP7rxmRs/LvN4Wep+ao0h+mVZIPoBG2qXOAKXjGmjKq+LCO2hofX9QbmDoeLX3oRR",
+	"a9Ds1y0vfxHSvGOLt/WjR58B6+Sa+NUd+ciTuxImGzKSqT/69gtC3N5r4MZUfFHydew5/u3bXwzwklaf",
+	"9GV6akBFl7qFNGmicmioFgFPj/QCWDgOjtcn5M5tL58bM44CfaIlpDaobrQ+QnddryDrxZ2Xq5c5Y7BK",
+	"tdkscG9HsdLI4n5lmpR5ay6k9o5baK3BTeCyCy7RtAfZJeRk8YFtaXbzTne16iiaXnQIbRMC2ph1ylpF",
+	"Fn5MFFjm3KnifQvScsc0GOOjLN7AJewuVJv06pB8Qd30NTq1UYlTA+0SmTXctm6M/uI7B1SElJelzwJD",
+	"6QA8Wzxr+ML3SW9kq/IeYRPHmKKTXiVFCF5FCEEdUiS4A6I43r1YP4Ye3jKW9uSL5A/0sp+5Ju3lyb3g",
+	"h9hcbJrvW6DsoupasyXXNkaU6GFTtARSrNZ8DQkNOXxkmZgIpfMwQ4PsO/eiJ13wvus6Ds6bKMi28QJx",
+	"jnIK4BdkFbrM9DyE/Uz2Hc+9EFC+a0ewZUFqUhurTEKHV53HLrkeAy3OwFDJVuHwYHQpEmo2G659zs58",
+	"HuzlSTrAB0w5NJZoLjToB/lLG/u6l7n9fTq4Xbp0cz7HnE8sF14tJySJm89cPE1sOZQkBSiHAtZNiLwL",
+	"x+6mP2oXCOH4cbUqhAS2iPnJcq1VJkgUBceMmwNQP37ImDUBs8kjxNg4AJvep2lg9oMK96ZcHwKkdOmb",
+	"uB+bXraDv2EsAwCqPKpEES4SD0iZlwDcOVc351fPxZ+GYULOGYq5K16ANP7G1w4yyHdGamsvu5nzkPg0",
+	"pc6OWODtwXIQTtTjTtiEOpMHOq7QjUC8VDcLGzwe1XiXN0vk92gwDfaKbkybWe6BZkt1Qw6GdLTY4I09",
+	"sKTh8GC0AFDKMMSd+qVOcwvM2LTj2lSMCzX7pNFtWnZJqRNTpk5oMCl2+SRIFncnAHrGjrasgrv87r2k",
+	"dtWT4WHenmrzNgmqj1OMbf/UFoquUoJ+QytMk97NmRDeQKaqPG2nQEYVpqlTMTQv2HaU/mRyAriRmhln",
+	"3duGv0IMVy7hHNKBp51nhBAvbLT0AJKvb0qlQbsYZDrq3eBOT6zAJonQ1maFr+AFNLEKUTLFEPauaZ7i",
+	"FuU2sa4fcJruHFvcxCV/DJayjMNxyE3ljaPPCBSJXd7CgQ3uC4lLxjcKy22aP173VfvoRum06qWADO5a",
+	"sdMB2Wf4mjl8M9VQAN2eF53bxuISdnEjAJBqdu67BVY+SjTJ5e7TwHWvgrXQBtrXJu/Y83vY8Rvf3TR2",
+	"pqxWiN8bpRp9LvRwDtH86BhQlM9KVBhPgk91URSw0TearE/fYNP4paKz2MyWehB52gcdKbbIRVHH+dXN",
+	"+90LnPaHRnfQ9ZIUEyGtE9WSSpNEoyNGprYBNKMIv7IIv+JHw3fabsCmOHGF7NKd4w+yL/oO7iPiIMKA",
+	"MeYYrlqSpCMHaJCcZCgdgwtGkNLjZOyZYrCZcj/2Xv8qnyIlpczZkUZwIdegpI92xCHH+pFZod5WJYum",
+	"n5DKLDrGjwi5GgOPNvzShlB3F1iu/TTxYE1l79WThnZt9wwop48n9w/nlOBFAVdQpOgchOITxb0Bhzwj",
+	"7AjkeuOSCjq/hP1a/XAFWoI1mPZhjHLLQLsZe7htr0YuT3h7tyaGRdpZLXP66x1qaJ7fWv4ePt2VJcbt",
+	"QjSy+q+BuygvS/KQ9Y1jIaw4mEB3gjg49tPBPr7HSmHfG2c62mGi9ykkIHVO3yFNfvqOGaxSSOY0Ugmm",
+	"9DOOC2IavLnZtdrpgPsSxzgvS5Hf9N497ahJ6/hRKEYHlBtsDwUC3ojF7FegO+seGPNsmalOft2TSZS5",
+	"6KbhD3WacCqhfZHEIaGanB77aIUp9r6D3c/YltCZ3c5n93smjdHajbiH1q+b5Y3Smdzw7LNZx+vhQJLz",
+	"Ep1beLFwj8kp1qzUlWNNau7fnj+ythaXehdfn7167cDH97oCeLVobjtJrKhd+YfBytYSSGwQX4Rtw01j",
+	"n7O34WDxmwTo4QP09QZcwavgQj2ozNE6F7Tj+QfpVdwbeO/zsvODsCiO+ENA2bhDtE911LnnAcGvuCj8",
+	"G5mHNuG5S8hNOxujUiEc4N6eFOFZdFRxM9jd8d3RctcemURz/Vi6DMDRoFDlvzaeEV0R9EA7zjolrE/R",
+	"eE/QnKTMexGHclV1hL8Ln4p6VjTqXE8w4rdgjDvwL2kUk04spSHQhCy0+cndlDq7cgnXWV+TsX8/PGHE",
+	"vezX9a9MaPbwYbi5Hz6cs18L9yEgCf2+dL/T48fDhwHQrTocNQ4gFejuL/kWPm2c3pNL/3EtSRKup6sE",
+	"RDvspdKc32wK65Xh6X3tyIeZvS1Bc/eL1TmjFB1uYusc3lt+S/gQqim79zwVo9R4/21tzUjNlOw7u1Ig",
+	"IDIZHTQYg7EE93453L6y3tKb30IXIot7Q8ilRtEurZcbNmbUOGENwxFrkXCalLUIxsJmesKTVA/IYI4o",
+	"MX2FpRTtlsqJllqKf9TARA7S4KfKp/QMj1l6/XB+MUNlOH4ndANTn2D4+9wQwopQfX3V3ZjGrgehT90A",
+	"3BeNzd4j2rwdc+mF86GuueGMg0NjxK3W8YfjZhtmtOn6xk0WxXsLg3uR50pTJeaIFvoWerGq1G8QNzST",
+	"fT4S4u8moqsQ9Z4QHdq+w7b1ytvZk8udupsEH1nXnTjB9bTygQMdFePxviRc2qW2odedqJQ4wwQt9Kkd",
+	"v2UYB/MgZq7g11i/In5FQJiCx9OO14tRzHf2tNdNCLKdnQVen01bYRPVlVC12TeGSW/vqO7baScr+q1e",
+	"jx07Gv3ceuoVWkWGqeU1lwZ8sTW7lVxvDfb1DXtdq4rSTOq4g04OmdhGTcNv3/6SZ0NnjFyshS1eXGsI",
+	"quO6gWzVd8tFrsJwE3XvSPNyxR7Ng/rbbjVycSW0WBZALR7bFvgiTbg1yqTvguiBNBtNzZ9MaL6pZV5B",
+	"bjbaElYr1lzJbA0Q72a2BHMNINkjavf4S/YJOdhpcQWfIhXd+Tx79vhLco+wfzyKHQCuSvmYNMlJnHjr",
+	"XZyPycPQjoGC2416ErXlrSqA3yAtuEZ2k+06ZS9RSyfr9u+lLZd8DXGf7u0emGxfWk16yevRRea2Lro2",
+	"ldoxYeLzg+EonxJxoij+LBgsU9utMFvnhqXVFvmpLX1rJ/XD2SLr9mxq4PIfyZux9M5cPRPQR9a1+TbO",
+	"D5x8Tn/gW+iSdc64zS1aiNbP2NdSZC996mIq49ZUb7O0wbkQdVJzcAmpKIqQhswCtVkt/oT3r4pnKP5O",
+	"UuAull88jZSu6xZFkYcB/tHpXoGG6ipO+irB9l6HcH0xclYutgJF/adtXHawK5Nul9FpTcrLb3zoqUoZ",
+	"jrJIslvdYTceSOp7MZ4cGfCerNjgcxA/HozZR+fMuoqzB69xhX5688ppGVtVxeoRtNvdaRwVmErAFeTJ",
+	"RcIx77kWVTFpFe4D/e/r+uBVzkAt83s5eRE45L02uBvQi23oV3yXt9ruO21H54otIH2Y+H5Jy7P31fI+",
+	"NZs7nQ+BynWZCF3CiNAJX+9R7LAb8P1NDMGDbWeFUjTqohbjzK9UBGVf6LN5oXXxzhG7VeoAwQ8ooJZu",
+	"qDnrlkn7+P5w3oI59MvCLx5W+qMP7O8sbIjIHoPEIgYFX6PLmTffA9dQzr5SN1MXtSe7/cL+E5AmQZI3",
+	"sIIKoqF6zSekAWIyKGgbff0dd2p4+SJ8cMdRl1AovJwZdbjI+AMtAlJmPrIUtSjyn9skS71M0BWX2Sbq",
+	"dbfEjn+zii82aFC0RIrWBtlwKa1b12A4e2H8m79YRq6+f1dT59kKObFtP0e1RbeHXAt4F0wPlJ8QyStM",
+	"gROEVO3mr2nio4u1yhnN0xaiaFWsYZXuoDoiVSuN7Rv6YGO0sDMZxWxxPgYyJ5PSCfuWMkkgLJ3cu2TK",
+	"aZICdsqs1WWheD6npI34mM/srLZPBaauXHHAtdWAOlikAx0OiVgYC1I4Rmg0Yq0NZf3Xhm/LWK4nbHHh",
+	"GzDRe6YnG0dInRP2wpqXtDde2EkY5eystpCzZjp3wSGewP8Yw7MNNlCd0y3N8tOrWnqubK3a3P8/azjR",
+	"7juE2xW2tHUt50yhEnctMP/ghhu4gm56KQ+G18h8uqkuelUtpeWUeGnpkVyAdyG7B47Gbd4Co5D1CH/g",
+	"qeDifQ4s8nlOvWJMOagY2nus88mKfHZQ9r0zvGZcKikyys0c05IoFc40N4EJaazjIVbOcVHPIpsrWqe0",
+	"iXpzVExWLp3POoQbvtQFX3FRLXfYPw3cuGpXazDaSTYM/Xbldt1jgZAaXCEhZKJQTqoq4pUQ00faK8uB",
+	"bERZLhLWn2/w2w/ONohbkF0KW1bekc0ytLDmfIzYRm6XTBi2VqAdPt1UX/oX7HNCWa9yuHl38kqtRXYu",
+	"1jSG9bxBtK2b2XCoM+905py8sO1zbOtyAjc/d/w57KRnZekmTRdjjuoDmAA2ReCo34F7/w2I24wfjjbC",
+	"bqPeonSeIqNhlmemDZTMxRgmChP3ognx/mA5ilowG2gSI0rc3/6VkP55KX5AZNEjgRaG9muin84qbrJN",
+	"RwxNdjPpCzRt3PvkfYfqLbBzzC+zmZ8jvYxtTeWE4GgatIoblzvmNwVyd6BMPMcoY++9N6yQTFqVU6Jc",
+	"lGK3ZnJMcKDg9lXZuwfAcBsMdSLbndKDH3oSpXI+Let8DQbzCcVMO1/RV8bzIFc0piivmwJAZckQqH7O",
+	"1yG3uYkyJXW9HZnLN7jndEER8gg3hIXQ/Qojp6HVGf+NlYRIr4zzszw4WMk7VeZNHPIhenN3pIHWizy9",
+	"wEwj0ylBZ8r9ydFOfTdGb/sfldMLte4C8pEzPY5JuXCNYvLtazw4wkSIA5dWe7Q0eQrJfVTRd5/ao8mw",
+	"1ZVKPnx/MKdbvMiS9YD3DaOAX/EiESAYmN25PV+ti0EqTDBLRrVy4xLRGM5GRVAyuYd18aPvFor480rK",
+	"rc969eHnQe9pmuFAz066SjYE9d7eQ4C+86EkrOTC+c+0wmJIWecam7bcjm26doH7SLho1KTx9LurVOSo",
+	"T6hA3/tl+S/BZacrK7gSqnYL1rgu+iuh/XVFCXjCBA1J/KOuwb+3RTppP79wpUMtmu5O/t3P1tGVgTTV",
+	"7p/Amj5Y9FdkfBqLG37uNTNnp3LKVdTeZKaelS/sCYt+4FeLrcrHMk989zN74Z/5Jp07npFjeetU7gpQ",
+	"R7NuvHIllXwz1D4nT/u963RWluNTJ1JtDCe3DQ+dPpWzD/fnmNXttd+/lPKXhSaEyF0lyAsh4cYk6sb2",
+	"0wpcA4ObEihpeJAhIp2GaCpDuWhxuq0uCuAaRigcpr90bScS+eLmFbaflrWkv7dsJbqvURTEhKwluxeb",
+	"HauwPUoFGf3yOos5zlPviBJPg5Ln18DMHT8CqcdfgOfeG3CCEt3HdFLGPicj++UAkg8GhKAHyI8fO8he",
+	"ifXGBGi83pMzvc2TTtQvlRZtPdUCB3Nrs6HhTqZ63SOqInw0H47lXV6vIDNURLd15asADskAj5P517B/",
+	"5U5Ps1ETnODlzkie9PkslOnRSHsn1nib440elsnrYMgork3kkK2gqa9X4bu7GwJ/oKJNUXeNpL93L3VX",
+	"4LMVqVQQR+xlvp+WHp154AYk8nFCxoNhzqzzzP9IYtrQjuOS8wf7pIIFgSMzskxJCRmibMv5Ou8VU/HV",
+	"SmQn0/2lLrqxkVwyVZu1oj0K9EhlH6VUJdZC9poKmamtbxqlVwOnrb8dnx8VEV9nSLr8WcgjoNETT+iN",
+	"TSfLXOF8l4kEO0Cpsk386rkCWz02pcivlbFxn0RA3/owu4uQ2nCZQeJ9wR4Pton1MrIZ8Smxiq1RnvAM",
+	"p+v9wlb5jy/ZFVR8TXqD1aFxZNcvupCsgoJTHWgnTZUNFArbaKKy5FI5Sscp6/uM+aW5Yv1MlUA1YDuL",
+	"Gw8RKCmDI575C1OJckyxwO+Wa/y5y7VhOEAfg3lT0BShKSmAADcKoRtHz/B1gmnc7mojhe0OWe485Znh",
+	"68m58oIdfsHXF3bsg4schozcq801IYa0cbLr79Ng/wQL7ojTgrRHcgV4jRIUtTQJIRUtjW1l9hP2hoz9",
+	"vMImXNcVnhv+tZyWHsmLJwB7/MhJCXYtZK6uh5Jww2VeAF2T9kgjD47tUVHsmjT+zbvJk+SaTdw8drC8",
+	"Y6iNrGqjDQ2mYSXXLgqF90FMZfi2Q9iI1ir68IaTLskY10xIGlgjsEqoHGU7ameu6mURHLcW8MGse9Ec",
+	"m3sPVl5aphFrSHd03Kau4h4I4hhqkGbamukgQ/PdMGrmmrhS/RlHMDhgfY6Ex8GrMg0bw9ej8HvpPy5z",
+	"Q/kTEQfR7TrYTSn2j7HmgJE6qx1bpD4ZLeoxWd/JxPod7EbvhXyY3zLI0WqP6AM017Mm3NWmlECNcQ2S",
+	"PKzyXtqnyclnVivIUEsazyf61w3IIFfl3PuJECyrIL2oaNIhUN2Ww72gWoAKfkd4Cn48cFKJTS5h90Cz",
+	"DjdEK6s3WUHuUrKDKEC2FTRLlkrzIuXY5iJ8hG44g6jgwzdtd2iLn8W2O00XWEHvOJdnya49dGTKK2Xg",
+	"jnNh14MSrlNkfyrlKGpwX3EZtWRyZzyzt0873oG3zpevx26TzcVzo7SJXWgS181oloehSkWJxWUbHmQB",
+	"CYuWEJWVhJT2wXVKJbcXSvSadAUsKrFe47504eCScHs723JZ8+LtrMm1TRBV1vEY8qDsO7Cz1y+jCE+5",
+	"VeNiacPxunn4NbqWRhQTJoCbUlSgD51g5EJiM1A4QntMPUBxfqUqAEHx7yjYTWkXzTJVFNaKEhxB/gWz",
+	"u1kHL2FQUpjg4hrQ5pueCjNLURNmO0HOXFET58Fs30jZS2MXSfvPNhjW9rWOlDlkFT1mNLoKWVH9+M59",
+	"x5DVwUCFvnMrVRTq2v88TQAtK8XzjOsEUqo2mepdvbkxsC1Jh7Je+AhuM05/7mdMKrNwfZApMXrkejEg",
+	"qv9g0Wx/xBkWWBcf+2qQ7R8kzklLWgCvCgHVwj1FVNjQpIsSN8AuEk6FiDp9sps641boRiiAwMydz2F0",
+	"QlfGf0HsUSXE4wb4lUD+QKFeaZ8vrGXea6hAPjAtE89tFgbiEYoFJHpNtke0++ZnnDH+wBgsRBxs+82u",
+	"eIf7KY8F3GRgjyrLJpOYcuSdKdBtcAF0sJX3DmvBm4KMtws30qJdhZGhq733j7GVbWcbK+EyBnwoPzwK",
+	"dsZ0fvepMI+NsNj7LmgRdtgj3lMWbOgY35PAA+k13Gh9Dg785DvM0K5gj9ANlXrIjhxHgbf/11cQLVPr",
+	"cv+5/N4GvGolrSIyVts/pYf8dbMbFKG+pvJsf3eyAq5E5h5g7NmdHxI/MnjktQ+btiJSU+zZ/dit6+8y",
+	"3TTFRQZwlkoVBCw4P265ptGacJnEjrhJ3U7CwRPXEnOTUOawAKMTZHEP2NGn9pGAj+GKURGu0hnMB4EF",
+	"J0ElOZ5bspJ+ZBcUv14J/z+7oE2IXTeLdcIw4bcC0dFSxHWZxt1pZ8kXYLgotMt0EOdsjI6IM2xmS0M0",
+	"gV5eSQftf/N1YOwshbiEgLtsWB2lsXcton7i3gV9MfLUP8jAzUQc6FUzs2gzcQ2jboc8bGPYs0KR2Eol",
+	"retK5CZY/4G2KT5IP6EzhOBaQVW1HIVjw8Ko8NKWgmOMFNjgjkTQydr3FrhkRco3bcnNrcgqZQsWcJe+",
+	"JESQVbDlCF0VFMZMzzlG7Of2u08y7O9qe93hG36dch7SYlHx1h4RQ65fMWdK25+8+C6e8UJKqBY+TK6f",
+	"GUJCFQKnG0clytkYbIwmemCy4jkiSqJO5dkQy4F/cEEVmV8F2YAvYXdqXTftaduWuAqht94pFoegelRv",
+	"tY8aNBD3jy7WFoH1UeD8PR3v5zM80FPXqpfDYp/9PXAp8E6H984mexHe0x/oodbwCYUINcG416QFBefq",
+	"pyeMnUmbL87H5YblRgeT4wVrZP4bmjWvbf1dFxNw8lbGE2+RZb66p3zzw4xLNbwV33sqO8j4RB9Pceqp",
+	"KwFTWShiWsq5Dbh7Tjs+pnlTBuYgOznFYXLmAvWYLlQsFcyd0kTjWHFShbMRRAbklCTFDRhu8CgFXBaC",
+	"vYkOmhwHLm+BUEGeg6HGhGalxVZVsCgUpSqIOeOuDKpjW2E0o6K3a6bKTOVgq3t7g1U7YdwxxM5VS8np",
+	"NIUgMry3nNiQSv3Ru5Birk9QPXLilChYbSzUgk7gvYmcPJkvsI/NTNsWM2jDq2xIXiK5CmhXv8ARyTYe",
+	"gkyrREm3m9ji6Na0k9vBjj1zKCapKtdBppuXKzJdCgoW72YEph6o6GSQN2bLdrVcFLQ/wlsTuS8Ux65F",
+	"UfgXLuSBqnYvJuEoP+ma4vkpHRxO8ZRtlTb+aYBG0s1QbY6ETzIlTYWWma7J2DKJCw35nt+cZZl5pdQl",
+	"Zvb9lO42UpkG03zuk6X2s1m0M1W98hlTX+gw+JoWRO+/C9t2CIKjDVDy0B152rjLty1BrSrnytxE9tAl",
+	"f860svxAQzENbhU7BRiaLP92uiWsKAXeAZbJrgwLToVvcci9sX4BTSaIyMHwkWNjQMUOEQfSMq5Un0nG",
+	"jdqKLL6b/liJKpLpJWKSMUYK28MZ16kZ2ULD06iJSybJPCQzSNw60fdKK9pdfKa1JpXGpRjsjdv4gqZO",
+	"wuFx4Q7wRZbUM3oAEKQ2o6qpK4q06ygBjXxTa+tRSe5wfUAnHmYUxH8/2HCEowNl4F5ADRKHHBPA23FO",
+	"7giIVAaE85Z9KmrS5LhP7Ppo/oLxdAG2huNyatKApqDmxPM7ACCdRqADw6RkAoeCYZ/3FtwkVAmyisyD",
+	"m5zLTheMLpwWQLOEr3c4dl2By7lOwo1V3WCWkpuNP6ix+dB2iXYw56H6G1TKFi2fB+5AUMDWJsDvXDZV",
+	"aQtfhsO5RPA1abHosO366qYzywFKOo/7VpmY+3p4WetdzB3uiyDwfAp1ozd1S1i7UmzPNTxhgV/YbaKn",
+	"biWE6ErkNe/QTx+qVnQNT7iVpygUHtZ30yTFwUIijtyYiNib6KPWqX0p43k+wjoEjdGdZssbzz3LhO3O",
+	"1iW/lmmTVOya4u9aExdMKBk+qt1ARrpFN5HF/WnCaDCmxXo/DuFDIykPY0ea20j0RB8GTHTElWZuzOBh",
+	"OHqQtrx4Pzvr4H69sPdoyPeN67n9JzuCT/6sz3z/9PYZ3T3J8WIXGw0kfRvog1cQj0eMJR+Q0atJwYIK",
+	"g6qNM6/S9JGj6oRdKLbll9D/YKW2tRVqkdsX3YZp6TzYNQU97GltFMWj4JUIH8JJ3lBeNEx4WjXJW8eT",
+	"Pw6veW41m/rQATmaQfPDgp3iAfWdyVqNbPqEky+2fXASQfYdgKjNBweFquCNQ0JN9gMyts06eX4nhae3",
+	"8hJzhvx4BVUl8hSkGsi83Sv36a13rm9EebYPt0JHBhC61R4ocR60idmCZuiSnIvVCiobFaENlzm+1wbN",
+	"hWQZVIYLtMrv9N0Mki99uCi3hkJszVzroxsj+5MdxSo5zZqIyxmz5zUSp2M8HM5+d2vitJmHauM0ELb8",
+	"BrGnnGwJLnZVkHBVnT6iJFlGrLw+bB4tfoPxaag2oXuKN4pmnTLF+Gb9kUhHOs1PUpjR7WqvtP0keTZq",
+	"wO6mwImmMd7ZxRluojIRp1d2cxs2Pj8u8Y9fa/sm6e2GJ2MpEN3NP7GK9CrjkmKGdhE93WTYefiJyGyn",
+	"pi5IfdUjqR1a+yXRWrubx+A9vK/3WqKEfqAHXMysyYbnuaDB4+CRXq5ZWetN8GaHPXtATKba/nyTi1KV",
+	"i0le9j4k3wLkYB3ClUo8M8ofzXudbsoJh/zYrStM403nnHRd4323wjIbU2dTt5aEDO3arNSKpBltYntX",
+	"Ix/15oYy7+fs6t7KGjHBOKsgqyuyK1zz3f7K7wsTh9KnO7Uje6uty/DZQu1EgxVIZPax8A8Kqx9yY4/I",
+	"yAi/RkpaHx8Zm8e39V39cOg495w4Amfu5oBQjvNba9vyrBLhNS53MRHn3U3ugGDqwj4hE+XRlqrZLR9i",
+	"gaJH+kjStbOB9brJwjgJtGFWwgg1x0JhOoGZge91UAmnsskt6R3Wmwj78uL71nQ4LbjAd9gDXpjtqG3X",
+	"+EI5cH7ncjXfN0QJUHmX4oQO+vsSKDUxxd7WGiyRNTTbgAfaxWoox4PsWPp5k3QqoUgMclNVShmmJN5d",
+	"IjmtNNlJ7GtwwDg+6Ojj56X6RlTanLkAkDdpd8swNDcksiWlvluhgld80twF/wBTy9cUz/DXRBjImWRu",
+	"KGfEHQh/sizywvrmrCJBZbjS7PEXbOkqMZYVZEL3jcPXqi7y0OviCiqxcg4ScGP2BHfsw5MCku7Mxiv/",
+	"1sJ+COxhqo08IQjbLfo7C5XEzo1yeYz7BmwRod+4jKLmMQs2xbxY4/h4eORY3K8dhHfr9CWCW9ELYbz8",
+	"nXO/8ZFZTZBTazaP21LGIqfsAG343OSgVQdwM3qMzHjHSmcS7pzKl520wq3yHCgOqoIjpxcO7oAHphcO",
+	"MaNCDpPRIzzobK81DPE86P46pg+1uE3NjR1Jw5lMaW2WU1Jax6N/sDvl1LYEwUYnjEBlvz7+1ZqLSWg9",
+	"fEgTPHw4d01/fdL9jFLz4cN4LPfHyqbtU4vQGG7eKMe4ZKuDUmkUwMTj6cffuDPUR1HjCD78PIp24efo",
+	"uadSR1dX5OPqK9axem8iQouaa7zv2AhI5lFuJorR/udUVjFbvylRRq23FzCId++7RVgUD+NUQIIWmsq+",
+	"/c3Vzv245PcQ2HjBoZi0sB5UQ6G/AYgwEVw7kwdTBeXuJlS6GyZ48+tKzJXVlTC7c6S/N+qIv0Vzrn/b",
+	"5Mtx2WqbZxmn3hl1CdKXJG6z69TaK5DfKl6QymVfiyQwo1Rxwr6+4ZiSzQmpPz9Y/gd89qen+aPPHv/H",
+	"8k+PPn+UwdPPv3z0iH/5lD/+8rPH8ORPnz99BI9XX3y5fJI/efpk+fTJ0y8+/zL77Onj5dMvvvyPB3gG",
+	"IMgWUF+F8dnsfy3OirVanL1+ubhAYFua8FJgSqLbW7KerBSiT0TNSApihFgxe+Z/+v+9dDvJ1LYd3v86",
+	"c/WpZxtjSv3s9PT6+vok7HK6poi5hVF1tjn189zOexQ/e/2ycSa0rh60oq1F82TWssIZfXvz9fmFTwDS",
+	"5AKcPTp5dPIYx1clSF6K2bPZZ/QT7Z4NrfupY7bZs/e389npBnhhNu6PLZhKZP6TvuaYo+Tk7zaaGH+6",
+	"enLqteXT907HuR37dhq+/56+7wRV5nt60kPt6XsXd7indWiUOnXBpEGHiVCMNTtdqpsDmoIOGqdRoTu0",
+	"Pn1Pt8Dk76eubGf8I93G7R449WmJ4i07VHqPUb23/R4ZvpEQK/Z+q8vT9+3HAFQqynTq6v2dUhbN8GNh",
+	"uD7VlAUd+S/6RmiTpGN0N1Q7nxPdvw+2idHnPTdOvH8OU6bPGSVNIcO41Qfa/DkuscrX0lTCOee54BrG",
+	"tfXFwN8icz/DQg8ozDDCEF/AIWf/df7jD85JWVMCn0xJTZbTK2BbvS7xmcN9JweRCtDziPEVXXdYLrRL",
+	"sjn3MIZRytSqySaiZJtg8oT9FfHXO5kxqtkfJo/HH30KfvvObMhWhCQm7/zmjiSBgsbyK07FgEufl8Uu",
+	"WtCNbbhmO6BUJoj+lc211Eiol3mziP2s8JRhoXnYnz37Za8RRrlJh4Umh3QJsuX4w+AfNVS7Vlg32RxI",
+	"9UDW2wqJD7WzZ49i17jkm71zG4nROQIePWo7UkFuS4GkQMSROhAOPY/j6lRL11OnLd2+m898+SES908e",
+	"PfJnnDOSBLLq1In2YOpDEv6HpQ1ub+edkR33H2vw4ZnpOVOtrLzQPoOEqCIyQZ/gsfj0iMToFoO6N/r9",
+	"4QYIf8Vz5gP2CJXHf1hUXkrKWYj6C7P6GSH09A+L0HMydUpl2EqgBLA81zxMNMeRlUW389nnf2BGfCkN",
+	"VJIXjFpabD77w2JzDtWVyIBdwLZUFa9EsWM/ySbmzirrdAoNtZaf5KVU19ITAu9Z9XbLq11zGA5FU1/M",
+	"tepDqLRwtqYi4m3EHyUg/2VW1stC4GFBNop3t10tq9Ui42rWt2C8bLQ9XF67Zprugf4tmD64Uw7zfvmf",
+	"QKNA3SoH7TML0WGIN5Phcd3enOn0nXp8f8zjLy4JunWz8qbk1YcU2L+/hD1EJDZS8OmjP308gIzY+oQN",
+	"0ivbH1oU/86y86MJO5QrPCXanIwJBNp+eWZ3z6muy5LqpfZ+Rn2ZACwglhf3J6nBxFX1oZCjxuc7mb1p",
+	"JM9Afnxg1XG4Tu3VAncQ5Ub6pxAh/9os998sb2CrrkAzd46F98gKtKmEdYJuigFYHh5TAubJ0975jQxn",
+	"8qppO/jg6N+zJ6avQi87YdodYhKcex5eU7kXh+vr177vJmmnehBboNm/BMG/BMERBYGpK5ncosH5Rbnd",
+	"oXS5CTKebeBk+iG6k1l4MyhVLOXP+YiwcNVtUrLivCsr/oD3g4+9rZ9z6fdzz4zIJXNJqhsu4LLz2ufU",
+	"mH9Jgf8pujPpxe4OPmcGMEAp2PtG0d63zg2WJ4S0zrgT5UCnwkqrTHd+Pn3f+bP7RkVPOqdYiSD2W+wh",
+	"UG9qk6vrYDayxVpP1eHzXOTj8EUsbBT9WOv+36fXXBj0THElQsg0P+xsgBfEE6KA3q+50Fxr2C6HX6pd",
+	"VQcIdrINRH+15vrkxz5RYl/bd7SxRvZhL9HIZyTyn1vHhtBRgER34yLwyzsUnBqqKy/V23fvZ6enlH5z",
+	"o7Q5nd3Ow2+69/Fdw6vvvTwvK3GF0Ny+u/1/AwA3JIIkjyQBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,11 +8,13 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	. "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
@@ -21,7 +23,7 @@ import (
 type ServerInterface interface {
 	// Simulates a raw transaction or transaction group as it would be evaluated on the network. WARNING: This endpoint is experimental and under active development. There are no guarantees in terms of functionality or future support.
 	// (POST /v2/transactions/simulate)
-	SimulateTransaction(ctx echo.Context) error
+	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -35,8 +37,17 @@ func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SimulateTransaction(ctx)
+	err = w.Handler.SimulateTransaction(ctx, params)
 	return err
 }
 
@@ -75,177 +86,240 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4Ka96oc+4aSv5Jdq2rrnWInWV1sr8tSsvee5ctiyJ4ZrDgAFwClmfj8",
-	"v1+hAZAgCXA4kuLsXr2fbA3x0Wg0Go3+/DTLxaYSHLhWs5NPs4pKugENEv+ieS5qrjNWmL8KULlklWaC",
-	"z078N6K0ZHw1m8+Y+bWiej2bzzjdQNvG9J/PJPyjZhKK2YmWNcxnKl/DhpqB9a4yrZuRttlKZG6IUzvE",
-	"2avZ55EPtCgkKDWE8i+83BHG87IugGhJuaK5+aTIDdNrotdMEdeZME4EByKWRK87jcmSQVmoI7/If9Qg",
-	"d8Eq3eTpJX1uQcykKGEI50uxWTAOHipogGo2hGhBClhiozXVxMxgYPUNtSAKqMzXZCnkHlAtECG8wOvN",
-	"7OTDTAEvQOJu5cCu8b9LCfArZJrKFejZx3lscUsNMtNsE1namcO+BFWXWhFsi2tcsWvgxPQ6Im9qpckC",
-	"COXk/fcvybNnz16YhWyo1lA4Ikuuqp09XJPtPjuZFVSD/zykNVquhKS8yJr2779/ifOfuwVObUWVgvhh",
-	"OTVfyNmr1AJ8xwgJMa5hhfvQoX7TI3Io2p8XsBQSJu6JbXyvmxLO/7vuSk51vq4E4zqyLwS/Evs5ysOC",
-	"7mM8rAGg074ymJJm0A+PsxcfPz2ZP3n8+d8+nGb/5f78+tnnict/2Yy7BwPRhnktJfB8l60kUDwta8qH",
-	"+Hjv6EGtRV0WZE2vcfPpBlm960tMX8s6r2lZGzphuRSn5UooQh0ZFbCkdamJn5jUvDRsyozmqJ0wRSop",
-	"rlkBxdxw35s1y9ckp8oOge3IDStLQ4O1giJFa/HVjRymzyFKDFy3wgcu6J8XGe269mACtsgNsrwUCjIt",
-	"9lxP/sahvCDhhdLeVeqwy4pcrIHg5OaDvWwRd9zQdFnuiMZ9LQhVhBJ/Nc0JW5KdqMkNbk7JrrC/W43B",
-	"2oYYpOHmdO5Rc3hT6BsgI4K8hRAlUI7I8+duiDK+ZKtagiI3a9Brd+dJUJXgCohY/B1ybbb9f53/5S0R",
-	"krwBpegK3tH8igDPRZHeYzdp7Ab/uxJmwzdqVdH8Kn5dl2zDIiC/oVu2qTeE15sFSLNf/n7QgkjQteQp",
-	"gOyIe+hsQ7fDSS9kzXPc3HbajqBmSImpqqS7I3K2JBu6/dPjuQNHEVqWpAJeML4iesuTQpqZez94mRQ1",
-	"LybIMNpsWHBrqgpytmRQkGaUEUjcNPvgYfwweFrJKgDHD5IEp5llDzgcthGaMUfXfCEVXUFAMkfkJ8e5",
-	"8KsWV8AbBkcWO/xUSbhmolZNpwSMOPW4eM2FhqySsGQRGjt36DDcw7Zx7HXjBJxccE0Zh8JwXgRaaLCc",
-	"KAlTMOH4Y2Z4RS+ogm+epy7w9uvE3V+K/q6P7vik3cZGmT2SkXvRfHUHNi42dfpPePyFcyu2yuzPg41k",
-	"qwtzlSxZidfM383+eTTUCplABxH+4lFsxamuJZxc8kfmL5KRc015QWVhftnYn97UpWbnbGV+Ku1Pr8WK",
-	"5edslUBmA2v0NYXdNvYfM16cHett9NHwWoirugoXlHdepYsdOXuV2mQ75qGEedo8ZcNXxcXWvzQO7aG3",
-	"zUYmgEzirqKm4RXsJBhoab7Ef7ZLpCe6lL+af6qqNL11tYyh1tCxu29RN+B0BqdVVbKcGiS+d5/NV8ME",
-	"wL4SaNviGC/Uk08BiJUUFUjN7KC0qrJS5LTMlKYaR/p3CcvZyezfjlvlyrHtro6DyV+bXufYycijVsbJ",
-	"aFUdMMY7I9eoEWZhGDR+QjZh2R5KRIzbTTSkxAwLLuGacn3Uvkc6/KA5wB/cTC2+rShj8d17XyURTmzD",
-	"BSgr3tqGDxQJUE8QrQTRitLmqhSL5oevTquqxSB+P60qiw8UDYGh1AVbprR6iMun7UkK5zl7dUR+CMdG",
-	"OVvwcmcuBytqmLth6W4td4s1iiO3hnbEB4rgdgp5ZLbGo8HI8PdBcfhmWIvSSD17acU0/rNrG5KZ+X1S",
-	"538NEgtxmyYufEU5zNkHDP4SvFy+6lHOkHCcLueInPb73o5szChxgrkVrYzupx13BI8NCm8krSyA7ou9",
-	"SxnHF5htZGG9IzedyOiiMAdnOKA1hOrWZ23veYhCgqTQg+HbUuRXf6ZqfQ9nfuHHGh4/nIasgRYgyZqq",
-	"9dEsJmWEx6sdbcoRMw3x9U4WwVRHzRLva3l7llZQTYOlOXjjYolFPfZDpgcy8nb5C/6HlsR8NmfbsH47",
-	"7BG5QAam7HF2FoTCPOXtA8HOZBqgikGQjX29E/PqPgjKl+3k8X2atEffWYWB2yG3CNwhsb33Y/Ct2MZg",
-	"+FZsB0dAbEHdB32YcVCM1LBRE+B75SATuP8OfVRKuhsiGceegmSzQCO6KjwNPLzxzSyt5vV0IeTtuE+P",
-	"rXDS6pMJNaMGzHfeQxI2ravMkWJEJ2Ub9AZqTXjjTKM/fAxjHSyca/obYEGZUe8DC92B7hsLYlOxEu6B",
-	"9NdRpr+gCp49Jed/Pv36ydNfnn79jSHJSoqVpBuy2GlQ5Cv3NiNK70p4OFwZvo7qUsdH/+a510J2x42N",
-	"o0Qtc9jQajiU1W5aEcg2I6bdEGtdNOOqGwCnHM4LMJzcop1Yxb0B7RVTRsLaLO5lM1IIK9pZCuIgKWAv",
-	"MR26vHaaXbhEuZP1fTxlQUohI/o1PGJa5KLMrkEqJiKmkneuBXEtvHhb9X+30JIbqoiZG1W/NUeBIkJZ",
-	"esun83079MWWt7gZ5fx2vZHVuXmn7EsX+V6TqEgFMtNbTgpY1KvOS2gpxYZQUmBHvKN/AH2+4zlq1e6D",
-	"SNPPtA3jqOJXO54HbzazUSUUq84m3P1t1seK18/ZqR6oCDgGHa/xMz7rX0Gp6b3LL/0JYrC/9BtpgSWF",
-	"aYiv4NdstdaBgPlOCrG8fxhjs8QAxQ9WPC9Nn6GQ/lYUYBZbq3u4jNvBWlo3expSOF2IWhNKuCgANSq1",
-	"il/TCbM82gPRjKnDm1+vrcS9AENIOa3NauuKoJFuwDnajhnNLfVmiBqVsGI05ifbyk5nTb6lBFqYVz1w",
-	"IhbOVOCMGLhIihZG7S86JyREzlIHrkqKHJSCInMqir2g+XaWiegRPCHgCHAzC1GCLKm8M7BX13vhvIJd",
-	"hvZwRb768Wf18HeAVwtNyz2IxTYx9DYPPmcPGkI9bfoxgutPHpIdlUA8zzWvS8MgStCQQuFBOEnuXx+i",
-	"wS7eHS3XINEy85tSvJ/kbgTUgPob0/tdoa2rhJeXe+hcsA3q7TjlQkEueKGig5VU6WwfWzaNOq8xs4KA",
-	"E8Y4MQ6cEEpeU6WtNZHxApUg9jrBeayAYqZIA5wUSM3IP3tZdDh2bu5BrmrVCKaqriohNRSxNXDYjsz1",
-	"FrbNXGIZjN1Iv1qQWsG+kVNYCsZ3yLIrsQiiulG6O3P7cHGomjb3/C6Kyg4QLSLGADn3rQLshp4uCUCY",
-	"ahFtCYepHuU07jXzmdKiqgy30FnNm34pNJ3b1qf6p7btkLiobu/tQoCZXXuYHOQ3FrPWx2lNzRMaRyYb",
-	"emVkD3wQW7PnEGZzGDPFeA7ZGOWbY3luWoVHYO8hrauVpAVkBZR0Nxz0J/uZ2M9jA+COtw8foSGz/izx",
-	"TW8p2bsPjAwtcDwVEx4JfiG5OYLm5dESiOu9Z+QCcOwYc3J09KAZCueKbpEfD5dttzoyIt6G10KbHbfk",
-	"gBA7hj4F3gQampFvjwnsnLXPsv4U/wnKTdCIEYdPsgOVWkI7/kELSCjTnBtwcFx63L3HgKNcM8nF9rCR",
-	"1IlNaPbeUalZzip86vwIu3t/+fUniNqbSAGashIKEnywr8Aq7E+sI0Z/zNu9BCcpYYbgD7QwkeWUTKHE",
-	"0wX+Cnb45H5nPfwuAr/Ae3jKRkY11xPlBAH1fkNGAg+bwJbmutwZOU2vYUduQAJR9WLDtLYum92XrhZV",
-	"Fg4QVXCPzOisOdY7zu/AFPPSOQ4VLG+4FfOZfRKMw3fRexd00OGeApUQ5QTl0QAZUQgmGf5JJcyuM+ch",
-	"7N1IPSV1gHRMG015ze3/QHXQjCsg/ylqklOOL65aQyPSCIlyAsqPZgYjgTVzOhN/iyEoYQP2IYlfHj3q",
-	"L/zRI7fnTJEl3Hi3etOwj45Hj1CN804o3Tlc96AqNMftLHJ9oOYf7z3nvNDjKftNzG7kKTv5rjd4Yy4w",
-	"Z0opR7hm+XdmAL2TuZ2y9pBGppnXcdxJSv1g6Ni6cd/P2aYu72vDl5SVtYS0dezy8sNyc3n5kXxvW3rD",
-	"9twTeYiOmzYsYuluo1qiaw0pmXnfSkELIyBEdfu4SL7KGudMFQVnoww4f3XnkPJdL5BvKgxkATmtrVey",
-	"49oOgtY9VB1F5MXe7vZRGF3IRPV4XWp7aYdYXUlRV0Q1226pQFMNv42quR06BuVw4sA3qP2Ycg8yz8Ry",
-	"dw+3tR2ISKgkKOStoXpF2a9iGcbfOOardkrDZqiBtl1/SbzP3iffOYKXjEO2ERx20ZBTxuENfoz1tvw9",
-	"0Rlv2lTfvvDcgb8HVneeKdR4V/zibgcM7V3jF3cPm98ft2d8CCOPULkGZUUoyUuGqjfBlZZ1ri85xcd9",
-	"cNgi/gP+GZNW97z0TeL6pYj6xw11ySn6jjRP/ihfXEKEL38P4LU+ql6tQOmelLgEuOSuFeOk5kzjXBuz",
-	"X5ndsAokGvGPbMsN3ZElLVE79StIQRa17jJXDJBQmpWls4SYaYhYXnKqSQmGq75h/GKLw3lLoqcZDvpG",
-	"yKsGC0fR87ACDoqpLO7n8IP9ii5obvlr546G0ar2s9Wdm/HbKIodvv3bCMz/89V/nHw4zf6LZr8+zl78",
-	"j+OPn55/fvho8OPTz3/60//t/vTs858e/se/x3bKwx5z33eQn71yb4qzVyg4tsrzAexfTHG6YTyLEllo",
-	"Iu7RFvnKiL+egB521Qp6DZdcb7khpGtasoLq25FDn8UNzqI9HT2q6WxET43g13qgOHYHLkMiTKbHGm99",
-	"jQ9dg+KBMmjNcbEveF6WNbdbWStnUUI/cO+iIZbzJhjKJkE4IRgps6bev8j9+fTrb2bzNsKl+T6bz9zX",
-	"jxFKZsU2FsdUwDYmZbsDggfjgSIV3SnQce6BsEe9UaxRPBx2A+Z5ptas+vKcQmm2iHM4713rXutbfsat",
-	"26s5P2gb2jmVs1h+ebi1BCig0utYcHRHUsBW7W4C9Oz1lRTXwOeEHcFR/7VcrEB5v5gS6BKDdNG+IaZE",
-	"CzTnwBKap4oA6+FCJj1JY/SDwq3j1p/nM3f5q3uXx93AMbj6czaGIP+3FuTBD99dkGPHMNUDG1Jnhw6C",
-	"oCJaKOfn3/HkMNzMpoSwMYWX/JK/giXjzHw/ueQF1fR4QRXL1XGtQH5LS8pzOFoJcuJDB15RTS/5QNJK",
-	"Zm0JgjZIVS9KlpOrUCJuydNG4kefjbRcCfNw7Bu1h/KrmyrKX+wE2Q3Ta1HrzIUaZxJuqIwZDVQTaooj",
-	"20QBY7POiRvbsmIXyuzGj/M8WlWqH3I2XH5VlWb5ARkqF1BltowoLaSXRYyAYqHB/X0r3MUg6Y2PU68V",
-	"KPK3Da0+MK4/kuyyfvz4GZBODNbf3JVvaHJXQUdfeauQuL6uEhdu3zWw1ZJmFV0llAYaaIW7j/LyBh/Z",
-	"ZUmwWyf2y/u24lDtAjw+0htg4Tg4jgUXd257+Zwx8SXgJ9xCbGPEjdZietv9CqLBbr1dvYiywS7Vep2Z",
-	"sx1dlTIk7nemSSWxMkKWN2MrtkJXQZd1YwEkX0N+BQUmAIBNpXfzTnfvKeEETc86mLKJMmwsB0Zzo2p3",
-	"AaSuCupE8Z5CyWBYgdbeV/E9XMHuQrTB4IfE0XbDOlXqoCKlBtKlIdbw2Lox+pvv3HFQ11VVPjoSw2Q8",
-	"WZw0dOH7pA+yFXnv4RDHiKITdphCBJURRFjiT6DgFgs1492J9GPLM6+Mhb35Ink1PO8nrkn7eHKeM+Fq",
-	"MJrSft8AZt0RN4osqJHbhUsYY0MXAy5WK7qChIQcatcnBgh2NPI4yL57L3rTiWX/QhvcN1GQbePMrDlK",
-	"KWC+GFLBx0zPX8rPZA04VoFKMA+cQ9iiRDGpcSyzTIfKjpXDJrZKgRYnYJC8FTg8GF2MhJLNmiqfywZT",
-	"/vizPEkG+A1DcccSMJwFrj5BXp9G8e15bv+cDl6XLg2Dz73gEy6ET8sJyROMhI/exbHtEBwFoAJKWNmF",
-	"28aeUNqw4HaDDBx/WS5LxoFkMa8hqpTImU1G1F4zbg4w8vEjQqwKmEweIUbGAdhomMSByVsRnk2+OgRI",
-	"7sKaqR8bTZrB3xCPwLB+tEbkEZVh4YwnPLY9B6DO1ay5v3oOjzgMYXxODJu7pqVhc+7F1w4yyAOAYmsv",
-	"6t+Zxh+mxNkRDby9WA5ak72KbrOaUGbyQMcFuhGIF2Kb2RCsqMS72C4MvUddizEgLHYwbcaFB4osxBbd",
-	"LfBqsa6se2BJw+HBCF74W6aQXrFf6ja3wIxNOy5NxahQIck4dV5DLilxYsrUCQkmRS5fBUkUbgVAT9nR",
-	"pht1j9+9j9SueDK8zNtbbd4mB/JRG7HjnzpC0V1K4G+ohWnSHjgVwnvIhSzSegpDqEw3+VuH6gWXfdbw",
-	"jcmJEUZyyZ52Xxv+CTHcuYRXQAeedp4RRLyyMUcDSL7bVsJItzYmySaocEixcqIEG2qprM5KMb4qofHc",
-	"jKIptmDvk+QxbpfcJpzyA06TnWObm3jkj8FSVXE4DnmpvHf4GYEiccpbOFAOvyMkLknFKCyf0/Txri/a",
-	"Rw9K172mmxoleGvFbgdDPkNr5tBmqqAEfD1nnddGdhWzcV9eflCAotm57xZo+TABC+W7h4HPloQVUxpa",
-	"a5ORYD2mv7Qen2LeNyGW6dXpSi7N+t4L0chzNrEQduws84uvAH2el0wqnaGpLroE0+h7hdqn703T+KOi",
-	"6xVmU6CyIn6J4rRXsMsKVtZxenXz/vjKTPu2kR1UvUDBhHECNF+TBabsjfqKjkxt3YlHF/zaLvg1vbf1",
-	"TjsNpqmZWBpy6c7xL3IuejfdGDuIEGCMOIa7lkTpyAUahPgOuWPwwLCHE6/TozEzxeAwFX7svf5VPtA4",
-	"JczZkUbWgq5BSefciEOO9SOzTL3N1h8NxuVCZx3lRwRdjYJHaXplA8q6G8xXjU4l7jZl39WThnZt9wzI",
-	"p4/H9w/nhOCshGso9ztBU8S4V+CgZ4QdAV1vCIYTeB+P/VL9cAdahDUr7cMYpZaBdDNmuG2fRi5/Xvu2",
-	"RoI1uHOR75Otd0ZC8/TW0vfQdFdVWQElROPM/hoEktGqwmwRvnEsoMcMxngB2zg49tM8llN/qLyvGdc2",
-	"/+p9pXbsjTN92WECxCkoqGyqvsPTR6bfmMEuhWhOLypBlI1xYJQR4+DNyy6oRtKnvsQ1TquKFdue3dOO",
-	"mtSO3wvG8IJyg+3BQEAbsQhGCaqb+LJV5tn06528U0eTMHPRTU8ZyjThVEz54iFDRDURzvtwdQG0/BF2",
-	"P5u2uJzZ5/nsbmbSGK7diHtw/a7Z3iie0Q3Pms06Xg8HopxWlRTXtMycMTlFmlJcO9LE5t72/IWltTjX",
-	"u/ju9PU7B/7n+Swvgcqsee0kV4Xtqn+ZVdkcm4kD4osTrKlu9HP2NRxsfpMYMDRA36zBJYIPHtSDjLWt",
-	"c0FwFJ1Behn3Bt5rXnZ+EHaJI/4QUDXuEK2pznpDdD0g6DVlpbeReWgTnru4uGl3Y5QrhAPc2ZMivIvu",
-	"ld0MTnf8dLTUtYcnhXONpKrf2GoMigjed5czr2A0vSGpbijmm7UWkCFz4vUGrQaZKlket6fyBYbYcOsn",
-	"YxoTbJx4T5sRa5Zwu+I1C8YyzdQEpXYPyGCOKDJ97uIU7hbCldGqOftHDYQVwLX5JPFU9g4q6k+dZX14",
-	"ncalSjewtca3w99FxghzLfdvPCdzjQkYoVfOANxXjdbPL7SxPpkfAveDA5z7whkHV+KIY56jD0fNNlBh",
-	"3fWumSyh7y255fVvLulzYo5oCS2msqUUv0JcVYUavkh0qM8uzdCj9VfgE0LKWktOWwmsnT253SnpJrQ4",
-	"dR0SE1SPOx+44GCaW2+Nptxuta1o0/FrjxNMGEFybMdvCcbBPIi6KenNgsZyABshw8AUmF86dnMtiO/s",
-	"ce9sNMwl/D4igd9Y05bZxB8VyDZwe5hE7JYCg512sqjQSgZItaFMMLe+PqUSkWFqfkO5LYyE1gg8Sq63",
-	"eeB7hdCNkJi2R8VN/AXkbBNVLl1efijyoTm3YCtmywLVCoK6M24gW0/NUpGr3WPd6VrUnC3J43lQ2crt",
-	"RsGumWKLErDFE9tiQRVYpYr33PBdzPKA67XC5k8nNF/XvJBQ6LWyiFWCNEIdPm8aR5UF6BsATh5juycv",
-	"yFfooqPYNTw0WHT38+zkyQs0sNo/HscuAFf/a4ybFMswyDVOx+ijZMcwjNuNehTVBtiijWnGNXKabNcp",
-	"ZwlbOl63/yxtKKcriHuFbvbAZPvibqItoIcXXtiKY0pLsSMsEW4Mmhr+lIg0M+zPgkFysdkwvXGOHEps",
-	"DD21RWXspH44W77M5QP3cPmP6A9VeXeQ3iPyy9p97P0WWzV6rb2lG+iidU6ozdVUstZT0VcpIGc+FRwm",
-	"SG/yolvcmLnM0lHMQcfFJakk4xofFrVeZn8k+ZpKmhv2d5QCN1t88zySFL6bnJgfBvgXx7sEBfI6jnqZ",
-	"IHsvQ7i+5CsueLYxHKV42EZ2Bqcy6bgVd9FJ+QmNDz1VKDOjZElyqzvkRgNOfSfC4yMD3pEUm/UcRI8H",
-	"r+yLU2Yt4+RBa7NDP71/7aSMjZCx/K7tcXcShwQtGVyjn358k8yYd9wLWU7ahbtA//saT73IGYhl/iwn",
-	"HwKHWHyCtwHafELPxNtYe7qWno7MFTX74AtnmgXE1jzdZ/e4SzWkTudDoPIcehp0CSVCJwC2h7HDXsB3",
-	"VzEEJp/ODqVw1F1ajDK/FZEl+xIajY3HRUxG9FapC8R8MAxq4Yaak265gi/vUePNIkPPDvPFw4p/9IH9",
-	"nZkNItmvILGJQSmV6HYWzffAuYySb8V26qb2eLff2H8C1ERRUrOy+LnNDdKrVCMpz9dRZ5GF6fhLW1Oz",
-	"WZw9zNEEv2vKufVGGOom8JXyi3/NRN5bfxdT59kwPrFtv3iOXW5vcS3gXTA9UH5Cg16mSzNBiNVu2oUm",
-	"rK9ciYLgPG022fZeHxZdCkpj/KMGpWP3In6woQWoUV8aKrYVKoAXqMc4Ij/YmvhrIJ1cgag/sFmaoPB1",
-	"Aqypp65KQYs5MeNcfHf6mthZbR9bGc5WhljZa7ezirR/7iGOtmO+tfcR0WdWrTSm7lSabqpYihLT4sI3",
-	"wDwooXUJH9Yhdo7IK6vTUP7FbCcx9LBkcgMFaaZzUjXShPmP1jRfo7Kgw1LTJD+9pImnShWUEW7KATbZ",
-	"o/HcGbhdVRNb1GROhJEcbpiypdDhGrpZUZoUQU4M8FlSusuTNeeWUqJS8VgKq9ug3QNnvSC9ASoKWQ/x",
-	"B0ovzk39wAov59grms2yXy5mUD/Y5thoyry98RWgKRec5ZhLMnY1u7LqU6yzE9JuxiMDnL+NmkUOV7RI",
-	"TROs4bCYLFvjGaFD3NA8FHw1m2qpw/6psX73mmqyAq0cZ4Ni7mstOQ014wpcNnCssB/wSSE7Fm/kkFEn",
-	"ilZOPpCMMDg7oXL43nx76xRSGLV4xTg+PX2MhA2QtDpkrPqszXuVabISGEHhDkW4pg+mzxEmaylg+/HI",
-	"V4nGMazB2CzbekcMhzr1vhLON8G0fWna2oR67c+dODg76WlVuUnTlbii8oDe8iSCIzbvxtErQG4zfjja",
-	"CLmNOjnhfWoIDa7RRQIq4kJjElWpekEwRmi1FIUtiPWPjubRirqJvmYc2hrmkQsij14JuDF4XhP9VC6p",
-	"tiLgJJ52AbREv4gYQ1PaGcXuOlRvg50/aZXP/BzpbWwLaiUYR9OgFdwo3zWl0w11B8LES1o2TkKR8lgo",
-	"VTkhygXXdAtmxRiHYdw+IWf3Ahgeg6FMZLtrSe3JOeQmSqUqWdTFCnRGiyKmT/gWvxL86tOVwhbyusni",
-	"XVUkx8x83VSFQ2pzE+WCq3ozMpdvcMfpggp0EWoIq+D5HUbH68UO/42lsE7vjHMPOtjH3vsCFU343CFy",
-	"c3ekgdRraDpTbJVNxwTeKXdHRzv17Qi97X+vlF6KVReQL5ygbIzLhXsU42/fmYsjzN81yMtur5YmvRa6",
-	"gwpfNxifjU1imC5X8lGngzmDzMvjCoh0hdE5Xn6JuJZA10vt/Wrt2qnoljwZjEW1y5+gKRllQcmYdOtX",
-	"ZqPPEYq4Tj/lS2ZdycznQe9pkuFAzsaxRxHqnRSHAP3oPaBJRZlz2miZxRCzLtwrrS4cO3TtBvcX4YKo",
-	"khq7H69TAU8+DthGdvRqMl6BS6pUSbhmovbuEN5fzj8J7a+uJn4QV5xc/9BvBqf6fdWgSaXthav/Y5fp",
-	"3uQ//my9KwlwLXf/BCrcwaYPKlrGchZ36lk64Sqqb9JT78pXTVHMq+tsI4qxgOkffyavvG1p0r3jCTmW",
-	"bkkUropcNFj8tSsB4ZsZ6XPytG9cp9OqGp86ESE+nNw2PHT6VKopcz7HtG7v/Pm1dUBDFULkrRKEM3PY",
-	"6kTxp3407A0Q2FaAuW6DwOZ09oypBOWCHPG1mpVAFYxgOMza5tpORPLF9rVpPy3YPl6JNZ1ytk0zi8yz",
-	"Eoq1xXliJVonuhxfYJXVwGI4HMv7+11DrrEiU+vHJAEOSaBrJgvKf/936tmEoqTxzPb0P5Jmdj4LeUs0",
-	"UNEdL9qmyEGrGppcI6nqbZsIs3edmTkkNcz9EOaHJS1VvCpa0tm1l/kkcFiJJHqOL+ysmJDt2y1nHvhA",
-	"sGIckfFIAOv8/f8nMq1f+/2ic1Cza/xVMUi8ECQPsaWVjg5wIGm8qFEyxP1aAXeV4Zcx1OyPilouIdfs",
-	"ek+ii7+ugQdJFOZeE4ywLIO8F6yJssGEoofbOVqAxvJQjMITJPa/MzipGNEr2D1QpEMN0VpPcy/c3yaX",
-	"JGIAby0jeFRCxbwUrenKOY4x1VAGYsF7Bdvu0GblTlaJDeScW87lSbIr8YxMGS9TOWku0/WgTGAYMJLK",
-	"hTEsc5fWeLzCqoKqqeDuc1GGekFyFikE5XJZYlqSxlrrs1qC8r/5HER2lpJdQVjHFm3jmELBtYgqe70e",
-	"ORuRkwbR39HqVZg7y8/M2hiOYbxvJAc0ej/lpcDKT6lwp27YROPm9UBZ51AUU7ASFcK1BOnqfePNUAoF",
-	"mRbetW4MjjFUWA/YWyFBJesuWOCS2VDft+lesf6MTZZBneNruEAiYUMNdDJIypqecwzZL+13H+Dqc3Lt",
-	"1Wk39Jrtzarqo3eYGiAxpPolcbfl/sDZ26i3GecgM2/r7vsUcoPK0P5aSVHUuUsEExyMxgQwOWHZCCuJ",
-	"aobz4SoHSr4Ss4G/DtIQXMHu2Opf8jXlqyC9Wgi9Fe3tGoLMZb3dvlfNf1zJWa7sAlb3AufvqT2fzyoh",
-	"yixhcD0bJprtn4Erll8ZMbtu/d4ThTbJV2jnazxqbtY7n1i1qoBD8fCIkFNuI428c0230lFvcv5Aj82/",
-	"xVmL2uZ+dor9o0seD9nApD7yjvzNDzPO1RQY5nfHqewge9KYbhNJbiW9iZSdHfrTTXZ36ZcCbYnKQhGT",
-	"Um6ZqmvS+R4q9yOkH1RBHH/9hJn8Wi9maW1EKC21lSG7wsub1vQzrR6j77AHvFBZE1Rk9NzIgfM7uxq/",
-	"aZASLCVJCZ3l79P/uAW2fCnYIoVRk2aZNgGxdVPr7kug3FMvG51ZHM9D1Rqm7RMcc/4OVXIKbYY2DWtA",
-	"OOZcymtafnm1GuZzPEV8QPE+LfCE798QyRaV6nb+fq/ppLmDt+79Tc3foRrwr2D2KGrsdUM5409TCdOb",
-	"yDDFPS1JKdq6yDgkucExrXX4yTdk4aLoKgk5U6wXYHzjq5o0zz0s8uV8LLd6z/ty3zp/FvoOZOweCKIi",
-	"b9sKCVrg/dBC2B7R35mpJE5ulMpj1Dcgiwj+YjwqTGez57q46piNbcWZnj+kkHDP5uPAEexA8/EwUc/U",
-	"5VkTqbl0agXDdU6+rTu4jVzU7dqm+j4MkTuWRn+Ky0K8Oobpjj4TFiFYWoYgqORvT/5GJCyxdqQgjx7h",
-	"BI8ezV3Tvz3tfjbH+dGjqBj3xbwlLI7cGG7eKMU4Y9ogFAa2FZOJpH/vHXN3Fzaa7wh2gHh2zhKi1WBw",
-	"au83+oVTQaPMvVfBb5fmGu/jZwHK/JKbiWK4/zkVu2D98xNhMr2zULOy2HcoO0FPbeVbDOv5xQXk/i61",
-	"d3+xuuwhm3T1Dw/xkesfAERMZK2dyYOpgnCmCZFMrlskbgmJK68l0zvME+ZVn+yXqE/ND421xFmBm8wy",
-	"Tu7Q4gqaTHOtbaVWXrL5QdASZQHznkEPRS1EeUS+29JNVYJjUn96sPgDPPvj8+Lxsyd/WPzx8dePc3j+",
-	"9YvHj+mL5/TJi2dP4Okfv37+GJ4sv3mxeFo8ff508fzp82++fpE/e/5k8fybF394YO4AA7IFdOazUsz+",
-	"Nxaozk7fnWUXBtgWJ7RiP8LO1sI0ZOyrbNIcuSBsKCtnJ/6n/+m521EuNu3w/teZC3qfrbWu1Mnx8c3N",
-	"zVHY5XiFytRMizpfH/t5BmU4T9+dNeFh1hcKd9RG/hhSwE11pHCK395/d35BTt+dHbUEMzuZPT56fPQE",
-	"cxlXwGnFZiezZ/gTnp417vuxTyJ88unzfHa8BlqiTdz8sQEtWe4/qRu6WoE8cuVGzU/XT4+9GHf8ySmS",
-	"P499Ow4r9xx/6ujbiz090dHl+JNPYjXeupMlytkZgg4ToRhrdrzACOSpTUEFjdNLwcedOv6Ez5Pk78cu",
-	"LDP+EZ+J9gwce6NUvGUHS5/01sDa65FTna/r6vgT/gdpMgDLOkEPwbVuYMe2rv/w5x3Poz8OB+rXl4v9",
-	"fPypm7a9g1C1rnUhboK++ACyr/fhfE3Fr87fxzeUaSPSOMsi5qQadtZAy2MXuNT7tfUVHnxBB+jgx2BP",
-	"4r8eN/H40Y99Yo99dZudaOTDTlHoEja0teE+ZwWq5WyLUDFnrzdQ+ltR7EbKFW+zBeNU7roli9vr3X4c",
-	"yjLDguprsOkkvXYq1Nniq9ItI7x4tazB5s1BywLywKePH4/Au1GryoXJpEqlLykrawnZJqVSurz8sMT0",
-	"T9/bll7pMI8azfAJjzXAzMBtVAUlJbsGspCCFjlViURUTKEdq6maF3+JbFSYEqxXplRNh4EsIKfmSabX",
-	"sLPWRgdBW7dPTUhS2EdhdCFTimu7WCH0hwuxivUKPE34svnPD9/5UVVwx+M/Aty3tCA+LDwjb2hpyB4K",
-	"LLAg0Rm6G9j5/PGTLwrfGUfnESNKECsqfZ7Pvv7CSDrjRrCnJcGWFoJnXxSCc5DXLAdyAZtKSCpZuSM/",
-	"8SbOOci6NzxbP/ErLm64B95I2/Vmg/yuYZuKULSLhPQpZIRcqSJMtzo9sFGJ0I+aPiJ/PX3/9uztDydW",
-	"JG+kR/P/bQWSbYBrWqJFoXbGHG3OcQHXUIrKfMZUcxJQo80FWdVUUq4BXCJEucFH57LmuQ1QYXpngF7W",
-	"WJrTXPVCWpZEVwotM1heZjafhSCYM7zNDL9eAc/cjZEtRLHzOVIlvdFbyyCOg3dW+G6ZnXwIXiwfPn7+",
-	"aL5J0xo/tWL4yfExGorXQunj2ef5p56IHn782IDuU4nMKsmuMTLp4+f/FwAA//+fi696zcQAAA==",
+	"H4sIAAAAAAAC/+y9e3PctrIg/lVQc2+VY/+Gkl/JPXHVqftT7CRHG8dxWUrO3o29JxgSM4OIA/ACoDQT",
+	"r777VjcAEiQBDkeaOCdb5y9bQzy6G41Go9GPj7NcbiopmDB69uLjrKKKbphhCv+ieS5rYTJewF8F07ni",
+	"leFSzF74b0QbxcVqNp9x+LWiZj2bzwTdsNmLsP98pth/11yxYvbCqJrNZzpfsw2Fgc2ugtbNSNtsJTM3",
+	"xJkd4vzV7HbkAy0KxbQeQvmDKHeEi7ysC0aMokLTHD5pcsPNmpg118R1JlwQKRiRS2LWncZkyVlZ6BOP",
+	"5H/XTO0CLN3kaZRuWxAzJUs2hPOl3Cy4YB4q1gDVLAgxkhRsiY3W1BCYAWD1DY0kmlGVr8lSqj2gWiBC",
+	"eJmoN7MXP880EwVTuFo549f436Vi7DeWGapWzMw+zGPILQ1TmeGbCGrnjvqK6bo0mmBbxHHFr5kg0OuE",
+	"fF9rQxaMUEHeffOSPHv27EtAZEONYYVjsiRW7ewhTrb77MWsoIb5z0Neo+VKKiqKrGn/7puXOP+FQ3Bq",
+	"K6o1i2+WM/hCzl+lEPAdIyzEhWErXIcO90OPyKZof16wpVRs4prYxkddlHD+P3RVcmrydSW5MJF1IfiV",
+	"2M9RGRZ0H5NhDQCd9hVQSsGgPz/Ovvzw8cn8yePbf/v5LPtf7s/Pn91ORP9lM+4eCkQb5rVSTOS7bKUY",
+	"xd2ypmJIj3eOH/Ra1mVB1vQaF59uUNS7vgT6WtF5Tcsa+ITnSp6VK6kJdWxUsCWtS0P8xKQWJdMaR3Pc",
+	"TrgmlZLXvGDFnHBBbtY8X5OcajsEtiM3vCyBB2vNihSvxbEb2Uy3IUkArjvRAxH65yVGi9ceSrAtSoMs",
+	"L6VmmZF7jid/4lBRkPBAac8qfdhhRS7XjODk8MEetkg7ATxdljticF0LQjWhxB9Nc8KXZCdrcoOLU/Ir",
+	"7O+wAaptCBANF6dzjsLmTZFvQIwI8RZSlowKJJ7fd0OSiSVf1YppcrNmZu3OPMV0JYVmRC5+ZbmBZf8f",
+	"Fz+8IVKR75nWdMXe0vyKMJHLIr3GbtLYCf6rlrDgG72qaH4VP65LvuERkL+nW76pN0TUmwVTsF7+fDCS",
+	"KGZqJVIA2RH38NmGboeTXqpa5Li47bQdRQ1YieuqpLsTcr4kG7r96+O5A0cTWpakYqLgYkXMViSVNJh7",
+	"P3iZkrUoJugwBhYsODV1xXK+5KwgzSgjkLhp9sHDxWHwtJpVAA4Xe8DhYho4gm0jPANbF76Qiq5YwDIn",
+	"5EcnufCrkVdMNAKOLHb4qVLsmstaN50SMOLU4+q1kIZllWJLHuGxC0cOTSixbZx43TgFJ5fCUC5YQbiw",
+	"QEvDrCRKwhRMOH6ZGR7RC6rZF89nt/u+Tlz9peyv+uiKT1ptbJTZLRk5F+Gr27BxtanTf8LlL5xb81Vm",
+	"fx4sJF9dwlGy5CUeM7/C+nky1BqFQIcQ/uDRfCWoqRV78V48gr9IRi4MFQVVBfyysT99X5eGX/AV/FTa",
+	"n17LFc8v+CpBzAbW6G0Ku23sPzBeXBybbfTS8FrKq7oKEco7t9LFjpy/Si2yHfNQxjxrrrLhreJy628a",
+	"h/Yw22YhE0AmaVdRaHjFdooBtDRf4j/bJfITXarf4J+qKqG3qZYx0gIfu/MWbQPOZnBWVSXPKRDxnfsM",
+	"X0EIMHtLoG2LUzxQX3wMQKyUrJgy3A5KqyorZU7LTBtqcKR/V2w5ezH7t9PWuHJqu+vTYPLX0OsCO4E+",
+	"anWcjFbVAWO8Bb1GjwgLEND4CcWEFXuoEXFhFxFYiYMILtk1FeZkNo/tyXYD/+xmaultVRlL7979Kklw",
+	"YhsumLbqrW34QJOA9ATJSpCsqG2uSrlofvjsrKpaCuL3s6qy9EDVkHHUutiWa6MfIvq03UnhPOevTsi3",
+	"4dioZ0uwHS2YUzXgbFi6U8udYo3hyOHQjvhAE1xOsMTczhsyaM3MMTgO7wxrWYLWs5dXoPHfXNuQzeD3",
+	"SZ3/HCwW0jbNXNCKOMrZCwz+EtxcPutxzpBxnC3nhJz1+96NbWCUOMPciVdG19OOO0LHhoQ3ilYWQPfF",
+	"nqVc4A3MNrKw3lOaThR0UZjbzyGvIVR33mt790MUEvjQh+GrUuZXf6N6fYQ9v/BjDbcfTkPWjBZMkTXV",
+	"65NZTMsIt1c72pQtBg3x9k4WwVQnDYrHQm8PagU19GTWhzeulljSYz8UekxF7i4/4H9oSeAz7G1q/L0c",
+	"bBIct6gMXhAKuMrbC4KdCRrAwhtJNvb2TuDWfRCUL9vJ4+s0aY2+tgYDt0IOCVwhuT36NvhKbmMwfCW3",
+	"gy0gt0wfgz/k1v6HG7bRE+B75SCTuP6OfFQpuhsSGceeQmRAEFRXjbtBhCc+zNJaXs8WUt1N+vTEiiCt",
+	"PZlQGDUQvvMekbBpXWWOFSM2KdugN1D7hDcuNPrDxyjWocK3TDB1NDXbnkI626uEuAc834FoQSu9llG1",
+	"Yz5jSkmVGI5RLYWVfEvKS1aQVYNSRMjOZyVdsDI+WLCO2GpOpMgZcepYdDQgPtOorCUsL4Zv3KtNAxe5",
+	"oZo0PdGUrFkuRaGJ5iK3zVkl83WcHiPkDVDAVnOn63HdJTh+JFVZ299DzKW8WnQFZDC1NlGuvezih61e",
+	"hCjeKG64WGVwQMzJDbV/LaXKHJgFvusqt4hRWhtpaJl5FOJAtAbKBlWY2jBBuDCyjyyAA5YKRrQkS6ri",
+	"SNuJr673znnFdhk+MZCKcnX/qfsad8hsfi2mCEWAUhtqaisVQzCCVWuGJ1KQgm0o2Kc60uLC0N9BZmpD",
+	"A1F3D5nZHejYMlNuKl6yI4jJdVRFBJPis6fk4m9nnz95+o+nn38BS1UpuVJ0QxY7wzT5zFlyiDa7kj2M",
+	"yyMwtMVH/+K5f7PojhsbR8ta5WxDq+FQ9i3ECnDbjEC7IdW6ZEasGwAncS0Dvc+SndhnPgDtFddUa7ZZ",
+	"HGUxUgQr2lkK4iAp2F5mOhS9dppdiKLaqfoYJ3Jzcg4WuFLSyFyW2TVTmsvIw+pb14K4Fv4Mqfq/W2jx",
+	"UIO58aGoFnj9iEnxrZiuJdqhL7eipc2onmjxjWDn5p2yLl3i+3cHTSp4tN6CZFzUq47dZKnkhlBSYEcU",
+	"md8yc7ETOdrgj8Gk6QN/wwU+COqdyAMLDyxUyYoVU0e15PSp4q35dqoHOgIOkOM1fkYj4CtWGnr0205/",
+	"ghjsL/1CWmBJAQ11DLwLoxjd/O5A2mm+FkbtovYKOMAY3YCsxSujfc43a8aVx8GaQi0myHiv+Wptgpv1",
+	"WyXl8viYxGaJ4YAfrM5RQp+hdeINMzdSXb1lTB3jEloxpqaLl2DyvaLFjjxV28qlECwHSYj9gpUzii6X",
+	"PLfIy4JdoF52BKWqHayVWbA3Q0lFF7I2hBIhC68RxtWthDMWoobOKybU4Mza2lkWDARCTmtYangXk7ET",
+	"oO2Y0dwSOLMMvk+/tq3sdNbRp1SMFmDLZYLIhXsgdk/XiCRFvxLjFRan7EXV/ACuSsmcaQ2a9qHXDTwM",
+	"zAidEHAEuJnFXQHuDewhVxRNPvvuJ/3wD4B3yj0O28TI25j5uEhAPW36MYbrTx6yHVWMeLFAjET9tGSG",
+	"pUh4EE2S69eHaLCK9yfLNVP4Hv+7cryf5H4M1ID6O/P7faGtq4Rvr7uwXvINvtYIKqQzAEUHK6k22T6x",
+	"DI1CXDRgEEjCuC1Mm5Sx7jXVxvqQcFGg6dseJzgP9sEp0gAnLxYw8k/+TjEcO5dCM6Fr3VwwdF1VUhlW",
+	"xHAAx6P0XG/YtplLLoOxm1uMkaTWbN/IKSoF4ztiWUwsgahpnlqdk9UQOXyQhHN+FyVlB4iWEGOAXPhW",
+	"AXVD/8YEIFy3hLaMw3WPcxqnyvlMG1lVaInKatH0S5HpwrY+Mz+2bYfMRU17bheSwezGw+Qgv7GUtQrV",
+	"mmri4CAbegW6Bxo2rLPLEGbYjBlaWLMxzodteQGtwi2wd5PW1UrRgmUFK+luOOiP9jOxn8cGwBVvL7DS",
+	"sMx6McYXveVk7zQ2MrTE8SJC840k+IXksAXhBtkyiOu9Z+SC4dgx4eT46EEzFM4VXSI/HqJtlzoyIp6G",
+	"1xJsyATbWIidQJ8Cb4IMzch3pwR2ztqbQ3+K/2LaTeDb3GGSHdMpFNrxD0IgYRR1wR/BdulJ954AjkrN",
+	"pBTbI0ZSOzZhoX1LleE5r/Cq8x3bHf3a258gfmsvmLEPUcEHewWuwv7Eut/1x7zbTXDSbXcI/uDKG0Gn",
+	"5Bo1ni7wV2yHphO4On9Fj+9V4sZNXa8X1JOUMeW9dU4CgI7yoE0PsFQ2AO97yaZiuhWB5oZfI7b4cBMi",
+	"yzy66FePRiU0tvwkzXEsKSM2x0AFpMYw3SgMzBmkKoAk/pZ2Lc0hFpoeYnvJ6wWHm2YqoW1zy1IBCq06",
+	"ksuyZLnRrZeytkGcsTU4BvkRigyhOIQJe5DspVdnmkOeEh2F9EEkumxdo7++ZvCe+LsYWhOz7TOyNjEq",
+	"bT/CoKNO4HAMq11kVMJt2BksmHeMZ0U34oZtaW7KHaG4ADtywxQjul5suHGuCl12MrLKwgGib7IjMzp3",
+	"JRv+4Vlxiv/UBQ4VoDdkSf/APg7fZc8E0iGHs3pUUpYT3jsGxIhCMMmzlVQSVp27EDgfJ+UPzQ6QTj8t",
+	"dx5cpxSHZEYMyH/JmuRUoHGpNqy5vUmFVyLoizNwHczpfFhbCrGSbZi1meGXR4/6iD965Naca7JkNz5u",
+	"9NGjITkePbKbQGrT0SOOYbOnypxHjhp8rEYV32LWV5/2+1C6kaes5Nve4H5S3FNaO8YF9O8tAHo7czsF",
+	"95BHpvmPmu1EzAN8onjjul/wTV1Sc4wXd3ZNy0xeM6V4wfYrAHZilOG0/KHphjGxLAcezVmWYyTnxLHY",
+	"JfSxwZ/7rGCtyxrfbFjBqWHljlSK5cyeFXC51Q2MJ8RGOuRrKlZo01CyXjlXezsOSmoIDsaIzVoMhhjK",
+	"r7hkrbkwNgbNbEW2UrKuYmLdxV75YFa4DzIKJqlg2bGzNcDc0AYYVnSk/UTK+kG/hTFTz/bzWdJih+pY",
+	"a7GzlOtG5MY1SwwxznSd54xFI/JitrAG1V7mkTaW3A0I97la2ZAEQnNT0zLcIxD2SsWum5KE8lKDzOaa",
+	"YDvo3GpHc4ubjxdf0lKzALMwgDnc152reLDyLUn7pJj4sI9MAgrwkDNC7gRh0OiXR1fdQtV1COVw4iAG",
+	"ov2YCoMAw2i5O4LSZgciilWKaTxiwwcFbb/KZZhnwJ3BeqcN2wzfXG3XfySk0LukZU+KkguWbaRgu2hq",
+	"HS7Y9/gx7diY6IwKV6pv31zUgb8HVneeKdx4X/riagey6G0T/3OExe+P23tuDzMs4HMSKytCSV5ygD2X",
+	"QhtV5+a9oGjODjZbxPPRG+7SDxwvfZP4i0rkwcMN9V5QvPA2Ru6ot9aSRSy63zDm3zl0vVpZA0BH8jH2",
+	"XrhWXJBacINzbWC9MrtgFVPofnhiW27oDkQgvsf8xpQki9p0ZTIGgmsD4tK+/cM0RC7fC2pIyag25HsO",
+	"vmIwnPeB8jwjrKdHQ4X4EbJigmmus7iH5rf2K4baOPTXLuzG+3drru1rMYzfRovvDOtkmvnfn/3nC8gw",
+	"Q7PfHmdf/n+nHz4+v334aPDj09u//vX/dH96dvvXh//577GV8rDzIgn5+St3tTx/hfeH9rl4APsneyrc",
+	"cJFFmSx0buvxFvlMSNMw0MOuId2s2XsBfnpGQroXXlBzN3boi7jBXrS7o8c1nYXoGc49rgdq5feQMiQi",
+	"ZHqi8c7H+NCpOZ4QABbSx/hDK7KshV1KrwXbAAvvXCqX8ybpg0329oJgRoA19Z7R7s+nn38xm7eR/M33",
+	"2Xzmvn6IcDIvtlHtkG1jly23QXBjPNCkojvNEgoowh71o7U+cOGwGwa3dL3m1aeXFNrwRVzC+ShCZ7TZ",
+	"inNhw/tg/6A3xM49ssrlp4fbKNDDK7OOJYHqaArYql1NxnoeahDny8Sc8BN20jeaFHBvcx69JaNL4kI3",
+	"lJRToqKbfWAZzXNFQPUQkUmWiRj/oHLrpPXtfOYOf310fdwNHIOrP2fj+uD/NpI8+PbrS3LqBKZ+gNRy",
+	"QwfJHiK3Vvuh67toCHWp72zulPfivXjFllxw+P7ivSiooacLqnmuT2sN7zElFTk7WUnywodIv6KGvhcD",
+	"TSuZnTKImiJVvSh5Dm9fMfa0GceGI7x//zNc3t+//zBw4xrqr26qqHyxE2SQ4EvWJnPm6kyxG6piz+S6",
+	"SamDI2Pv0VnnxI2NP7rxiRs/LvNoVel+ao0h+lVVAvoBG2qXOAKWjGgjlddFuPbQ4Pq+ke5gUPTGmzBq",
+	"zTT5ZUOrn7kwH0j2vn78+BkjnVwTv7gjH3hyV7HJhoxk6o++/QIRt/catjWKZhVdxZ7j37//2TBa4eqj",
+	"voxPDaDoYreQJk1UDg7VIuDpkV4AC8fB8fqI3IXt5XNjxlHAT7iE2AbUjdZH6K7rFWS9uPNy9TJnDFap",
+	"NusM9nYUKw0s7lemSZm3olxo77gF1hrYBC674AJMeyy/YgVafNimMrt5p7tcdhRNLzq4tgkBbcw6Zq1C",
+	"Cz8kCqwK6lTxvgVpsSOaGeOjLN6xK7a7lG3Sq0PyBXXT1+jURkVODbRLYNZw27ox+ovvHFABUlpVPgsM",
+	"pgPwbPGi4QvfJ72Rrcp7hE0cY4pOepUUIaiKEAI7pEhwB0RhvHuxfgw9uGUs7MkXyR/oZT9xTdrLk3vB",
+	"D7G5XDffNwyzi8obTRZU2xhRpIdN0RJIsVrTFUtoyOEjy8REKJ2HGRxk37kXPemC913XcXDeREG2jTPA",
+	"OcopDL4Aq+Blpuch7Gey73juhQDzXTuCLUpUk9pYZRQ6VHUeu8RqDLQ4AzMlWoXDg9GlSKjZrKn2OTuL",
+	"ebCXJ+kAv2PKobFEc6FBP8hf2tjXvczt79PB7dKlm/M55nxiufBqOSFJ3Hzm4mliyyEFKkAFK9mqCZF3",
+	"4djd9EftAgEcPyyXJReMZDE/Waq1zDmKouCYcXMw0I8fEWJNwGTyCDE2DsDG92kcmLyR4d4Uq0OAFC59",
+	"E/Vj48t28DcbywAAKo+sQITzxANS7iUAdc7VzfnVc/HHYQgXcwJi7pqWTBh/42sHGeQ7Q7W1l93MeUg8",
+	"TKmzIxZ4e7AchBP2uBM2oc7kgY4rdCMQL+Q2s8HjUY13sV0Av0eDaaBXdGPazHIPNFnILToY4tFigzf2",
+	"wJKGw4PRAoApwwB37Jc6zS0wY9OOa1MxLtTks0a3adklpU5MmTqhwaTY5bMgWdydAOgZO9qyCu7yu/eS",
+	"2lVPhod5e6rN2ySoPk4xtv1TWyi6Sgn6Da0wTXo3Z0J4x3KpirSdAhiVm6ZOxdC8YNth+pPJCeBGamac",
+	"dW8b/goxXLmEc0gHnnaeEUK8stHSA0i+3lZSM+1ikPGod4M7PVExmyRCW5sVvIKXrIlViJIphrB3TfMU",
+	"tyi3iXX9gNN059jiJi75Y7BUVRyOQ24q7xx9RqBI7PIWDmhwX0hcMr5RWG7T/PG2r9pHN0qnVS8FZHDX",
+	"ip0OwD7D18zhm6lmJcPbc9a5bWRXbBc3AjBUzS58t8DKh4kmqdg9DFz3FFtxbVj72uQde/4IO37ju5vG",
+	"zlRqCfi9k7LR50IP5xDNT44BRvksuYJ4Eniqi6IAjb7RaH36BprGLxWdxSa21AMv0j7oQLGs4GUd51c3",
+	"73evYNo3je6g6wUqJlxYJ6oFliaJRkeMTG0DaEYRfm0Rfk2Phu+03QBNYWIF7NKd40+yL/oO7iPiIMKA",
+	"MeYYrlqSpCMHaJCcZCgdgwtGkNLjZOyZYrCZCj/2Xv8qnyIlpczZkUZwQdegpI92xCHH+pFZod5WJYum",
+	"nxDSZB3jR4RcjYFHG3plQ6i7CyxWfpp4sKa09+pJQ7u2ewYU08cT+4dzSnBWsmtWpugchOIjxb0BBz0j",
+	"7AjoeuOSCjq/hP1a/XAFWoI1mPZhjHLLQLsZe7htr0YuT3h7t0aGBdpZLXP66x1oaJ7fWv4ePt1VFcTt",
+	"smhk9d8Dd1FaVegh6xvHQlhhMA7uBHFw7KeDfXyPlcK+N850tMNE71NIgOqcvkOa/PQdM1ilkMxppBJM",
+	"6WccF8Q4eHOza7XTAfcljnFaVbzY9t497ahJ6/hRKIYHlBtsDwUC3ojF7CumO+seGPNsmalOft2TSZS5",
+	"7KbhD3WacCqufZHEIaGanB77aAUp9r5ju5+gLaIzu53P7vdMGqO1G3EPrd82yxulM7rh2WezjtfDgSSn",
+	"FTi30DJzj8kp1lTy2rEmNvdvz59YW4tLvcuvz16/deDDe13JqMqa204SK2xX/WmwsrUEEhvEF2FbU9PY",
+	"5+xtOFj8JgF6+AB9s2au4FVwoR5U5midC9rx/IP0Mu4NvPd52flBWBRH/CFY1bhDtE912LnnAUGvKS/9",
+	"G5mHNuG5i8hNOxujUiEc4N6eFOFZdFRxM9jd8d3RctcemYRz/VC5DMDRoFDpvzaeEV0R9EA7zjpFrE/B",
+	"eI/QnKTMexGHcqk6wt+FT0U9Kxp1ricY4Vswxh34FzWKSSeW1CzQhCy0xcndlDq7cgnXWV+TsX8/PCHI",
+	"veSX1S+Ea/LoUbi5Hz2ak19K9yEgCf6+cL/j48ejRwHQrTocNQ4AFfDuL+iGPWyc3pNL/2ktSYLdTFcJ",
+	"kHbQS6Y5v9kU1ivD0/vGkQ8ye1uCFu4Xq3NGKTrcxNY5vLf8lvAhVFN270UqRqnx/tvYmpGaSNF3dsVA",
+	"QGAyPGggBmPB3PvlcPuKeoNvfpkueR73hhALDaJdWC83aEywccIaBiPWPOE0KWoejAXN9IQnqR6QwRxR",
+	"YvoKSynaLaQTLbXg/10zwgsmDHxSPqVneMzi64fzixkqw/E7oRsY+wTD3+eGEFaE6uur7sY0dj0IfeoG",
+	"4L5qbPYe0ebtmAovnA91zQ1nHBwaI261jj8cN9swo3XXN26yKN5bGNyLPFeaKjFHtNA319lSyd9Y3NCM",
+	"9vlIiL+bCK9C2HtCdGj7DtvWK29nTy536m4SfCRdd+IE1+PKBw50WIzH+5JQYZfahl53olLiDBO00Kd2",
+	"/JZhHMyDmLmS3kD9ivgVAWAKHk87Xi9GEt/Z0143Ich2dhJ4fTZtuU1UVzHVZt8YJr29o7pvp52s6Ld6",
+	"PXTsaPRz66lXahkZphY3VBjmi63ZreR6a2Zf36DXjVSYZlLHHXQKlvNN1DT8/v3PRT50xij4itvixbVm",
+	"QXVcN5Ct+m65yFUYbqLuHWnOl+TxPKi/7Vaj4Ndc80XJsMUT2wJepBG3Rpn0XQA9JsxaY/OnE5qva1Eo",
+	"Vpi1toTVkjRXMlsDxLuZLZi5YUyQx9juyZfkM3Sw0/yaPQQquvN59uLJl+geYf94HDsAXJXyMWlSoDjx",
+	"1rs4H6OHoR0DBLcb9SRqy1sqxn5jacE1spts1yl7CVs6Wbd/L22ooCsW9+ne7IHJ9sXVxJe8Hl1EYeui",
+	"a6PkjnATn58ZCvIpEScK4s+CQXK52XCzcW5YWm6An9rSt3ZSP5wtsm7PpgYu/xG9GSvvzNUzAX1iXZtu",
+	"4vxA0ef0Dd2wLlnnhNrcoiVv/Yx9LUVy7lMXYxm3pnqbpQ3MBaijmgNLiEVRuDBoFqjNMvsL3L8UzUH8",
+	"naTAzRZfPI+UrusWRRGHAf7J6a6YZuo6TnqVYHuvQ7i+EDkrsg0HUf+wjcsOdmXS7TI6rUl5+Y0PPVUp",
+	"g1GyJLvVHXajgaS+F+OJkQHvyYoNPgfx48GYfXLOrFWcPWgNK/Tju9dOy9hIFatH0G53p3EoZhRn16xI",
+	"LhKMec+1UOWkVbgP9H+s64NXOQO1zO/l5EXgkPfa4G6AL7ahX/Fd3mq777QdnSu2gPhh4vslLs/eV8v7",
+	"1GzudD4EKtdlInQJI0InfL1HscNuwPc3MQQPtp0VStGoi1qMM7+SEZR9oc/mhdbFO0fsVqkDBD6AgFq4",
+	"oeakWybt0/vDeQvm0C8LvnhY8Y8+sH+wsEEiewwSixgUfI0uZ9F8D1xDKflKbqcuak92+4X9JyBNgiTv",
+	"2JIpFg3Vaz4BDQCTQUHb6OvvuFPD+avwwR1GXbBSwuXMyMNFxp9oEYAy85GlqHlZ/NQmWeplglZU5Ouo",
+	"190COv7DKr7QoEHREilaG2RNhbBuXYPh7IXxH/5iGbn6/iqnzrPhYmLbfo5qi24PuRbwLpgeKD8hkJeb",
+	"EiYIqdrNX9PER5crWRCcpy1E0apYwyrdQXVErFYa2zf4wcZoQWc0itnifISJAk1KJ+RbzCQBsHRy76Ip",
+	"p0kK2CmzVlelpMUckzbCYz6xs9o+iplaueKAK6sBdbBIBzocErEwFqRwjNBowFobzPqvDd1UsVxP0OLS",
+	"NyC890yPNo6QOifklTUvaW+8sJMQzNmpNqwgzXTugoM8Af8xhuZraCA7p1ua5adXtfRc2Vq1qf9/3nCi",
+	"3XcAtytsaetazokEJe6GQ/7BNTXsmnXTS3kwvEbm00110VO1EJZT4qWlR3IB3oXsHjgct3kLjELWI/yB",
+	"p4KL9zmwyOcF9oox5aBiaO+xzicr8tlByffO8JpTIQXPMTdzTEvCVDjT3AQmpLGOh1g5x0U9i2yuaJ3S",
+	"JurNUTFZuXQ+6xBu+FIXfIVFtdxh/zRs66pdrZjRTrJB6Lcrt+seC7jQzBUSAiYK5aRUEa+EmD7SXlkO",
+	"ZCPMcpGw/nwD39442yBsQXLFbVl5RzbL0Nya8yFiG7hdEG7ISjLt8Omm+tI/Q58TzHpVsO2Hk9dyxfML",
+	"vsIxrOcNoG3dzIZDnXmnM+fkBW1fQluXE7j5uePPYSc9qyo3aboYc1QfgASwKQJH/Q7c+29A3Gb8cLQR",
+	"dhv1FsXzFBgNsjwTbVhFXIxhojBxL5oQ7g+Wo7AFsYEmMaLE/e1fc+Gfl+IHRB49EnBhcL8m+ulcUZOv",
+	"O2JosptJX6Bp494n7ztUb4GdY36Vz/wc6WVsayonBEfToFXcqNgRvymAuwNl4iVEGXvvvWGFZNSqnBLl",
+	"ohS7NZNjggMEt6/K3j0AhttgqBPZ7pge/NCTKJXzaVEXK2Ygn1DMtPMVfiW0CHJFQ4ryuikAVFUEgOrn",
+	"fB1ym5sol0LXm5G5fIN7ThcUIY9wQ1gI3a8wcBpYneHfWEmI9Mo4P8uDg5W8U2XRxCEfojd3RxpovcDT",
+	"GWQamU4JPFPuT4526rsxetv/qJxeylUXkE+c6XFMyoVrFJNvX8PBESZCHLi02qOlyVOI7qMSv/vUHk2G",
+	"ra5U8uH7gznd4kWWrAe8bxgF/JqWiQDBwOxO7flqXQxSYYJ5MqqVGpeIxlAyKoKSyT2six9+t1DEn1dS",
+	"bn3Wqw8+D3pP0wwHenbSVbIhqPf2HgL0nQ8lIRXlzn+mFRZDyjrX2LTldmzTtQvcR8JFoyaNp99dpyJH",
+	"fUIF/N4vy3/FXHa6SrFrLmu3YI3ror8S2l+XmIAnTNCQxD/qGvxHW6ST9vNLVzrUounu5N/9ZB1dCRNG",
+	"7f4JrOmDRX+NxqexuOGXXjNzdiqnXEXtTWbqWfnKnrDgB36dbWQxlnniu5/IK//MN+nc8Ywcy1snC1eA",
+	"Opp147UrqeSbgfY5edrvXaezqhqfOpFqYzi5bXjo9KmcfbA/x6xub/3+xZS/JDQhRO4qQV4IwbYmUTe2",
+	"n1bghhG2rRgmDQ8yRKTTEE1lKBctjrfVrGRUsxEKh+kvXduJRL7cvob207KW9PeWrUT3NYiCmJC1ZPdi",
+	"s2MVtkcpR6NfUecxx3nsHVHicVD0/BqYueNHIPb4G6OF9wacoET3MZ2Usc/JyH45gOSDASLoAfLjxw6y",
+	"13y1NgEab/fkTG/zpCP1K6l5W0+1hMHc2qxxuJOpXveAKg8fzYdjeZfXa5YbLKLbuvIpxg7JAA+T+dew",
+	"f+VOT7NRE5zg5c5InvT5LJTp0Uh7J9Zom+MNH5bR62DIKK5N5JBVrKmvp+Dd3Q0BP2DRpqi7RtLfu5e6",
+	"K/DZilQqiCN2XuynpUdnHrgB8WKckPFgmDPrPPP/JDFtaMdxyfnGPqlAQeDIjCSXQrAcULblfJ33ilF0",
+	"ueT5yXR/qctubCQVRNZmJXGPMnykso9SUvEVF72mXORy45tG6dXAaetvx+cHRcTXGRIufxbwCNPgicf1",
+	"2qaTJa5wvstEAh1YJfN1/Oq5ZLZ6bEqRX0lj4z6RgL71YXYXLrShImeJ9wV7PNgm1svIZsTHxCq2RnnC",
+	"Mxyv95mt8h9fsmum6Ar1BqtDw8iuX3QhiWIlxTrQTppKGygUttFIZUGFdJSOU9b3GfNLc8X6iawY1oDt",
+	"LG48RKDCDI5w5mdG8WpMsYDvlmv8uUu1ITBAH4N5U9AUoKkwgAA2CqIbR8/QVYJp3O5qI4XtDlnsPOWJ",
+	"oavJufKCHX5JV5d27IOLHIaM3KvNNSGGtHGy6+/TYP8EC+6I04K0R3IFeI0SFLQ0wUIqWhrbyuwn5B0a",
+	"+6mCJlTXCs4N/1qOSw/khROAPHnspAS54aKQN0NJuKaiKBlek/ZIIw+O7aEwdk0Y/+bd5ElyzSZuHjtY",
+	"0THURla10YYG05CKaheFQvsgpjJ82yFsRKuKPrzBpAs0xjUTogbWCKyKKUfZjtpZyHpRBsetBXww6140",
+	"x+beg5WXlmnEGtIdHbepq7gHgjiGmgkzbc10kKH5bhg1c01cqf6MIxgcsD5HwuPgVZmGjaGrUfi99B+X",
+	"uaH8iYiD6HYd7KYU+8dYc8BIndWOLVKfjBb1mKzvZGL9ju1G74V0mN8yyNFqj+gDNNezJtzVppQAjXHF",
+	"BHpYFb20T5OTzyyXLActaTyf6N/XTAS5KufeTwRhWQbpRXmTDgHrthzuBdUCVNI7wlPS44GTSmxyxXYP",
+	"NOlwQ7SyepMV5C4lO5ACaFsBs2QlNS1Tjm0uwofrhjOQCj5803ZnbfGz2HbH6QIr6B3n8izZtYeOTHkt",
+	"DbvjXND1oITrGNmfSjkKGtxXVEQtmdQZz+zt04534K3z/O3YbbK5eK6lNrELTeK6Gc3yMFSpMLG4aMOD",
+	"LCBh0RKkshQspX1QnVLJ7YUSvCZdAQvFVyvYly4cXCBu72cbKmpavp81ubYRImUdj1kRlH1n5OzteRTh",
+	"KbdqWCxtKFw3D79G18LwcsIEbFtxxfShE4xcSGwGCkdoj6kHKM6vWAUgKP4dBbsp7aJJLsvSWlGCI8i/",
+	"YHY36+AljFUYJpjdMLD5pqeCzFLYhNhOrCCuqInzYLZvpOTc2EXS/rMNhrV9rSNlwXKFjxmNroJWVD++",
+	"c98xaHUwTIHv3FKWpbzxP08TQAslaZFTnUBK1iaXvas3NYZtKtShrBc+gNuM05/7BRHSZK4PMCVEj9xk",
+	"A6L6DxbN9keYIYO6+NBXM9H+geIctaSMUVVypjL3FKGgoUkXJW6AzRJOhYA6frKbOqdW6EYoAMDMnc9h",
+	"dEJXxj9D9lAJ8bhm9JoDf4BQV9rnC2uZ94YpJh6YlonnNgsD8gjGAiK9Jtsj2n3zE8wYf2AMFiIOtv1m",
+	"V7zD/ZjHgm1zZo8qyyaTmHLknSnQbWABdLCV9w5rwZuCjLcLN9KiXYWRodXe+8fYyrazjZVwGQM+lB8e",
+	"BTtjOr/7VJjHRsj2vgtahB32gPeUBRs6xvck8EB6DTdan4MDP/kOM7Qr2CN0Q6UesiPHUeDt//U1i5ap",
+	"dbn/XH5vw7xqJawiMlbbP6WH/H29GxShvsHybL86WcGuee4eYOzZXRwSPzJ45LUPm7YiUlPs2f3Yrevv",
+	"Mt00xUUGcFZSlggsc37cYoWjNeEyiR2xTd1OwsET1xKzTShzUIDRCbK4B+zoU/tIwMdwxbAIV+UM5oPA",
+	"gpOgkhwtLFlRP7ILCl+vuf+fXdAmxK6bxTphmPBbAeloKeK6TOPutLPkK2YoL7XLdBDnbIiOiDNsbktD",
+	"NIFeXkln2v/m68DYWUp+xQLusmF1mMbetYj6iXsX9GzkqX+QgZvwONDLZmbeZuIaRt0OedjGsOelRLGV",
+	"SlrXlchNsP4DbVN8oH6CZwjCtWRKtRwFY7PMyPDSloJjjBTQ4I5E0Mna9xa4ZEXKd23JzQ3PlbQFC6hL",
+	"XxIiSBTbUIBOBYUx03OOEful/e6TDPu72l53+IZfp5yHuFhYvLVHxJDrl8SZ0vYnL76LZzwXgqnMh8n1",
+	"M0MIpkLgdOOohDkbg43RRA9MVjxHREnUqTwfYjnwDy6xIvPrIBvwFdudWtdNe9q2Ja5C6K13isUhqB7V",
+	"W+2jBg3E/aPLlUVgdRQ4/0jH+/kMDvTUtep8WOyzvweuONzp4N7ZZC+Ce/oDPdQaPsMQoSYY9wa1oOBc",
+	"fXhCyJmw+eJ8XG5YbnQwOVywRubf4qxFbevvupiAk/cinngLLfPqnvLNDzMu1eBWfO+p7CDjE306xamn",
+	"rgRMZaGIaSkXNuDuJe74mOaNGZiD7OQYh0mJC9QjupSxVDB3ShMNY8VJFc6GEBkmpiQpbsBwg0cp4LIQ",
+	"7E100OQ4cHkLuAzyHAw1JjArZRupWFZKTFUQc8ZdGlDHNtxogkVvV0RWuSyYre7tDVbthHHHEDtXLQTF",
+	"05QFkeG95YSGWOoP34UkcX2C6pETpwTBamOhMjyB9yZy8mS+hD42M21bzKANr7IheYnkKky7+gWOSLbx",
+	"EGRcJUy63cQWR7emndwOduyZQzGJVbkOMt2cL9F0yTFYvJsRGHuAopOzojFbtqvloqD9Ed6ayH2hOHLD",
+	"y9K/cAEPqNq9mISj/KhrjOfHdHAwxXOykdr4pwEcSTdDtTkSPsulMAosM12TsWUSFxryPd2e5bl5LeUV",
+	"ZPZ9iHcbIU2DaTH3yVL72SzamVSvfMbUFzoIvsYF0fvvwrYdgOBowzB56A49bdzl25aglsq5MjeRPXjJ",
+	"nxMtLT/gUEQzt4qdAgxNln873YItMQXeAZbJrgwLToVvYci9sX4BTSaIyMHwkWNjQMUOEQfSMq5UnwlC",
+	"jdzwPL6b/lyJKpLpJWKSMUYK28MZ17EZ2kLD06iJS0bJPCQzE7B1ou+VVrS7+ExrTaqMSzHYG7fxBU2d",
+	"hMPjwh3gWZ7UM3oAIKQ2o6qpFUbadZSARr7JlfWoRHe4PqATDzMM4r8fbDDC0YEy7F5ADRKHHBPA23FO",
+	"7giIVAaEi5Z9FDZpctwndn00f8F4ugBbw3ExNWlAU1Bz4vkdAJBOI9CBYVIygUPBsM97GTUJVQKtIvPg",
+	"Juey0wWjc6cF4Czh6x2MXSvmcq6jcCOqG8xSUbP2BzU0H9ouwQ7mPFR/Y0raouXzwB2IlWxjE+B3Lpuy",
+	"soUvw+FcIvgatVhw2HZ9ddOZFIxVeB73rTIx9/Xwsta7mDvcsyDwfAp1ozd1S1i7UmTPNTxhgc/sNtFT",
+	"txJAdM2Lmnbopw9VK7qGJ9jKUxQKD+uHaZLiYCERR25MROxN9FHr1L4U8TwfYR2CxuiOsxWN555lwnZn",
+	"64reiLRJKnZN8XetiQvGpQgf1bYsR92im8ji/jQhOBjRfLUfh/ChEZWHsSPNbSR8og8DJjriShM3ZvAw",
+	"HD1IW168n511cL/O7D2aFfvG9dz+ox3BJ3/WZ75/evuM7p7keLGLjWYofRvog1cQj0eMJR+g0atJwQIK",
+	"g6yNM6/i9JGj6oRcSrKhV6z/wUptayvUvLAvug3T4nmwawp62NPaSIxHgSsRPISjvMG8aJDwVDXJW8eT",
+	"Pw6veW41m/rQATmaQYvDgp3iAfWdyVqNbPqEky+2fXASQfYdgLDN7w4KVsEbhwSb7AdkbJt18vxOCk9v",
+	"5SXkDPnhminFixSkmqF5u1fu01vvXN+I8mwfbrmODMB1qz1g4jzWJmYLmoFLcsGXS6ZsVIQ2VBTwXhs0",
+	"54LkTBnKwSq/03czSJ77cFFqDYXQmrjWRzdG9ic7ilVymjURljNmz2skTsd4OJz97tbEaTMP1cZpIGzo",
+	"FrDHnGwJLnZVkGBVnT4iBVpGrLw+bB7Nf2Pj02BtQvcUbyTOOmWK8c36A5IOdZofBTej29VeaftJ8mzU",
+	"gN1NgRNNY7yzizPcRFUiTq/q5jZsfH5c4h+/1vZN0tsNT8ZSILqbf2IV8VXGJcUM7SJ6usmw8/ATkdlO",
+	"Tc1QfdUjqR1a+yXSWrubx+A9vK/3WqKEfqAHXMysyYYWBcfB4+ChXq5JVet18GYHPXtATKba/nyTWSWr",
+	"bJKXvQ/JtwA5WIdwpRLPjPJH816nm3LCIT926wrjeNM5J13XeN+tsMrH1NnUrSUhQ7s2K7lEaYab2N7V",
+	"0Ee9uaHM+zm7ureyRkwQShTLa4V2hRu621/5PTNxKH26Uzuyt9q6DJ8t1E40WIGEZh8L/6Cw+iE39oiM",
+	"jPBrpKT18ZGxeXxb39XfDx3nnhNH4MzdHADKcX5rbVueVSK8RsUuJuK8u8kdEExd2CdkojzaUjW75fdY",
+	"oOiRPpJ07WxgvW6yME4CbZiVMELNsVCYTmBm4HsdVMJRNrklvsN6E2FfXnzfmg6nBRf4DnvAC7Mdte0a",
+	"XygHzh9crub7higBKh9SnNBBf18CpSam2NtagyWyhmYb8IC7WA7leJAdS79skk4lFIlBbiolpSFSwN0l",
+	"ktNKo53EvgYHjOODjj59XqpvuNLmzAWAvEu7W4ahuSGRLSn13QoVvKaT5i7p7zC1eIvxDH9PhIGcCeKG",
+	"ckbcgfBHyyItrW/OMhJUBitNnnxBFq4SY6VYznXfOHwj67IIvS6umeJL5yDBtmZPcMc+PDEg6c5svPRv",
+	"LeRNYA+TbeQJQthu0T9YqCR2bpTLY9w3YIsI/cZlFDaPWbAx5sUax8fDI8fifu0gtFunLxHcCl4I4+Xv",
+	"nPuNj8xqgpxas3ncljIWOWUHaMPnJgetOoCb0WNkhjtWOpNw51S+6qQVbpXnQHGQih05vXBwBzwwvXCI",
+	"GRZymIwe4oFne63ZEM+D7q9j+lCL29Tc2EPiplNam8WUlNbx6B/ojjm1LUGg0QlBUMkvT36x5mIUWo8e",
+	"4QSPHs1d01+edj+D1Hz0KB7L/amyafvUIjiGmzfKMS7Z6qBUGgYw0Xj68XfuDPVR1DCCDz+Pol36OXru",
+	"qdjR1RX5tPqKdazem4jQouYa7zs2ApJ5lJuJYrT/KZVVzNZvSpRR6+0FCOLd+24RFsWDOBUmmOYay779",
+	"w9XO/bTk9xDYeMGhmLSwHlRDob8BkDARXDuTB1MF5e4mVLobJnjz64rMldeKm90F0N8bdfg/ojnXv23y",
+	"5bhstc2zjFPvjLxiwpckbrPr1NorkN9KWqLKZV+LBCNGyvKEfL2lkJLNCam/Plj8B3v2l+fF42dP/mPx",
+	"l8efP87Z88+/fPyYfvmcPvny2RP29C+fP3/Mniy/+HLxtHj6/Oni+dPnX3z+Zf7s+ZPF8y++/I8Hs/mM",
+	"A8gWUF+F8cXsf2Zn5UpmZ2/Ps0sAtqUJrTikJLq9RevJUgL6SNQcpSDbUF7OXvif/n8v3U5yuWmH97/O",
+	"XH3q2dqYSr84Pb25uTkJu5yuMGIuM7LO16d+ntt5j+Jnb88bZ0Lr6oEr2lo0T2YtK5zht3dfX1z6BCBN",
+	"LsDZ45PHJ09gfFkxQSs+ezF7hj/h7lnjup86Zpu9+Hg7n52uGS3N2v2xYUbx3H/SNxRylJz8aqOJ4afr",
+	"p6deWz796HSc27Fvp+H77+nHTlBlsacnPtSefnRxh3tah0apUxdMGnSYCMVYs9OF3B7QlOmgcRoVvEPr",
+	"0494C0z+furKdsY/4m3c7oFTn5Yo3rJDpY8Q1Xvb75HDGwmyYu+3ujr92H4MQMWiTKeu3t8pZtEMP5aG",
+	"6lONWdAHPw/QtgnQT7H+/G74807k0R+HA3XSiiV+Pv3Y+bO7MIjH6YIKHfstxv16XZtC3gSz4YUI6Rvh",
+	"ycjHIRpho+jHWvf/Pr2h3IA65vJi0aVhatjZMFqeuqJ8vV/bOjiDL1jcJ/gx4Kf4r6dN2e/oxz5RYl8H",
+	"zBNtZLk50ci74aNWKW3IUyNez4vW5y50z0Op6T0SZi9+jqs0bZNTp7HcfrAHP9PmK1ns/BHjbBSBqDh1",
+	"knVm1aIDQx7wYA9H2+hV5cq/3XXA23nk6tux9wcxDeDGIIVPyyp2Ln0wF1VtX2Vb7ceomqE6ZH3L8CB6",
+	"+vjxQaTp3QbgPUqG7ivTjPZdr5cjhnVhopG9Yd18s2EFp4aVu05AUy8WaX9EE1Pj4UzHjRI6846oLiIj",
+	"HfPl/Jmobmsdn9zD+TZ0pj80DbL72AToeu7zQTLRiyFaLTPnszcWmnwTGJwaVLt7Bccia3rNvBNg61dK",
+	"BaG5AU9eXS/QrdP5TcFGCqm6xAQWUoXun7S1ZLm8jj4sDTPdJ4IXOgUdW27trHxL0j4pPsTuFXvFz782",
+	"7b827b827T/Tph0c8e8ckywJ7eBgOSPkztv57PmBh/boc3WnnOK9tZn+cANEv6IF8ZHtGfmelrCfoOKW",
+	"218h9hbXJ39aXM8FpgUGEwGxJpDb+ezzP/HinQvDlKAlwZYWm2d/WmwumLrmOSOXbFNJRRUvd+RH0UR6",
+	"WxMRRoMPpdmP4krIG+EJAda9erOhahfcYjShmGwj3M9SRbY31YSb9qm2DfDuVvE/IX8/e/fm/M23L6wJ",
+	"sLFWwf+3FVN8w4ShJTqK1C5DCFYWKSCUTVbwGQPJlY3JEJKsaqqoMIzZyD+mNmjkXtYitwVTudkB0Msa",
+	"RCYBk4BU5sQXnADnunpR8tzmNmtAAJm3zXJZsBWDkBLc79lCFjtXkcXfzZB0p4FhNzSU4nWvMZH+/AHu",
+	"dJqpa38TbO1+L05PMf3QWmpzOrudf+zZBMOPHxrYP3pbYqX4NZbK/XD7fwcAMMCJW48ZAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
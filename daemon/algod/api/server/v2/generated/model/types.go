@@ -21,6 +21,15 @@ const (
 	AccountSigTypeSig  AccountSigType = "sig"
 )
 
+// Defines values for PendingTransactionEventType.
+const (
+	Admitted  PendingTransactionEventType = "admitted"
+	Committed PendingTransactionEventType = "committed"
+	Evicted   PendingTransactionEventType = "evicted"
+	Expired   PendingTransactionEventType = "expired"
+	Rejected  PendingTransactionEventType = "rejected"
+)
+
 // Defines values for AddressRole.
 const (
 	FreezeTarget AddressRole = "freeze-target"
@@ -106,16 +115,34 @@ const (
 	GetTransactionProofParamsFormatMsgpack GetTransactionProofParamsFormat = "msgpack"
 )
 
+// Defines values for StreamLedgerStateDeltasParamsFormat.
+const (
+	StreamLedgerStateDeltasParamsFormatJson    StreamLedgerStateDeltasParamsFormat = "json"
+	StreamLedgerStateDeltasParamsFormatMsgpack StreamLedgerStateDeltasParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
 	GetPendingTransactionsParamsFormatMsgpack GetPendingTransactionsParamsFormat = "msgpack"
 )
 
+// Defines values for StreamPendingTransactionEventsParamsFormat.
+const (
+	StreamPendingTransactionEventsParamsFormatJson    StreamPendingTransactionEventsParamsFormat = "json"
+	StreamPendingTransactionEventsParamsFormatMsgpack StreamPendingTransactionEventsParamsFormat = "msgpack"
+)
+
 // Defines values for PendingTransactionInformationParamsFormat.
 const (
-	Json    PendingTransactionInformationParamsFormat = "json"
-	Msgpack PendingTransactionInformationParamsFormat = "msgpack"
+	PendingTransactionInformationParamsFormatJson    PendingTransactionInformationParamsFormat = "json"
+	PendingTransactionInformationParamsFormatMsgpack PendingTransactionInformationParamsFormat = "msgpack"
+)
+
+// Defines values for SimulateTransactionParamsFormat.
+const (
+	SimulateTransactionParamsFormatJson    SimulateTransactionParamsFormat = "json"
+	SimulateTransactionParamsFormatMsgpack SimulateTransactionParamsFormat = "msgpack"
 )
 
 // Account Account information at a given round.
@@ -352,6 +379,27 @@ type ApplicationParams struct {
 	LocalStateSchema *ApplicationStateSchema `json:"local-state-schema,omitempty"`
 }
 
+// ApplicationStateOperation An operation against an application's global/local/box state.
+type ApplicationStateOperation struct {
+	// Account For local state changes, the address of the account associated with the local state.
+	Account *string `json:"account,omitempty"`
+
+	// AppId The application whose state was changed.
+	AppId uint64 `json:"app-id"`
+
+	// AppStateType Type of application state. Value `g` is **global state**, `l` is **local state**, `b` is **boxes**.
+	AppStateType string `json:"app-state-type"`
+
+	// Key The key (name) of the global/local/box state.
+	Key []byte `json:"key"`
+
+	// NewValue Represents a TEAL value.
+	NewValue *TealValue `json:"new-value,omitempty"`
+
+	// Operation Operation type. Value `w` is **write**, `d` is **delete**.
+	Operation string `json:"operation"`
+}
+
 // ApplicationStateSchema Specifies maximums on the number of each type that may be stored.
 type ApplicationStateSchema struct {
 	// NumByteSlice \[nbs\] num of byte slices.
@@ -487,6 +535,15 @@ type BoxDescriptor struct {
 	Name []byte `json:"name"`
 }
 
+// BoxReference References a box of an application.
+type BoxReference struct {
+	// App Application ID which this box belongs to
+	App uint64 `json:"app"`
+
+	// Name Base64 encoded box name
+	Name []byte `json:"name"`
+}
+
 // BuildVersion defines model for BuildVersion.
 type BuildVersion struct {
 	Branch      string `json:"branch"`
@@ -625,6 +682,18 @@ type LedgerStateDelta struct {
 	TxLeases *[]TxLease `json:"tx-leases,omitempty"`
 }
 
+// LedgerStateDeltaStreamEntry A block and the ledger state delta it produced.
+type LedgerStateDeltaStreamEntry struct {
+	// Block Block data.
+	Block map[string]interface{} `json:"block"`
+
+	// Delta Contains ledger updates.
+	Delta LedgerStateDelta `json:"delta"`
+
+	// Round The round of the block.
+	Round uint64 `json:"round"`
+}
+
 // LightBlockHeaderProof Proof of membership and position of a light block header.
 type LightBlockHeaderProof struct {
 	// Index The index of the light block header in the vector commitment tree
@@ -661,6 +730,72 @@ type ModifiedAsset struct {
 	Id uint64 `json:"id"`
 }
 
+// NetworkPeer A connected peer and its traffic.
+type NetworkPeer struct {
+	// Address The address of an outgoing peer, or the origin address of an incoming peer.
+	Address string `json:"address"`
+
+	// ConnectedSince The time the connection was established, in seconds since the epoch.
+	ConnectedSince uint64 `json:"connected-since"`
+
+	// Features The negotiated peer features.
+	Features []string `json:"features"`
+
+	// InstanceName The instance name the peer reported.
+	InstanceName *string `json:"instance-name,omitempty"`
+
+	// MessageDelay The average delay of the messages of an outgoing peer relative to the other outgoing peers, in nanoseconds.
+	MessageDelay *uint64 `json:"message-delay,omitempty"`
+
+	// Outgoing Whether this node opened the connection.
+	Outgoing bool `json:"outgoing"`
+
+	// PingRoundTrip The round trip time of the last ping, in nanoseconds, if the node pings its peers.
+	PingRoundTrip *uint64 `json:"ping-round-trip,omitempty"`
+
+	// Tags The traffic with the peer, by message tag.
+	Tags []NetworkPeerTagTraffic `json:"tags"`
+
+	// Version The negotiated protocol version.
+	Version string `json:"version"`
+}
+
+// NetworkPeerTagTraffic The traffic of one message tag with a peer. Rates are measured over the last complete 10 second window.
+type NetworkPeerTagTraffic struct {
+	// HandleTime The time the message handlers spent on the received messages, in nanoseconds.
+	HandleTime uint64 `json:"handle-time"`
+
+	// HandledMessages The number of received messages passed to a message handler.
+	HandledMessages uint64 `json:"handled-messages"`
+
+	// ReceivedByteRate The bytes received from the peer per second.
+	ReceivedByteRate float64 `json:"received-byte-rate"`
+
+	// ReceivedBytes The number of bytes received from the peer.
+	ReceivedBytes uint64 `json:"received-bytes"`
+
+	// ReceivedMessageRate The messages received from the peer per second.
+	ReceivedMessageRate float64 `json:"received-message-rate"`
+
+	// ReceivedMessages The number of messages received from the peer.
+	ReceivedMessages uint64 `json:"received-messages"`
+
+	// SentByteRate The bytes sent to the peer per second.
+	SentByteRate float64 `json:"sent-byte-rate"`
+
+	// SentBytes The number of bytes sent to the peer.
+	SentBytes uint64 `json:"sent-bytes"`
+
+	// SentMessageRate The messages sent to the peer per second.
+	SentMessageRate float64 `json:"sent-message-rate"`
+
+	// SentMessages The number of messages sent to the peer.
+	SentMessages uint64 `json:"sent-messages"`
+
+	// Tag The message tag.
+	Tag string `json:"tag"`
+}
+
 // ParticipationKey Represents a participation key used by the node.
 type ParticipationKey struct {
 	// Address Address the key was generated for.
@@ -688,6 +823,81 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PeerBan A ban of a peer address.
+type PeerBan struct {
+	// Address The IP address of an incoming peer, or the host of an outgoing peer.
+	Address string `json:"address"`
+
+	// Count The number of recent bans of the address, including this one.
+	Count uint64 `json:"count"`
+
+	// Reason The peer fault that triggered the ban, or "manual" for the bans requested through the API.
+	Reason string `json:"reason"`
+
+	// Since The time the ban started, in seconds since the epoch.
+	Since uint64 `json:"since"`
+
+	// Until The time the ban expires, in seconds since the epoch.
+	Until uint64 `json:"until"`
+}
+
+// PendingStateProof The signatures collected by the node for a state proof.
+type PendingStateProof struct {
+	// AcceptableWeight The signed weight accepted at the latest round. It starts at the total weight, and decreases to the proven weight during the interval following the state proof.
+	AcceptableWeight uint64 `json:"acceptable-weight"`
+
+	// LastBroadcast The outcome of the last attempt to build and broadcast the state proof: not-attempted, below-acceptable-weight, below-proven-weight, build-failed, send-failed, blocked-by-earlier-round or sent.
+	LastBroadcast string `json:"last-broadcast"`
+
+	// LastBroadcastError The error that caused the last attempt to fail, if any.
+	LastBroadcastError *string `json:"last-broadcast-error,omitempty"`
+
+	// MissingSigners The heaviest voters whose signatures weren't collected, by decreasing weight.
+	MissingSigners []StateProofVoter `json:"missing-signers"`
+
+	// ProvenWeight The weight the signed weight must exceed to build the state proof.
+	ProvenWeight uint64 `json:"proven-weight"`
+
+	// Round The last round attested by the state proof.
+	Round uint64 `json:"round"`
+
+	// SignedWeight The weight of the collected signatures.
+	SignedWeight uint64 `json:"signed-weight"`
+
+	// Signers The number of voters whose signatures were collected.
+	Signers uint64 `json:"signers"`
+
+	// TotalWeight The total weight of the voters.
+	TotalWeight uint64 `json:"total-weight"`
+
+	// Voters The number of voters.
+	Voters uint64 `json:"voters"`
+
+	// VotersRound The round whose voters sign the state proof.
+	VotersRound uint64 `json:"voters-round"`
+}
+
+// PendingTransactionEvent A change of state of a pending transaction.
+type PendingTransactionEvent struct {
+	// Reason Why the transaction was rejected, evicted or expired.
+	Reason *string `json:"reason,omitempty"`
+
+	// Round The round of the committing block for committed transactions, and the round the transaction pool was evaluating for otherwise.
+	Round uint64 `json:"round"`
+
+	// Txid The transaction ID.
+	Txid string `json:"txid"`
+
+	// Txn The raw signed transaction.
+	Txn map[string]interface{} `json:"txn"`
+
+	// Type What happened to the transaction.
+	Type PendingTransactionEventType `json:"type"`
+}
+
+// PendingTransactionEventType What happened to the transaction.
+type PendingTransactionEventType string
+
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 type PendingTransactionResponse struct {
 	// ApplicationIndex The application index if the transaction was found and it created an application.
@@ -733,6 +943,171 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// ScratchChange A write operation into a scratch slot.
+type ScratchChange struct {
+	// NewValue Represents a TEAL value.
+	NewValue TealValue `json:"new-value"`
+
+	// Slot The scratch slot written.
+	Slot uint64 `json:"slot"`
+}
+
+// SimulateRequest Request type for simulation endpoint.
+type SimulateRequest struct {
+	// AllowMoreLogging Lifts limits on log opcode usage during simulation.
+	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
+
+	// AllowUnnamedResources Allows access to unnamed resources during simulation.
+	AllowUnnamedResources *bool `json:"allow-unnamed-resources,omitempty"`
+
+	// ExecTraceConfig An object that configures simulation execution trace.
+	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
+
+	// ExtraLogicSigBudget Applies extra opcode budget during simulation for each LogicSig.
+	ExtraLogicSigBudget *uint64 `json:"extra-logic-sig-budget,omitempty"`
+
+	// ExtraOpcodeBudget Applies extra opcode budget during simulation for each transaction group.
+	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+
+	// Round If provided, specifies the round preceding the simulation. State changes through this round will be used to run this simulation. Usually only the 4 most recent rounds will be available (controlled by the node config value MaxAcctLookback). If not specified, defaults to the latest available round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// TxnGroups The transaction groups to simulate. They are evaluated in order in a single block, so each group sees the state changes of the groups before it.
+	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
+}
+
+// SimulateRequestTransactionGroup A transaction group to simulate.
+type SimulateRequestTransactionGroup struct {
+	// Txns An atomic transaction group.
+	Txns []json.RawMessage `json:"txns"`
+}
+
+// SimulateTraceConfig An object that configures simulation execution trace.
+type SimulateTraceConfig struct {
+	// Enable A boolean option for opting in execution trace features simulation endpoint.
+	Enable *bool `json:"enable,omitempty"`
+
+	// ScratchChange A boolean option enabling returning scratch slot changes together with execution trace during simulation.
+	ScratchChange *bool `json:"scratch-change,omitempty"`
+
+	// StackChange A boolean option enabling returning stack changes together with execution trace during simulation.
+	StackChange *bool `json:"stack-change,omitempty"`
+
+	// StateChange A boolean option enabling returning application state changes together with execution trace during simulation.
+	StateChange *bool `json:"state-change,omitempty"`
+}
+
+// SimulateTransactionGroupResult Simulation result for an atomic transaction group
+type SimulateTransactionGroupResult struct {
+	// AppBudgetAdded Total budget added during execution of app calls in the transaction group.
+	AppBudgetAdded *uint64 `json:"app-budget-added,omitempty"`
+
+	// AppBudgetConsumed Total budget consumed during execution of app calls in the transaction group.
+	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+
+	// FailedAt If present, indicates which transaction in this group caused the failure. This array represents the path to the failing transaction. Indexes are zero based, the first element indicates the top-level transaction, and successive elements indicate deeper inner transactions.
+	FailedAt *[]uint64 `json:"failed-at,omitempty"`
+
+	// FailureMessage If present, indicates that the transaction group failed and specifies why that happened
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// TxnResults Simulation result for individual transactions
+	TxnResults []SimulateTransactionResult `json:"txn-results"`
+}
+
+// SimulateTransactionResult Simulation result for an individual transaction
+type SimulateTransactionResult struct {
+	// AppBudgetConsumed Budget used during execution of an app call transaction. This value includes budged used by inner app calls spawned by this transaction.
+	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+
+	// ExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
+	ExecTrace *SimulationTransactionExecTrace `json:"exec-trace,omitempty"`
+
+	// LogicSigBudgetConsumed Budget used during execution of a logic sig transaction.
+	LogicSigBudgetConsumed *uint64 `json:"logic-sig-budget-consumed,omitempty"`
+
+	// MissingSignature A boolean indicating whether this transaction is missing signatures
+	MissingSignature *bool `json:"missing-signature,omitempty"`
+
+	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
+	TxnResult PendingTransactionResponse `json:"txn-result"`
+
+	// UnnamedResourcesAccessed These are resources that were accessed by this transaction's app program without being named in the transaction. To make the transaction succeed outside of simulation, they must be added to its foreign arrays or box references.
+	UnnamedResourcesAccessed *SimulateUnnamedResourcesAccessed `json:"unnamed-resources-accessed,omitempty"`
+}
+
+// SimulateUnnamedResourcesAccessed These are resources that were accessed by this transaction's app program without being named in the transaction. To make the transaction succeed outside of simulation, they must be added to its foreign arrays or box references.
+type SimulateUnnamedResourcesAccessed struct {
+	// Accounts The unnamed accounts that were referenced.
+	Accounts *[]string `json:"accounts,omitempty"`
+
+	// Apps The unnamed applications that were referenced.
+	Apps *[]uint64 `json:"apps,omitempty"`
+
+	// Assets The unnamed assets that were referenced.
+	Assets *[]uint64 `json:"assets,omitempty"`
+
+	// Boxes The unnamed boxes that were referenced.
+	Boxes *[]BoxReference `json:"boxes,omitempty"`
+}
+
+// SimulationEvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
+type SimulationEvalOverrides struct {
+	// AllowMoreLogging If true, allows more logging during simulation.
+	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
+
+	// AllowUnnamedResources If true, allows access to unnamed resources during simulation.
+	AllowUnnamedResources *bool `json:"allow-unnamed-resources,omitempty"`
+
+	// ExtraLogicSigBudget The extra opcode budget added to each LogicSig during simulation
+	ExtraLogicSigBudget *uint64 `json:"extra-logic-sig-budget,omitempty"`
+
+	// ExtraOpcodeBudget The extra opcode budget added to each transaction group during simulation
+	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+
+	// MaxLogCalls The maximum log calls one can make during simulation
+	MaxLogCalls *uint64 `json:"max-log-calls,omitempty"`
+
+	// MaxLogSize The maximum byte number to log during simulation
+	MaxLogSize *uint64 `json:"max-log-size,omitempty"`
+}
+
+// SimulationOpcodeTraceUnit The set of trace information and effect from evaluating a single opcode.
+type SimulationOpcodeTraceUnit struct {
+	// Pc The program counter of the current opcode being evaluated.
+	Pc uint64 `json:"pc"`
+
+	// ScratchChanges The writes into scratch slots.
+	ScratchChanges *[]ScratchChange `json:"scratch-changes,omitempty"`
+
+	// SpawnedInners The indexes of the traces for inner transactions spawned by this opcode, if any.
+	SpawnedInners *[]uint64 `json:"spawned-inners,omitempty"`
+
+	// StackAdditions The values pushed to the stack by this opcode.
+	StackAdditions *[]TealValue `json:"stack-additions,omitempty"`
+
+	// StackPopCount The number of deleted stack values by this opcode.
+	StackPopCount *uint64 `json:"stack-pop-count,omitempty"`
+
+	// StateChanges The operations against the current application's states.
+	StateChanges *[]ApplicationStateOperation `json:"state-changes,omitempty"`
+}
+
+// SimulationTransactionExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
+type SimulationTransactionExecTrace struct {
+	// ApprovalProgramTrace Program trace that contains a trace of opcode effects in an approval program.
+	ApprovalProgramTrace *[]SimulationOpcodeTraceUnit `json:"approval-program-trace,omitempty"`
+
+	// ClearStateProgramTrace Program trace that contains a trace of opcode effects in a clear state program.
+	ClearStateProgramTrace *[]SimulationOpcodeTraceUnit `json:"clear-state-program-trace,omitempty"`
+
+	// InnerTrace An array of SimulationTransactionExecTrace representing the execution trace of any inner transactions executed.
+	InnerTrace *[]SimulationTransactionExecTrace `json:"inner-trace,omitempty"`
+
+	// LogicSigTrace Program trace that contains a trace of opcode effects in a logic sig.
+	LogicSigTrace *[]SimulationOpcodeTraceUnit `json:"logic-sig-trace,omitempty"`
+}
+
 // StateDelta Application state delta.
 type StateDelta = []EvalDeltaKeyValue
 
@@ -763,6 +1138,18 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// StateProofVoter A voter of a state proof.
+type StateProofVoter struct {
+	// Address The voter account address.
+	Address string `json:"address"`
+
+	// Signed Whether the node collected the voter signature.
+	Signed bool `json:"signed"`
+
+	// Weight The voter weight.
+	Weight uint64 `json:"weight"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -945,6 +1332,33 @@ type CatchpointAbortResponse struct {
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CatchpointGenerationResponse defines model for CatchpointGenerationResponse.
+type CatchpointGenerationResponse struct {
+	// AccountsRound The round of the accounts snapshot.
+	AccountsRound *uint64 `json:"accounts-round,omitempty"`
+
+	// Error The reason of a failed generation.
+	Error *string `json:"error,omitempty"`
+
+	// Label The catchpoint label, once created.
+	Label *string `json:"label,omitempty"`
+
+	// RequestedAt The time the generation was requested, in seconds since the epoch.
+	RequestedAt uint64 `json:"requested-at"`
+
+	// Round The catchpoint round, which is the accounts round plus the catchpoint lookback.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Stage The generation stage: requested, writing-data, waiting-for-round, done or failed.
+	Stage string `json:"stage"`
+
+	// TotalAccounts The number of accounts written into the catchpoint data file so far.
+	TotalAccounts *uint64 `json:"total-accounts,omitempty"`
+
+	// TotalKvs The number of key-value pairs written into the catchpoint data file so far.
+	TotalKvs *uint64 `json:"total-kvs,omitempty"`
+}
+
 // CatchpointStartResponse An catchpoint start response.
 type CatchpointStartResponse struct {
 	// CatchupMessage Catchup start response string
@@ -987,9 +1401,17 @@ type GetSyncRoundResponse struct {
 // LedgerStateDeltaResponse Contains ledger updates.
 type LedgerStateDeltaResponse = LedgerStateDelta
 
+// LedgerStateDeltaStreamResponse A block and the ledger state delta it produced.
+type LedgerStateDeltaStreamResponse = LedgerStateDeltaStreamEntry
+
 // LightBlockHeaderProofResponse Proof of membership and position of a light block header.
 type LightBlockHeaderProofResponse = LightBlockHeaderProof
 
+// NetworkPeersResponse defines model for NetworkPeersResponse.
+type NetworkPeersResponse struct {
+	Peers []NetworkPeer `json:"peers"`
+}
+
 // NodeStatusResponse NodeStatus contains the information about a node status
 type NodeStatusResponse struct {
 	// Catchpoint The current catchpoint that is being caught up to
@@ -1077,6 +1499,29 @@ type ParticipationKeyResponse = ParticipationKey
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// PeerBanResponse A ban of a peer address.
+type PeerBanResponse = PeerBan
+
+// PeerBansResponse defines model for PeerBansResponse.
+type PeerBansResponse struct {
+	Bans []PeerBan `json:"bans"`
+}
+
+// PendingStateProofVotersResponse defines model for PendingStateProofVotersResponse.
+type PendingStateProofVotersResponse struct {
+	// Round The last round attested by the state proof.
+	Round  uint64            `json:"round"`
+	Voters []StateProofVoter `json:"voters"`
+}
+
+// PendingStateProofsResponse defines model for PendingStateProofsResponse.
+type PendingStateProofsResponse struct {
+	StateProofs []PendingStateProof `json:"state-proofs"`
+}
+
+// PendingTransactionEventStreamResponse A change of state of a pending transaction.
+type PendingTransactionEventStreamResponse = PendingTransactionEvent
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1098,13 +1543,25 @@ type PostTransactionsResponse struct {
 	TxId string `json:"txId"`
 }
 
-// SimulationResponse defines model for SimulationResponse.
-type SimulationResponse struct {
-	// FailureMessage \[fm\] Failure message, if the transaction would have failed during a live broadcast.
-	FailureMessage string `json:"failure-message"`
+// SimulateResponse defines model for SimulateResponse.
+type SimulateResponse struct {
+	// EvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
+	EvalOverrides *SimulationEvalOverrides `json:"eval-overrides,omitempty"`
+
+	// ExecTraceConfig An object that configures simulation execution trace.
+	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
+
+	// LastRound The round immediately preceding this simulation. State changes through this round were used to run this simulation.
+	LastRound uint64 `json:"last-round"`
+
+	// TxnGroups A result object for each transaction group that was simulated.
+	TxnGroups []SimulateTransactionGroupResult `json:"txn-groups"`
 
-	// MissingSignatures \[ms\] Whether any transactions would have failed during a live broadcast because they were missing signatures.
-	MissingSignatures bool `json:"missing-signatures"`
+	// Version The version of this response object.
+	Version uint64 `json:"version"`
+
+	// WouldSucceed Indicates whether the simulated transactions would have succeeded during an actual submission. If any transaction fails or is missing a signature, this will be false.
+	WouldSucceed bool `json:"would-succeed"`
 }
 
 // StateProofResponse Represents a state proof and its corresponding message
@@ -1184,6 +1641,9 @@ type AccountInformationParams struct {
 
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Round Round at which to look up the account information. Defaults to the latest round. Rounds older than the ledger lookback are only available on archival nodes with EnableAccountHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountInformationParamsFormat defines parameters for AccountInformation.
@@ -1222,10 +1682,19 @@ type GetPendingTransactionsByAddressParams struct {
 // GetPendingTransactionsByAddressParamsFormat defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParamsFormat string
 
+// GetApplicationByIDParams defines parameters for GetApplicationByID.
+type GetApplicationByIDParams struct {
+	// Round Round at which to look up the application information. Defaults to the latest round. Rounds older than the ledger lookback are only available on archival nodes with EnableAccountHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
 // GetApplicationBoxByNameParams defines parameters for GetApplicationBoxByName.
 type GetApplicationBoxByNameParams struct {
 	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
 	Name string `form:"name" json:"name"`
+
+	// Round Round at which to look up the box value. Defaults to the latest round. Rounds older than the ledger lookback are only available on archival nodes with EnableAccountHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // GetApplicationBoxesParams defines parameters for GetApplicationBoxes.
@@ -1260,11 +1729,50 @@ type GetTransactionProofParamsHashtype string
 // GetTransactionProofParamsFormat defines parameters for GetTransactionProof.
 type GetTransactionProofParamsFormat string
 
+// StartCatchupParams defines parameters for StartCatchup.
+type StartCatchupParams struct {
+	// CatchpointFile Path of a local catchpoint file, either tar or tar.gz, matching the catchpoint. When provided, the catchpoint file is loaded from it instead of being downloaded.
+	CatchpointFile *string `form:"catchpoint-file,omitempty" json:"catchpoint-file,omitempty"`
+
+	// CatchpointDeltas Paths of local incremental catchpoint files, applied in order on top of the catchpoint file. The first one applies to the catchpoint file, each of the others to the one before it, and the last one has to match the catchpoint.
+	CatchpointDeltas *[]string `form:"catchpoint-deltas,omitempty" json:"catchpoint-deltas,omitempty"`
+
+	// BlocksDir Path of a local directory of msgpack encoded blocks, one file per round named after the round number. Blocks missing from the directory are downloaded.
+	BlocksDir *string `form:"blocks-dir,omitempty" json:"blocks-dir,omitempty"`
+}
+
+// StreamLedgerStateDeltasParams defines parameters for StreamLedgerStateDeltas.
+type StreamLedgerStateDeltasParams struct {
+	// Round The first round to stream. Defaults to the round after the latest round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// Sync If true, sets the ledger sync round to the round after each delivered entry.
+	Sync *bool `form:"sync,omitempty" json:"sync,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded.
+	Format *StreamLedgerStateDeltasParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamLedgerStateDeltasParamsFormat defines parameters for StreamLedgerStateDeltas.
+type StreamLedgerStateDeltasParamsFormat string
+
+// BanPeerParams defines parameters for BanPeer.
+type BanPeerParams struct {
+	// Duration The ban duration in seconds. Defaults to the PeerBanDurationSeconds of the node configuration, doubled for each recent ban of the address.
+	Duration *uint64 `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
+// GetPendingStateProofsParams defines parameters for GetPendingStateProofs.
+type GetPendingStateProofsParams struct {
+	// MaxMissingSigners Maximum number of missing signers to return per state proof.
+	MaxMissingSigners *uint64 `form:"max-missing-signers,omitempty" json:"max-missing-signers,omitempty"`
+}
+
 // TealCompileTextBody defines parameters for TealCompile.
 type TealCompileTextBody = openapi_types.File
 
@@ -1286,6 +1794,21 @@ type GetPendingTransactionsParams struct {
 // GetPendingTransactionsParamsFormat defines parameters for GetPendingTransactions.
 type GetPendingTransactionsParamsFormat string
 
+// StreamPendingTransactionEventsParams defines parameters for StreamPendingTransactionEvents.
+type StreamPendingTransactionEventsParams struct {
+	// Sender Only include the transactions sent by this account.
+	Sender *string `form:"sender,omitempty" json:"sender,omitempty"`
+
+	// ApplicationId Only include the transactions calling this application.
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded.
+	Format *StreamPendingTransactionEventsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamPendingTransactionEventsParamsFormat defines parameters for StreamPendingTransactionEvents.
+type StreamPendingTransactionEventsParamsFormat string
+
 // PendingTransactionInformationParams defines parameters for PendingTransactionInformation.
 type PendingTransactionInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded.
@@ -1295,8 +1818,20 @@ type PendingTransactionInformationParams struct {
 // PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
 type PendingTransactionInformationParamsFormat string
 
+// SimulateTransactionParams defines parameters for SimulateTransaction.
+type SimulateTransactionParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded.
+	Format *SimulateTransactionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
+type SimulateTransactionParamsFormat string
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
 // TealDryrunJSONRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody = DryrunRequest
+
+// SimulateTransactionJSONRequestBody defines body for SimulateTransaction for application/json ContentType.
+type SimulateTransactionJSONRequestBody = SimulateRequest
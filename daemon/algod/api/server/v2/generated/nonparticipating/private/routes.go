@@ -21,15 +21,39 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Returns the status of the catchpoint generation.
+	// (GET /v2/catchpoint)
+	GetCatchpointGeneration(ctx echo.Context) error
+	// Starts a catchpoint generation.
+	// (POST /v2/catchpoint)
+	StartCatchpointGeneration(ctx echo.Context) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
-	StartCatchup(ctx echo.Context, catchpoint string) error
+	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
+	// Returns the connected peers and their traffic.
+	// (GET /v2/debug/network/peers)
+	GetNetworkPeers(ctx echo.Context) error
+	// Returns the banned peer addresses.
+	// (GET /v2/peers/bans)
+	GetPeerBans(ctx echo.Context) error
+	// Lifts the ban of a peer address.
+	// (DELETE /v2/peers/bans/{address})
+	UnbanPeer(ctx echo.Context, address string) error
+	// Bans a peer address.
+	// (POST /v2/peers/bans/{address})
+	BanPeer(ctx echo.Context, address string, params BanPeerParams) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
+	// Returns the state proofs the node collects signatures for.
+	// (GET /v2/stateproofs/pending)
+	GetPendingStateProofs(ctx echo.Context, params GetPendingStateProofsParams) error
+	// Returns the voters of a state proof the node collects signatures for.
+	// (GET /v2/stateproofs/pending/{round})
+	GetPendingStateProofVoters(ctx echo.Context, round uint64) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -37,6 +61,28 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetCatchpointGeneration converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatchpointGeneration(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCatchpointGeneration(ctx)
+	return err
+}
+
+// StartCatchpointGeneration converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCatchpointGeneration(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartCatchpointGeneration(ctx)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 	var err error
@@ -68,8 +114,98 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartCatchupParams
+	// ------------- Optional query parameter "catchpoint-file" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "catchpoint-file", ctx.QueryParams(), &params.CatchpointFile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter catchpoint-file: %s", err))
+	}
+
+	// ------------- Optional query parameter "catchpoint-deltas" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "catchpoint-deltas", ctx.QueryParams(), &params.CatchpointDeltas)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter catchpoint-deltas: %s", err))
+	}
+
+	// ------------- Optional query parameter "blocks-dir" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blocks-dir", ctx.QueryParams(), &params.BlocksDir)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocks-dir: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartCatchup(ctx, catchpoint, params)
+	return err
+}
+
+// GetNetworkPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNetworkPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNetworkPeers(ctx)
+	return err
+}
+
+// GetPeerBans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerBans(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerBans(ctx)
+	return err
+}
+
+// UnbanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnbanPeer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnbanPeer(ctx, address)
+	return err
+}
+
+// BanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) BanPeer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BanPeerParams
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.StartCatchup(ctx, catchpoint)
+	err = w.Handler.BanPeer(ctx, address, params)
 	return err
 }
 
@@ -93,6 +229,44 @@ func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	return err
 }
 
+// GetPendingStateProofs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPendingStateProofs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPendingStateProofsParams
+	// ------------- Optional query parameter "max-missing-signers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-missing-signers", ctx.QueryParams(), &params.MaxMissingSigners)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-missing-signers: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPendingStateProofs(ctx, params)
+	return err
+}
+
+// GetPendingStateProofVoters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPendingStateProofVoters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPendingStateProofVoters(ctx, round)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -121,186 +295,279 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/catchpoint", wrapper.GetCatchpointGeneration, m...)
+	router.POST(baseURL+"/v2/catchpoint", wrapper.StartCatchpointGeneration, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET(baseURL+"/v2/debug/network/peers", wrapper.GetNetworkPeers, m...)
+	router.GET(baseURL+"/v2/peers/bans", wrapper.GetPeerBans, m...)
+	router.DELETE(baseURL+"/v2/peers/bans/:address", wrapper.UnbanPeer, m...)
+	router.POST(baseURL+"/v2/peers/bans/:address", wrapper.BanPeer, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
+	router.GET(baseURL+"/v2/stateproofs/pending", wrapper.GetPendingStateProofs, m...)
+	router.GET(baseURL+"/v2/stateproofs/pending/:round", wrapper.GetPendingStateProofVoters, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4KafVX+uKEkf2XXqkq9U6wkq4vtuCwle+9ZvgRD9sxgxQEYApRm4tP/",
-	"foUGQIIkwOFIE3tztT/ZGgKNRqPRaPQXPk1SsSoEB67k5PjTpKAlXYGCEv+iaSoqrhKW6b8ykGnJCsUE",
-	"nxy7b0SqkvHFZDph+teCquVkOuF0BU0b3X86KeG3ipWQTY5VWcF0ItMlrKgGrDaFbl1DWicLkVgQJwbE",
-	"2enkduADzbISpOxj+SPPN4TxNK8yIKqkXNJUf5LkhqklUUsmie1MGCeCAxFzopatxmTOIM/kgZvkbxWU",
-	"G2+WdvD4lG4bFJNS5NDH85VYzRgHhxXUSNULQpQgGcyx0ZIqokfQuLqGShAJtEyXZC7KLagaJHx8gVer",
-	"yfGHiQSeQYmrlQK7xv/OS4DfIVG0XICafJyGJjdXUCaKrQJTO7PUL0FWuZIE2+IcF+waONG9DsibSioy",
-	"A0I5ef/dK/Ls2bOXeiIrqhRklsmis2pG9+dkuk+OJxlV4D73eY3mC1FSniV1+/ffvcLxz+0Ex7aiUkJ4",
-	"s5zoL+TsNDYB1zHAQowrWOA6tLhf9whsiubnGcxFCSPXxDTe66L443/RVUmpSpeFYFwF1oXgV2I+B2WY",
-	"131IhtUItNoXmlKlBvrhKHn58dOT6ZOj2798OEn+2/754tntyOm/quFuoUCwYVqVJfB0kyxKoLhblpT3",
-	"6fHe8oNciirPyJJe4+LTFYp625fovkZ0XtO80nzC0lKc5AshCbVslMGcVrkibmBS8VyLKQ3NcjthkhSl",
-	"uGYZZFMtfW+WLF2SlEoDAtuRG5bnmgcrCVmM18KzG9hMtz5JNF53ogdO6F+XGM28tlAC1igNkjQXEhIl",
-	"thxP7sShPCP+gdKcVXK3w4pcLIHg4PqDOWyRdlzzdJ5viMJ1zQiVhBJ3NE0Jm5ONqMgNLk7OrrC/nY2m",
-	"2opoouHitM5RvXlj5OsRI0C8mRA5UI7Ec/uuTzI+Z4uqBElulqCW9swrQRaCSyBi9k9IlV72/3X+41si",
-	"SvIGpKQLeEfTKwI8FVl8je2goRP8n1LoBV/JRUHTq/BxnbMVC6D8hq7ZqloRXq1mUOr1cueDEqQEVZU8",
-	"hpCBuIXPVnTdH/SirHiKi9sM21LUNCsxWeR0c0DO5mRF118fTS06ktA8JwXwjPEFUWseVdL02NvRS0pR",
-	"8WyEDqP0gnmnpiwgZXMGGamhDGBih9mGD+O74dNoVh46DkgUnXqULehwWAd4Rm9d/YUUdAEeyxyQn6zk",
-	"wq9KXAGvBRyZbfBTUcI1E5WsO0VwxKGH1WsuFCRFCXMW4LFzSw4tPUwbK15XVsFJBVeUcci05EWkhQIj",
-	"iaI4eQMOX2b6R/SMSvjqeewAb76OXP256K764IqPWm1slJgtGTgX9Ve7YcNqU6v/iMufP7Zki8T83FtI",
-	"trjQR8mc5XjM/FOvnyNDJVEItAjhDh7JFpyqqoTjS/5Y/0UScq4oz2iZ6V9W5qc3Va7YOVvon3Lz02ux",
-	"YOk5W0SIWeMavE1ht5X5R8MLi2O1Dl4aXgtxVRX+hNLWrXS2IWensUU2MHdlzJP6KuvfKi7W7qaxaw+1",
-	"rhcygmSUdgXVDa9gU4LGlqZz/Gc9R36i8/J3/U9R5Lq3KuYh0mo+tuct2gaszeCkKHKWUk3E9/az/qqF",
-	"AJhbAm1aHOKBevzJQ7EoRQGlYgYoLYokFynNE6moQkj/UcJ8cjz5y2FjXDk03eWhN/hr3escO2l91Og4",
-	"CS2KHWC803qNHBAWWkDjJxQTRuyhRsS4WUTNSkyL4ByuKVcHzX2kJQ/qDfzBjtTQ26gyht6d+1WU4MQ0",
-	"nIE06q1p+EASj/QEyUqQrKhtLnIxq394eFIUDQXx+0lRGHqgaggMtS5YM6nkI5w+bXaSP87Z6QH53oeN",
-	"erbg+UYfDkbV0GfD3J5a9hSrDUd2Dg3EB5LgcoryQC+NI4PW4ffBcXhnWIpcaz1beUU3/rtt67OZ/n1U",
-	"5z8Hi/m0jTMX3qIs5cwFBn/xbi4PO5zTZxxryzkgJ92+d2MbDSXMMHfilcH1NHAH6FiT8KakhUHQfjFn",
-	"KeN4AzONDK73lKYjBV0QZ28Pe7yGWN15r23dD0FMkBU6OHyTi/Tq71Qu97DnZw5Wf/vhMGQJNIOSLKlc",
-	"HkxCWoa/vRpoY7aYboi3dzLzhjqop7iv6W2ZWkYV9aZm8Q2rJYb02A+FHpSBu8uP+B+aE/1Z720t+g3Y",
-	"A3KBAkya7Ww9CJm+ypsLghlJN0ATgyArc3sn+ta9E5avmsHD6zRqjb41BgO7QnYSuEJivfdt8I1Yh3D4",
-	"Rqx7W0CsQe6DPzQcVCMVrOQI/E4tZgLX35KPliXd9ImMsMcQWU9Qq64SdwP3T3w9SmN5PZmJ8m7SpyNW",
-	"OGnsyYRqqJ7wnXaIhE2rIrGsGLBJmQYdQI0Lb1hodMGHKNaiwrmifwAVpIa6Dyq0Ae2bCmJVsBz2wPrL",
-	"oNCfUQnPnpLzv5+8ePL0l6cvvtIsWZRiUdIVmW0USPLQ3s2IVJscHvVnhrejKldh6F89d1bINtwQHCmq",
-	"MoUVLfqgjHXTqECmGdHt+lRrkxlnXSM4ZnNegJbkhuzEGO41aqdMag1rNdvLYsQIljWjZMRiksFWZtp1",
-	"es0wG3+K5aas9nGVhbIUZcC+hltMiVTkyTWUkomAq+SdbUFsC6feFt3fDbbkhkqix0bTb8VRoQhwllrz",
-	"8XLfgL5Y84Y2g5LfzDcwOzvumHVpE99ZEiUpoEzUmpMMZtWidROal2JFKMmwI57R34M63/AUrWr7YNL4",
-	"NW3FOJr45Yan3p1NL1QO2aK1CPe/m3Wp4uxzZqgHMoCOJsdr/IzX+lPIFd27/tIdIIT7K7eQBlmS6YZ4",
-	"C37NFkvlKZjvSiHm+8cxNEoIUfxg1PNc9+kr6W9FBnqyldzDYdwAa3hdr6nP4XQmKkUo4SIDtKhUMnxM",
-	"R9zy6A9EN6byT361NBr3DDQjpbTSs60Kgk66nuRoOiY0NdybIGlkxItRu59MKzOccfnmJdBM3+qBEzGz",
-	"rgLrxMBJUvQwKnfQWSUhsJdaeBWlSEFKyBJrotiKmmtnhIgaoBMijgjXoxApyJyW90b26nornlewSdAf",
-	"LsnDH36Wj74Avkoomm8hLLYJkbe+8Fl/UB/rccMPMVx3cJ/taAnEyVx9u9QCIgcFMRLuRJPo+nUx6q3i",
-	"/clyDSV6Zv5QjneD3I+BalT/YH6/L7ZVEYnyshedC7ZCux2nXEhIBc9kEFhOpUq2iWXdqHUb0zPwJGFI",
-	"EiPgiFLymkplvImMZ2gEMccJjmMUFD1EHOGoQqoh/+x00T7sVJ+DXFayVkxlVRSiVJCF5sBhPTDWW1jX",
-	"Y4m5B7vWfpUglYRtkGNU8uBbYpmZGAJRVRvdrbu9Pzk0TetzfhMkZQuJhhBDiJy7Vh51/UiXCCJMNoQ2",
-	"jMNkh3Pq8JrpRCpRFFpaqKTidb8Ymc5N6xP1U9O2z1xUNed2JkCPrhxOFvMbQ1kT47Sk+gqNkMmKXmnd",
-	"Ay/Exu3Zx1lvxkQynkIyxPl6W57rVv4W2LpJq2JR0gySDHK66QP9yXwm5vMQAFzx5uIjFCQmniW86A0n",
-	"u/CBAdAC4cmQ8kjwC0n1FtQ3j4ZBbO8tkDNA2CHhZPnoQQ0KxwoukYOH0zZLHYCIp+G1UHrFDTsgxlag",
-	"j8E3QoYa8t0pgZ2T5lrWHeK/QNoBajVi90E2IGNTaODvNIGIMc2GAXvbpSPdOwI4KDWjUmyLGInt2Ihl",
-	"7x0tFUtZgVedH2Cz95tfd4Cgv4lkoCjLISPeB3MLLPz+xARidGHe7SY4ygjTR79nhQlMJ2cSNZ428lew",
-	"wSv3OxPhd+HFBe7hKhuAqo8nygki6uKGtAbuN4E1TVW+0XqaWsKG3EAJRFazFVPKhGy2b7pKFIkPIGjg",
-	"HhjRenNMdJxbgTHupXME5U2vvxTTibkSDON30bkXtMhhrwKFEPkI41GPGEEMRjn+SSH0qjMbIezCSB0n",
-	"tZC0QhtdefXp/0C2yIwzIP8lKpJSjjeuSkGt0ogS9QTUH/UIWgOrx7Qu/oZCkMMKzEUSvzx+3J3448d2",
-	"zZkkc7hxYfW6YZccjx+jGeedkKq1ufZgKtTb7SxwfKDlH889G7zQkSnbXcwW8piVfNcBXrsL9J6S0jKu",
-	"nv69BUBnZ67HzN3nkXHudYQ7yqjvgQ7NG9f9nK2qfF8LPqcsr0qIe8cuLz/MV5eXH8l3pqVzbE8dk/vk",
-	"uGnSIub2NKpKDK0hOdP321LQTCsIQds+TpIvkjo4UwbRWUmNzj/sPqR800nkG4sDmUFKKxOVbKW2xaAJ",
-	"D5UHAX2xs7pdEgYnMtI8XuXKHNo+VRelqAoi62U3XKCogj/G1NyADmHZH9iLDWo+xsKD9DUx3+zhtDaA",
-	"SAlFCRJlq29ekearmPv5N1b4yo1UsOpboE3XXyL3s/fRe47gOeOQrASHTTDllHF4gx9DvY18j3TGkzbW",
-	"t6s8t/DvoNUeZww33pe+uNqeQHtXx8XtYfG7cDvOBz/zCI1rkBeEkjRnaHoTXKqyStUlp3i59zZbIH7A",
-	"XWPi5p5XrknYvhQw/1hQl5xi7Eh95Q/KxTkE5PJ3AM7qI6vFAqTqaIlzgEtuWzFOKs4UjrXS65WYBSug",
-	"RCf+gWm5ohsypzlap36HUpBZpdrCFRMkpGJ5bj0hehgi5pecKpKDlqpvGL9YIzjnSXQ8w0HdiPKqpsJB",
-	"cD8sgINkMgnHOXxvvmIImp3+0oajYbaq+Wxs5xp+k0Wxwbt/k4H5fx7+5/GHk+S/afL7UfLyfxx+/PT8",
-	"9tHj3o9Pb7/++v+2f3p2+/Wj//yP0Eo53EPh+xbzs1N7pzg7RcWxMZ73cP9shtMV40mQyXwXcYe3yEOt",
-	"/joGetQ2K6glXHK15pqRrmnOMqruxg5dEdfbi2Z3dLimtRAdM4Kb647q2D2kDAkImY5ovPMx3g8NCifK",
-	"oDfH5r7gfplX3CxlJa1HCePAXYiGmE/rZChTBOGYYKbMkrr4Ivvn0xdfTaZNhkv9fTKd2K8fA5zMsnUo",
-	"jymDdUjLthsEN8YDSQq6kaDC0gNxD0ajGKe4D3YF+noml6z4/JJCKjYLSzgXXWtv62t+xk3Yq94/6Bva",
-	"WJOzmH9+vFUJkEGhlqHk6JamgK2a1QTo+OuLUlwDnxJ2AAfd23K2AOniYnKgc0zSRf+GGJMtUO8Dw2iO",
-	"Kzyq+xMZdSUN8Q8qt1Za304n9vCXe9fHLeAQXt0xa0eQ+1sJ8uD7by/IoRWY8oFJqTOgvSSogBXKxvm3",
-	"Ijm0NDMlIUxO4SW/5KcwZ5zp78eXPKOKHs6oZKk8rCSU39Cc8hQOFoIcu9SBU6roJe9pWtGqLV7SBimq",
-	"Wc5ScuVrxA17mkz84LWR5guhL45dp3Zff7VDBeWLGSC5YWopKpXYVOOkhBtahpwGsk41RcimUMDQqFNi",
-	"YRtRbFOZLfywzKNFIbspZ/3pF0Wup++xobQJVXrJiFSidLqIVlAMNri+b4U9GEp64/LUKwmS/LqixQfG",
-	"1UeSXFZHR8+AtHKwfrVHvubJTQEte+WdUuK6tkqcuLnXwFqVNCnoImI0UEALXH3Ul1d4yc5zgt1auV8u",
-	"thVBNRNw9IgvgMFj5zwWnNy56eVqxoSngJ9wCbGNVjcaj+ld18vLBrvzcnUyynqrVKllovd2cFZSs7hb",
-	"mbqUxEIrWc6NLdkCQwVt1Y0ZkHQJ6RVkWAAAVoXaTFvdXaSEVTSd6GDSFMowuRyYzY2m3RmQqsioVcU7",
-	"BiVNYQlKuVjF93AFmwvRJIPvkkfbTuuUsY2KnOppl5pZ/W1rYXQX34bjoK2rKFx2JKbJOLY4rvnC9Ylv",
-	"ZKPy7mETh5iilXYYIwQtA4QwzB8hwR0mquHdi/VD09O3jJk5+QJ1NZzsJ7ZJc3mykTP+bDCb0nxfAVbd",
-	"ETeSzKjW24UtGGNSFz0pVkm6gIiG7FvXRyYItizyCGTbuRc86cS8e6D1zpsgyqZxoucc5BTQXzSr4GWm",
-	"Ey/lRjIOHGNAJVgHzhJslqOaVAeWGaFDy5aXwxS2iqEWZmAoeaNwODTaFPE1myWVrpYNlvxxe3mUDvAH",
-	"puIOFWA480J9vLo+teHbydzuPu3dLm0ZBld7wRVc8K+WI4onaA0fo4tDyyE4KkAZ5LAwEzeNHaM0acHN",
-	"Amk8fpzPc8aBJKGoISqlSJkpRtQcM3YM0PrxY0KMCZiMhhBiYw9tdEwiYPJW+HuTL3ZBktu0Zupgo0vT",
-	"+xvCGRgmjlarPKLQIpzxSMS2kwDUhprV51cn4BHBEManRIu5a5prMWdvfA2QXh0AVFs7Wf/WNf4ops4O",
-	"WODNwbLTnMxRdJfZ+DqTQzqs0A1gPBPrxKRgBTXe2Xqm+T0YWowJYaGNaSouPJBkJtYYboFHiwll3YJL",
-	"HA+HhnfDXzOJ/Ir9Yqe5QWZo2GFtKsSFElnGmvNqdompE2OGjmgwMXZ56BVRuBMCHWNHU27UXn63XlLb",
-	"6kn/MG9OtWlTHMhlbYS2f2wLBVcpQr++FaYue2BNCO8hFWUWt1NoRmWqrt/aNy/Y6rNabowujDBQS/ak",
-	"fdtwV4j+ykWiAlr4NOMMEOLU5Bz1MPl2XQit3ZqcJFOgwhLF6IklmFRLaWxWkvFFDnXkZpBMoQm7mCRH",
-	"cTPlpuCUAzhOdw4tbuSSP4RLUYTx2OWm8t7SZwCLyC5v8EA9/J6Y2CIVg7jcxvnjXVe1D26UdnhNuzSK",
-	"d9cKnQ6affrezL7PVEIOeHtOWreN5Crk4768/CABVbNz182z8mEBFso3j7yYrRIWTCpovE1ag3WU/tx2",
-	"fIp134SYx2eninKu5/deiFqfM4WFsGNrmp99BhjzPGelVAm66oJT0I2+k2h9+k43DV8q2lFhpgQqy8KH",
-	"KA57BZskY3kV5lc77g+neti3te4gqxkqJowToOmSzLBkbzBWdGBoE048OOHXZsKv6d7mO2436KZ64FKz",
-	"S3uMP8m+6Jx0Q+IgwIAh5uivWpSkAweol+Lbl47eBcNsTjxOD4bcFL3NlDnYW+OrXKJxTJkzkAbmgqFB",
-	"0eDcQECOiSMzQr2p1h9MxuVCJS3jR4BctYFHKnplEsraC8wXtU0lHDZl7tWjQNu2WwDy8fD4dnBWCU5y",
-	"uIZ8exA0RYo7Aw5GRhgIGHpDMJ3AxXhs1+r7K9AQrJ5pF8cgt/S0myHHbXM1svXzmrs1Mqymnc18H+29",
-	"0xqa47eGv/uuu6JIMsghmGf2Dy+RjBYFVotwjUMJPRoY4xmsw+iYT9NQTf2+8b5iXJn6q/sq7diBM37a",
-	"fgHEMSQoTKm+3ctHxu+Y3ir5ZI5PKsKUtXNgUBAj8Ppm571G0uW+yDFOi4Jl647f00CNWsf3QjE8oCyw",
-	"LRTweCOUwViCbBe+bIx5pvx6q+7UwSjKXLTLU/o6jT8Uk+7xkD6h6gznbbS6AJr/AJufdVuczuR2Ormf",
-	"mzREawtxC63f1csbpDOG4Rm3WSvqYUeS06IoxTXNE+tMjrFmKa4ta2Jz53v+zNpaWOpdfHvy+p1F/3Y6",
-	"SXOgZVLfdqKzwnbFn2ZWpsZmZIO4xwmWVNX2OXMb9ha/LgzoO6BvlmALwXsX6l7F2ia4wNuK1iE9D0cD",
-	"b3Uv2zgIM8WBeAgo6nCIxlVnoiHaERD0mrLc+cgctpHIXZzcuLMxKBV8APeOpPDPor2Km97uDu+Ohru2",
-	"yCR/rIFS9SvzGoMkgnfD5fQtGF1vyKorivVmjQekL5x4tUKvQSJzlob9qXyGKTbcxMnoxgQbR+7TGmLF",
-	"ImFXvGIeLN1MjjBqd5D0xggS09UujtFuJuwzWhVnv1VAWAZc6U8l7srORkX7qfWs94/TsFZpARtvfAP+",
-	"PjqGX2u5e+JZnWtIwfCjcnrontZWPzfR2vukf/DCD3YI7vNH7B2JA4F5lj8sN5tEhWU7uma0hr71yS1n",
-	"f7NFnyNjBJ/QYjKZl+J3CJuq0MIXyA511aUZRrT+DnxESlnjyWleAmtGjy53TLvxPU7tgMQI1+PKeyE4",
-	"WObWeaMpN0ttXrRpxbWHGcbPIDk08BuGsTj3sm5yejOjoRrAWsnQOHnul5bfXAniOjvaWx8NswW/D4gX",
-	"N1a3ZabwRwFlk7jdLyJ2R4XBDDtaVWg0A+RaXyeYmlifXIoAmIrfUG4eRkJvBG4l21tf8J1B6EaUWLZH",
-	"hl38GaRsFTQuXV5+yNK+OzdjC2aeBaokeO/OWEDmPTXDRfbtHhNO15DmbE6Opt7LVnY1MnbNJJvlgC2e",
-	"mBYzKsEYVVzkhuuipwdcLSU2fzqi+bLiWQmZWkpDWClIrdTh9aYOVJmBugHg5AjbPXlJHmKIjmTX8EhT",
-	"0Z7Pk+MnL9HBav44Ch0A9v2vIWmSzf0k1zAfY4ySgaEFt4V6ELQGmEcb44JrYDeZrmP2Era0sm77XlpR",
-	"ThcQjgpdbcHJ9MXVRF9Ahy48My+OSVWKDWGRdGNQVMunSKaZFn8GDZKK1YqplQ3kkGKl+al5VMYM6sCZ",
-	"58tsPXCHl/uI8VCFCwfpXCI/r9/HnG+hWWPU2lu6gjZZp4SaWk05ayIV3SsF5MyVgsMC6XVddEMbPZae",
-	"Oqo5GLg4J0XJuMKLRaXmyd9IuqQlTbX4O4ihm8y+eh4oCt8uTsx3Q/yz070ECeV1mPRlhO2dDmH7kodc",
-	"8GSlJUr2qMns9HZlNHArHKITixMaBj1WKdNQkii7VS12o56kvhfj8QGA92TFej478ePOM/vsnFmVYfag",
-	"lV6hn96/tlrGSpSh+q7NdrcaRwmqZHCNcfrhRdIw77kWZT5qFe6D/Zd1njqV01PL3F6OXgR28fh4dwP0",
-	"+fiRiXfx9rQ9PS2dK+j2wRvOOA+IefN0m9/jPq8htTrvgpWT0OOwixgRWgmwHYrtdgO+v4nBc/m0VihG",
-	"o/bUQpz5jQhM2T2hUft4bMZkwG4VO0D0By2gZhbUlLSfK/j8ETXOLdKP7NBfHK74RxfZLyxskMhuBpFF",
-	"9J5SCS5nVn/3gsso+Uasxy5qR3a7hf0XIE2QJBXLs5+b2iCdl2pKytNlMFhkpjv+0rypWU/ObOZggd8l",
-	"5dxEI/RtE3hL+cXdZgL3rX+KseOsGB/Ztvt4jpluZ3IN4m00HVJuQE1epnI9gE/VdtmFOq0vX4iM4DhN",
-	"NdnmXO8/uuQ9jfFbBVKFzkX8YFIL0KI+11xsXqgAnqEd44B8b97EXwJp1QpE+4Gp0gSZeyfAuHqqIhc0",
-	"mxIN5+Lbk9fEjGr6mJfhzMsQC3PstmYRj8/dJdB2KLZ2Hxl9etZSYelOqeiqCJUo0S0uXAOsg+J7l/Bi",
-	"7VPngJwam4Z0N2YziOaHOStXkJF6OKtVI0/o/yhF0yUaC1oiNc7y4580cVwpvWeE6+cA6+rRuO803vZV",
-	"E/OoyZQIrTncMGmeQodraFdFqUsEWTXAVUlpT6+sODecEtSKh0pY3YXsDjkTBekcUEHMOoTfUXuxYeo7",
-	"vvByjr2C1Sy7z8X03g82NTbqZ97euBegKRecpVhLMnQ022fVx3hnR5TdDGcG2HgbOQlsruAjNXWyhqVi",
-	"9NkaJwgt4fruIe+rXlTDHeZPhe93L6kiC1DSSjbIpu6tJWuhZlyCrQaOL+x7clKULY83SshgEEWjJ+/I",
-	"RpicHTE5fKe/vbUGKcxavGIcr54uR8IkSBobMr76rPR9lSmyEJhBYTeFP6cPus8BFmvJYP3xwL0SjTCM",
-	"w1hP20RH9EGduFgJG5ug277SbU1BvebnVh6cGfSkKOyg8Ze4gvqAWvMogQM+7zrQyyNuDd+HNsBug0FO",
-	"eJ5qRoNrDJGAgtjUmMirVJ0kGK20Go7CFsTERwfraAXDRF8zDs0b5oEDIg0eCbgwuF8j/WRaUmVUwFEy",
-	"7QJojnERIYEmlXWK3RdUZ4FtPGmRTtwY8WVsHtSKCI66QaO4Ub6pn07X3O0pE69oXgcJBZ7HQq3KKlE2",
-	"uab9YFZIcGjB7Qpytg+A/jbo60Smuyqp2Tm7nESxUiWzKluASmiWhewJ3+BXgl9duVJYQ1rVVbyLgqRY",
-	"ma9dqrDPbXagVHBZrQbGcg3uOZz3Al2AG/xX8NwKY+D1bIP/hkpYx1fGhgftHGPvYoGyOn1uF725Damn",
-	"9WqeTiRbJOMpgWfK/cnRDH03Rm/675XTc7FoI/KZC5QNSTl/jULy7Vt9cPj1u3p12c3RUpfXwnBQ4d4N",
-	"xmtjXRimLZVc1mlvTK/y8rABIv7C6BQPv0hei2frpeZ8NX7tWHZLGk3GosrWT1CUDIqgaE66iSsz2eeI",
-	"RdimH4slM6Fk+nOv9zjNsKdnI+xBgrogxT5CP7gIaFJQZoM2GmHRp6xN94qbC4c2XbPA3UnYJKqoxe6H",
-	"61jCk8sDNpkdnTcZr8AWVSpKuGaicuEQLl7OXQnNr/ZNfC+vODr/ftwMDvVlzaBRo+2Fff/HTNPeyX/4",
-	"2URXEuCq3PwLmHB7i9570TJUs7j1nqVVroL2JjX2rDytH8W8uk5WIhtKmP7hZ3LqfEujzh3HyKFySyKz",
-	"r8gFk8Vf2ycgXDOtfY4e9o3tdFIUw0NHMsT7g5uGuw4fKzWl9+eQ1e2d27/mHVDfhBC4q3jpzBzWKvL4",
-	"Uzcb9gYIrAvAWrdeYnO8esZYhrJJjnhbTXKgEgYo7Fdts21HEvli/Vq3H5dsH36JNV5ytikzi8KzEJI1",
-	"j/OEnmgdGXJ8ga+seh7DPiwX73cNqcIXmZo4phJglwK6ejDv+e9/l56NGErqyGzH/wNlZqcTX7YEExXt",
-	"9qJNiRz0qqHLNVCq3rQJCHvbmelNUsHUgdA/zGkuw6+iRYNdO5VPvICVQKHn8MTOshHVvu10pl4MBMuG",
-	"CRnOBDDB3/9/EtPEte+XnL03u4ZvFb3CC17xEPO00sEOASR1FDVqhrheC+D2Zfh5iDTbs6Lmc0gVu95S",
-	"6OIfS+BeEYWpswQjLnOv7gWrs2ywoOjufo4GoaE6FIP4eIX9741OLEf0CjYPJGlxQ/Ctp6lT7u9SSxIp",
-	"gKeWVjwKIUNRisZ1ZQPHmKw5A6ngooJNd2iqckdfifX0nDuO5ViyrfEMDBl+pnLUWLrrTpXAMGEkVguj",
-	"/8xd3OJxiq8KyvoFd1eL0rcLkrPAQ1C2liWWJam9ta6qJUj3m6tBZEbJ2RX479iibxxLKNgWQWOvsyMn",
-	"A3pSL/s7+HoV1s5yI7Mmh6Of7xuoAY3RT2ku8OWnWLpTO22iDvN6IE1wKKop+BIV4jWH0r73jSdDLiQk",
-	"SrjQuiE8hkhhImDvRAQZfXfBIBethvq+KfeK78+YYhnUBr76EyQlrKjGrvSKssbHHCL2K/PdJbi6mlxb",
-	"bdo1vyZbq6q67B0me0T0uX5O7Gm5PXH2LuZtxjmUifN1d2MKuSal738tSpFVqS0E422M2gUwumDZgCgJ",
-	"WobT/ix7Rr4cq4G/9soQXMHm0Nhf0iXlC6+8mo+9Ue3NHLzKZZ3V3qvlP2zkzBdmAou94PklrefTSSFE",
-	"nkQcrmf9QrPdPXDF0iutZldN3HvkoU3yEP18dUTNzXLjCqsWBXDIHh0QcsJNppELrmm/dNQZnD9QQ+Ov",
-	"cdSsMrWfrWH/4JKHUzawqE95T/nmwAxLNQla+N1zKANkSxnTdaTIbUlvAs/O9uPpRoe7dJ8CbZjKYBHS",
-	"Uu5YqmvU/u4b9wOs772COHz78Sv5NVHMpfERobbUvAzZVl7eNK6fce8xug5b0PONNd6LjE4aWXS+cKjx",
-	"m5oo3lSinNCa/jb7j51gI5e8JZKYNamnaQoQmzC19rp4xj35qraZhencN61h2T7BseZv3yQn0WdoyrB6",
-	"jKP3ZXlN889vVsN6jidID8jexxUe//7rE9mQUt4t3u81HTW2d9fd39D8HZoB/wF6jYLOXgvKOn/qlzCd",
-	"iwxL3NOc5KJ5FxlBkhuEabzDT74iM5tFV5SQMsk6CcY37lWT+rqHj3zZGMu12nK/3DbPn4W6BxvbC4Io",
-	"yNvmhQQl8HxoMGy26BcWKpGdG+TyEPf12CJAv5CM8svZbDkurlpuY/PiTCceUpSwZ/exFwi2o/u4X6hn",
-	"7PSMi1QfOpWE/jxHn9Yt2gYO6mZuY2Mf+sQdKqM/JmQh/DqG7o4xE4Yg+LQMQVTJr09+JSXM8e1IQR4/",
-	"xgEeP57apr8+bX/W2/nx46Aa99miJQyNLAw7bpBjrDOtlwoD64KVkaJ/761wtwc2uu8IdoBwdc4cgq/B",
-	"4NAubvQzl4JGnXurgd9MzTbeJs88krkp1wOFaP9zLHfBxOdH0mQ6e6FiebZtU7aSnpqXbzGt5xebkPtF",
-	"3t79xdiy+2LSvn+4S4xcdwMgYQJzbQ3uDeWlM43IZLLdAnlLyFxpVTK1wTphzvTJfgnG1Hxfe0usF7iu",
-	"LGP1DiWuoK401/hWKuk0m+8FzVEX0PcZjFBUQuQH5Ns1XRU5WCH19YPZX+HZ355nR8+e/HX2t6MXRyk8",
-	"f/Hy6Ii+fE6fvHz2BJ7+7cXzI3gy/+rl7Gn29PnT2fOnz7968TJ99vzJ7PlXL//6QJ8BGmWD6MRVpZj8",
-	"b3ygOjl5d5ZcaGQbmtCC/QAb8xamZmP3yiZNUQrCirJ8cux++p9Ouh2kYtWAd79ObNL7ZKlUIY8PD29u",
-	"bg78LocLNKYmSlTp8tCN03uG8+TdWZ0eZmKhcEVN5o9mBVxUywon+O39t+cX5OTd2UHDMJPjydHB0cET",
-	"rGVcAKcFmxxPnuFPuHuWuO6Hrojw8afb6eRwCTRHn7j+YwWqZKn7JG/oYgHlgX1uVP90/fTQqXGHn6wh",
-	"+Xbo26H/cs/hp5a9PdvSEwNdDj+5IlbDrVtVoqyfweswEouhZoczzEAe2xSk1zg+FbzcycNPeD2J/n5o",
-	"0zLDH/GaaPbAoXNKhVu2qPRJrTWunR4pVemyKg4/4X+QJ2+NkMgh5IIy2YyUNM2nhClCZ6LE6lEqXWq5",
-	"4MrWMOm1nCCnGiY/yzRz616vDAauQJ2p2Hv8oR+AiICIg4SSQLN5s1FbIzWyGP3uXhHZ+qRptW/Omw9H",
-	"ycuPn55Mnxzd/kWfJ/bPF89uR/qSX9VwyXl9WIxs+BFrvqBVHPfv06OjnZ4G7l1Lm0maRarDkQNBDGYl",
-	"klXMcmKXqgOI1MTYUpuiAz70lPLtdPJ8xxkP2u5aIdqBJ5G/oRlxCb449pPPN/YZR0++luvEnFu308mL",
-	"zzn7M65ZnuYEW3rFxvpL/xO/4uKGu5ZayahWK1pu3DaWLaFA7GLjUUYXEi25JbumqNtxwdvl6j+i9yCU",
-	"ZB2RN1LRO8ibc93r3/Lmc8kbXKR9yJs2oD3Lm6c77vk//4z/LWH/bBL23Ii7e0lYq/CZvLa+Bmoi+w+x",
-	"vtim//OGp8Ef+4C6TwaHfj781H6Jp6Ujy2WlMnFjyqMEDwWs1UxzW9gRDdD1hUoJ4gA0AYXkR5t1lW/Q",
-	"6s4yIBSj20Wlmhuv7uzcxI15SUNonhNfMI4DoGEfRzEVTKkXqiMhFdw8vts5gCxmb0UG/QMIj5jfKig3",
-	"zRljcZxMWxLIslCgXui9BXpfYNzuxmDogDDesz5z1C/utv4+vKFM6WPKRvYhRfudFdD80BYO6Pza5Or1",
-	"vmACovejdycK/3pY18MKfuxeNkNf7WUr0siVfXGfG2OTb7xBlqjNNh8+6pXFgo6WWxpbxPHhIUbLLIVU",
-	"h5Pb6aeOncL/+LFeTFdPqV7U24+3/y8AAP//k2KIE9LJAAA=",
+	"H4sIAAAAAAAC/+y9f3PcNrIo+lVQc06VE78ZyXacnI2qts5T4mzWL47jspzsOy/222BIzAxWHIALgJIm",
+	"vvrut7obIEES5HCkibPZ679sDfGju9FoNBr94/0s09tSK6GcnZ29n5Xc8K1wwuBfPMt0pdxC5vBXLmxm",
+	"ZOmkVrOz8I1ZZ6Raz+YzCb+W3G1m85niWzE7i/vPZ0b8s5JG5LMzZyoxn9lsI7YcBna7ElrXI90s1nrh",
+	"hzinIZ4/m92OfOB5boS1fSh/UMWOSZUVVS6YM1xZnsEny66l2zC3kZb5zkwqppVgesXcptWYraQocnsS",
+	"kPxnJcwuwtJPPozSbQPiwuhC9OH8Wm+XUokAlaiBqheEOc1yscJGG+4YzACwhoZOMyu4yTZspc0eUAmI",
+	"GF6hqu3s7OeZFSoXBlcrE/IK/7syQvwqFo6btXCzd/MUcisnzMLJbQK15576RtiqcJZhW8RxLa+EYtDr",
+	"hH1fWceWgnHFXv/la/bZZ599CYhsuXMi90w2iFUze4wTdZ+dzXLuRPjc5zVerLXhKl/U7V//5Wuc/8Ij",
+	"OLUVt1akN8s5fGHPnw0hEDomWEgqJ9a4Di3uhx6JTdH8vBQrbcTENaHGR12UeP7fdVUy7rJNqaVyiXVh",
+	"+JXR56QMi7qPybAagFb7EihlYNCfHy2+fPf+8fzxo9v/+Pl88f/5Pz//7HYi+l/X4+6hQLJhVhkjVLZb",
+	"rI3guFs2XPXp8drzg93oqsjZhl/h4vMtinrfl0FfEp1XvKiAT2Rm9Hmx1pZxz0a5WPGqcCxMzCpVCGtx",
+	"NM/tTFpWGn0lc5HPmVTseiOzDcu4pSGwHbuWRQE8WFmRD/FaGruRzXQbkwTguhM9EKF/XWI0eO2hhLhB",
+	"abDICm3Fwuk9x1M4cbjKWXygNGeVPeywYm82guHk8IEOW6SdAp4uih1zuK4545ZxFo6mOZMrttMVu8bF",
+	"KeQl9vfYANW2DIiGi9M6R2HzDpGvR4wE8ZZaF4IrJF7Yd32SqZVcV0ZYdr0RbuPPPCNsqZUVTC//ITIH",
+	"y/7/XPzwkmnDvhfW8rV4xbNLJlSm8+E19pOmTvB/WA0LvrXrkmeX6eO6kFuZAPl7fiO31ZaparsUBtYr",
+	"nA9OMyNcZdQQQDTiHj7b8pv+pG9MpTJc3GbalqIGrCRtWfDdCXu+Ylt+8+dHcw+OZbwoWClULtWauRs1",
+	"qKTB3PvBWxhdqXyCDuNgwaJT05YikyspclaPMgKJn2YfPFIdBk+jWUXgSLUHHKmmgaPETYJnYOvCF1by",
+	"tYhY5oT96CUXfnX6UqhawLHlDj+VRlxJXdm60wCMOPW4eq20E4vSiJVM8NiFJ4dlnFEbL163XsHJtHJc",
+	"KpEzqQho7QRJokGYognHLzP9I3rJrfji6ex239eJq7/S3VUfXfFJq42NFrQlE+cifPUbNq02tfpPuPzF",
+	"c1u5XtDPvYWU6zdwlKxkgcfMP2D9Ahkqi0KgRYhw8Fi5VtxVRpy9VQ/hL7ZgF46rnJscftnST99XhZMX",
+	"cg0/FfTTC72W2YVcDxCzhjV5m8JuW/oHxkuLY3eTvDS80PqyKmOEstatdLljz58NLTKNeShjntdX2fhW",
+	"8eYm3DQO7eFu6oUcAHKQdiWHhpdiZwRAy7MV/nOzQn7iK/Mr/FOWBfR25SpFWuBjf96ibcDbDM7LspAZ",
+	"ByK+9p/hKwgBQbcE3rQ4xQP17H0EYml0KYyTNCgvy0WhM14srOMOR/pPI1azs9l/nDbGlVPqbk+jyV9A",
+	"rwvsBPoo6TgLXpYHjPEK9Bo7IixAQOMnFBMk9lAjkooWEVhJggguxBVX7mQ2T+3JZgP/7Gdq6E2qDNG7",
+	"c78aJDijhkthSb2lhg8si0jPkKwMyYra5rrQy/qHT87LsqEgfj8vS6IHqoZCotYlbqR19lNEnzc7KZ7n",
+	"+bMT9m08NurZGmxHS+FVDTgbVv7U8qdYbTjyODQjPrAMlxMsMbfzmgzWCncMjsM7w0YXoPXs5RVo/Fff",
+	"NmYz+H1S5z8Gi8W0HWYuaMU85egCg79EN5dPOpzTZxxvyzlh592+d2MbGCXNMHfildH1pHFH6FiT8Nrw",
+	"kgD0X+gslQpvYNSIYL2nNJ0o6JIwN59jXkOo7rzX9u6HJCTwoQvDV4XOLv/K7eYIe34ZxupvP5yGbQTP",
+	"hWEbbjcns5SWEW+vZrQpWwwa4u2dLaOpTmoUj4XeHtRy7vjJrAtvWi0h0mM/FHrCJO4uP+B/eMHgM+xt",
+	"7sK9HGwSEreojl4QcrjK0wWBZoIGsPBOsy3d3hncug+C8utm8vQ6TVqjb8hg4FfII4ErpG+Ovg2+0jcp",
+	"GL7SN70toG+EPQZ/6Bv6j3RiayfA98xDpnH9Pfm4MXzXJzKOPYXIgCCorhZ3g4pPfJilsbyeL7W5m/Tp",
+	"iBXFGnsy4zBqJHznHSJh06pceFZM2KSoQWeg5glvXGh0h09RrEWFb4US5mhqNp1CdrFXCfEPeKEDs4qX",
+	"dqOTasd8JozRZmA4wa1WJPlWXBYiZ+sapYSQnc8KvhRFerBoHbHVnGmVCebVseRoQHxhUVkbsLw4ufWv",
+	"NjVc7JpbVvdEU7IVmVa5ZVaqjJqLUmebND1GyBuhgK3mXteTtk1w/MjKoqLfY8y1vly2BWQ0tXVJrn3T",
+	"xg9bncUoXhvppFov4ICYs2tOf620WXgwc3zXNX4Rk7R22vFiEVBIA9EYKGtUYWonFJPK6S6yAA5YKgSz",
+	"mq24SSNNE19e7Z3zUuwW+MTASi7N/afuatwxs4W1mCIUAUrruKtIKsZgRKtWD8+0YrnYcrBPtaTFheO/",
+	"gcy0jkei7h4ysz3QsWWm3payEEcQk5ukiggmxc+esIu/nn/++Mnfn3z+BSxVafTa8C1b7pyw7BNvyWHW",
+	"7QrxaVoegaEtPfoXT8ObRXvc1DhWVyYTW172h6K3EBLg1IxBuz7V2mRGrGsAJ3GtAL2PyM7omQ9AeyYt",
+	"t1Zsl0dZjCGC5c0sOfOQ5GIvMx2KXjPNLkbR7Ex1jBO5Pjl7C1wa7XSmi8WVMFbqxMPqK9+C+RbhDCm7",
+	"vxO0eKjB3PhQVCm8fqSk+I2ariXS0G9uVEObUT2R8E1g5+edsi5t4od3B8tKeLS+Acm4rNYtu8nK6C3j",
+	"LMeOKDK/Fe5ipzK0wR+DSYcP/K1U+CBodyqLLDywUIXI18Ic1ZLTpUqw5tNUD2wCHCDHC/yMRsBnonD8",
+	"6Led7gQp2L8OC0nAshwa2hR4F84Ivv3NgaRpvlHO7JL2CjjABN+CrMUrIz3nu42QJuBAplDCBBnvhVxv",
+	"XHSzfmW0Xh0fk9QsKRzwA+kcBfTpWydeCnetzeUrIcwxLqGlEGa6eIkm3ytaaOSp2lamlRIZSELsF62c",
+	"M3y1khkhr3NxgXrZEZSqZrBGZsHejCUVX+rKMc6UzoNGmFa3BpyxEDV0XnGxBuc2ZGdZChAIGa9gqeFd",
+	"TKdOgKbjgmdE4AUx+D79mlrRdOToUxjBc7DlCsX00j8Q+6drRJKjX4kLCotX9pJqfgRXaXQmrAVN+9Dr",
+	"Bh4GboROCDgCXM/irwD3BvaQK4pln3z3k/30d4B3yj0O26TIW5v5pBqAetr0YwzXnTxmO24EC2KBOY36",
+	"aSGcGCLhQTQZXL8uRL1VvD9ZroTB9/jflOPDJPdjoBrU35jf7wttVQ749voL6xu5xdcaxZX2BqDkYAW3",
+	"brFPLEOjGBcLGESSMG0Ls27IWPeCW0c+JFLlaPqm4wTnwT44xTDAgxcLGPmncKfoj51pZYWyla0vGLYq",
+	"S22cyFM4gOPR8FwvxU09l15FY9e3GKdZZcW+kYeoFI3viUWYEIG4q59avZNVHzl8kIRzfpckZQuIhhBj",
+	"gFyEVhF1Y//GAUCkbQhNjCNth3Nqp8r5zDpdlmiJWlSq7jdEpgtqfe5+bNr2mYu75tzOtYDZXYDJQ35N",
+	"lCWFasMt83CwLb8E3QMNG+Ts0ocZNuMCLayLMc6HbXkBreItsHeTVuXa8FwsclHwXX/QH+kzo89jA+CK",
+	"NxdY7cSCvBjTi95wcnAaGxla43gJoflSM/zCMtiCcINsGMT33jNyLnDslHDyfPSgHgrnSi5RGA/RpqVO",
+	"jIin4ZUGGzLDNgSxF+hT4B0gQz3y3SmBnRfNzaE7xf8I6ycIbe4wyU7YIRSa8Q9CYMAo6oM/ou3Ske4d",
+	"AZyUmoNSbI8YGdqxAxbaV9w4mckSrzrfid3Rr73dCdK39lw4eoiKPtAVuIz7M3K/6455t5vgpNtuH/ze",
+	"lTeBTiEtajxt4C/FDk0ncHX+ih/fq8SPO3S9XvJAUiFM8NY5iQA6yoM2P8BSWQO87yWbq+lWBJ45eYXY",
+	"4sNNjKwI6KJfPRqV0Njyk3bHsaSM2BwjFZA7J2ytMAhvkCoBkvRb2pV2h1hoOojtJW8QHH6aqYSm5sRS",
+	"EQqNOpLpohCZs42XsqUgztQaHIP8CMUCoTiECTuQ7KVXa5pDnhI9hexBJHrTuEZ/cyXgPfE3MbQOzLbP",
+	"yFrHqDT9mICOdgCHY1jtEqMySWFnsGDBMV7k7YgbccMzV+wYxwXYsWthBLPVciudd1Vos5PT5SIeIPkm",
+	"OzKjd1ei8I/AilP8py5wqAi9PkuGB/Zx+N50TCAtcnirR6l1MeG9o0eMJASTPFtZqWHVpQ+BC3FS4dBs",
+	"Aen102IXwPVKcUxmxID9j65YxhUalyon6tubNnglgr44g7TRnN6HtaGQKMRWkM0Mvzx82EX84UO/5tKy",
+	"lbgOcaMPH/bJ8fAhbQJtXUuPOIbNnhv3PHHU4GM1qviEWVd92u9D6UeespKvOoOHSXFPWesZF9C/twDo",
+	"7MybKbjHPDLNf9TdTMQ8wieJN677hdxWBXfHeHEXV7xY6CthjMzFfgWAJkYZzosf6m4YEysy4NFMLDKM",
+	"5Jw4lngDfSj4c58VrHFZk9utyCV3otix0ohM0FkBl1tbw3jCKNIh23C1RpuG0dXau9rTOCipITgYIzYr",
+	"1RuiL7/SkrWSylEMmrtRi7XRVZkS6z72KgSzwn1QcDBJRcuOnckAc81rYETekvYTKRsG/RbGHHq2n88G",
+	"LXaojjUWO6JcOyI3rVliiPHCVlkmRDIiL2ULq1HtZB5pYsn9gHCfqwyFJDCeuYoX8R6BsFeudu2UJFwW",
+	"FmS2tAzbQedGO5oTbiFefMULKyLM4gDmeF+3ruLRyjck7ZJi4sM+MgkowH3OiLkThEGtXx5ddYtV1z6U",
+	"/YmjGIjm41AYBBhGi90RlDYaiBlRGmHxiI0fFCx91as4z4A/g+3OOrHtv7lS178PSKHXg5Y9rQqpxGKr",
+	"ldglU+tIJb7Hj8OOjQOdUeEa6ts1F7Xg74DVnmcKN96XvrjakSx6Vcf/HGHxu+N2ntvjDAv4nCSKknGW",
+	"FRJgz7SyzlSZe6s4mrOjzZbwfAyGu+EHjq9Dk/SLSuLBww/1VnG88NZG7qS31kokLLp/ESK8c9hqvSYD",
+	"QEvyCfFW+VZSsUpJh3NtYb0WtGClMOh+eEItt3wHIhDfY34VRrNl5doyGQPBrQNxSW//MA3Tq7eKO1YI",
+	"bh37XoKvGAwXfKACzyjy9KipkD5C1kIJK+0i7aH5LX3FUBuP/saH3QT/bistvRbD+E20+M6JVqaZ//+T",
+	"/z6DDDN88eujxZf/1+m7909vP33Y+/HJ7Z///L/aP312++dP//s/UysVYJf5IOTPn/mr5fNneH9onot7",
+	"sH+wp8KtVIskk8XObR3eYp8o7WoG+rRtSHcb8VaBn57TkO5F5tzdjR26Iq63F2l3dLimtRAdw3nA9UCt",
+	"/B5ShiWETEc03vkY7zs1pxMCwEKGGH9oxVaVoqUMWjAFWATnUr2a10kfKNnbGcOMABsePKP9n08+/2I2",
+	"byL56++z+cx/fZfgZJnfJLVDcZO6bPkNghvjgWUl31kxoIAi7Ek/WvKBi4fdCril240sP7yksE4u0xIu",
+	"RBF6o82Neq4ovA/2D3pD7Pwjq159eLidAT28dJtUEqiWpoCtmtUUouOhBnG+Qs2ZPBEnXaNJDvc279Fb",
+	"CL5iPnTDaD0lKrreB8RogSsiqseITLJMpPgHlVsvrW/nM3/426Pr437gFFzdOWvXh/C30+zBt9+8Yade",
+	"YNoHSC0/dJTsIXFrpQ9t30XHuE99R7lT3qq36plYSSXh+9lblXPHT5fcysyeVhbeYwquMnGy1uwshEg/",
+	"446/VT1NazA7ZRQ1xcpqWcgM3r5S7EkZx/ojvH37M1ze375913Pj6uuvfqqkfKEJFpDgS1du4c3VCyOu",
+	"uUk9k9s6pQ6OjL1HZ50zPzb+6Mdnfvy0zONlabupNfrol2UB6EdsaH3iCFgyZp02QReRNkCD6/tS+4PB",
+	"8OtgwqissOyXLS9/lsq9Y4u31aNHnwnWyjXxiz/ygSd3pZhsyBhM/dG1XyDidK8RN87wRcnXqef4t29/",
+	"doKXuPqoL+NTAyi62C2mSR2Vg0M1CAR6DC8AwXFwvD4id0G9Qm7MNAr4CZcQ24C60fgI3XW9oqwXd16u",
+	"TuaM3ipVbrOAvZ3EygKLh5WpU+atuVQ2OG6BtQY2gc8uuATTnsguRY4WH7Et3W7e6q5XLUUziA5pKSEg",
+	"xaxj1iq08EOiwDLnXhXvWpCWO2aFcyHK4rW4FLs3ukl6dUi+oHb6Gju0UZFTI+0SmDXetn6M7uJ7B1SA",
+	"lJdlyAKD6QACW5zVfBH6DG9kUnmPsIlTTNFKrzJECG4ShMAOQyS4A6Iw3r1YP4Ue3DKWdPIl8gcG2c98",
+	"k+by5F/wY2zebOrvW4HZRfW1ZUtuKUYU6UEpWiIpVlm+FgMacvzIMjERSuthBgfZd+4lT7rofdd37J03",
+	"SZCp8QJwTnKKgC/AKniZ6XgIh5noHc+/EGC+a0+wZYFqUhOrjEKHm9Zjl1qPgZZmYGFUo3AEMNoUiTWb",
+	"DbchZ2c+j/byJB3gN0w5NJZoLjboR/lLa/t6kLndfdq7Xfp0cyHHXEgsF18tJySJm898PE1qObRCBSgX",
+	"hVjXIfI+HLud/qhZIIDjh9WqkEqwRcpPllurM4miKDpm/BwC9OOHjJEJmE0eIcXGEdj4Po0Ds5c63ptq",
+	"fQiQyqdv4mFsfNmO/hZjGQBA5dEliHA58ICUBQnAvXN1fX51XPxxGCbVnIGYu+KFUC7c+JpBevnOUG3t",
+	"ZDfzHhKfDqmzIxZ4OlgOwgl73AmbWGcKQKcVuhGIl/pmQcHjSY13ebMEfk8G00Cv5MakzHIPLFvqG3Qw",
+	"xKOFgjf2wDIMRwCjAQBThgHu2G/oNCdgxqYd16ZSXGjZJ7Vu07DLkDoxZeoBDWaIXT6JksXdCYCOsaMp",
+	"q+Avv3svqW31pH+YN6favEmCGuIUU9t/aAslV2mAfn0rTJ3ezZsQXotMm3zYTgGMKl1dp6JvXqB2mP5k",
+	"cgK4kZoZ5+3bRrhC9FduwDmkBU8zzwghnlG0dA+Sb25KbYX1Mch41PvBvZ5oBCWJsGSzglfwQtSxCkky",
+	"pRAOrmmB4oRyk1g3DDhNd04t7sAlfwyWskzDcchN5bWnzwgUA7u8gQMa3BcSn4xvFJbbYf541VXtkxul",
+	"1aqTAjK6a6VOB2Cf/mtm/83UikLg7XnRum0sLsUubQQQqJpdhG6RlQ8TTXK1+zRy3TNiLa0TzWtTcOz5",
+	"Pez4te/uMHauNCvA77XWtT4XezjHaH5wDDDKZyUNxJPAU10SBWj0F4vWp79A0/SlorXYjEo9yHzYBx0o",
+	"tshlUaX51c/73TOY9mWtO9hqiYqJVOREtcTSJMnoiJGpKYBmFOEXhPALfjR8p+0GaAoTG2CX9hx/kH3R",
+	"dXAfEQcJBkwxR3/VBkk6coBGyUn60jG6YEQpPU7Gnil6mykPY+/1rwopUoaUORppBBd0DRr00U445JAf",
+	"GQn1pipZMv2E0m7RMn4kyFUbeKzjlxRC3V5gtQ7TpIM1Nd2rJw3t2+4ZUE0fT+0fzivBi0JciWKIzlEo",
+	"PlI8GHDQM4JGQNcbn1TQ+yXs1+r7K9AQrMa0C2OSW3razdjDbXM18nnCm7s1MizQjrTM6a93oKEFfmv4",
+	"u/90V5YQtyuSkdV/i9xFeVmih2xonAphhcEkuBOkwaFPB/v4HiuFfWec6WjHid6nkADVOXuHNPnDd8xo",
+	"lWIyDyM1wJRhxnFBjIPXN7tGO+1x38AxzstS5jedd08addA6fhSK4QHlB9tDgYg3UjH7RtjWukfGPCoz",
+	"1cqvezKJMm/aafhjnSaeStpQJLFPqDqnxz5aQYq978TuJ2iL6Mxu57P7PZOmaO1H3EPrV/XyJumMbnj0",
+	"bNbyejiQ5LwE5xZeLPxj8hBrGn3lWRObh7fnD6ytpaXem2/OX7zy4MN7XSG4WdS3nUGssF35h8GKagkM",
+	"bJBQhG3DXW2fo9twtPh1AvT4Afp6I3zBq+hC3avM0TgXNOOFB+lV2ht47/Oy94MgFEf8IURZu0M0T3XY",
+	"ueMBwa+4LMIbWYB2wHMXkZt2NialQjzAvT0p4rPoqOKmt7vTu6Phrj0yCef6ofQZgJNBoTp8rT0j2iLo",
+	"gfWcdYpYn4LxHqE5GTLvJRzKtWkJfx8+lfSsqNW5jmCEb9EYd+Bf1CgmnVjaikgTImjzk7spdbRyA66z",
+	"oSZj9354wpB72S/rX5i07OHDeHM/fDhnvxT+Q0QS/H3pf8fHj4cPI6AbdThpHAAq4N1f8a34tHZ6H1z6",
+	"D2tJUuJ6ukqAtINeepjz601BXhmB3teefJDZmwia+19I50xStL+JyTm8s/xE+BiqKbv3YihGqfb+21LN",
+	"SMu06jq7YiAgMBkeNBCDsRT+/bK/fVW1xTe/hS1klvaGUEsLol2Rlxs0Zth4wBoGI1ZywGlSVTIaC5rZ",
+	"CU9SHSCjOZLEDBWWhmi31F60VEr+sxJM5kI5+GRCSs/4mMXXD+8X01eG03dCPzD2iYa/zw0hrgjV1Vf9",
+	"jWnsehD71PXAfVbb7AOi9dsxV0E4H+qaG8/YOzRG3Go9f3hupjCjTds3brIo3lsYPIg8X5pqYI5koW9p",
+	"FyujfxVpQzPa5xMh/n4ivAph7wnRoc07bFOvvJl9cLmH7ibRR9Z2Jx7gelz5yIEOi/EEXxKuaKkp9LoV",
+	"lZJmmKiFPaXxG4bxMPdi5gp+DfUr0lcEgCl6PG15vTjNQudAe1uHINPsLPL6rNtKSlRXCtNk3+gnvb2j",
+	"uk/TTlb0G70eOrY0+jl56hVWJ4ap1DVXToRia7SVfG8r6PUNel1rg2kmbdpBJxeZ3CZNw2/f/pxnfWeM",
+	"XK4lFS+urIiq4/qBqOo7cZGvMFxH3XvSPF+xR/Oo/rZfjVxeSSuXhcAWj6kFvEgjbrUyGboAekK5jcXm",
+	"TyY031QqNyJ3G0uEtZrVVzKqARLczJbCXQuh2CNs9/hL9gk62Fl5JT4FKvrzeXb2+Et0j6A/HqUOAF+l",
+	"fEya5ChOgvUuzcfoYUhjgOD2o54kbXkrI8SvYlhwjewm6jplL2FLL+v276UtV3wt0j7d2z0wUV9cTXzJ",
+	"69BF5VQX3Tqjd0y69PzCcZBPA3GiIP4IDJbp7Va6rXfDsnoL/NSUvqVJw3BUZJ3Ophqu8BG9GcvgzNUx",
+	"AX1gXZtv0/zA0ef0Jd+KNlnnjFNu0UI2fsahliJ7HlIXYxm3unob0QbmAtRRzYElxKIoUjk0C1RutfgT",
+	"3L8Mz0D8nQyBu1h+8TRRuq5dFEUdBvgHp7sRVpirNOnNANsHHcL3hchZtdhKEPWfNnHZ0a4cdLtMTuuG",
+	"vPzGh56qlMEoi0F2q1rsxiNJfS/GUyMD3pMVa3wO4seDMfvgnFmZNHvwClbox9cvvJax1SZVj6DZ7l7j",
+	"MMIZKa5EPrhIMOY918IUk1bhPtD/vq4PQeWM1LKwlwcvAoe810Z3A3yxjf2K7/JW236nbelcqQXEDxPf",
+	"L3F59r5a3qdmc6vzIVD5LhOhGzAitMLXOxQ77AZ8fxND9GDbWqEhGrVRS3HmVzqBcij0Wb/Q+njnhN1q",
+	"6ACBDyCgln6oOWuXSfvw/nDBgtn3y4IvAVb8owvs7yxskMgBg4FFjAq+Jpczr79HrqGcfaVvpi5qR3aH",
+	"hf0XIM0ASV6LlTAiGapXfwIaACa9grbJ199xp4bnz+IHdxh1KQoNlzOnDxcZf6BFAMrMR5aikkX+U5Nk",
+	"qZMJ2nCVbZJed0vo+HdSfKFBjSIRKVkbZMOVIreu3nB0Yfx7uFgmrr7/0FPn2Uo1sW03RzWh20GuAbwN",
+	"ZgAqTAjkla6ACWKqtvPX1PHRxVrnDOdpClE0Kla/SndUHRGrlab2DX6gGC3ojEYxKs7HhMrRpHTCvsVM",
+	"EgBLK/cumnLqpICtMmtVWWiezzFpIzzmM5qV+hjhKuOLA65JA2phMRzocEjEwliQwjFCowFr6zDrv3V8",
+	"W6ZyPUGLN6EBk51nerRxxNQ5Yc/IvGSD8YImYZiz02xFzurp/AUHeQL+4xzPNtBAt063YZafXtUycGVj",
+	"1ebh/1nNibTvAG5f2JLqWs6ZBiXuWkL+wQ134kq000sFMIJGFtJNtdEzlVLEKenS0iO5AO9C9gAcjlu/",
+	"BSYh6xD+wFPBx/scWOTzAnulmLJXMbTzWBeSFYXsoOx7b3jNuNJKZpibOaUlYSqcaW4CE9JYp0OsvOOi",
+	"nSU2V7JOaR315qk4WLl0PmsRrv9SF32FRSXuoD+duPHVrtbCWS/ZIPTbl9v1jwVSWeELCQETxXJSm4RX",
+	"Qkofaa4sB7IRZrkYsP78Bb699LZB2ILsUlJZeU82YmhJ5nyI2AZuV0w6ttbCenzaqb7sz9DnBLNe5eLm",
+	"3ckLvZbZhVzjGOR5A2iTm1l/qPPgdOadvKDt19DW5wSuf275c9Ck52XpJx0uxpzUByAB7BCBk34H/v03",
+	"Im49fjzaCLuNeovieQqMBlmemXWiZD7GcKAwcSeaEO4PxFHYglGgSYooaX/7F1KF56X0AZEljwRcGNyv",
+	"A/1sZrjLNi0xNNnNpCvQrPPvk/cdqrPA3jG/zGZhjuFlbGoqDwiOukGjuHG1Y2FTAHdHysTXEGUcvPf6",
+	"FZJRq/JKlI9SbNdMTgkOENyhKnv7AOhvg75ORN0xPfihJ9FQzqdlla+Fg3xCKdPOV/iV8TzKFQ0pyqu6",
+	"AFBZMgCqm/O1z21+okwrW21H5goN7jldVIQ8wQ1xIfSwwsBpYHWGf1MlIYZXxvtZHhysFJwq8zoO+RC9",
+	"uT1ST+sFnl5AppHplMAz5f7kaKa+G6M3/Y/K6YVetwH5wJkex6RcvEYp+fYNHBxxIsSeSysdLXWeQnQf",
+	"1fg9pPaoM2y1pVII3+/N6RcvsWQd4EPDJOBXvBgIEIzM7pzOV3IxGAoTzAajWrnziWgcZ6MiaDC5B7n4",
+	"4XeCIv28MuTWR1598LnXe5pm2NOzB10la4IGb+8+QN+FUBJWcun9Zxph0aesd40dttyObbpmgbtI+GjU",
+	"QePpd1dDkaMhoQJ+75blvxQ+O11pxJXUlV+w2nUxXAnp1xUm4IkTNAzin3QN/r0t0oP28ze+dCih6e/k",
+	"3/1Ejq5MKGd2/wLW9N6iv0Dj01jc8NdBM/N2Kq9cJe1NbupZ+YxOWPADv1psdT6WeeK7n9iz8Mw36dwJ",
+	"jJzKW6dzX4A6mXXjhS+pFJqB9jl52u99p/OyHJ96INVGf3JqeOj0Qzn7YH+OWd1ehf2LKX9ZbEJI3FWi",
+	"vBBK3LiBurHdtALXgombUmDS8ChDxHAaoqkM5aPF8ba6KAS3YoTCcfpL33Yikd/cvID207KWdPcWVaL7",
+	"BkRBSsgS2YPYbFmF6SiVaPTLqyzlOI+9E0o8DoqeXz0zd/oIxB5/FTwP3oATlOguppMy9nkZ2S0HMPhg",
+	"gAgGgML4qYPshVxvXITGqz0505s86Uj9UlvZ1FMtYDC/Nhsc7mSq1z2gKuNH8/5YweX1SmQOi+g2rnxG",
+	"iEMywMNk4TXsY+70YTaqgxOC3BnJkz6fxTI9GWnvxRpvcrzhwzJ6HfQZxbdJHLJG1PX1DLy7+yHgByza",
+	"lHTXGPT37qTuiny2EpUK0og9z/fTMqAzj9yAZD5OyHQwzDk5z/xbEpNCO45Lzpf0pAIFgRMzskwrJTJA",
+	"mcr5eu8VZ/hqJbOT6f5Sb9qxkVwxXbm1xj0q8JGKHqW0kWupOk2lyvQ2NE3Sq4aT6m+n5wdFJNQZUj5/",
+	"FvCIsOCJJ+2G0skyXzjfZyKBDqLU2SZ99VwJqh47pMivtaO4TyRgaH2Y3UUq67jKxMD7Ah0P1IS8jCgj",
+	"PiZWoRrlA57heL1fUJX/9JJdCcPXqDeQDg0j+37JhWRGFBzrQHtpqilQKG5jkcqKK+0pnaZs6DPml+aL",
+	"9TNdCqwB21rcdIhAiRkc4cxfOCPLMcUCvhPXhHOXW8dggC4G87qgKUBTYgABbBREN42e4+sBpvG7q4kU",
+	"ph2y3AXKM8fXk3PlRTv8DV+/obEPLnIYM3KnNteEGNLaya67T6P9Ey24J04D0h7JFeE1SlDQ0pSIqUg0",
+	"psrsJ+w1Gvu5gSbcVgbOjfBajksP5IUTgD1+5KUEu5Yq19d9SbjhKi8EXpP2SKMADvUwGLumXHjzrvMk",
+	"+WYTNw8NlrcMtYlVrbWh3jSs5NZHofAuiEMZvmkIimg1yYc3mHSJxrh6QtTAaoFVCuMp21I7c10ti+i4",
+	"JcB7s+5Fc2zuPVgFaTmMWE26o+M2dRX3QJDG0Arlpq2ZjTI03w2jeq6JK9WdcQSDA9bnSHgcvCrTsHF8",
+	"PQp/kP7jMjeWPwlxkNyuvd00xP4p1uwxUmu1U4vUJSOhnpL1rUys34nd6L2Q9/NbRjla6Yg+QHM9r8Nd",
+	"KaUEaIxrodDDKu+kfZqcfGa1EhloSeP5RP+2ESrKVTkPfiIIyypKLyrrdAhYt+VwL6gGoILfEZ6CHw+c",
+	"ocQml2L3wLIWNyQrq9dZQe5SsgMpgLYVMEuW2vJiyLHNR/hIW3MGUiGEb1J30RQ/S213nC6ygt5xrsCS",
+	"bXvoyJRX2ok7zgVdD0q4jpH9QylHQYP7iqukJZN74xndPmm8A2+dz1+N3Sbri+dGW5e60AxcN5NZHvoq",
+	"FSYWV014EAESFy1BKmslhrQPbodUcrpQgtekL2Bh5HoN+9KHgyvE7e1sy1XFi7ezOtc2QmTI8VjkUdl3",
+	"wc5fPU8iPOVWDYtlHYfr5uHX6Eo5WUyYQNyU0gh76AQjFxLKQOEJHTANAKX5FasARMW/k2DXpV0sy3RR",
+	"kBUlOoLCC2Z7s/ZewkSJYYKLawE23+GpILMUNmHUSeTMFzXxHsz0RsqeO1okGz5TMCz1JUfKXGQGHzNq",
+	"XQWtqGF8777j0OrghAHfuZUuCn0dfp4mgJZG8zzjdgApXblMd67e3DmxLVGHIi98ALcepzv3GVPaLXwf",
+	"YEqIHrle9IgaPhCazY8wwwLq4kNfK1TzB4pz1JIWgptCCrPwTxEGGrrhosQ1sIsBp0JAHT/Rps44Cd0E",
+	"BQCYufc5TE7oy/gvkD3MgHjcCH4lgT9AqBsb8oU1zHstjFAPXMPEc8rCgDyCsYBIr8n2iGbf/AQzph8Y",
+	"o4VIg03faMVb3I95LMRNJuioIjaZxJQj70yRbgMLYKOtvHdYAm8KMsEuXEuLZhVGhjZ77x9jK9vMNlbC",
+	"ZQz4WH4EFGjG4fzuU2EeG2Gx912QEPbYA95TFqzvGN+RwD3p1d9oXQ6O/ORbzNCsYIfQNZU6yI4cR5G3",
+	"/zdXIlmm1uf+8/m9nQiqlSJFZKy2/5Ae8rfNrleE+hrLs/3DywpxJTP/AENnd35I/EjvkZceNqkiUl3s",
+	"2f/YruvvM93UxUV6cJZaFwis8H7cao2j1eEyAzviZuh2Eg8+cC1xNwPKHBRg9IIs7QE7+tQ+EvDRXzEs",
+	"wlV6g3kvsOAkqiTHcyIr6ke0oPD1Sob/0YLWIXbtLNYDhomwFZCORBHfZRp3DztLPhOOy8L6TAdpzobo",
+	"iDTDZlQaog70Ckq6sOG3UAeGZinkpYi4i8LqMI29b5H0Ew8u6IuRp/5eBm4m00Cv6pllk4mrH3Xb52GK",
+	"Yc8KjWJrKGldWyLXwfoPLKX4QP0EzxCEayWMaTgKxhYLp+NL2xAcY6SABnckgh2sfU/ADVakfN2U3NzK",
+	"zGgqWMB9+pIYQWbElgN0JiqMOTznGLG/pu8hyXC4q+11h6/5dcp5iIuFxVs7RIy5fsW8KW1/8uK7eMZL",
+	"pYRZhDC5bmYIJUwMnK0dlTBnY7Qx6uiByYrniChJOpVnfSx7/sEFVmR+EWUDvhS7U3LdpNO2KXEVQ0/e",
+	"KYRDVD2qs9pHDRpI+0cXa0JgfRQ4f0/H+/kMDvSha9XzfrHP7h64lHCng3tnnb0I7ukPbF9r+ARDhOpg",
+	"3GvUgqJz9dMTxs4V5YsLcblxudHe5HDBGpn/BmfNK6q/62MCTt6qdOIttMybe8q3MMy4VINb8b2nokHG",
+	"J/pwilNHXYmYiqBIaSkXFHD3Ne74lOaNGZij7OQYh8mZD9RjttCpVDB3ShMNY6VJFc+GEDmhpiQprsHw",
+	"gycp4LMQ7E10UOc48HkLpI7yHPQ1JjArLbbaiEWhMVVByhl35UAd20pnGRa9XTNdZjoXVN07GKyaCdOO",
+	"ITRXpRTH01REkeGd5YSGWOoP34U0832i6pETpwTBSrFQCzyB9yZyCmR+A30oM21TzKAJr6KQvIHkKsL6",
+	"+gWeSNS4DzKuEibdrmOLk1uTJqfBjj1zLCaxKtdBppvnKzRdSgwWb2cExh6g6GQir82WzWr5KOhwhDcm",
+	"8lAojl3LoggvXMADpvIvJvEoP9oK4/kxHRxM8ZRttXXhaQBHsvVQTY6ETzKtnAHLTNtkTEziQ0O+5zfn",
+	"WeZeaH0JmX0/xbuN0q7GNJ+HZKndbBbNTKZTPmPqCx0EX+OC2P13YWoHIHjaCEweukNPG3/5phLU2nhX",
+	"5jqyBy/5c2Y18QMOxazwq9gqwFBn+afplmKFKfAOsEy2ZVh0KnwLQ+6N9YtoMkFE9oZPHBs9KraI2JOW",
+	"aaX6XDHu9FZm6d30x0pUMZheIiUZU6SgHt64js3QFhqfRnVcMkrmPpmFgq2TfK8k0e7jM8maVDqfYrAz",
+	"bu0LOnQS9o8Lf4AvskE9owMAQkoZVV1lMNKupQTU8k2vyaMS3eG6gE48zDCI/36wwQhHB8qJewHVSxxy",
+	"TABvxzm5JSCGMiBcNOxjsEmd435g1yfzF4ynC6AajsupSQPqgpoTz+8IgOE0Ai0YJiUTOBQMet5bcDeg",
+	"SqBVZB7d5Hx2umh06bUAnCV+vYOxKyN8znUUbsy0g1lK7jbhoIbmfdsl2MG8h+qvwmgqWj6P3IFEIbaU",
+	"AL912dQlFb6Mh/OJ4CvUYsFh2/e1dWeWC1Hiedy1yqTc1+PLWudi7nFfRIHnU6ibvKkTYWml2J5r+IAF",
+	"fkHbxE7dSgDRlcwr3qKfPVStaBueYCtPUSgCrO+mSYqDhUQauTERsTfRR2WH9qVK5/mI6xDURnecLa89",
+	"94gJm51tS36thk1SqWtKuGtNXDCpVfyodiMy1C3aiSzuTxOGgzEr1/txiB8aUXkYO9L8RsIn+jhgoiWu",
+	"LPNjRg/DyYO04cX72Vl79+sF3aNFvm/cwO0/0ggh+bM9D/2Ht8/o7hkcL3WxsQKlbw199AoS8Eix5AM0",
+	"etUpWEBh0JXz5lWcPnFUnbA3mm35peh+IKlNtkIrc3rRrZkWz4NdXdCDTmunMR4FrkTwEI7yBvOiQcJT",
+	"UydvHU/+2L/m+dWs60NH5KgHzQ8LdkoH1LcmazSy6RNOvth2wRkIsm8BhG1+c1CwCt44JNhkPyBj26yV",
+	"53dSeHojLyFnyA9XwhiZD0FqBZq3O+U+g/XO900oz/RwK21iAGkb7QET54kmMVvUDFySc7laCUNREdZx",
+	"lcN7bdRcKpYJ47gEq/zO3s0g+TyEi3IyFEJr5lsf3RjZnewoVslp1kRYzpQ9r5Y4LeNhf/a7WxOnzdxX",
+	"G6eBsOU3gD3mZBvgYl8FCVbV6yNaoWWE5PVh81j5qxifBmsT+qd4p3HWKVOMb9YfkHSo0/yopBvdrnSl",
+	"7SbJo6gB2k2RE01tvKPF6W+iciBOr2znNqx9fnzin7DW9CYZ7IYnYykQ/c1/YBXxVcYnxYztIna6ybD1",
+	"8JOQ2V5NXaD6akdSOzT2S6S19TeP3nt4V+8losR+oAdczMhkw/Nc4uBp8FAvt6ys7CZ6s4OeHSAmU21/",
+	"vslFqcvFJC/7EJJPAHlY+3ANJZ4Z5Y/6vc7W5YRjfmzXFcbxpnPOcF3jfbfCMhtTZ4duLQMytG2z0iuU",
+	"ZriJ6a6GPur1DWXezdnVvpXVYoJxZkRWGbQrXPPd/srvC5eGMqQ7pZGD1dZn+Gyg9qKBBBKafQj+XmH1",
+	"Q27sCRmZ4NdESevjI0N5fBvf1d8OHe+ek0bg3N8cAMpxfmtsW4FVErzG1S4l4oK7yR0QHLqwT8hEebSl",
+	"qnfLb7FAySN9JOnaec96XWdhnARaPythgppjoTCtwMzI9zqqhGMouSW+wwYTYVdefN+YDqcFF4QOe8CL",
+	"sx017WpfKA/O71yu5vuaKBEq74Y4oYX+vgRKdUxxsLVGS0SGZgp4wF2s+3I8yo5lv66TTg0oEr3cVEZr",
+	"x7SCu0sip5VFOwm9BkeME4KOPnxeqr9IY925DwB5PexuGYfmxkQmUtq7FSp4wSfNXfDfYGr1CuMZ/jYQ",
+	"BnKumB/KG3F7wh8ti7wg35xVIqgMVpo9/oItfSXG0ohM2q5x+FpXRR57XVwJI1feQULcuD3BHfvwxICk",
+	"O7PxKry1sJeRPUw3kScIYbNFf2ehMrBzk1ye4r4eWyToNy6jsHnKgo0xL2QcHw+PHIv7pUF4u07fQHAr",
+	"eCGMl7/z7jchMqsOcmrM5mlbyljkFA3QhM9NDlr1ANejp8gMd6zhTMKtU/mylVa4UZ4jxUEbceT0wtEd",
+	"8MD0wjFmWMhhMnqIB57tlRV9PA+6v47pQw1uU3NjJ9JwDqa0dsspKa3T0T/QHXNqE0Gg0QlDUNkvj38h",
+	"czEKrYcPcYKHD+e+6S9P2p9Baj58mI7l/lDZtENqERzDz5vkGJ9stVcqDQOYeDr9+Gt/hoYoahghhJ8n",
+	"0S7CHB33VOzo64p8WH2FHKv3JiIk1HzjfcdGRLKAcj1RivY/DWUVo/pNA2XUOnsBgnj3vlvERfEgTkUo",
+	"YaXFsm9/97VzPyz5AwQUL9gXkwTrQTUUuhsACZPAtTV5NFVU7m5Cpbt+grewrshcWWWk210A/YNRR/49",
+	"mXP92zpfjs9WWz/LePXO6UuhQkniJrtOZYMC+a3mBapc9FqkBHNaFyfsmxsOKdm8kPrzg+V/ic/+9DR/",
+	"9Nnj/1r+6dHnjzLx9PMvHz3iXz7lj7/87LF48qfPnz4Sj1dffLl8kj95+mT59MnTLz7/Mvvs6ePl0y++",
+	"/K8HcAYAyARoqMJ4Nvt/F+fFWi/OXz1fvAFgG5rwUkJKottbtJ6sNKCPRM1QCootl8XsLPz0fwfpdpLp",
+	"bTN8+HXm61PPNs6V9uz09Pr6+iTucrrGiLmF01W2OQ3z3M47FD9/9bx2JiRXD1zRxqJ5MmtY4Ry/vf7m",
+	"4k1IAFLnApw9Onl08hjG16VQvJSzs9ln+BPung2u+6lnttnZ+9v57HQjeOE2/o+tcEZm4ZO95pCj5OQf",
+	"FE0MP109OQ3a8ul7r+Pcjn07jd9/T9+3girzPT3xofb0vY873NM6Nkqd+mDSqMNEKMaanS71zQFNhY0a",
+	"D6OCd2h7+h5vgYO/n/qynemPeBunPXAa0hKlW7ao9B6iem+7PTJ4I0FWBG5Jvui9Rn/Lxqu7sq3EH80I",
+	"QTqQJ1PIYYNxuSAbgHlrJn+eo+xxX9e9v607Y0wzuaUgDz959ChsXH/zixbg1PPrjA6bviIRuGcxNY49",
+	"dGBW8dJu9EDY00iSEArJp1uSd4drSDOQgGQpBnLsRPTFVnOmVSZCOO1JOs7MEz/pL9lK3BMtGcVa+56H",
+	"pwkaIW+EAraae+9MadsEx4+sLCr6PcbcR1IMvhetB55RIvyw1VmMIjwyYgVx7vicXXP6a6V9ypg5y7US",
+	"TBu/iGllHpNDjLviNE9iNao+xqup+hch6ysHFYJZzVbcjKX/uLzaO+dlpzTNfafuJg2ImS2sRV/dvL2d",
+	"J6Bs5Ak/RJDczmdPHz0+SCiMWtJblZ4SoD5XmHYPjmBGKgZC8PTDQfBSD9CntWsBrM8fPfpwYD1XThjF",
+	"C4YtSdfBsKY+U/6oLpW+VqElbNtqu+VmN3rEJJE+CWmI4cnVyCvKWKm0irJLqvXsHQYej0Q82q4I7PIh",
+	"Clx8DoE9gaFRzRbmRtRHRMjYhXbEugXZIZtkJzScjFLfB7kaaqi0UnWFsX2YWUIcMhkmgaOAdWStjLNh",
+	"GrHmJi/iJPdRy5AsjNMxeN01r4VxbNwLaGIhGZdrGqJrozMAW9OSqqfymNJYJdgInsMzIz2bUvY9ZwMH",
+	"SOtDPURCdbhw3HxUHj4qDx+Vh4/Kw+HKw5NHjz8KhY9C4aNQ+CgU4hvFB1Scv+I581rov8Zt5o94bbjw",
+	"2XLveUuITWFVefq+Ge2W4ClEKh/3txKcJOLJQX1lfKkBKPwVVHnKDIA+r03Lnj57vtRen6Ug4Do2Ynb2",
+	"cyJbJDRkYSQ0ioPFt7FZt2Zq9haGI0SLWD+6tNo3Ty8/P1p8+e794/njR7f/AU8r/s/PP7udmEWg0dDZ",
+	"RS3gJjZ8d09NvucIE3EJLlJduTlRd4xWYjgs1i9VZyBWE2P8pa47fEq4fZRIf0CJdE6bvyWR/GLf22gx",
+	"IG98yvBxedOzDeCJHOwS9GzAMnThCgXMC6wSYQSPKtX4pGeYgA+HsDvrxBZLnEFDvfIhIEY4I0Wryo2i",
+	"olBjV/mPoi807Pk8c6qlyT3tOys5Z0KSwYYbpvGfk/Wvc7YNTNFWyk4YlgtpEjC5BHNIWGeehyWUrr/K",
+	"ub5W1OYkrMU/K2F2qcVYwKCzeAV6IjKFNGpyhLNUmcE0CH387bxOXFenKtIKHf76xq7GlEfOoFr5xIpN",
+	"Vv0+eXlW1zLFJMR1U+hd5zOKTX1+5A3HprgS3WWgPL2FzsXsDItW7iViHopkN2ScHr5r3Q7flIHzJvBY",
+	"Lg26U2JIwdauSzDqBYdsEhlzRBHZpRTG37Z84O3KeQOi/xVvDycMfRub0PZaPDSzcSMmcBYBsMilGWWq",
+	"31KJQMl7DCWiPdCRlYhDbR1/fIw/qk3/Dhe5Q9Umf4vLxbJan3pl4xSLfE7ybGiX07V1hU9pvGjC9A3d",
+	"ApvzOlXYHBP4qWxXnwHS1NUt2/VBz+o/oCUGEIcnJZUzemaRii212zRzRznz0dRGlSixeFw44HuFIpMe",
+	"F1FxTnvcx5Ka2IcWP90fV4kjTzUBdZeytx4nH3fqMV5qJ9D50L0L2s2pdUbwbeOj5H/uOU0VIl8Lc2qr",
+	"six2/Z93Kkv+2B+oVZRw4OfT960/225diP/pkqtpsoZjPb+6/FhcQU3YE3Ye/ouq0JIrJXLGK6e33Pnk",
+	"jr4KHNCaaG8FEF+DoomRm8RunZobayMoB1lTJtoEOdtodIZRWbJi106u6v0PewLFF4k7sjAJxJyYPx5B",
+	"2O+Uy9V0MTK+Sh9FyDFEiGfuLm0PExvN7mt5qQ4abikrtZ++X8QQ5RgGdVOhcJv5EJs25/+ollzh6bXH",
+	"ZnGkSocJU0cTdjRs55h4IWqD/MN3/we6WIXQi5fasa+QL/+oO2wPg9/bEgnSPrVpcmm9ThCX2GdvvOWQ",
+	"GbGqLBXtUV6rJQMAV76aeTjs/KBY6bFbYLK/Fb/6I27EebKYN1csr+pKBOG9/IQ962QL9yfeM9/2gtoF",
+	"E1WUkdw3mDOqmJ03CdybIqidGqhDJpcAWMvgspUK8iDNzh4l3nXva32ZdOanT+8Bzv9oHvgDyrOEvDlU",
+	"R7CbyoFRESZOC7WLUmSSF6D88jWpyXWUjtMsDNDUKWc/NC8l3pTOOF7OdeWaMCroXKftrWMWcYfajQ+a",
+	"X8NW31RUPA9nIfMpj5wugijov594yF7qXPRFYGojexhb+7hemN9iH/etdLcHLp/jTlDmiyjcZ1q8Sp0y",
+	"oxssbuOKmytt5rXRJ6Q+8NVryHNlHn8Jcr4pZIoyNZqPmt+hVG78jNAtAetzKF9h2VeCbuBO1imEbPcd",
+	"jt/7fHYNw8XZZ/2DB/njoq2/E/2fYjNIotevv/mBjo72bTIq4n7IrbJDxL33y9Y0h3gsTebRjyfYv0WA",
+	"wwHrfW9BGdm79gtML2e8uIpADT+t0QvBezOmqk2T8LoeTdAhTaeA8n4B9lOourtXxZ9eETqhyYcSqMN6",
+	"/AeUWr9R2eumzPNxqoIPVJH100wVgw3ntXNKfZSI/1pmkvo2n2tho+rznWWZID/+HYT53bn27nK9935B",
+	"Ps/dv0/B/Rx9z9G0v8ArRb+zE7xAaspCdH7NpeXWiu2y/8XsTBU9lbSKbyR/PUW5PfixG8Kf+tp7GEo2",
+	"ojj3gUahQFf43OT5iPNm4MFSZ8z4+R2IdSvMVThzmjQQZ6en6Cyz0dadok9NO0VE/PFdveLvw2kTVv72",
+	"3e3/HgC+UvxVnkcBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -32,7 +32,7 @@ type ServerInterface interface {
 	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
-	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	GetApplicationByID(ctx echo.Context, applicationId uint64, params GetApplicationByIDParams) error
 	// Get box information for a given application.
 	// (GET /v2/applications/{application-id}/box)
 	GetApplicationBoxByName(ctx echo.Context, applicationId uint64, params GetApplicationBoxByNameParams) error
@@ -114,6 +114,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -202,8 +209,17 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationByIDParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetApplicationByID(ctx, applicationId, params)
 	return err
 }
 
@@ -229,6 +245,13 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
 	return err
@@ -548,234 +571,298 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3PcNpLwv4Kauyo/bjjyM7dRVeo+2U6yuthel61kby/yl2DInhmsOAAXAKWZ+PP/",
-	"/hUaAAmSIIcjyXKc6CdbQzwajUaj0c8Pk1SsC8GBazU5/DApqKRr0CDxL5qmouQ6YZn5KwOVSlZoJvjk",
-	"0H8jSkvGl5PphJlfC6pXk+mE0zXUbUz/6UTCv0omIZscalnCdKLSFaypGVhvC9O6GmmTLEXihjiyQxy/",
-	"mHwc+ECzTIJSXSj/xvMtYTzNywyIlpQrmppPilwwvSJ6xRRxnQnjRHAgYkH0qtGYLBjkmZr5Rf6rBLkN",
-	"Vukm71/SxxrERIocunA+F+s54+ChggqoakOIFiSDBTZaUU3MDAZW31ALooDKdEUWQu4A1QIRwgu8XE8O",
-	"f54o4BlI3K0U2Dn+dyEBfoNEU7kEPXk/jS1uoUEmmq0jSzt22Jegylwrgm1xjUt2DpyYXjPyqlSazIFQ",
-	"Tt5+95w8fvz4a7OQNdUaMkdkvauqZw/XZLtPDicZ1eA/d2mN5kshKc+Sqv3b757j/O/cAse2okpB/LAc",
-	"mS/k+EXfAnzHCAkxrmGJ+9CgftMjcijqn+ewEBJG7oltfK2bEs7/WXclpTpdFYJxHdkXgl+J/RzlYUH3",
-	"IR5WAdBoXxhMSTPozw+Sr99/eDh9+ODjv/18lPyv+/Pp448jl/+8GncHBqIN01JK4Ok2WUqgeFpWlHfx",
-	"8dbRg1qJMs/Iip7j5tM1snrXl5i+lnWe07w0dMJSKY7ypVCEOjLKYEHLXBM/MSl5btiUGc1RO2GKFFKc",
-	"swyyqeG+FyuWrkhKlR0C25ELlueGBksFWR+txVc3cJg+higxcF0KH7ig3y8y6nXtwARskBskaS4UJFrs",
-	"uJ78jUN5RsILpb6r1H6XFTlZAcHJzQd72SLuuKHpPN8SjfuaEaoIJf5qmhK2IFtRkgvcnJydYX+3GoO1",
-	"NTFIw81p3KPm8Pahr4OMCPLmQuRAOSLPn7suyviCLUsJilysQK/cnSdBFYIrIGL+T0i12fb/fve310RI",
-	"8gqUokt4Q9MzAjwVWf8eu0ljN/g/lTAbvlbLgqZn8es6Z2sWAfkV3bB1uSa8XM9Bmv3y94MWRIIuJe8D",
-	"yI64g87WdNOd9ESWPMXNradtCGqGlJgqcrqdkeMFWdPNNw+mDhxFaJ6TAnjG+JLoDe8V0szcu8FLpCh5",
-	"NkKG0WbDgltTFZCyBYOMVKMMQOKm2QUP4/vBU0tWATh+kF5wqll2gMNhE6EZc3TNF1LQJQQkMyM/Os6F",
-	"X7U4A14xODLf4qdCwjkTpao69cCIUw+L11xoSAoJCxahsXcOHYZ72DaOva6dgJMKrinjkBnOi0ALDZYT",
-	"9cIUTDj8mOle0XOq4KsnfRd4/XXk7i9Ee9cHd3zUbmOjxB7JyL1ovroDGxebGv1HPP7CuRVbJvbnzkay",
-	"5Ym5ShYsx2vmn2b/PBpKhUyggQh/8Si25FSXEg5P+X3zF0nIO015RmVmflnbn16VuWbv2NL8lNufXool",
-	"S9+xZQ8yK1ijrynstrb/mPHi7Fhvoo+Gl0KclUW4oLTxKp1vyfGLvk22Y+5LmEfVUzZ8VZxs/Etj3x56",
-	"U21kD5C9uCuoaXgGWwkGWpou8J/NAumJLuRv5p+iyE1vXSxiqDV07O5b1A04ncFRUeQspQaJb91n89Uw",
-	"AbCvBFq3OMAL9fBDAGIhRQFSMzsoLYokFynNE6WpxpH+XcJicjj5t4NauXJgu6uDYPKXptc77GTkUSvj",
-	"JLQo9hjjjZFr1ACzMAwaPyGbsGwPJSLG7SYaUmKGBedwTrme1e+RBj+oDvDPbqYa31aUsfhuva96EU5s",
-	"wzkoK97ahncUCVBPEK0E0YrS5jIX8+qHu0dFUWMQvx8VhcUHiobAUOqCDVNa3cPl0/okhfMcv5iR78Ox",
-	"Uc4WPN+ay8GKGuZuWLhby91ileLIraEe8Y4iuJ1CzszWeDQYGf46KA7fDCuRG6lnJ62Yxn91bUMyM7+P",
-	"6vxlkFiI237iwleUw5x9wOAvwcvlbotyuoTjdDkzctTuezmyMaPECeZStDK4n3bcATxWKLyQtLAAui/2",
-	"LmUcX2C2kYX1itx0JKOLwhyc4YDWEKpLn7Wd5yEKCZJCC4ZnuUjP/krV6hrO/NyP1T1+OA1ZAc1AkhVV",
-	"q9kkJmWEx6sebcwRMw3x9U7mwVSzaonXtbwdS8uopsHSHLxxscSiHvsh0wMZebv8Df9Dc2I+m7NtWL8d",
-	"dkZOkIEpe5ydBSEzT3n7QLAzmQaoYhBkbV/vxLy694LyeT15fJ9G7dG3VmHgdsgtAndIbK79GDwTmxgM",
-	"z8SmcwTEBtR10IcZB8VIDWs1Ar4XDjKB++/QR6Wk2y6ScewxSDYLNKKrwtPAwxvfzFJrXo/mQl6O+7TY",
-	"Cie1PplQM2rAfKctJGHTskgcKUZ0UrZBa6DahDfMNNrDxzDWwMI7TT8BFpQZ9Tqw0BzourEg1gXL4RpI",
-	"fxVl+nOq4PEj8u6vR08fPvrl0dOvDEkWUiwlXZP5VoMid93bjCi9zeFed2X4OipzHR/9qydeC9kcNzaO",
-	"EqVMYU2L7lBWu2lFINuMmHZdrDXRjKuuABxzOE/AcHKLdmIV9wa0F0wZCWs9v5bN6ENYVs+SEQdJBjuJ",
-	"ad/l1dNswyXKrSyv4ykLUgoZ0a/hEdMiFXlyDlIxETGVvHEtiGvhxdui/buFllxQRczcqPotOQoUEcrS",
-	"Gz6e79uhTza8xs0g57frjazOzTtmX5rI95pERQqQid5wksG8XDZeQgsp1oSSDDviHf096HdbnqJW7TqI",
-	"tP+ZtmYcVfxqy9PgzWY2Kods2diEq7/N2ljx+jk71R0VAceg4yV+xmf9C8g1vXb5pT1BDPbnfiMtsCQz",
-	"DfEV/JItVzoQMN9IIRbXD2Nslhig+MGK57np0xXSX4sMzGJLdQ2XcT1YTetmT0MKp3NRakIJFxmgRqVU",
-	"8Wu6xyyP9kA0Y+rw5tcrK3HPwRBSSkuz2rIgaKTrcI66Y0JTS70Jokb1WDEq85NtZaezJt9cAs3Mqx44",
-	"EXNnKnBGDFwkRQuj9hedExIiZ6kBVyFFCkpBljgVxU7QfDvLRPQAnhBwBLiahShBFlReGdiz851wnsE2",
-	"QXu4Ind/+End+wzwaqFpvgOx2CaG3urB5+xBXajHTT9EcO3JQ7KjEojnueZ1aRhEDhr6ULgXTnr3rw1R",
-	"ZxevjpZzkGiZ+aQU7ye5GgFVoH5ier8qtGXR4+XlHjonbI16O065UJAKnqnoYDlVOtnFlk2jxmvMrCDg",
-	"hDFOjAP3CCUvqdLWmsh4hkoQe53gPFZAMVP0A9wrkJqRf/KyaHfs1NyDXJWqEkxVWRRCashia+CwGZjr",
-	"NWyqucQiGLuSfrUgpYJdI/dhKRjfIcuuxCKI6krp7szt3cWhatrc89soKhtA1IgYAuSdbxVgN/R06QGE",
-	"qRrRlnCYalFO5V4znSgtisJwC52UvOrXh6Z3tvWR/rFu2yUuqut7OxNgZtceJgf5hcWs9XFaUfOExpHJ",
-	"mp4Z2QMfxNbs2YXZHMZEMZ5CMkT55li+M63CI7DzkJbFUtIMkgxyuu0O+qP9TOznoQFwx+uHj9CQWH+W",
-	"+KbXlOzdBwaGFjieigmPBL+Q1BxB8/KoCcT13jFyBjh2jDk5OrpTDYVzRbfIj4fLtlsdGRFvw3OhzY5b",
-	"ckCIHUMfA28PGqqRL48J7JzUz7L2FP8A5SaoxIj9J9mC6ltCPf5eC+hRpjk34OC4tLh7iwFHuWYvF9vB",
-	"RvpObI9m7w2VmqWswKfOD7C99pdfe4KovYlkoCnLISPBB/sKLML+xDpitMe83EtwlBKmC35HCxNZTs4U",
-	"SjxN4M9gi0/uN9bD7yTwC7yGp2xkVHM9UU4QUO83ZCTwsAlsaKrzrZHT9Aq25AIkEFXO10xr67LZfOlq",
-	"USThAFEF98CMzppjveP8DowxL73DoYLldbdiOrFPgmH4TlrvggY63FOgECIfoTzqICMKwSjDPymE2XXm",
-	"PIS9G6mnpAaQjmmjKa+6/e+oBppxBeQfoiQp5fjiKjVUIo2QKCeg/GhmMBJYNacz8dcYghzWYB+S+OX+",
-	"/fbC7993e84UWcCFd6s3DdvouH8f1ThvhNKNw3UNqkJz3I4j1wdq/vHec84LLZ6y28TsRh6zk29ag1fm",
-	"AnOmlHKEa5Z/ZQbQOpmbMWsPaWSceR3HHaXUD4aOrRv3/R1bl/l1bfiCsryU0G8dOz39ebE+PX1PvrMt",
-	"vWF76ok8RMdFHRaxcLdRKdG1huTMvG+loJkREKK6fVwkXyaVc6aKgrNWBpy/u3NI+bYVyDcWBjKHlJbW",
-	"K9lxbQdB7R6qZhF5sbW7bRRGFzJSPV7m2l7aIVaXUpQFUdW2WyrQVMOnUTXXQ8eg7E4c+AbVH/vcg8wz",
-	"Md9ew21tByISCgkKeWuoXlH2q1iE8TeO+aqt0rDuaqBt11963mdve985gueMQ7IWHLbRkFPG4RV+jPW2",
-	"/L2nM960fX3bwnMD/hZYzXnGUONV8Yu7HTC0N5Vf3DVsfnvclvEhjDxC5RrkBaEkzRmq3gRXWpapPuUU",
-	"H/fBYYv4D/hnTL+657lvEtcvRdQ/bqhTTtF3pHryR/niAiJ8+TsAr/VR5XIJSrekxAXAKXetGCclZxrn",
-	"Wpv9SuyGFSDRiD+zLdd0SxY0R+3UbyAFmZe6yVwxQEJplufOEmKmIWJxyqkmORiu+orxkw0O5y2JnmY4",
-	"6AshzyoszKLnYQkcFFNJ3M/he/sVXdDc8lfOHQ2jVe1nqzs349dRFFt8+9cRmP/37n8d/nyU/C9NfnuQ",
-	"fP0fB+8/PPl4737nx0cfv/nm/zV/evzxm3v/9e+xnfKwx9z3HeTHL9yb4vgFCo618rwD+40pTteMJ1Ei",
-	"C03ELdoid4346wnoXlOtoFdwyvWGG0I6pznLqL4cObRZXOcs2tPRoprGRrTUCH6te4pjV+AyJMJkWqzx",
-	"0td41zUoHiiD1hwX+4LnZVFyu5WlchYl9AP3LhpiMa2CoWwShEOCkTIr6v2L3J+Pnn41mdYRLtX3yXTi",
-	"vr6PUDLLNrE4pgw2MSnbHRA8GHcUKehWgY5zD4Q96o1ijeLhsGswzzO1YsXNcwql2TzO4bx3rXutb/gx",
-	"t26v5vygbWjrVM5icfNwawmQQaFXseDohqSArerdBGjZ6wspzoFPCZvBrP1azpagvF9MDnSBQbpo3xBj",
-	"ogWqc2AJzVNFgPVwIaOepDH6QeHWceuP04m7/NW1y+Nu4Bhc7TkrQ5D/Wwty5/tvT8iBY5jqjg2ps0MH",
-	"QVARLZTz8294chhuZlNC2JjCU37KX8CCcWa+H57yjGp6MKeKpeqgVCCf0ZzyFGZLQQ596MALqukp70ha",
-	"vVlbgqANUpTznKXkLJSIa/K0kfjRZyPNl8I8HNtG7a786qaK8hc7QXLB9EqUOnGhxomECypjRgNVhZri",
-	"yDZRwNCsU+LGtqzYhTK78eM8jxaFaoecdZdfFLlZfkCGygVUmS0jSgvpZREjoFhocH9fC3cxSHrh49RL",
-	"BYr8uqbFz4zr9yQ5LR88eAykEYP1q7vyDU1uC2joKy8VEtfWVeLC7bsGNlrSpKDLHqWBBlrg7qO8vMZH",
-	"dp4T7NaI/fK+rThUvQCPj/4NsHDsHceCi3tne/mcMfEl4CfcQmxjxI3aYnrZ/QqiwS69Xa2Iss4ulXqV",
-	"mLMdXZUyJO53pkolsTRCljdjK7ZEV0GXdWMOJF1BegYZJgCAdaG300Z37ynhBE3POpiyiTJsLAdGc6Nq",
-	"dw6kLDLqRPGWQslgWIHW3lfxLZzB9kTUweD7xNE2wzpV30FFSg2kS0Os4bF1Y7Q337njoK6rKHx0JIbJ",
-	"eLI4rOjC9+k/yFbkvYZDHCOKRthhHyKojCDCEn8PCi6xUDPelUg/tjzzypjbmy+SV8PzfuKa1I8n5zkT",
-	"rgajKe33NWDWHXGhyJwauV24hDE2dDHgYqWiS+iRkEPt+sgAwYZGHgfZde9FbzqxaF9onfsmCrJtnJg1",
-	"RykFzBdDKviYaflL+ZmsAccqUAnmgXMIm+coJlWOZZbpUNmwctjEVn2gxQkYJK8FDg9GEyOhZLOiyuey",
-	"wZQ//iyPkgE+YSjuUAKG48DVJ8jrUym+Pc9tn9PO69KlYfC5F3zChfBpOSJ5gpHw0bs4th2CowCUQQ5L",
-	"u3Db2BNKHRZcb5CB42+LRc44kCTmNUSVEimzyYjqa8bNAUY+vk+IVQGT0SPEyDgAGw2TODB5LcKzyZf7",
-	"AMldWDP1Y6NJM/gb4hEY1o/WiDyiMCyc8R6Pbc8BqHM1q+6vlsMjDkMYnxLD5s5pbtice/HVg3TyAKDY",
-	"2or6d6bxe33i7IAG3l4se63JXkWXWU0oM3mg4wLdAMRzsUlsCFZU4p1v5obeo67FGBAWO5g248IdReZi",
-	"g+4WeLVYV9YdsPTD4cEIXvgbppBesV/fbW6BGZp2WJqKUaFCknHqvIpc+sSJMVP3SDB95HI3SKJwKQBa",
-	"yo463ah7/O58pDbFk+5lXt9q0zo5kI/aiB3/viMU3aUe/HW1MFXaA6dCeAupkFm/nsIQKtNV/tauesFl",
-	"nzV8Y3RihIFcskfN14Z/QnR3rscroAFPPc8AIl7YmKMOJN9uCmGkWxuTZBNUOKRYOVGCDbVUVmelGF/m",
-	"UHluRtEUW7D3SfIYt0uuE075AcfJzrHN7XnkD8FSFHE49nmpvHX4GYCi55TXcKAcfkVIXJKKQVg+9tPH",
-	"m7ZoHz0oTfeaZmqU4K0Vux0M+XStmV2bqYIc8PWcNF4byVnMxn16+rMCFM3e+W6Blg8TsFC+vRf4bElY",
-	"MqWhtjYZCdZj+qb1+BTzvgmx6F+dLuTCrO+tEJU8ZxMLYcfGMm98BejzvGBS6QRNddElmEbfKdQ+fWea",
-	"xh8VTa8wmwKVZfFLFKc9g22SsbyM06ub94cXZtrXleygyjkKJowToOmKzDFlb9RXdGBq6048uOCXdsEv",
-	"6bWtd9xpME3NxNKQS3OOL+RctG66IXYQIcAYcXR3rRelAxdoEOLb5Y7BA8MeTrxOZ0Nmis5hyvzYO/2r",
-	"fKBxnzBnRxpYC7oG9TrnRhxyrB+ZZep1tv5oMC4XOmkoPyLoqhQ8StMzG1DW3GC+rHQqcbcp+64eNbRr",
-	"u2NAPn48vns4JwQnOZxDvtsJmiLGvQIHPSPsCOh6QzCcwPt47JbquztQI6xaaRvGKLV0pJshw239NHL5",
-	"8+q3NRKswZ2LfB9tvTMSmqe3mr67pruiSDLIIRpn9vcgkIwWBWaL8I1jAT1mMMYz2MTBsZ+msZz6XeV9",
-	"ybi2+VevK7Vja5zxyw4TII5BQWFT9e2fPrL/jRnsUojm/kX1EGVlHBhkxDh49bILqpG0qa/nGqdFwbJN",
-	"y+5pR+3Vjl8LxvCCcoPtwEBAG7EIRgmqmfiyVubZ9OuNvFOzUZg5aaanDGWacCqmfPGQLqKqCOdduDoB",
-	"mv8A259MW1zO5ON0cjUzaQzXbsQduH5TbW8Uz+iGZ81mDa+HPVFOi0KKc5onzpjcR5pSnDvSxObe9nzD",
-	"0lqc6518e/TyjQP/43SS5kBlUr12eleF7YovZlU2x2bPAfHFCVZUV/o5+xoONr9KDBgaoC9W4BLBBw/q",
-	"Tsba2rkgOIrOIL2IewPvNC87Pwi7xAF/CCgqd4jaVGe9IZoeEPScstzbyDy0PZ67uLhxd2OUK4QDXNmT",
-	"IryLrpXddE53/HTU1LWDJ4VzDaSqX9tqDIoI3naXM69gNL0hqa4p5pu1FpAuc+LlGq0GicpZGren8jmG",
-	"2HDrJ2MaE2zc8542I5asx+2KlywYyzRTI5TaLSCDOaLI9LmL+3A3F66MVsnZv0ogLAOuzSeJp7J1UFF/",
-	"6izr3es0LlW6ga01vh7+KjJGmGu5feM5mWtIwAi9cjrgvqi0fn6hlfXJ/BC4H+zh3BfO2LkSBxzzHH04",
-	"araBCqumd81oCX1nyS2vf3NJn3vmiJbQYipZSPEbxFVVqOGLRIf67NIMPVp/Az4ipKy25NSVwOrZe7e7",
-	"T7oJLU5Nh8QeqsedD1xwMM2tt0ZTbrfaVrRp+LXHCSaMIDmw49cE42DuRN3k9GJOYzmAjZBhYArMLw27",
-	"uRbEd/a4dzYa5hJ+z0jgN1a1ZTbxRwGyDtzuJhG7pMBgpx0tKtSSAVJtKBNMra9PrkRkmJJfUG4LI6E1",
-	"Ao+S620e+F4hdCEkpu1RcRN/BilbR5VLp6c/Z2nXnJuxJbNlgUoFQd0ZN5Ctp2apyNXuse50NWqOF+TB",
-	"NKhs5XYjY+dMsXkO2OKhbTGnCqxSxXtu+C5mecD1SmHzRyOar0qeScj0SlnEKkEqoQ6fN5Wjyhz0BQAn",
-	"D7Ddw6/JXXTRUewc7hksuvt5cvjwazSw2j8exC4AV/9riJtkizDINU7H6KNkxzCM2406i2oDbNHGfsY1",
-	"cJps1zFnCVs6Xrf7LK0pp0uIe4Wud8Bk++Juoi2ghRee2YpjSkuxJawn3Bg0NfypJ9LMsD8LBknFes30",
-	"2jlyKLE29FQXlbGT+uFs+TKXD9zD5T+iP1Th3UFaj8ibtfvY+y22avRae03X0ETrlFCbqylntaeir1JA",
-	"jn0qOEyQXuVFt7gxc5mlo5iDjosLUkjGNT4sSr1I/kLSFZU0Nexv1gduMv/qSSQpfDM5Md8P8BvHuwQF",
-	"8jyOetlD9l6GcH3JXS54sjYcJbtXR3YGp7LXcSvuotPnJzQ89FihzIyS9JJb2SA3GnDqKxEeHxjwiqRY",
-	"rWcvetx7ZTdOmaWMkwctzQ79+PalkzLWQsbyu9bH3UkcErRkcI5++vFNMmNecS9kPmoXrgL95zWeepEz",
-	"EMv8We59COxj8QneBmjzCT0TL2PtaVp6GjJX1OyDL5xxFhBb83SX3eMq1ZAanfeBynPocdD1KBEaAbAt",
-	"jO33Ar66iiEw+TR2qA9HzaXFKPOZiCzZl9CobDwuYjKit+q7QMwHw6DmbqgpaZYruHmPGm8W6Xp2mC8e",
-	"VvyjDexnZjaIZL+Cnk0MSqlEtzOrvgfOZZQ8E5uxm9ri3X5jfweoiaKkZHn2U50bpFWpRlKerqLOInPT",
-	"8Ze6pma1OHuYowl+V5Rz643Q1U3gK+UX/5qJvLf+KcbOs2Z8ZNt28Ry73NbiasCbYHqg/IQGvUznZoIQ",
-	"q820C1VYX74UGcF56myy9b3eLboUlMb4VwlKx+5F/GBDC1CjvjBUbCtUAM9QjzEj39ua+CsgjVyBqD+w",
-	"WZog83UCrKmnLHJBsykx45x8e/SS2FltH1sZzlaGWNprt7GKfv/cfRxth3xrryOiz6xaaUzdqTRdF7EU",
-	"JabFiW+AeVBC6xI+rEPszMgLq9NQ/sVsJzH0sGByDRmppnNSNdKE+Y/WNF2hsqDBUvtJfnxJE0+VKigj",
-	"XJUDrLJH47kzcLuqJraoyZQIIzlcMGVLocM5NLOiVCmCnBjgs6Q0lydLzi2lRKXioRRWl0G7B856QXoD",
-	"VBSyFuL3lF6cm/qeFV7eYa9oNst2uZhO/WCbY6Mq8/bKV4CmXHCWYi7J2NXsyqqPsc6OSLsZjwxw/jZq",
-	"Ejlc0SI1VbCGw2Jv2RrPCB3iuuah4KvZVEsd9k+N9btXVJMlaOU4G2RTX2vJaagZV+CygWOF/YBPCtmw",
-	"eCOHjDpR1HLynmSEwdk9KofvzLfXTiGFUYtnjOPT08dI2ABJq0PGqs/avFeZJkuBERTuUIRr+tn0mWGy",
-	"lgw272e+SjSOYQ3GZtnWO6I71JH3lXC+Cabtc9PWJtSrf27EwdlJj4rCTdpfiSsqD+gN70VwxOZdOXoF",
-	"yK3GD0cbILdBJye8Tw2hwTm6SEBBXGhMT1WqVhCMEVotRWELYv2jo3m0om6iLxmHuoZ55IJIo1cCbgye",
-	"155+KpVUWxFwFE87AZqjX0SMoSntjGJXHaq1wc6ftEgnfo7+bawLavUwjqpBLbhRvq1KpxvqDoSJ5zSv",
-	"nIQi5bFQqnJClAuuaRbMijEOw7h9Qs7mBdA9Bl2ZyHbXktqTs89N1JeqZF5mS9AJzbKYPuEZfiX41acr",
-	"hQ2kZZXFuyhIipn5mqkKu9TmJkoFV+V6YC7f4IrTBRXoItQQVsHzO4yO1/Mt/htLYd2/M849aG8fe+8L",
-	"lFXhc/vIzc2ROlKvoelEsWUyHhN4p1wdHfXUlyP0uv+1Unoulk1AbjhB2RCXC/coxt++NRdHmL+rk5fd",
-	"Xi1Vei10BxW+bjA+G6vEME2u5KNOO3MGmZeHFRD9FUanePn1xLUEul5q71dr1+6Lbkl7g7GodvkTNCWD",
-	"LKg3Jt36ldnoc4QirtPv8yWzrmTmc6f3OMmwI2fj2IMI9U6KXYB+8B7QpKDMOW3UzKKLWRfu1a8uHDp0",
-	"9Qa3F+GCqHo1dj+c9wU8+ThgG9nRqsl4Bi6pUiHhnInSu0N4fzn/JLS/upr4QVxx7/q7fjM41edVg/Yq",
-	"bU9c/R+7TPcm/+En611JgGu5/R2ocDub3qloGctZ3Khn6YSrqL5Jj70rX1RFMc/Ok7XIhgKmf/iJvPC2",
-	"pVH3jifkWLolkbkqctFg8ZeuBIRvZqTP0dO+cp2OimJ46p4I8e7ktuG+0/elmjLnc0jr9safX1sHNFQh",
-	"RN4qQTgzh43uKf7Ujoa9AAKbAjDXbRDY3J89YyxBuSBHfK0mOVAFAxgOs7a5tiORfLJ5adqPC7aPV2Lt",
-	"Tzlbp5lF5lkIxeriPLESrSNdjk+wympgMeyO5f39ziHVWJGp9mOSAPsk0DWTBeW/b1PP9ihKKs9sT/8D",
-	"aWank5C3RAMV3fGidYoctKqhyTWSqt62iTB715mZQ1LC1A9hfljQXMWrovU6u7YynwQOK5FEz/GFHWcj",
-	"sn275UwDHwiWDSMyHglgnb//mMi0fu3Xi85Oza7hV0Un8UKQPMSWVprt4UBSeVGjZIj7tQTuKsMvYqjZ",
-	"HRW1WECq2fmORBd/XwEPkihMvSYYYVkEeS9YFWWDCUX3t3PUAA3loRiEJ0jsf2Vw+mJEz2B7R5EGNURr",
-	"PU29cH+ZXJKIAby1jOBRCBXzUrSmK+c4xlRFGYgF7xVsu0Odlbu3Smwg51xyLk+STYlnYMp4mcpRc5mu",
-	"e2UCw4CRvlwY3TJ3/RqPF1hVUFUV3H0uylAvSI4jhaBcLktMS1JZa31WS1D+N5+DyM6SszMI69iibRxT",
-	"KLgWUWWv1yMnA3JSJ/o7Wr0Kc2f5mVkdw9GN943kgEbvpzQXWPmpL9ypGTZRuXndUdY5FMUUrESFcC1A",
-	"unrfeDPkQkGihXetG4JjCBXWA/ZSSFC9dRcscL3ZUN/W6V6x/oxNlkGd42u4QCJhTQ10MkjK2j/nELKf",
-	"2+8+wNXn5Nqp067oNdmZVdVH7zDVQWJI9QvibsvdgbOXUW8zzkEm3tbd9inkBpWh/bWQIitTlwgmOBiV",
-	"CWB0wrIBVhLVDKfdVXaUfDlmA38ZpCE4g+2B1b+kK8qXQXq1EHor2ts1BJnLWrt9rZr/uJIzX9oFLK8F",
-	"zs+pPZ9OCiHypMfgetxNNNs+A2csPTNidln7vfcU2iR30c5XedRcrLY+sWpRAIfs3oyQI24jjbxzTbPS",
-	"UWtyfkcPzb/BWbPS5n52iv3ZKY+HbGBSH3lF/uaHGeZqCgzzu+JUdpAdaUw3PUluJb2IlJ3t+tONdndp",
-	"lwKticpCEZNSLpmqa9T57ir3I6QfVEEcfv2EmfxqL2ZpbUQoLdWVIZvCy6va9DOuHqPvsAO8UFkTVGT0",
-	"3MiB85ldjV9VSAmW0ksJjeXv0v+4BdZ8KdgihVGTZpk2AbF1U2vuS6DcU88rnVkcz13VGqbtExxz/nZV",
-	"cgpthjYNa0A45lzKc5rfvFoN8zkeIT4ge9sv8ITv3xDJFpXqcv5+L+mouYO37vVNzd+gGvDvYPYoaux1",
-	"QznjT1UJ05vIMMU9zUku6rrIOCS5wDGtdfjhV2TuougKCSlTrBVgfOGrmlTPPSzy5XwsN3rH+3LXOn8S",
-	"+gpk7B4IoiCv6woJWuD9UENYH9HPzFR6Tm6UymPU1yGLCP5iPCpMZ7PjujhrmI1txZmWP6SQcM3m48AR",
-	"bE/zcTdRz9jlWROpuXRKBd11jr6tG7iNXNT12sb6PnSRO5RGf4zLQrw6humOPhMWIVhahiCo5NeHvxIJ",
-	"C6wdKcj9+zjB/ftT1/TXR83P5jjfvx8V427MW8LiyI3h5o1SjDOmdUJhYFMw2ZP0761j7u7CRvMdwQ4Q",
-	"z86ZQ7QaDE7t/UZvOBU0ytw7Ffx2aa7xLn4WoMwvuZoohvuf+mIXrH9+T5hM6yyULM92HcpG0FNd+RbD",
-	"en5xAbmfpfbuL1aX3WWTrv7hPj5y7QOAiImstTF5MFUQzjQiksl1i8QtIXGlpWR6i3nCvOqT/RL1qfm+",
-	"spY4K3CVWcbJHVqcQZVprratlMpLNt8LmqMsYN4z6KGohchn5NsNXRc5OCb1zZ35f8LjvzzJHjx++J/z",
-	"vzx4+iCFJ0+/fvCAfv2EPvz68UN49JenTx7Aw8VXX88fZY+ePJo/efTkq6dfp4+fPJw/+err/7xj7gAD",
-	"sgV04rNSTP4HC1QnR2+OkxMDbI0TWrAfYGtrYRoy9lU2aYpcENaU5ZND/9P/8dxtlop1Pbz/deKC3icr",
-	"rQt1eHBwcXExC7scLFGZmmhRpqsDP0+nDOfRm+MqPMz6QuGO2sgfQwq4qY4UjvDb22/fnZCjN8ezmmAm",
-	"h5MHswezh5jLuABOCzY5nDzGn/D0rHDfD3wS4cMPH6eTgxXQHG3i5o81aMlS/0ld0OUS5MyVGzU/nT86",
-	"8GLcwQenSP5oRl3G7KY20C2IbupW4XRGKfQWtoFsjapWyqWYnla1zpyeh2cYf2R1s4bFV8g6zuow8uOa",
-	"Ufl0Zzb/6+HPEYemBVuWEpVHdXh25arpCiEyRf773d9eEyGJe06+oelZ6LuFBPmvEuS2JhjHysLEpb4u",
-	"lYsEWqtl0XSbr1l65GkRLWeKM5t9Dii1sunUnAitzmER6IqvGl75IPn6/Yenf/k4GQEIGhgVYFqbX2me",
-	"/0ouGFbFRCtNM7RdTSM1mPBpMq1tBNih3qYp+v1XX8Mym1WbZrTZr1xw+LVvGxxg0X2geW4aCg6xPXiP",
-	"iVeQEvAQPXrw4Nrq81YBljZ6oBrFk8QlBupyGPupqvN7IWlhD5pPuIDhqqhX8AvFqsRPrnGhTffoKy+3",
-	"PVxn0c9ohqUPQWm7lIdf7FKOOdr4Dccn9kb7OJ08/YL35pgbnkNzgi2DrGbdW+RHfsbFBfctjTRTrtdU",
-	"blFWCeqztoK36VKhuhhZpD3bzZT47z/2XmkHYcG5gw8NM3F2pQuvU2vz+MWOO/CO6uOc3ZzArXp2Lgu/",
-	"zdGBhkRXtA8LqKl7M/J92Bu5N6bYsQlsSsmdo5LTTbHM8GH3IPGZCGvY7qjQ/yh6Iwe699vL+ZNezkdN",
-	"tVAjqWwMmAaJD8LU8SO56u3YDcC7jjIJQdm4SyTk/6Q1UVsvQzvT+9jDbScXvsVdD+76ZKAA3kocalYx",
-	"+/R81we8VNdE4z74hFz5C5foXtHc0Emw3FYyAJtp+VbS+9NIepVr4dKKXq64wNVkP4ywOfjgs2dfg7zn",
-	"soePkPQa6eDqvkF257stdnJv5qpHBm0uxzOcL+FOGQ5zmt9Kb59aeusWA4iBUad4/3wS21VyJjYK+e6V",
-	"cvALFdH+xMjqlclc1tEd0tgleGNH0nKc+JPxzD+khOWQditb/allq8p9/0rSVaOchwsICaxLV9K7tfVq",
-	"TFdiVjOEI+BsGFJiGIo7wtO69JhhMZhzy6dbUVP/7EPLpn0R2s2adh6FXfnpewhfn8+2xy92iU5fkBJn",
-	"dO7HyC0Q35tPzUujBoO3N2MwGMebnjx4cnMQhLvwWmjyHd7in5hDflKWFierfVnYEEc6mNus1ENcibfY",
-	"EjKKOtt0wKOw3EyY0do6Stx1dcrDLCH3ZsTnvlZVjRkXrr8UhkH5HFxULm0nw+MMEsgd/+chjn9nRr4T",
-	"kjCu1RR97bQrQELuMK4PHz56/MQ1kfTCurK1282/enJ49M03rlmdg9++bzrNlZaHK8hz4Tq4u6E7rvlw",
-	"+D//+N/ZbHZnJzsVm2fb1zat4O+Fp3afdeHG9+3WF75JsVe6S/e4E3U3YnB/JjZR7i82t7fPZ7t9DPb/",
-	"ELfOvElG7gFaqScbYcDXeAvZY7LPPTT1mcMN36kukxl5LVxGhjKnkgiZgXRFuZYllZRrgGzmKZUsMPQa",
-	"I9DTnAHX5sGIZYZkolgGNpB1WUrISM7WWIdbwjmGCOD0+JZvQLCb0aNT7++Wyb+imyBKe15d01q4JWPM",
-	"+5pufKEzLOUjJP70zTfkwbR+teS5GSCpEBNjrmu6mdygtq8itlHu982KDzt9ZHHsMZqjWvqxNSVpM738",
-	"n5tzf7ESuyV3t7HXxDn3tubU1ppQf+DyHgxqDqxgZ8ugYV2uLaniko2U50WoOIszM4xVCvyObQM7VdLR",
-	"x2cbvbeH+PbxfyVW0iaoPdkGBt2qgw9oywh5RufcYtDgH8gGGhiEpFh7i5AgC9DpygUjt/Aa4T2+mEQ/",
-	"4xkqcnvdIgtuUTeXeZjrEIuvjkxSEMSJolUOZIRC/+bzOpvPbIGpJqpCIb6WM9qbmC9vWFU2dPVfmfLu",
-	"9T5m2eziXlA+ryfvSluIluswat4ieD8Edzjft75YGWLMLeKP4IDv34kJeS3qkHhXJ+OPaE/8lNf2p17Q",
-	"a8HBGs6NWGtp8dZGWskUqJ9HpPhcKPZxUmUsv7R8ceDL7g0KGX+1Re8GBY0xt7eZ7Iu8wv8aLbXeuGXM",
-	"2mY7A6Pr0cYwZ9PQ5ltuZlr+jE+Uz8JPf4fvls/BsW6GxeAh9XzGiQX8epkOpheyxHxQJTPt40DxvOWj",
-	"uZEWlW9ZNNX4HHLBl+r3yYqGqCOOlwiVVBnd42nb/3xn9zlmLuLCJwl1uawU4ynYspJYEYcpsmZKOQ/I",
-	"Jw/+cnMQarb2+f94GEr6mbnL0wePb276dyDPWQrkBNaFkFSyfEt+5FUJ0KtwO0z+XeWW86reaB0CNCU1",
-	"c56lYYKmyzPBhj/aB71h2cfdzDDIT7gnH2Q84INhzklaFEDl5RngbrvUSWvG4xehy28jJ3WVLSwCikHR",
-	"nl7v/zEZqXfCKHSxcJdfyS2gPrOZYxPOH1csppXni5ECxOKQnPL7RK3o04ePfnn09Cv/56OnX/Vozsw8",
-	"LiFRV3dWD2Q+22HGKNB+v7q+6xXJK+Qd3vRW7rdD0wnLNtEEtHXxk/BcOMcc5BN3FCnotjdvdbGjeEs4",
-	"bF3I5eazNCrN5qvo48m/bapaxsf8WfXEtakEXc2T26ItPeEOARMxhFZXb6mwPlzIZUBUbJFlVZngpl+e",
-	"dViAvcU88mTrQvmsUqz+XC/QBB+gwL3U0kTL5xMYMUnyNDBUV9Xh0eukLAohdXW61WyULAd9BreGKNdH",
-	"uHtJainV6aosDj7gfzA91sc6VMDWYw0sdO53W5HuwNrfh4S4d7bFFe/ElrRsrf6yyZx8pjbnEyAW5BVL",
-	"pTjC3NvuulFbpWHdrSNku/4yVJM/ejUJnjMOyVrwWJK3v+HXV/ixt8xaX2csq9bXt102qAF/C6zmPGM4",
-	"41Xx+zt5Z19JP9RarQRzjOuCSZb+9zxq/tBsedo9SVuedo9Zo2pTz88HHxp/Ou8b11KtSp2Ji6Avvu4s",
-	"LxpjeA8Sf49XilcPnlYCbUUyUIZovzwNVICH2ImpvkayfwXp3XsTgP1JdVILxrMWkaBEmYpzkKrSVkjv",
-	"KHOrmPrjKKZG7/tePNamstzF0Up1vRLJa5GBHbeZPTYW6MlFBi7jZlcQqWSw+Hvf30p1u9YLLKXlcqVJ",
-	"WRAtYm+9umNCU8tkbV03tasQlm3lC76cA6G5BJptyRyAEzE3i24WFCRUoZN7VTbRSprxek41XIUUKSgF",
-	"WeIDW3eBVuUxxeelHsATAo4AV7MQJciCyisDe3a+E84q77oid3/4Sd37DPBaUXAYsda1NoLeysPHSXtd",
-	"qMdNP0Rw7clDsqMSiBcNUL8l1kUOTsMVQeFeOOndvzZEnV28OlpQBcQ+McX7Sa5GQBWon5jerwptWWDB",
-	"7UjFOfv1hK1REuOUCwWp4Jnqrwu5iy1j7ZNgLcqsIOCEMU6MA/c8OF9Spd86S0ZYPiuosWKmGChk2Zdj",
-	"3oz8U5VhvjN2au5DrkpVpaF3CgzIYmvgsBmY6zVsqrnQlOTHrjQkWpBSwa6R+7AUjO+QpcLKlDqwAWEF",
-	"lO7iMBsJdQqKLiobQNSIGALknW8VYDe0T/QAwlSN6KrcXJNygjrFSouiMNxCJyWv+vWh6Z1tfaR/rNt2",
-	"icsVdcB7OxOgQu2Vg/zCYlZhuMWKKuLgIGt65hRcS5etqQuzOYwJWp2TIco3x/KdaRUegZ2HtCyWkmZY",
-	"sZBGVCk/2s/Efh4aAHfckyeWg03msIhWVMEi+xUly14VUTW0wPFUTHjE6rGKpOYILrDEjycQ13vHyBn0",
-	"lK49CcrpueY4V3SL/Hi4bLvVPWopM4bZcUsOCLFj6GPg7UFDNfLlMYGdk1p70J7iH6DcBJUYsf8kW1B9",
-	"S6jH32sBbW1eeH81LooWd28x4CjX7OViO9hI34mN6Q+/yHC8ttn2EzqcNfWnwftvdpm37cEFZTpZCOkK",
-	"ctOFBhlR5bXKCFCmfbSfNaBo4dwhCI7grk03jivsXKfMcEzEgkB8LVG2jmTgMVN9J+SokJ2m7xplmpRc",
-	"szwIW65eyr8/feGtDuBWB3CrA7jVAdzqAG51ALc6gFsdwK0O4FYHcKsDuNUB/Gl1AJ8rTC/xAof3b+aC",
-	"JxyWVLNzqOL3btMG/aHCWqqryuskUItxQZl2STgJ9WIAfrlaVJ8GmiMOWG7LJgvVm90Iq1grUcoUSGog",
-	"ZJwUOTVPA9joKiVcM9moT3/s6lhj/lKq4PEj8u6vR95Bf+UcyZtt7/ryxUpvc7jn8jJUxU59ggbgBuku",
-	"PwP1V4JPHecS6bEciDLo/RZbv4BzyEUB0vr+Ei3LiMbnBGj+3OFmh8KnUc7SjPbrtKFncmhb0yKo149r",
-	"pYpQDOZoVaNc0Fz1l6O0461pEcveVl18VhWE3OSZyLatE2J27QA3sHk2ajd9xqncRuJvOieiQxpaGH7l",
-	"CKury/p47cEkXaLtktkuCotJ6xJU9BwPUXk0iqLasM5QNpJn0aKTaC3mdujApAJwjAOsoWe/J+St7fd5",
-	"49ARInfEamb+u/EbbLasmAa2NY8Ix3q+1KBxj/jo6cWzPzWEnZUpEKYV8fEou6+X6WSTmJGWwBPHgJK5",
-	"yLZJg31NGrdQxhRVCtbz3TdRyD9dvmJ3+Zgvw/fU57lGXgSLG+LJIdFsEseAe7jzVsNo3lxhC0d07DnA",
-	"+Kdm0X1sNASBOP4UUyq1q8TsyfTqaba3jO+W8QWnsSURMO7i99pMZPYJGZ/cypL387xvN5CWBrjwJN9F",
-	"7Tya5GCjG3bNDOblcol5lzs2OrM0wPGY4J+JFdrljuWC+1GQHbzKxXnVDFHt4brcJYhVuyskWUpRFvds",
-	"gSm+RWPGuqB8602+kCi2LnOLQ5vV7noZrQ2x6zoCoDnW6f76tNpvvMov0N26q7b5u0ULuaCK2P2FjJQ8",
-	"c5FDnUDcDR+f89kOfbLhNZsezPps1xtZnZt3zBXhd9mFuFRm7gJkojfcHqhmYnYb8GtP7uw23+yf49p4",
-	"Ywu59TDYbvBqzRCu6faQAV/D6yPIP1KHwjWrZNkafn2BI2EyEtvyWp1HOsM3fUiCCnrWRgp5QagvBpAK",
-	"rrQsU33KKdpogoXNuv4lXhvdz9+e+yZxM2HEiueGOuUUc8VXlpson1tAxEzxHYBno6pcLkEZXhkSyQLg",
-	"lLtWjJOSm5eWWJA1S6VIbBiqOUNGPpnZlmu6JQuao5HxN5CCzM3NHuy6VRgrzfLcObSYaYhYnHKqSQ5U",
-	"afKKGS5rhvOJwipPLtAXQp5VWIinr1gCB8VUEle+fG+/YoYIt3yv5EOFpf1cR3bfbGoIDzvLeiE/fmHg",
-	"ppjpJmdK1z4QHdhvzP69ZjyJEtnJCohzCWvTFrlrGK8noHtN65BewSk3N5wWBLk61Zcjh7aZp3MW7elo",
-	"UU1jI1rWIL/WUU+8a+EyJMJkbk0rf6DAzIAOvPkSNx6ryLT3fk8zymBhythXly6sp5F7JID/bE8R3vFm",
-	"WZCWkukt2iFowX45A/P/9x/fm2/y3JsoSplPDicrrYvDgwOsOLkSSh9MPk7Db6r18X218g/e2lBIdo45",
-	"qt9//P8BAAD//0vKaWunRwEA",
+	"H4sIAAAAAAAC/+y9e5PbtvIg+lVQ2q3yY8UZ23HyO5mq1N6JnYc3tuPyODl7fnFuApGQhGMK4AHAGSm5",
+	"/u63ugGQIAlQ1Ixs5+G/7BGBRqPRaDQa/fh9lstNJQUTRs/Ofp9VVNENM0zhXzTPZS1Mxgv4q2A6V7wy",
+	"XIrZmf9GtFFcrGbzGYdfK2rWs/lM0A2bnYX95zPF/lNzxYrZmVE1m890vmYbCoDNroLWDaRttpKZA3Fu",
+	"QTx5PHs78oEWhWJaD7H8XpQ7wkVe1gUjRlGhaQ6fNLniZk3MmmviOhMuiBSMyCUx605jsuSsLPSJn+R/",
+	"aqZ2wSzd4OkpvW1RzJQs2RDPR3Kz4IJ5rFiDVLMgxEhSsCU2WlNDYATA1Tc0kmhGVb4mS6n2oGqRCPFl",
+	"ot7Mzn6aaSYKpnC1csYv8b9LxdhvLDNUrZiZ/TyPTW5pmMoM30Sm9sRRXzFdl0YTbItzXPFLJgj0OiHP",
+	"am3IghEqyMuvH5FPPvnkc5jIhhrDCsdkyVm1o4dzst1nZ7OCGuY/D3mNliupqCiypv3Lrx/h+BduglNb",
+	"Ua1ZfLOcwxfy5HFqAr5jhIW4MGyF69DhfugR2RTtzwu2lIpNXBPb+KiLEo7/QVclpyZfV5ILE1kXgl+J",
+	"/RyVYUH3MRnWINBpXwGlFAD96V72+c+/35/fv/f2f/x0nv23+/PTT95OnP6jBu4eCkQb5rVSTOS7bKUY",
+	"xd2ypmJIj5eOH/Ra1mVB1vQSF59uUNS7vgT6WtF5Scsa+ITnSp6XK6kJdWxUsCWtS0P8wKQWJdMaoTlu",
+	"J1yTSslLXrBiTrggV2uer0lOtQWB7cgVL0vgwVqzIsVr8dmNbKa3IUkAr2vRAyf0xyVGO689lGBblAZZ",
+	"XkrNMiP3HE/+xKGiIOGB0p5V+rDDirxaM4KDwwd72CLtBPB0We6IwXUtCNWEEn80zQlfkp2syRUuTsnf",
+	"YH83G6DahgDRcHE65yhs3hT5BsSIEG8hZcmoQOL5fTckmVjyVa2YJldrZtbuzFNMV1JoRuTi3yw3sOz/",
+	"5+L750Qq8oxpTVfsBc3fECZyWaTX2A0aO8H/rSUs+EavKpq/iR/XJd/wCMrP6JZv6g0R9WbBFKyXPx+M",
+	"JIqZWokUQhbiHj7b0O1w0FeqFjkubjtsR1EDVuK6KunuhDxZkg3dfnFv7tDRhJYlqZgouFgRsxVJJQ3G",
+	"3o9epmQtigk6jIEFC05NXbGcLzkrSANlBBM3zD58uDgMn1azCtDhYg86XExDR7BthGdg68IXUtEVC1jm",
+	"hPzgJBd+NfINE42AI4sdfqoUu+Sy1k2nBI449Lh6LaRhWaXYkkd47MKRQxNKbBsnXjdOwcmlMJQLVhAu",
+	"LNLSMCuJkjgFA45fZoZH9IJq9tnD2dt9Xyeu/lL2V310xSetNjbK7JaMnIvw1W3YuNrU6T/h8heOrfkq",
+	"sz8PFpKvXsFRsuQlHjP/hvXzZKg1CoEOIfzBo/lKUFMrdvZa3IW/SEYuDBUFVQX8srE/PatLwy/4Cn4q",
+	"7U9P5YrnF3yVIGaDa/Q2hd029h+AFxfHZhu9NDyV8k1dhRPKO7fSxY48eZxaZAvzUMY8b66y4a3i1dbf",
+	"NA7tYbbNQiaQTNKuotDwDdspBtjSfIn/bJfIT3SpfoN/qqqE3qZaxkgLfOzOW7QNOJvBeVWVPKdAxJfu",
+	"M3wFIcDsLYG2LU7xQD37PUCxUrJiynALlFZVVsqclpk21CCk/6nYcnY2+x+nrXHl1HbXp8HgT6HXBXYC",
+	"fdTqOBmtqgNgvAC9Ro8ICxDQ+AnFhBV7qBFxYRcRWImDCC7ZJRXmZDaP7cl2A//kRmrpbVUZS+/e/SpJ",
+	"cGIbLpi26q1teEuTgPQEyUqQrKhtrkq5aH64fV5VLQXx+3lVWXqgasg4al1sy7XRd3D6tN1J4ThPHp+Q",
+	"b0LYqGdLsB0tmFM14GxYulPLnWKN4cjNoYV4SxNcTrDEvJ03ZNCamWNwHN4Z1rIErWcvr0Djb13bkM3g",
+	"90md/xwsFtI2zVzQijjK2QsM/hLcXG73OGfIOM6Wc0LO+32vxzYAJc4w1+KV0fW0cEfo2JDwStHKIui+",
+	"2LOUC7yB2UYW1xtK04mCLopz+znkNcTq2ntt736IYgIf+jh8Wcr8zbdUr4+w5xce1nD74TBkzWjBFFlT",
+	"vT6ZxbSMcHu10KZsMWiIt3eyCIY6aaZ4rOntmVpBDT2Z9fGNqyWW9NgPhR5TkbvL9/gfWhL4DHubGn8v",
+	"B5sExy0qgxeEAq7y9oJgR4IGsPBGko29vRO4dR+E5aN28Pg6TVqjr6zBwK2QmwSukNwefRt8KbcxHL6U",
+	"28EWkFumj8Efcmv/ww3b6An4PXaYSVx/Rz6qFN0NiYywpxAZJgiqq8bdIMITH0ZpLa/nC6muJ316YkWQ",
+	"1p5MKEANhO+8RyRsWleZY8WITco26AFqn/DGhUYffIxiHSp8wwRTR1Oz7Smks71KiHvA8x2IFrTSaxlV",
+	"O+YzppRUCXCMaims5FtSXrKCrJopRYTsfFbSBSvjwIJ1xFZzIkXOiFPHotCA+EyjspawvBi+ca82DV7k",
+	"imrS9ERTsma5FIUmmovcNmeVzNdxeoyQN5gCtpo7XY/rLsHxI6nK2v4ezlzKN4uugAyG1ibKta+688NW",
+	"Z+EUrxQ3XKwyOCDm5Irav5ZSZQ7NAt91lVvEKK2NNLTM/BTiSLQGymaqMLRhgnBhZH+ygA5YKhjRkiyp",
+	"ik/aDvzmcu+Yb9guwycGUlGubj50X+MOmc2vxRShCFhqQ01tpWKIRrBqDXgiBSnYhoJ9qiMtLgx9BzJT",
+	"GxqIuhvIzC6gY8tMual4yY4gJtdRFRFMip88IBffnn96/8EvDz79DJaqUnKl6IYsdoZpcttZcog2u5Ld",
+	"icsjMLTFoX/20L9ZdOHG4GhZq5xtaDUEZd9CrAC3zQi0G1KtS2acdYPgJK5loPdZshP7zAeoPeaaas02",
+	"i6MsRopgRTtKQRwmBdvLTIdOrx1mF05R7VR9jBO5OTkHC1wpaWQuy+ySKc1l5GH1hWtBXAt/hlT93y22",
+	"eKjB2PhQVAu8fsSk+FZM1xIt6Fdb0dJmVE+0843Mzo07ZV26xPfvDppU8Gi9Bcm4qFcdu8lSyQ2hpMCO",
+	"KDK/YeZiJ3K0wR+DSdMH/oYLfBDUO5EHFh5YqJIVK6aOasnpU8Vb8+1Qt3QEHSDHU/yMRsDHrDT06Led",
+	"/gAx3B/5hbTIkgIa6hh6F0YxunnnSNphvhJG7aL2CjjAGN2ArMUro33ON2vGlZ+DNYXamSDjPeWrtQlu",
+	"1i+UlMvjzyQ2SmwO+MHqHCX0GVonnjNzJdWbF4ypY1xCK8bUdPESDL5XtFjIU7WtXArBcpCE2C9YOaPo",
+	"cslzO3lZsAvUy46gVLXAWpkFezOUVHQha0MoEbLwGmFc3Uo4Y+HU0HnFhBqcWVs7y4KBQMhpDUsN72Iy",
+	"dgK0HTOaWwJnlsH36de2lR3OOvqUitECbLlMELlwD8Tu6RonSdGvxHiFxSl7UTU/wKtSMmdag6Z96HUD",
+	"DwMzQidEHBFuRnFXgBsje8gVRZPb3/2o73wAfKfc47BNjLyNmY+LBNbThh9juP7gIdtRxYgXC8RI1E9L",
+	"ZliKhAfRJLl+fYwGq3hzslwyhe/x75Tj/SA3Y6AG1XfM7zfFtq4Svr3uwvqKb/C1RlAhnQEoCqyk2mT7",
+	"xDI0CueiYQaBJIzbwrRJGeueUm2sDwkXBZq+7XGC42AfHCKNcPJiAZB/9HeKIexcCs2ErnVzwdB1VUll",
+	"WBGbAzgepcd6zrbNWHIZwG5uMUaSWrN9kFNUCuA7YtmZWAJR0zy1Oier4eTwQRLO+V2UlB0kWkKMIXLh",
+	"WwXUDf0bE4hw3RLaMg7XPc5pnCrnM21kVaElKqtF0y9Fpgvb+tz80LYdMhc17bldSAajG4+Tw/zKUtYq",
+	"VGuqicODbOgb0D3QsGGdXYY4w2bM0MKajXE+bMsLaBVugb2btK5WihYsK1hJd0OgP9jPxH4eA4Ar3l5g",
+	"pWGZ9WKML3rLyd5pbAS0RHgRoflcEvxCctiCcINsGcT13gO5YAg7JpwcH91qQOFY0SXy8HDadqkjEPE0",
+	"vJRgQybYxmLsBPoUfBNkaCBfnxLYOWtvDv0h/sW0G8C3ucYgO6ZTU2jhHzSBhFHUBX8E26Un3XsCOCo1",
+	"k1JsjxhJ7diEhfYFVYbnvMKrzndsd/Rrb3+A+K29YMY+RAUf7BW4CvsT637Xh3m9m+Ck2+4Q/cGVNzKd",
+	"kmvUeLrIv2E7NJ3A1flLenyvEgc3db1eUE9SxpT31jkJEDrKgzY9wFLZILzvJZuK6VYEmht+ibPFh5tw",
+	"ssxPF/3q0aiExpYfpTmOJWXE5hiogNQYphuFgTmDVAWYxN/SLqU5xELTm9he8nrB4YaZSmjb3LJUMIVW",
+	"HcllWbLc6NZLWdsgztgaHIP8iEWGWBzChD1M9tKrM8whT4mOQvogEr1qXaO/umTwnvhODK2J0fYZWZsY",
+	"lbYfYdBRJ+ZwDKtdBCrhNuwMFsw7xrOiG3HDtjQ35Y5QXIAduWKKEV0vNtw4V4UuOxlZZSGA6JvsyIjO",
+	"XcmGf3hWnOI/dYGggukNWdI/sI/j96pnAumQw1k9KinLCe8dA2JEMZjk2UoqCavOXQicj5Pyh2YHSaef",
+	"ljuPrlOKQzLjDMi/ZE1yKtC4VBvW3N6kwisR9MURuA7GdD6sLYVYyTbM2szwy927/YnfvevWnGuyZFc+",
+	"bvTu3SE57t61m0Bq09EjjmGzp8o8iRw1+FiNKr6dWV992u9D6SBPWckXPeB+UNxTWjvGhenfWAD0duZ2",
+	"ytxDHpnmP2q2E2cezCc6b1z3C76pS2qO8eLOLmmZyUumFC/YfgXADowynJbfN90wJpblwKM5y3KM5JwI",
+	"i72CPjb4c58VrHVZ45sNKzg1rNyRSrGc2bMCLre6wfGE2EiHfE3FCm0aStYr52pv4aCkhuBgjNisxQDE",
+	"UH7FJWvNhbExaGYrspWSdRUT6y72ygezwn2QUTBJBcuOna0B5oo2yLCiI+0nUtYD/QZgpp7t57OkxQ7V",
+	"sdZiZynXjciNa5YYYpzpOs8Zi0bkxWxhzVR7mUfaWHIHEO5ztbIhCYTmpqZluEcg7JWKXTclCeWlBpnN",
+	"NcF20LnVjuZ2bj5efElLzYKZhQHM4b7uXMWDlW9J2ifFxId9ZBJQgIecEXInCINGvzy66haqrkMshwMH",
+	"MRDtx1QYBBhGy90RlDYLiChWKabxiA0fFLT9KpdhngF3BuudNmwzfHO1XX9JSKGXScueFCUXLNtIwXbR",
+	"1DpcsGf4Me3YmOiMCleqb99c1MG/h1Z3nCnceFP64moHsuhFE/9zhMXvw+09t4cZFvA5iZUVoSQvOeCe",
+	"S6GNqnPzWlA0ZwebLeL56A136QeOR75J/EUl8uDhQL0WFC+8jZE76q21ZBGL7teM+XcOXa9W1gDQkXyM",
+	"vRauFRekFtzgWBtYr8wuWMUUuh+e2JYbugMRiO8xvzElyaI2XZmMgeDagLi0b/8wDJHL14IaUjKqDXnG",
+	"wVcMwHkfKM8zwnp6NFSIHyErJpjmOot7aH5jv2KojZv+2oXdeP9uzbV9LQb4bbT4zrBOppn/9/b/PoMM",
+	"MzT77V72+f86/fn3h2/v3B38+ODtF1/8f92fPnn7xZ3//T9jK+Vx50US8yeP3dXyyWO8P7TPxQPc39tT",
+	"4YaLLMpkoXNbj7fIbSFNw0B3uoZ0s2avBfjpGQnpXnhBzfXYoS/iBnvR7o4e13QWomc493M9UCu/gZQh",
+	"ESHTE43XPsaHTs3xhACwkD7GH1qRZS3sUnot2AZYeOdSuZw3SR9ssrczghkB1tR7Rrs/H3z62WzeRvI3",
+	"32fzmfv6c4STebGNaodsG7tsuQ2CG+OWJhXdaZZQQBH3qB+t9YELwW4Y3NL1mlfvX1JowxdxCeejCJ3R",
+	"ZiueCBveB/sHvSF27pFVLt8/3kaBHl6ZdSwJVEdTwFbtajLW81CDOF8m5oSfsJO+0aSAe5vz6C0ZXRIX",
+	"uqGknBIV3ewDy2ieKwKqhxOZZJmI8Q8qt05av53P3OGvj66PO8AxvPpjNq4P/m8jya1vvnpFTp3A1LeQ",
+	"Wg50kOwhcmu1H7q+i4ZQl/rO5k55LV6Lx2zJBYfvZ69FQQ09XVDNc31aa3iPKanI2clKkjMfIv2YGvpa",
+	"DDStZHbKIGqKVPWi5Dm8fcXY02YcG0J4/fonuLy/fv3zwI1rqL+6oaLyxQ6QQYIvWZvMmaszxa6oij2T",
+	"6yalDkLG3qOjzomDjT86+MTBj8s8WlW6n1pjOP2qKmH6ARtqlzgCloxoI5XXRbj22OD6PpfuYFD0ypsw",
+	"as00+XVDq5+4MD+T7HV9794njHRyTfzqjnzgyV3FJhsykqk/+vYLnLi917CtUTSr6Cr2HP/69U+G0QpX",
+	"H/VlfGoARRe7hTRponIQVDsBT4/0Alg8Do7Xx8ld2F4+N2Z8CvgJlxDbgLrR+ghdd72CrBfXXq5e5ozB",
+	"KtVmncHejs5KA4v7lWlS5q0oF9o7boG1BjaByy64ANMey9+wAi0+bFOZ3bzTXS47iqYXHVzbhIA2Zh2z",
+	"VqGFHxIFVgV1qnjfgrTYEc2M8VEWL9kbtnsl26RXh+QL6qav0amNipwaaJfArOG2dTD6i+8cUAFTWlU+",
+	"CwymA/Bscdbwhe+T3shW5T3CJo4xRSe9SooQVEUIgR1SJLjGRAHejVg/Nj24ZSzsyRfJH+hlP3FN2suT",
+	"e8EPZ/Nq3XzfMMwuKq80WVBtY0SRHjZFSyDFak1XLKEhh48sExOhdB5mEMi+cy960gXvu67j4LyJomwb",
+	"ZzDnKKcw+AKsgpeZnoewH8m+47kXAsx37Qi2KFFNamOVUehQ1XnsEqsx1OIMzJRoFQ6PRpcioWazptrn",
+	"7CzmwV6epAO8w5RDY4nmQoN+kL+0sa97mdvfp4PbpUs353PM+cRy4dVyQpK4+czF08SWQwpUgApWslUT",
+	"Iu/Csbvpj9oFAjy+Xy5LLhjJYn6yVGuZcxRFwTHjxmCgH98lxJqAyWQIMTYO0Mb3aQRMnstwb4rVIUgK",
+	"l76Jetj4sh38zcYyAIDKIysQ4TzxgJR7CUCdc3VzfvVc/BEM4WJOQMxd0pIJ4298LZBBvjNUW3vZzZyH",
+	"xJ2UOjtigbcHy0Fzwh7Xmk2oM3mk4wrdCMYLuc1s8HhU411sF8Dv0WAa6BXdmDaz3C1NFnKLDoZ4tNjg",
+	"jT24pPHwaLQIYMowmDv2S53mFpmxYce1qRgXanK70W1adkmpE1OGTmgwKXa5HSSLuxYCPWNHW1bBXX73",
+	"XlK76snwMG9PtXmbBNXHKca2f2oLRVcpQb+hFaZJ7+ZMCC9ZLlWRtlMAo3LT1KkYmhdsO0x/MjkB3EjN",
+	"jPPubcNfIYYrl3AO6eDTjjNCiMc2WnqAyVfbSmqmXQwyHvUOuNMTFbNJIrS1WcEreMmaWIUomWIT9q5p",
+	"nuJ2ym1iXQ9wmu4cW9zEJX8Ml6qK43HITeWlo88IFold3uIBDW6KiUvGN4rL2zR/vOir9tGN0mnVSwEZ",
+	"3LVipwOwz/A1c/hmqlnJ8PacdW4b2Ru2ixsBGKpmF75bYOXDRJNU7O4ErnuKrbg2rH1t8o49H8KO3/ju",
+	"pmdnKrWE+b2UstHnQg/ncJrvfQYY5bPkCuJJ4KkuOgVo9LVG69PX0DR+qegsNrGlHniR9kEHimUFL+s4",
+	"v7pxv3sMwz5vdAddL1Ax4cI6US2wNEk0OmJkaBtAMzrhp3bCT+nR5jttN0BTGFgBu3TH+JPsi76D+4g4",
+	"iDBgjDmGq5Yk6cgBGiQnGUrH4IIRpPQ4GXumGGymwsPe61/lU6SklDkLaWQu6BqU9NGOOORYPzIr1Nuq",
+	"ZNH0E0KarGP8iJCrMfBoQ9/YEOruAouVHyYerCntvXoSaNd2D0AxHZ7YD84pwVnJLlmZonMQio8U9wYc",
+	"9IywEND1xiUVdH4J+7X64Qq0BGtm2scxyi0D7Wbs4ba9Grk84e3dGhkWaGe1zOmvd6CheX5r+Xv4dFdV",
+	"ELfLopHV/wzcRWlVoYesbxwLYQVgHNwJ4ujYTwf7+B4rhX0PzvRph4nep5AA1Tl9jTT56TtmsEohmdOT",
+	"SjClH3FcECPw5mbXaqcD7ksc47SqeLHtvXtaqEnr+FEohgeUA7aHAgFvxGL2FdOddQ+MebbMVCe/7skk",
+	"yrzqpuEPdZpwKK59kcQhoZqcHvtoBSn2vmO7H6EtTmf2dj672TNpjNYO4h5av2iWN0pndMOzz2Ydr4cD",
+	"SU4rcG6hZeYek1OsqeSlY01s7t+e37O2Fpd6r746f/rCoQ/vdSWjKmtuO8lZYbvqTzMrW0sgsUF8EbY1",
+	"NY19zt6Gg8VvEqCHD9BXa+YKXgUX6kFljta5oIXnH6SXcW/gvc/Lzg/CTnHEH4JVjTtE+1SHnXseEPSS",
+	"8tK/kXlsE567OLlpZ2NUKoQAbuxJEZ5FRxU3g90d3x0td+2RSTjW95XLABwNCpX+a+MZ0RVBt7TjrFOc",
+	"9SkY7xGbk5R5L+JQLlVH+LvwqahnRaPO9QQjfAtgXIN/UaOYdGJJzQJNyGJbnFxPqbMrl3Cd9TUZ+/fD",
+	"E4LcS35d/Uq4Jnfvhpv77t05+bV0HwKS4O8L9zs+fty9GyDdqsNR4wBQAe/+gm7YncbpPbn079eSJNjV",
+	"dJUAaQe9ZJrzm01hvTI8va8c+SCztyVo4X6xOmeUosNNbJ3De8tvCR9iNWX3XqRilBrvv42tGamJFH1n",
+	"VwwEBCbDgwZiMBbMvV8Ot6+oN/jml+mS53FvCLHQINqF9XKDxgQbJ6xhALHmCadJUfMAFjTTE56kekgG",
+	"Y0SJ6SsspWi3kE601IL/p2aEF0wY+KR8Ss/wmMXXD+cXM1SG43dCBxj7BOBvckMIK0L19VV3Yxq7HoQ+",
+	"dQN0Hzc2ez/R5u2YCi+cD3XNDUccHBojbrWOPxw32zCjddc3brIo3lsY3Is8V5oqMUa00DfX2VLJ31jc",
+	"0Iz2+UiIvxsIr0LYe0J0aPsO29Yrb0dPLnfqbhJ8JF134gTX48oHDnRYjMf7klBhl9qGXneiUuIME7TQ",
+	"pxZ+yzAO50HMXEmvoH5F/IoAOAWPpx2vFyOJ7+xpr5sQZDs6Cbw+m7bcJqqrmGqzbwyT3l5T3bfDTlb0",
+	"W70eOnY0+rn11Cu1jICpxRUVhvlia3Yrud6a2dc36HUlFaaZ1HEHnYLlfBM1Db9+/VORD50xCr7itnhx",
+	"rVlQHdcBslXfLRe5CsNN1L0jzZMluTcP6m+71Sj4Jdd8UTJscd+2gBdpnFujTPouMD0mzFpj8wcTmq9r",
+	"UShWmLW2hNWSNFcyWwPEu5ktmLliTJB72O7+5+Q2OthpfsnuABXd+Tw7u/85ukfYP+7FDgBXpXxMmhQo",
+	"Trz1Ls7H6GFoYYDgdlBPora8pWLsN5YWXCO7yXadspewpZN1+/fShgq6YnGf7s0enGxfXE18yevRRRS2",
+	"Lro2Su4IN/HxmaEgnxJxoiD+LBokl5sNNxvnhqXlBvipLX1rB/XgbJF1ezY1ePmP6M1YeWeungnoPeva",
+	"dBPnB4o+p8/phnXJOifU5hYteetn7Gspkic+dTGWcWuqt1nawFgwdVRzYAmxKAoXBs0CtVlm/4D7l6I5",
+	"iL+TFLrZ4rOHkdJ13aIo4jDE3zvdFdNMXcZJrxJs73UI1xciZ0W24SDq77Rx2cGuTLpdRoc1KS+/cdBT",
+	"lTKAkiXZre6wGw0k9Y0YT4wAvCErNvM5iB8Pntl758xaxdmD1rBCP7x86rSMjVSxegTtdncah2JGcXbJ",
+	"iuQiAcwbroUqJ63CTbD/sK4PXuUM1DK/l5MXgUPea4O7Ab7Yhn7F13mr7b7TdnSu2ALih4nvl7g8e18t",
+	"b1KzudP5EKxcl4nYJYwInfD1HsUOuwHf3MQQPNh2VihFo+7UYpz5pYxM2Rf6bF5oXbxzxG6VOkDgAwio",
+	"hQM1J90yae/fH85bMId+WfDF44p/9JH9wMIGiexnkFjEoOBrdDmL5nvgGkrJl3I7dVF7stsv7B+ANAmS",
+	"vGRLplg0VK/5BDSAmQwK2kZff8edGp48Dh/cAeqClRIuZ0YeLjL+RIsAlJmPLEXNy+LHNslSLxO0oiJf",
+	"R73uFtDxF6v4QoNmipZI0dogayqEdesagLMXxl/8xTJy9f23nDrOhouJbfs5qu10e5NrEe+i6ZHyAwJ5",
+	"uSlhgJCq3fw1TXx0uZIFwXHaQhStijWs0h1UR8RqpbF9gx9sjBZ0RqOYLc5HmCjQpHRCvsFMEoBLJ/cu",
+	"mnKapICdMmt1VUpazDFpIzzmEzuq7aOYqZUrDriyGlBnFulAh0MiFsaCFI4RGg2z1gaz/mtDN1Us1xO0",
+	"eOUbEN57pkcbR0idE/LYmpe0N17YQQjm7FQbVpBmOHfBQZ6A/xhD8zU0kJ3TLc3y06taeq5srdrU/z9v",
+	"ONHuO8DbFba0dS3nRIISd8Uh/+CaGnbJuumlPBpeI/PpprrTU7UQllPipaVHcgFeh+weOYTbvAVGMesR",
+	"/sBTwcX7HFjk8wJ7xZhyUDG091jnkxX57KDkmTO85lRIwXPMzRzTkjAVzjQ3gQlprOMhVs5xUc8imyta",
+	"p7SJenNUTFYunc86hBu+1AVfYVEtd9g/Ddu6alcrZrSTbBD67crtuscCLjRzhYSAiUI5KVXEKyGmj7RX",
+	"lgPZCLNcJKw/X8O35842CFuQvOG2rLwjm2Vobs35ELEN3C4IN2QlmXbz6ab60j9BnxPMelWw7c8nT+WK",
+	"5xd8hTCs5w1M27qZDUGde6cz5+QFbR9BW5cTuPm5489hBz2vKjdouhhzVB+ABLApAkf9Dtz7b0DcBn4I",
+	"bYTdRr1F8TwFRoMsz0QbVhEXY5goTNyLJoT7g+UobEFsoEmMKHF/+6dc+Oel+AGRR48EXBjcr4l+OlfU",
+	"5OuOGJrsZtIXaNq498mbguotsHPMr/KZHyO9jG1N5YTgaBq0ihsVO+I3BXB3oEw8gihj7703rJCMWpVT",
+	"olyUYrdmckxwgOD2Vdm7B8BwGwx1Itsd04MfehKlcj4t6mLFDOQTipl2vsSvhBZBrmhIUV43BYCqigBS",
+	"/ZyvQ25zA+VS6HozMpZvcMPhgiLkEW4IC6H7FQZOA6sz/BsrCZFeGedneXCwkneqLJo45EP05i6kgdYL",
+	"PJ1BppHplMAz5ebkaIe+HqO3/Y/K6aVcdRF5z5kex6RcuEYx+fYVHBxhIsSBS6s9Wpo8heg+KvG7T+3R",
+	"ZNjqSiUfvj8Y0y1eZMl6yPuGUcQvaZkIEAzM7tSer9bFIBUmmCejWqlxiWgMJaMiKJncw7r44XeLRfx5",
+	"JeXWZ7364POg9zTNcKBnJ10lG4J6b+8hQt/5UBJSUe78Z1phMaSsc41NW27HNl27wP1JuGjUpPH0u8tU",
+	"5KhPqIDf+2X53zCXna5S7JLL2i1Y47ror4T21yUm4AkTNCTnH3UN/tAW6aT9/JUrHWqn6e7k3/1oHV0J",
+	"E0bt/gDW9MGiP0Xj01jc8COvmTk7lVOuovYmM/WsfGxPWPADv8w2shjLPPHdj+Sxf+abdO54Ro7lrZOF",
+	"K0Adzbrx1JVU8s1A+5w87DPX6byqxodOpNoYDm4bHjp8Kmcf7M8xq9sLv38x5S8JTQiRu0qQF0KwrUnU",
+	"je2nFbhihG0rhknDgwwR6TREUxnKRYvjbTUrGdVshMJh+kvXdiKRX22fQvtpWUv6e8tWovsKREFMyFqy",
+	"e7HZsQrbo5Sj0a+o85jjPPaOKPEIFD2/Bmbu+BGIPb5ltPDegBOU6P5MJ2XsczKyXw4g+WCAE/QIefix",
+	"g+wpX61NMI0Xe3Kmt3nSkfqV1Lytp1oCMLc2awR3MtXrHqbKw0fzISzv8nrJcoNFdFtXPsXYIRngYTD/",
+	"GvYxd3qajZrgBC93RvKkz2ehTI9G2juxRtscb/iwjF4HQ0ZxbSKHrGJNfT0F7+4OBPyARZui7hpJf+9e",
+	"6q7AZytSqSA+sSfFflr66cwDNyBejBMyHgxzbp1n/pLEtKEdxyXnc/ukAgWBIyOSXArBcpiyLefrvFeM",
+	"osslz0+m+0u96sZGUkFkbVYS9yjDRyr7KCUVX3HRa8pFLje+aZReDZ62/nZ8fFBEfJ0h4fJnAY8wDZ54",
+	"XK9tOlniCue7TCTQgVUyX8evnktmq8emFPmVNDbuEwnoWx9md+FCGypylnhfsMeDbWK9jGxGfEysYmuU",
+	"JzzD8Xqf2Sr/8SW7ZIquUG+wOjRAdv2iC0kUKynWgXbSVNpAobCNRioLKqSjdJyyvs+YX5or1k9kxbAG",
+	"bGdx4yECFWZwhDM/M4pXY4oFfLdc489dqg0BAP0ZzJuCpoBNhQEEsFFwuvHpGbpKMI3bXW2ksN0hi52n",
+	"PDF0NTlXXrDDX9HVKwv74CKHISP3anNNiCFtnOz6+zTYP8GCO+K0KO2RXMG8RgkKWppgIRUtjW1l9hPy",
+	"Eo39VEETqmsF54Z/LcelB/LCCUDu33NSglxxUciroSRcU1GUDK9Je6SRR8f2UBi7Jox/827yJLlmEzeP",
+	"BVZ0DLWRVW20ocEwpKLaRaHQPoqpDN8WhI1oVdGHNxh0gca4ZkDUwBqBVTHlKNtROwtZL8rguLWID0bd",
+	"O82xsffMykvL9MQa0h19blNXcQ8G8RlqJsy0NdNBhubrzagZa+JK9UccmcEB63OkeRy8KtNmY+hqFH8v",
+	"/cdlbih/IuIgul0HuynF/jHWHDBSZ7Vji9Qno516TNZ3MrF+x3aj90I6zG8Z5Gi1R/QBmut5E+5qU0qA",
+	"xrhiAj2sil7ap8nJZ5ZLloOWNJ5P9J9rJoJclXPvJ4K4LIP0orxJh4B1Ww73gmoRKuk18Snp8dBJJTZ5",
+	"w3a3NOlwQ7SyepMV5DolO5ACaFsBs2QlNS1Tjm0uwofrhjOQCj5803ZnbfGz2HbH4QIr6DXH8izZtYeO",
+	"DHkpDbvmWND1oITrGNmfSjkKGtyXVEQtmdQZz+zt08I78Nb55MXYbbK5eK6lNrELTeK6Gc3yMFSpMLG4",
+	"aMODLCJh0RKkshQspX1QnVLJ7YUSvCZdAQvFVyvYly4cXODcXs82VNS0fD1rcm0jRso6HrMiKPvOyPmL",
+	"J9EJT7lVw2JpQ+G6efg1uhaGlxMGYNuKK6YPHWDkQmIzUDhC+5l6hOL8ilUAguLfUbSb0i6a5LIsrRUl",
+	"OIL8C2Z3sw5ewliFYYLZFQObb3ooyCyFTYjtxAriipo4D2b7RkqeGLtI2n+2wbC2r3WkLFiu8DGj0VXQ",
+	"iurhO/cdg1YHwxT4zi1lWcor//M0AbRQkhY51YlJydrksnf1psawTYU6lPXCB3QbOP2xz4iQJnN9gCkh",
+	"euQqGxDVf7DTbH+EETKoiw99NRPtHyjOUUvKGFUlZypzTxEKGpp0UeIG2SzhVAhTx092U+fUCt0IBQCZ",
+	"ufM5jA7oyvhnyB4qIR7XjF5y4A8Q6kr7fGEt814xxcQt0zLx3GZhQB7BWECk12R7RLtvfoQR4w+MwULE",
+	"0bbf7Ip3uB/zWLBtzuxRZdlkElOOvDMFug0sgA628l6wFr0pk/F24UZatKswAlrtvX+MrWw72lgJlzHk",
+	"Q/nhp2BHTOd3n4rzGIRs77ugnbCbPcx7yoINHeN7EnggvYYbrc/BgZ98hxnaFewRuqFSb7Ijx1Hg7f/V",
+	"JYuWqXW5/1x+b8O8aiWsIjJW2z+lh/xzvRsUob7C8mz/drKCXfLcPcDYs7s4JH5k8MhrHzZtRaSm2LP7",
+	"sVvX32W6aYqLDPCspCwRWeb8uMUKoTXhMokdsU3dTkLgiWuJ2SaUOSjA6ARZ3AN29Kl9JOBjuGJYhKty",
+	"BvNBYMFJUEmOFpasqB/ZBYWvl9z/zy5oE2LXzWKdMEz4rYB0tBRxXaZxd9pZ8jEzlJfaZTqIczZER8QZ",
+	"NrelIZpAL6+kM+1/83Vg7Cglf8MC7rJhdZjG3rWI+ol7F/Rs5Kl/kIGb8DjSy2Zk3mbiGkbdDnnYxrDn",
+	"pUSxlUpa15XITbD+LW1TfKB+gmcI4rVkSrUcBbBZZmR4aUvhMUYKaHBNIuhk7XuLXLIi5cu25OaG50ra",
+	"ggXUpS8JJ0gU21DATgWFMdNjjhH7kf3ukwz7u9ped/iGX6ech7hYWLy1R8SQ65fEmdL2Jy++jmc8F4Kp",
+	"zIfJ9TNDCKZC5HTjqIQ5G4ON0UQPTFY8R0RJ1Kk8H85y4B9cYkXmp0E24Ddsd2pdN+1p25a4CrG33il2",
+	"DkH1qN5qHzVoIO4fXa7sBFZHwfNDOt7PZ3Cgp65VT4bFPvt74A2HOx3cO5vsRXBPv6WHWsNtDBFqgnGv",
+	"UAsKztU7J4ScC5svzsflhuVGB4PDBWtk/C2OWtS2/q6LCTh5LeKJt9Ayr24o3zyYcakGt+IbD2WBjA/0",
+	"/hSnnroSMJXFIqalXNiAu0e442OaN2ZgDrKTYxwmJS5Qj+hSxlLBXCtNNMCKkyocDTEyTExJUtyg4YBH",
+	"KeCyEOxNdNDkOHB5C7gM8hwMNSYwK2UbqVhWSkxVEHPGXRpQxzbcaIJFb1dEVrksmK3u7Q1W7YBxxxA7",
+	"Vi0ExdOUBZHhveWEhljqD9+FJHF9guqRE4cEwWpjoTI8gfcmcvJkfgV9bGbatphBG15lQ/ISyVWYdvUL",
+	"HJFs4yHKuEqYdLuJLY5uTTu4BXbskUMxiVW5DjLdPFmi6ZJjsHg3IzD2AEUnZ0VjtmxXy0VB+yO8NZH7",
+	"QnHkipelf+ECHlC1ezEJofyga4znx3RwMMRDspHa+KcBhKQbUG2OhNu5FEaBZaZrMrZM4kJDntHteZ6b",
+	"p1K+gcy+d/BuI6RpZlrMfbLUfjaLdiTVK58x9YUOgq9xQfT+u7BtByg42jBMHrpDTxt3+bYlqKVyrsxN",
+	"ZA9e8udES8sPCIpo5laxU4ChyfJvh1uwJabAO8Ay2ZVhwanwDYDcG+sX0GSCiByAjxwbAyp2iDiQlnGl",
+	"+lwQauSG5/Hd9OdKVJFMLxGTjDFS2B7OuI7N0BYankZNXDJK5iGZmYCtE32vtKLdxWdaa1JlXIrBHtzG",
+	"FzR1Eg6PC3eAZ3lSz+ghgJjajKqmVhhp11ECGvkmV9ajEt3h+ohOPMwwiP9muAGEoyNl2I2QGiQOOSaC",
+	"b8c5uSMgUhkQLlr2UdikyXGf2PXR/AXj6QJsDcfF1KQBTUHNied3gEA6jUAHh0nJBA5Fwz7vZdQkVAm0",
+	"isyDm5zLThdA504LwFHC1zuAXSvmcq6jcCOqG8xSUbP2BzU0H9ouwQ7mPFR/Y0raouXzwB2IlWxjE+B3",
+	"LpuysoUvQ3AuEXyNWiw4bLu+uulMCsYqPI/7VpmY+3p4WetdzN3csyDwfAp1ozd1S1i7UmTPNTxhgc/s",
+	"NtFTtxJgdMmLmnbopw9VK7qGJ9jKUxQKj+vP0yTFwUIiPrkxEbE30UetU/tSxPN8hHUIGqM7jlY0nnuW",
+	"CdudrSt6JdImqdg1xd+1Ji4YlyJ8VNuyHHWLbiKLm9OEIDCi+Wr/HMKHRlQexo40t5HwiT4MmOiIK00c",
+	"zOBhOHqQtrx4Mzvr4H6d2Xs0K/bB9dz+g4Xgkz/rc98/vX1Gd08SXuxioxlK3wb74BXEzyPGkrfQ6NWk",
+	"YAGFQdbGmVdx+MhRdUJeSbKhb1j/g5Xa1laoeWFfdBumxfNg1xT0sKe1kRiPAlcieAhHeYN50SDhqWqS",
+	"t44nfxxe89xqNvWhA3I0QIvDgp3iAfWdwVqNbPqAky+2fXQSQfYdhLDNO0cFq+CNY4JN9iMyts06eX4n",
+	"hae38hJyhnx/yZTiRQpTzdC83Sv36a13rm9EebYPt1xHAHDdag+YOI+1idmCZuCSXPDlkikbFaENFQW8",
+	"1wbNuSA5U4ZysMrv9PUMkk98uCi1hkJoTVzroxsj+4MdxSo5zZoIyxmz5zUSp2M8HI5+fWvitJGHauM0",
+	"FDZ0C7PHnGwJLnZVkGBVnT4iBVpGrLw+bBzNf2Pjw2BtQvcUbySOOmWI8c36PZIOdZofBDej29VeaftJ",
+	"8mzUgN1NgRNNY7yzizPcRFUiTq/q5jZsfH5c4h+/1vZN0tsNT8ZSILqbf2IV8VXGJcUM7SJ6usmw8/AT",
+	"kdlOTc1QfdUjqR1a+yXSWrubx+A9vK/3WqKEfqAHXMysyYYWBUfgcfRQL9ekqvU6eLODnj0kJlNtf77J",
+	"rJJVNsnL3ofkW4QcrkO8UolnRvmjea/TTTnhkB+7dYUR3nTOSdc13ncrrPIxdTZ1a0nI0K7NSi5RmuEm",
+	"tnc19FFvbijzfs6u7q2sEROEEsXyWqFd4Yru9ld+z0wcS5/u1EL2VluX4bPF2okGK5DQ7GPxHxRWP+TG",
+	"HpGREX6NlLQ+/mRsHt/Wd/XdTce558QncO5uDoDlOL+1ti3PKhFeo2IXE3He3eQaE0xd2CdkojzaUjW7",
+	"5V0sUPRIH0m6dj6wXjdZGCehNsxKGKHmWChMJzAz8L0OKuEom9wS32G9ibAvL561psNpwQW+wx70wmxH",
+	"bbvGF8qh84HL1TxriBJM5ecUJ3Smvy+BUhNT7G2twRJZQ7MNeMBdLIdyPMiOpR81SacSisQgN5WS0hAp",
+	"4O4SyWml0U5iX4MDxvFBR+8/L9XXXGlz7gJAXqbdLcPQ3JDIlpT6eoUKntJJY5f0HQwtXmA8wz8TYSDn",
+	"gjhQzog7EP5oWaSl9c1ZRoLKYKXJ/c/IwlVirBTLue4bh69kXRah18UlU3zpHCTY1uwJ7tg3TwxIujYb",
+	"L/1bC3ke2MNkG3mCGLZb9AMLlcTOjXJ5jPsGbBGh37iMwuYxCzbGvFjj+Hh45FjcrwVCu3X6EsGt4IUw",
+	"Xv7Oud/4yKwmyKk1m8dtKWORUxZAGz43OWjVIdxAj5EZ7ljpTMKdU/lNJ61wqzwHioNU7MjphYM74IHp",
+	"hcOZYSGHydPDeeDZXms2nOdB99cxfaid29Tc2EPiplNam8WUlNbx6B/ojjm1LUGg0QlBVMmv93+15mIU",
+	"Wnfv4gB3785d018fdD+D1Lx7Nx7L/b6yafvUIgjDjRvlGJdsdVAqDQOYaDz9+Et3hvooaoDgw8+j0y79",
+	"GD33VOzo6oq8X33FOlbvTURop+Ya7zs2ApL5KTcDxWj/YyqrmK3flCij1tsLEMS7990iLIoHcSpMMM01",
+	"ln37xdXOfb/k9xjYeMGhmLS4HlRDob8BkDCRuXYGD4YKyt1NqHQ3TPDm1xWZK68VN7sLoL836vBfojnX",
+	"v2ny5bhstc2zjFPvjHzDhC9J3GbXqbVXIL+RtESVy74WCUaMlOUJ+WpLISWbE1Jf3Fr8F/vkHw+Le5/c",
+	"/6/FP+59ei9nDz/9/N49+vlDev/zT+6zB//49OE9dn/52eeLB8WDhw8WDx88/OzTz/NPHt5fPPzs8/+6",
+	"BWcAoGwR9VUYz2b/NzsvVzI7f/EkewXItjShFYeURG/fovVkKWH6SNQcpSDbUF7OzvxP/4+Xbie53LTg",
+	"/a8zV596tjam0menp1dXVydhl9MVRsxlRtb5+tSP83beo/j5iyeNM6F19cAVbS2aJ7OWFc7x28uvLl75",
+	"BCBNLsDZvZN7J/cBvqyYoBWfnc0+wZ9w96xx3U8ds83Ofn87n52uGS3N2v2xYUbx3H/SVxRylJz820YT",
+	"w0+XD069tnz6u9Nx3gLU6MuPLYQYVL9zfUlVL0qe+yICXFuDpHXjs7ztR0GdstaQRL/ElJnOi0gU6BFt",
+	"A/D0bD5riPWkaCs+P2kFFZLAPy/Ozn6KJLz37qVXgSrZlPKwm4lwTf7PxffPiVTE3dpfgCk7cK1FhvxP",
+	"zdSuZRiLxWw+s/IPOc2F7ToH3I1eVd2ySq1Ijxn2BoT0I8M6twO3amgrifAFMsCklasgK+9ln//8+6f/",
+	"eDubgAimmNLMECPJr7Qsf7Xe8WyLXkHdKtR63tFSyzYKb94GgmKHdpnmaHRsvgbd2zbdaoS/CinYr6ll",
+	"cIhF14GWJTSUgk1ag5cuv4T3KpSklPINqauQezsHZapYpUv1ggA1kSXGp66pCPPBly5sAG08GJ/QBgVI",
+	"QajK1xxs53DxsYYY8hX6Pbt98C3XRqodcUG2Mdo0SQ8aygxuOD/PZ347oCR5cO+eF5/u/h0s0amTGgHA",
+	"SVVI3847UPy+uAagoZi1n1421XkUray0cV9svJN7EbGNTkCaPjziRLs1hG483T64waS/pIVPH2Wncv9P",
+	"O5UnAlPdwbFH7LH+dj779E+8Nk+EYUrQkmBLqxegjBgepT+IN0JeCd8SVLp6s6FqhwqbiUocn5oXniHx",
+	"nLACLki4KFazn98mz/XTYPbwc/tXxosbnfp4itNO8fA9isAtnTo+EJaNc3c/3D6vKozyvmi+n1fVCzgy",
+	"bO5nxvF8Z1uujb5zQr4Je+MRhjJ2wRwmLqv8mjURak2WOpdPsfu6HCSLj6olwTvPRw3lnWoo513bGC+Y",
+	"MOB/rhLIdFh8FKejn45DF+4gq8EBDgot5zf1DWzlowNg2L0yKdVPW+UH92/odcQ1Uaxkl1RMsZimkiZN",
+	"kcIfaZegXUoHCvBt1CHbcMHel9z1ORWbY6JzHrxDqfwn1+ie0RL4JJhur2L2k8cfNb2/labXJNFaWdWr",
+	"qo6g+6Gb/OnvLuvTMfQ9WxJniqYXGg6CvoF/+O2eOLlzQs77ba4nM1zWrL06HLT7qL29c+0NF3Wv3uaY",
+	"9INqbIiDY9q9+gI0/ta1DVUNXyhrb+c/uYr2NyZWUicDTPdrY9eQjQNNy0nidyYz/5IaliPaR93qb61b",
+	"NYkqb6RdhS7Upy71afDEdiO7W9+uxk2jZoWfOpKtqXDgtvC89ccHEWMd2p0ru577ax98cjdCu1jzwaVw",
+	"qD99w8Lb55e7J4/3qU7v0Yhz6DtPnJ4f33qmGkuix2GcqO/6UIm+nLx8Py8n04T0w3sP3x8G4So8l4Z8",
+	"jTzzjo+KdyrbE3v1QFk+JppPF3K7TzyLnny2VTHkFhMLdIQ1VlFxH7CVdZu5jeGyC6rZZw/9vfDOCfnS",
+	"tdRNLgGXo2AladkGfVG1sp1cAvUNueX/PEP4t07I1xjMaPQcPS8Bhm3IhTm7/+CTh64JJPNEp75+u8Vn",
+	"D8/Ov/jCNasUF5iT3+VWHTTXRp2tWVlK18EdkkO48OHs//7rv09OTm7tPVfk9svdc7phf+TD5Txc+NRq",
+	"/ckXKXb+CLsue0l3XfcLoKr1Wf14EO/JHhE9+OT248H7wQ5eoP5f4sBddNnIGSEaE3Wn6MERD2CmDz2C",
+	"5+7Ixci25hw9Ic+lK05Zl1TZdKeYHVKTVU0VFYax4sRzKmY507YYX15yJoytL6Uguzfm/WkSWDYZVCrF",
+	"LqFhkL+wg8H+M47pP/L59oxuwyKxfl7ESDdlTBSzoVuC6dMN0cxgHTz46YsvyL15e3OFrLtymzWEiYnT",
+	"Dd3O3qPFt2G2qal6HjvqSLXfWRxhT7Eetopfk7StvV393SX3n/ayYtndLeyRJOfBL3rti11oQ8If91iP",
+	"rE5rq43puqrKXZv+kZat9hgXcTDCVMPQH/h9aO+zRPTe3Sfvx0380e5xI1HSZ6gDxQYG+evT3/GuEsqM",
+	"wb7FIOW/0Dt48Cio5Ka9ai6ZAQsMzLZP14js8Xe8tODZcAGJw2Zn9+bvXGXBJRrmPQ0SOWD049SSLEFc",
+	"Or7MMhXh0O/xP7TEbH3wAEkNaxLVv3JVyfGCbU8S1tS0tkYFiuzg4kx8jgRYxYOwfNQOPtS2Stnhies/",
+	"bH8k8GEEHki+r+wOd9vLTeKvEITh74kZeS7bFBz2evSXfFN+l8f2u57QcymYdZ5oi5d+fCdvdAo0sjYV",
+	"XeEvezlpC9RcV784hajovUrGt9Boj6Ix5fSGwf6UR/i3jkojpwzM7WRvhoAW2hThDA1tqpcw9dPJh7yi",
+	"fBB5+ge8t3wIifV+RAxuUi9n7E9SHFfoYDozy8ynlc89l5JAT6FxoJfZDG+TpZGRjX8hi+RRIwtWSrHS",
+	"f0xRNMYdcbpEuAQ/uHIKg/mf/A337iPMlCakL4nscudpLnJGtNzYjDRB/QeL4T/eH4aGb3y1UxGGE39g",
+	"6fLpvU/e3/AXTF3ynJFXbFNJRRUvd+QH0bwJ30TaaULdmoem3ohw4AKfkro5FvMwIdz1hWDHJ/F3qPj+",
+	"dr8wDHK4HigHuQjkYDA2oVXFqLq+ANz/LjWs/x+6fcsmp41flQQqrij+IZEP/2s20e4EjUBE2sOvFhZR",
+	"n0nRiQnnky2X88bpRwrodkZei7tEr+mn9x/88uDTz/yfDz79LGE5g3FcZq6h7awFBJ8tmCkGtD+ure+4",
+	"KnlDvLP3vZSHrdB8xotttNw22wYJ7bv10ZzOdUuTiu6SVfqreMbe5qgPwW4Y6Oh6zav3nxVWG75YRy9P",
+	"/m7T1NJ8Ir5srrg2dSlo1tWHyAY6nxnFWMEqs96bJBhbtavJXLpgrl0tBpvKdU74CTvBNu0LPfg9aXtd",
+	"pqRkdNnUF5dySshLIESA0TxXBFQPJzLlwhnlH0zQgkz5/m+ebWiIPcU88VTvQPmgWqz5UDfQDC+gTHit",
+	"pUuWD6cwMmg5Dx6qKyWNzGVpvU7qqpLKNLtbn0zS5Vjqwa2jyqUY9yBNLacmX2N6uDZIBH+rq9Pf249v",
+	"268FW9SrU8HMlVRvTitm65k0H0tD9ak2itHN4Of2pc/9bv0hT+07/pgyeGFb3PBs7WndCLNffdOnPrQ4",
+	"gYB4xnMlITdeU0dU77Rhm0F+Stf1l0QkoM+XPDzipCi5YNlGiljWxO/x6zP8GOuNvhCpzlgtNdW3J2S7",
+	"+PfQ6o4zRcLelL5/kPv6jexMvdkqBuKgreZu+f/ALes3zU7kw520E/lwmwWApEj8fPp750/nxeNbwi4/",
+	"XVChY7918jW6r3pdm0JeBaPhvdJKwWFcWuTjcBphowlOA0GRhOkG/eay1is2oEnBNGyUP5/1LKBDbJc2",
+	"XyPZ69qP6QR2f1N72pKLosckrjzMJVO6sbQo7+Tz0aj21zGqTV73g+S6zUe7T6LV+rha0HNZMAu3mwI6",
+	"FqiM1Q+0R6Kn/ISKZES++pOwbde7Pea0BqNkXREjY/fUtmNGcytkM2tn3FcbzrbyNcEvGaGlYrSARARM",
+	"ELmASbdnMk6SanTQb0oPWo04qn4FeFVK2tq/2Xi53BY1385ejc0InRBxRLgZhWhJllTdGNk3l3vxbIon",
+	"aHL7ux/1nQ+Ar1U/xwmLbWLkbbyTuEhgPW34MYbrDx6ynS0WbbkWbXMS0pUblkDmMJok16+P0WAVb04W",
+	"NF/xd8zxfpCbMVCD6jvm95tiW1cZnN9DFB/Zr6/4BjUxQYXULJeiSBSioNpk+8QyNArnomEGgSSMSWIE",
+	"nLjkQoGgl+4VBuvOuzQzQT0qGCKN8GWqUARA/rEpEzGAnUuhmdC1bmpJOOMLK2JzgCJM6bGes20zllwG",
+	"sBvrjpGk1mwf5BSVAviOWNpZQ7vBp75a1HBymE2HOqPIkJQdJFpCjCFy4VsF1A3fVhKIcN0Suim42uWc",
+	"oA6SNrKqQFqYrBZNvxSZLmzrc/ND23bIXK4yC4xJCsl0aHlzmF9Zytoi42uqicMDyjQ749zKZRsb4gyb",
+	"McMX82yM82FbXkCrcAvs3aR1tVK0YFnBShox3/xgPxP7eQwArrhnz+xSGpYt2DJaFgkWveVklTRLNaAl",
+	"wosIzecSq1dpksMWXEoVMIjrvQdywRB2TDg5PrrVgMKxokvk4eG07VInTGEAA1Yc21iMnUCfgm+CDA3k",
+	"61MCO2et9aA/xL+YdgP4NtcYZMd0agot/IMm0LcghudX56DoSfeeAI5KzaQU2yNGUjs2ZrP8U4YS9p+c",
+	"36GzXNdmG9z/Tq5ztz29otyAb7/VozO6NExFTHm9WiCUGx+piP2Ikc6VgyAEd2w6OCjjw0wnTohYFIg7",
+	"LYBFhiGCMNTXUk0KN+r63VFuSC0ML4OQ6+am/MezF360AXy0AXy0AXy0AXy0AXy0AXy0AXy0AXy0AXy0",
+	"AXy0AXy0AfxtbQAfKsQw8wqH980WUmSCrajhl6yJPfyY8ugvFZLTHFXeJoFWDLAhuNyphHo1AL/cLCLR",
+	"MFoiDXhpa59LnczMhKXotaxVzkgOGHJBqpJyQQzbmiadXTdHrE/f7YrRY9pZqtknD8jFt+c+uGDtnOC7",
+	"bW/7GuTa7Ep2x+WUaCoW++QSDLNfutwS1B8JPu2dSwLIS0Y0kNfmynzMLlkpK6as3zIxqo5YfKBG/yNH",
+	"mz0Gn05NWoD267xjZ3Jk29DKq/l+rlQTioEovZKyS1rqdE1ZC29Dq1jmuebgs6YglCZfymLX2yGwaqe4",
+	"gN290YYYcEHVLhI7NNgRA9YwEuSVY6yhLevt0QNhhkw7ZLN9HBbT1hXT0X08xuUxOO2CDUDZKKRlj0+i",
+	"BdX7YQ+zBsEpTrfAz35NyEvb78PG0CNGbou1wvwP4zfYbdkIDWwrpPGi588a8O4JH929uPfnwNhFnTPC",
+	"jSaO4yYcL5CvByCtmMicAMoWsthlHfE165xCBddUa7ZZ7D+JQvnp0ky7w8esI9PpnFMf5hh5HExuTCaH",
+	"TLPNnABOSOedYZNlc0MthOjEc0Dxdy2iU2I0RIE4+RQzKvVk36FCrx1m91HwfRR8wW7saQRcuNjDvhA5",
+	"eYeCT+1ULdIy76sty2tALtzJt9E6j09yYK0J3zUxCGuFOaMHb3QwNYbwsGDMBxGFdrpTpeBhHGSBN3lE",
+	"b5rdqg9uKF2COLvbUpGVknV1B5eDih0+ZmwqKnb+yRfMDpu6tDS0GfmOK2hteODQEWA+8wa9tFX7hWsR",
+	"2m7dUdv93ZKFXFFN7PqygtSicNFK/YHNVkzPV21Bv9qKVkyPZqy2843Mzo075Yjwq2wXoX3mrpjKzFbY",
+	"DdVNKm+Dle3OPfmYK/fvcWy8sIUIEwJ2GHjbCoQjnR4qkGt4fLSDBcF33SpvtgZlKnAkTKRiWx7VeWQA",
+	"vutDElSAtG+krKwI9YUMcim0UXVuXguKbzTBxE6G/iXeGp2Wb498k/gzYeQVz4F6LSjmuW9ebqJybski",
+	"zxRfM+bFqK5XK6ZBVoZMsmTstXCtuCC14AbH2vBcycyGvsIeAv3kxLbc0B1ZQq52I8lvTEmyqE0I0xVj",
+	"0gbeAK1DCwxD5PK1oIaUjGpDnnGQsgDOJzlrPLls+HZDhXjqjRUTTHOdxY0v39ivmN3CTd8b+eD/rnMb",
+	"lf5+01p43HmRxPzJY8CbYpaekmvT+kAMcH9v798bLrIok8FDvXMJ6/MWuQ2C1zPQne7rkFmz1wJOOCMJ",
+	"SnVqrscO/WeewV60u6PHNZ2F6L0G+blOuuIdRcqQiJD5+LTyFwrMDPjAP1/iwsNBPlj7A59RRgurxr4O",
+	"0mBEG9l8aIlG7ibB/Ge71VARgLmzvFbc7PCxglb8F6imfvbTz/AmYOsD2XeMWpWzs9namOrs9BTLqq6l",
+	"Nqezt/Pwm+59/Lkhz+/+SaJS/BKwefvz2/9/APYjOWzilwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
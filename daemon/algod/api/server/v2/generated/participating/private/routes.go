@@ -158,179 +158,241 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3PbONLgv4LS91XlcaLtPCa7cdXUd048M+vLY1OxZ/a+jXMzENmSsKYALgDa0uT8",
-	"v1+hAZAgCVKU7XF2r/JTYhGPRqPRaPTzyyQVq0Jw4FpNDr9MCirpCjRI/IumqSi5Tlhm/spApZIVmgk+",
-	"OfTfiNKS8cVkOmHm14Lq5WQ64XQFdRvTfzqR8M+SScgmh1qWMJ2odAkragbWm8K0rkZaJwuRuCGO7BAn",
-	"x5PrgQ80yyQo1YXyrzzfEMbTvMyAaEm5oqn5pMgV00uil0wR15kwTgQHIuZELxuNyZxBnqk9v8h/liA3",
-	"wSrd5P1Luq5BTKTIoQvna7GaMQ4eKqiAqjaEaEEymGOjJdXEzGBg9Q21IAqoTJdkLuQWUC0QIbzAy9Xk",
-	"8NNEAc9A4m6lwC7xv3MJ8DskmsoF6MnnaWxxcw0y0WwVWdqJw74EVeZaEWyLa1ywS+DE9Noj70qlyQwI",
-	"5eTjj6/Js2fPXpqFrKjWkDki611VPXu4Jtt9cjjJqAb/uUtrNF8ISXmWVO0//vga5z91CxzbiioF8cNy",
-	"ZL6Qk+O+BfiOERJiXMMC96FB/aZH5FDUP89gLiSM3BPb+E43JZz/q+5KSnW6LATjOrIvBL8S+znKw4Lu",
-	"QzysAqDRvjCYkmbQTwfJy89fnkyfHFz/x6ej5O/uz++eXY9c/utq3C0YiDZMSymBp5tkIYHiaVlS3sXH",
-	"R0cPainKPCNLeombT1fI6l1fYvpa1nlJ89LQCUulOMoXQhHqyCiDOS1zTfzEpOS5YVNmNEfthClSSHHJ",
-	"MsimhvteLVm6JClVdghsR65YnhsaLBVkfbQWX93AYboOUWLguhE+cEH/usio17UFE7BGbpCkuVCQaLHl",
-	"evI3DuUZCS+U+q5Su11W5GwJBCc3H+xli7jjhqbzfEM07mtGqCKU+KtpSticbERJrnBzcnaB/d1qDNZW",
-	"xCANN6dxj5rD24e+DjIiyJsJkQPliDx/7roo43O2KCUocrUEvXR3ngRVCK6AiNk/INVm2//X6V/fEyHJ",
-	"O1CKLuADTS8I8FRk/XvsJo3d4P9Qwmz4Si0Kml7Er+ucrVgE5Hd0zVblivByNQNp9svfD1oQCbqUvA8g",
-	"O+IWOlvRdXfSM1nyFDe3nrYhqBlSYqrI6WaPnMzJiq6/P5g6cBSheU4K4BnjC6LXvFdIM3NvBy+RouTZ",
-	"CBlGmw0Lbk1VQMrmDDJSjTIAiZtmGzyM7wZPLVkF4PhBesGpZtkCDod1hGbM0TVfSEEXEJDMHvnZcS78",
-	"qsUF8IrBkdkGPxUSLpkoVdWpB0aceli85kJDUkiYswiNnTp0GO5h2zj2unICTiq4poxDZjgvAi00WE7U",
-	"C1Mw4fBjpntFz6iCF8/7LvD668jdn4v2rg/u+KjdxkaJPZKRe9F8dQc2LjY1+o94/IVzK7ZI7M+djWSL",
-	"M3OVzFmO18w/zP55NJQKmUADEf7iUWzBqS4lHJ7zx+YvkpBTTXlGZWZ+Wdmf3pW5ZqdsYX7K7U9vxYKl",
-	"p2zRg8wK1uhrCrut7D9mvDg71uvoo+GtEBdlES4obbxKZxtycty3yXbMXQnzqHrKhq+Ks7V/aezaQ6+r",
-	"jewBshd3BTUNL2AjwUBL0zn+s54jPdG5/N38UxS56a2LeQy1ho7dfYu6AaczOCqKnKXUIPGj+2y+GiYA",
-	"9pVA6xb7eKEefglALKQoQGpmB6VFkeQipXmiNNU40n9KmE8OJ/+xXytX9m13tR9M/tb0OsVORh61Mk5C",
-	"i2KHMT4YuUYNMAvDoPETsgnL9lAiYtxuoiElZlhwDpeU6736PdLgB9UB/uRmqvFtRRmL79b7qhfhxDac",
-	"gbLirW34QJEA9QTRShCtKG0ucjGrfnh4VBQ1BvH7UVFYfKBoCAylLlgzpdUjXD6tT1I4z8nxHvkpHBvl",
-	"bMHzjbkcrKhh7oa5u7XcLVYpjtwa6hEfKILbKeSe2RqPBiPD3wXF4ZthKXIj9WylFdP4L65tSGbm91Gd",
-	"/z1ILMRtP3HhK8phzj5g8Jfg5fKwRTldwnG6nD1y1O57M7Ixo8QJ5ka0MrifdtwBPFYovJK0sAC6L/Yu",
-	"ZRxfYLaRhfWW3HQko4vCHJzhgNYQqhufta3nIQoJkkILhle5SC/+QtXyDs78zI/VPX44DVkCzUCSJVXL",
-	"vUlMygiPVz3amCNmGuLrncyCqfaqJd7V8rYsLaOaBktz8MbFEot67IdMD2Tk7fJX/A/NiflszrZh/XbY",
-	"PXKGDEzZ4+wsCJl5ytsHgp3JNEAVgyAr+3on5tW9E5Sv68nj+zRqj36wCgO3Q24RuENifefH4JVYx2B4",
-	"JdadIyDWoO6CPsw4KEZqWKkR8B07yATuv0MflZJuukjGsccg2SzQiK4KTwMPb3wzS615PZoJeTPu02Ir",
-	"nNT6ZELNqAHznbaQhE3LInGkGNFJ2QatgWoT3jDTaA8fw1gDC6ea/gFYUGbUu8BCc6C7xoJYFSyHOyD9",
-	"ZZTpz6iCZ0/J6V+Ovnvy9Nen370wJFlIsZB0RWYbDYo8dG8zovQmh0fdleHrqMx1fPQXz70WsjlubBwl",
-	"SpnCihbdoax204pAthkx7bpYa6IZV10BOOZwnoHh5BbtxCruDWjHTBkJazW7k83oQ1hWz5IRB0kGW4lp",
-	"1+XV02zCJcqNLO/iKQtSChnRr+ER0yIVeXIJUjERMZV8cC2Ia+HF26L9u4WWXFFFzNyo+i05ChQRytJr",
-	"Pp7v26HP1rzGzSDnt+uNrM7NO2Zfmsj3mkRFCpCJXnOSwaxcNF5CcylWhJIMO+Id/RPo0w1PUat2F0Ta",
-	"/0xbMY4qfrXhafBmMxuVQ7ZobMLt32ZtrHj9nJ3qgYqAY9DxFj/js/4Yck3vXH5pTxCD/bXfSAssyUxD",
-	"fAW/ZYulDgTMD1KI+d3DGJslBih+sOJ5bvp0hfT3IgOz2FLdwWVcD1bTutnTkMLpTJSaUMJFBqhRKVX8",
-	"mu4xy6M9EM2YOrz59dJK3DMwhJTS0qy2LAga6Tqco+6Y0NRSb4KoUT1WjMr8ZFvZ6azJN5dAM/OqB07E",
-	"zJkKnBEDF0nRwqj9ReeEhMhZasBVSJGCUpAlTkWxFTTfzjIRPYAnBBwBrmYhSpA5lbcG9uJyK5wXsEnQ",
-	"Hq7Iwze/qEdfAV4tNM23IBbbxNBbPficPagL9bjphwiuPXlIdlQC8TzXvC4Ng8hBQx8Kd8JJ7/61Iers",
-	"4u3RcgkSLTN/KMX7SW5HQBWofzC93xbasujx8nIPnTO2Qr0dp1woSAXPVHSwnCqdbGPLplHjNWZWEHDC",
-	"GCfGgXuEkrdUaWtNZDxDJYi9TnAeK6CYKfoB7hVIzci/eFm0O3Zq7kGuSlUJpqosCiE1ZLE1cFgPzPUe",
-	"1tVcYh6MXUm/WpBSwbaR+7AUjO+QZVdiEUR1pXR35vbu4lA1be75TRSVDSBqRAwBcupbBdgNPV16AGGq",
-	"RrQlHKZalFO510wnSouiMNxCJyWv+vWh6dS2PtI/1227xEV1fW9nAszs2sPkIL+ymLU+TktqntA4MlnR",
-	"CyN74IPYmj27MJvDmCjGU0iGKN8cy1PTKjwCWw9pWSwkzSDJIKeb7qA/28/Efh4aAHe8fvgIDYn1Z4lv",
-	"ek3J3n1gYGiB46mY8EjwC0nNETQvj5pAXO8tI2eAY8eYk6OjB9VQOFd0i/x4uGy71ZER8Ta8FNrsuCUH",
-	"hNgx9DHw9qChGvnmmMDOSf0sa0/x36DcBJUYsfskG1B9S6jH32kBPco05wYcHJcWd28x4CjX7OViW9hI",
-	"34nt0ex9oFKzlBX41HkDmzt/+bUniNqbSAaashwyEnywr8Ai7E+sI0Z7zJu9BEcpYbrgd7QwkeXkTKHE",
-	"0wT+Ajb45P5gPfzOAr/AO3jKRkY11xPlBAH1fkNGAg+bwJqmOt8YOU0vYUOuQAJR5WzFtLYum82XrhZF",
-	"Eg4QVXAPzOisOdY7zu/AGPPSKQ4VLK+7FdOJfRIMw3fWehc00OGeAoUQ+QjlUQcZUQhGGf5JIcyuM+ch",
-	"7N1IPSU1gHRMG0151e3/QDXQjCsg/y1KklKOL65SQyXSCIlyAsqPZgYjgVVzOhN/jSHIYQX2IYlfHj9u",
-	"L/zxY7fnTJE5XHm3etOwjY7Hj1GN80Eo3Thcd6AqNMftJHJ9oOYf7z3nvNDiKdtNzG7kMTv5oTV4ZS4w",
-	"Z0opR7hm+bdmAK2TuR6z9pBGxpnXcdxRSv1g6Ni6cd9P2arM72rD55TlpYR+69j5+af56vz8M/nRtvSG",
-	"7akn8hAdV3VYxNzdRqVE1xqSM/O+lYJmRkCI6vZxkXyRVM6ZKgrOShlw/ubOIeWbViDfWBjIDFJaWq9k",
-	"x7UdBLV7qNqLyIut3W2jMLqQkerxMtf20g6xupCiLIiqtt1SgaYa/hhVcz10DMruxIFvUP2xzz3IPBPz",
-	"zR3c1nYgIqGQoJC3huoVZb+KeRh/45iv2igNq64G2nb9ted99rH3nSN4zjgkK8FhEw05ZRze4cdYb8vf",
-	"ezrjTdvXty08N+BvgdWcZww13ha/uNsBQ/tQ+cXdwea3x20ZH8LII1SuQV4QStKcoepNcKVlmepzTvFx",
-	"Hxy2iP+Af8b0q3te+yZx/VJE/eOGOucUfUeqJ3+UL84hwpd/BPBaH1UuFqB0S0qcA5xz14pxUnKmca6V",
-	"2a/EblgBEo34e7blim7InOaonfodpCCzUjeZKwZIKM3y3FlCzDREzM851SQHw1XfMX62xuG8JdHTDAd9",
-	"JeRFhYW96HlYAAfFVBL3c/jJfkUXNLf8pXNHw2hV+9nqzs34dRTFBt/+dQTm/3n4X4efjpK/0+T3g+Tl",
-	"/9j//OX59aPHnR+fXn///f9t/vTs+vtH//WfsZ3ysMfc9x3kJ8fuTXFyjIJjrTzvwH5vitMV40mUyEIT",
-	"cYu2yEMj/noCetRUK+glnHO95oaQLmnOMqpvRg5tFtc5i/Z0tKimsREtNYJf647i2C24DIkwmRZrvPE1",
-	"3nUNigfKoDXHxb7geZmX3G5lqZxFCf3AvYuGmE+rYCibBOGQYKTMknr/Ivfn0+9eTKZ1hEv1fTKduK+f",
-	"I5TMsnUsjimDdUzKdgcED8YDRQq6UaDj3ANhj3qjWKN4OOwKzPNMLVlx/5xCaTaLczjvXete62t+wq3b",
-	"qzk/aBvaOJWzmN8/3FoCZFDoZSw4uiEpYKt6NwFa9vpCikvgU8L2YK/9Ws4WoLxfTA50jkG6aN8QY6IF",
-	"qnNgCc1TRYD1cCGjnqQx+kHh1nHr6+nEXf7qzuVxN3AMrvaclSHI/60FefDTD2dk3zFM9cCG1NmhgyCo",
-	"iBbK+fk3PDkMN7MpIWxM4Tk/58cwZ5yZ74fnPKOa7s+oYqnaLxXIVzSnPIW9hSCHPnTgmGp6zjuSVm/W",
-	"liBogxTlLGcpuQgl4po8bSR+9NlI84UwD8e2Ubsrv7qpovzFTpBcMb0UpU5cqHEi4YrKmNFAVaGmOLJN",
-	"FDA065S4sS0rdqHMbvw4z6NFodohZ93lF0Vulh+QoXIBVWbLiNJCelnECCgWGtzf98JdDJJe+Tj1UoEi",
-	"v61o8Ylx/Zkk5+XBwTMgjRis39yVb2hyU0BDX3mjkLi2rhIXbt81sNaSJgVd9CgNNNACdx/l5RU+svOc",
-	"YLdG7Jf3bcWh6gV4fPRvgIVj5zgWXNyp7eVzxsSXgJ9wC7GNETdqi+lN9yuIBrvxdrUiyjq7VOplYs52",
-	"dFXKkLjfmSqVxMIIWd6MrdgCXQVd1o0ZkHQJ6QVkmAAAVoXeTBvdvaeEEzQ962DKJsqwsRwYzY2q3RmQ",
-	"ssioE8VbCiWDYQVae1/Fj3ABmzNRB4PvEkfbDOtUfQcVKTWQLg2xhsfWjdHefOeOg7quovDRkRgm48ni",
-	"sKIL36f/IFuR9w4OcYwoGmGHfYigMoIIS/w9KLjBQs14tyL92PLMK2Nmb75IXg3P+4lrUj+enOdMuBqM",
-	"prTfV4BZd8SVIjNq5HbhEsbY0MWAi5WKLqBHQg616yMDBBsaeRxk270XvenEvH2hde6bKMi2cWLWHKUU",
-	"MF8MqeBjpuUv5WeyBhyrQCWYB84hbJajmFQ5llmmQ2XDymETW/WBFidgkLwWODwYTYyEks2SKp/LBlP+",
-	"+LM8Sgb4A0NxhxIwnASuPkFen0rx7Xlu+5x2XpcuDYPPveATLoRPyxHJE4yEj97Fse0QHAWgDHJY2IXb",
-	"xp5Q6rDgeoMMHH+dz3PGgSQxryGqlEiZTUZUXzNuDjDy8WNCrAqYjB4hRsYB2GiYxIHJexGeTb7YBUju",
-	"wpqpHxtNmsHfEI/AsH60RuQRhWHhjPd4bHsOQJ2rWXV/tRwecRjC+JQYNndJc8Pm3IuvHqSTBwDF1lbU",
-	"vzONP+oTZwc08PZi2WlN9iq6yWpCmckDHRfoBiCeiXViQ7CiEu9sPTP0HnUtxoCw2MG0GRceKDITa3S3",
-	"wKvFurJugaUfDg9G8MJfM4X0iv36bnMLzNC0w9JUjAoVkoxT51Xk0idOjJm6R4LpI5eHQRKFGwHQUnbU",
-	"6Ubd43frI7UpnnQv8/pWm9bJgXzURuz49x2h6C714K+rhanSHjgVwkdIhcz69RSGUJmu8rd21Qsu+6zh",
-	"G6MTIwzkkj1qvjb8E6K7cz1eAQ146nkGEHFsY446kPywLoSRbm1Mkk1Q4ZBi5UQJNtRSWZ2VYnyRQ+W5",
-	"GUVTbMHeJ8lj3C65TjjlBxwnO8c2t+eRPwRLUcTh2OWl8tHhZwCKnlNew4Fy+C0hcUkqBmG57qePD23R",
-	"PnpQmu41zdQowVsrdjsY8ulaM7s2UwU54Os5abw2kouYjfv8/JMCFM1OfbdAy4cJWCjfPAp8tiQsmNJQ",
-	"W5uMBOsxfd96fIp534SY969OF3Ju1vdRiEqes4mFsGNjmfe+AvR5njOpdIKmuugSTKMfFWqffjRN44+K",
-	"pleYTYHKsvglitNewCbJWF7G6dXN++bYTPu+kh1UOUPBhHECNF2SGabsjfqKDkxt3YkHF/zWLvgtvbP1",
-	"jjsNpqmZWBpyac7xb3IuWjfdEDuIEGCMOLq71ovSgQs0CPHtcsfggWEPJ16ne0Nmis5hyvzYW/2rfKBx",
-	"nzBnRxpYC7oG9TrnRhxyrB+ZZep1tv5oMC4XOmkoPyLoqhQ8StMLG1DW3GC+qHQqcbcp+64eNbRru2VA",
-	"Pn48vn04JwQnOVxCvt0JmiLGvQIHPSPsCOh6QzCcwPt4bJfquztQI6xaaRvGKLV0pJshw239NHL58+q3",
-	"NRKswZ2LfB9tvTMSmqe3mr67pruiSDLIIRpn9rcgkIwWBWaL8I1jAT1mMMYzWMfBsZ+msZz6XeV9ybi2",
-	"+VfvKrVja5zxyw4TII5BQWFT9e2ePrL/jRnsUojm/kX1EGVlHBhkxDh49bILqpG0qa/nGqdFwbJ1y+5p",
-	"R+3Vjt8JxvCCcoNtwUBAG7EIRgmqmfiyVubZ9OuNvFN7ozBz1kxPGco04VRM+eIhXURVEc7bcHUGNH8D",
-	"m19MW1zO5Ho6uZ2ZNIZrN+IWXH+otjeKZ3TDs2azhtfDjiinRSHFJc0TZ0zuI00pLh1pYnNve75naS3O",
-	"9c5+OHr7wYF/PZ2kOVCZVK+d3lVhu+LfZlU2x2bPAfHFCZZUV/o5+xoONr9KDBgaoK+W4BLBBw/qTsba",
-	"2rkgOIrOID2PewNvNS87Pwi7xAF/CCgqd4jaVGe9IZoeEPSSstzbyDy0PZ67uLhxd2OUK4QD3NqTIryL",
-	"7pTddE53/HTU1LWFJ4VzDaSqX9lqDIoI3naXM69gNL0hqa4o5pu1FpAuc+LlCq0GicpZGren8hmG2HDr",
-	"J2MaE2zc8542I5asx+2KlywYyzRTI5TaLSCDOaLI9LmL+3A3E66MVsnZP0sgLAOuzSeJp7J1UFF/6izr",
-	"3es0LlW6ga01vh7+NjJGmGu5feM5mWtIwAi9cjrgHldaP7/QyvpkfgjcD3Zw7gtn7FyJA455jj4cNdtA",
-	"hWXTu2a0hL615JbXv7mkzz1zREtoMZXMpfgd4qoq1PBFokN9dmmGHq2/Ax8RUlZbcupKYPXsvdvdJ92E",
-	"FqemQ2IP1ePOBy44mObWW6Mpt1ttK9o0/NrjBBNGkOzb8WuCcTB3om5yejWjsRzARsgwMAXml4bdXAvi",
-	"O3vcOxsNcwm/90jgN1a1ZTbxRwGyDtzuJhG7ocBgpx0tKtSSAVJtKBNMra9PrkRkmJJfUW4LI6E1Ao+S",
-	"620e+F4hdCUkpu1RcRN/BilbRZVL5+efsrRrzs3YgtmyQKWCoO6MG8jWU7NU5Gr3WHe6GjUnc3IwDSpb",
-	"ud3I2CVTbJYDtnhiW8yoAqtU8Z4bvotZHnC9VNj86Yjmy5JnEjK9VBaxSpBKqMPnTeWoMgN9BcDJAbZ7",
-	"8pI8RBcdxS7hkcGiu58nh09eooHV/nEQuwBc/a8hbpLNwyDXOB2jj5IdwzBuN+peVBtgizb2M66B02S7",
-	"jjlL2NLxuu1naUU5XUDcK3S1BSbbF3cTbQEtvPDMVhxTWooNYT3hxqCp4U89kWaG/VkwSCpWK6ZXzpFD",
-	"iZWhp7qojJ3UD2fLl7l84B4u/xH9oQrvDtJ6RN6v3cfeb7FVo9fae7qCJlqnhNpcTTmrPRV9lQJy4lPB",
-	"YYL0Ki+6xY2ZyywdxRx0XJyTQjKu8WFR6nnyZ5IuqaSpYX97feAmsxfPI0nhm8mJ+W6A3zveJSiQl3HU",
-	"yx6y9zKE60secsGTleEo2aM6sjM4lb2OW3EXnT4/oeGhxwplZpSkl9zKBrnRgFPfivD4wIC3JMVqPTvR",
-	"484ru3fKLGWcPGhpdujnj2+dlLESMpbftT7uTuKQoCWDS/TTj2+SGfOWeyHzUbtwG+i/rvHUi5yBWObP",
-	"cu9DYBeLT/A2QJtP6Jl4E2tP09LTkLmiZh984YyzgNiap9vsHrephtTovAtUnkOPg65HidAIgG1hbLcX",
-	"8O1VDIHJp7FDfThqLi1Gma9EZMm+hEZl43ERkxG9Vd8FYj4YBjVzQ01Js1zB/XvUeLNI17PDfPGw4h9t",
-	"YL8ys0Ek+xX0bGJQSiW6nVn1PXAuo+SVWI/d1Bbv9hv7L4CaKEpKlme/1LlBWpVqJOXpMuosMjMdf61r",
-	"alaLs4c5muB3STm33ghd3QS+Un71r5nIe+sfYuw8K8ZHtm0Xz7HLbS2uBrwJpgfKT2jQy3RuJgix2ky7",
-	"UIX15QuREZynziZb3+vdoktBaYx/lqB07F7EDza0ADXqc0PFtkIF8Az1GHvkJ1sTfwmkkSsQ9Qc2SxNk",
-	"vk6ANfWURS5oNiVmnLMfjt4SO6vtYyvD2coQC3vtNlbR75+7i6PtkG/tXUT0mVUrjak7laarIpaixLQ4",
-	"8w0wD0poXcKHdYidPXJsdRrKv5jtJIYe5kyuICPVdE6qRpow/9GapktUFjRYaj/Jjy9p4qlSBWWEq3KA",
-	"VfZoPHcGblfVxBY1mRJhJIcrpmwpdLiEZlaUKkWQEwN8lpTm8mTJuaWUqFQ8lMLqJmj3wFkvSG+AikLW",
-	"QvyO0otzU9+xwssp9opms2yXi+nUD7Y5Nqoyb+98BWjKBWcp5pKMXc2urPoY6+yItJvxyADnb6MmkcMV",
-	"LVJTBWs4LPaWrfGM0CGuax4KvppNtdRh/9RYv3tJNVmAVo6zQTb1tZachppxBS4bOFbYD/ikkA2LN3LI",
-	"qBNFLSfvSEYYnN2jcvjRfHvvFFIYtXjBOD49fYyEDZC0OmSs+qzNe5VpshAYQeEORbimT6bPHiZryWD9",
-	"ec9XicYxrMHYLNt6R3SHOvK+Es43wbR9bdrahHr1z404ODvpUVG4SfsrcUXlAb3mvQiO2LwrR68AudX4",
-	"4WgD5Dbo5IT3qSE0uEQXCSiIC43pqUrVCoIxQqulKGxBrH90NI9W1E30LeNQ1zCPXBBp9ErAjcHz2tNP",
-	"pZJqKwKO4mlnQHP0i4gxNKWdUey2Q7U22PmTFunEz9G/jXVBrR7GUTWoBTfKN1XpdEPdgTDxmuaVk1Ck",
-	"PBZKVU6IcsE1zYJZMcZhGLdPyNm8ALrHoCsT2e5aUntydrmJ+lKVzMpsATqhWRbTJ7zCrwS/+nSlsIa0",
-	"rLJ4FwVJMTNfM1Vhl9rcRKngqlwNzOUb3HK6oAJdhBrCKnh+h9HxerbBf2MprPt3xrkH7exj732Bsip8",
-	"bhe5uTlSR+o1NJ0otkjGYwLvlNujo576ZoRe979TSs/FognIPScoG+Jy4R7F+NsP5uII83d18rLbq6VK",
-	"r4XuoMLXDcZnY5UYpsmVfNRpZ84g8/KwAqK/wugUL7+euJZA10vt/Wrt2n3RLWlvMBbVLn+CpmSQBfXG",
-	"pFu/Mht9jlDEdfp9vmTWlcx87vQeJxl25GwcexCh3kmxC9Ab7wFNCsqc00bNLLqYdeFe/erCoUNXb3B7",
-	"ES6Iqldj9+ayL+DJxwHbyI5WTcYLcEmVCgmXTJTeHcL7y/knof3V1cQP4op719/1m8Gpvq4atFdpe+bq",
-	"/9hlujf5m1+sdyUBruXmX0CF29n0TkXLWM7iRj1LJ1xF9U167F15XBXFvLhMViIbCph+8ws59ralUfeO",
-	"J+RYuiWRuSpy0WDxt64EhG9mpM/R075znY6KYnjqngjx7uS24a7T96WaMudzSOv2wZ9fWwc0VCFE3ipB",
-	"ODOHte4p/tSOhr0CAusCMNdtENjcnz1jLEG5IEd8rSY5UAUDGA6ztrm2I5F8tn5r2o8Lto9XYu1POVun",
-	"mUXmWQjF6uI8sRKtI12Oz7DKamAx7I7l/f0uIdVYkan2Y5IAuyTQNZMF5b+/pZ7tUZRUntme/gfSzE4n",
-	"IW+JBiq640XrFDloVUOTayRVvW0TYfauMzOHpISpH8L8MKe5ildF63V2bWU+CRxWIome4ws7yUZk+3bL",
-	"mQY+ECwbRmQ8EsA6f///iUzr13636OzU7Bp+VXQSLwTJQ2xppb0dHEgqL2qUDHG/FsBdZfh5DDXbo6Lm",
-	"c0g1u9yS6OJvS+BBEoWp1wQjLPMg7wWromwwoejudo4aoKE8FIPwBIn9bw1OX4zoBWweKNKghmitp6kX",
-	"7m+SSxIxgLeWETwKoWJeitZ05RzHmKooA7HgvYJtd6izcvdWiQ3knBvO5UmyKfEMTBkvUzlqLtN1p0xg",
-	"GDDSlwujW+auX+NxjFUFVVXB3eeiDPWC5CRSCMrlssS0JJW11me1BOV/8zmI7Cw5u4Cwji3axjGFgmsR",
-	"VfZ6PXIyICd1or+j1aswd5afmdUxHN1430gOaPR+SnOBlZ/6wp2aYROVm9cDZZ1DUUzBSlQI1xykq/eN",
-	"N0MuFCRaeNe6ITiGUGE9YG+EBNVbd8EC15sN9WOd7hXrz9hkGdQ5voYLJBJW1EAng6Ss/XMOIfu1/e4D",
-	"XH1Orq067Ypek61ZVX30DlMdJIZUPyfuttweOHsT9TbjHGTibd1tn0JuUBnaXwspsjJ1iWCCg1GZAEYn",
-	"LBtgJVHNcNpdZUfJl2M28LdBGoIL2Oxb/Uu6pHwRpFcLobeivV1DkLmstdt3qvmPKznzhV3A4k7g/Jra",
-	"8+mkECJPegyuJ91Es+0zcMHSCyNml7Xfe0+hTfIQ7XyVR83VcuMTqxYFcMge7RFyxG2kkXeuaVY6ak3O",
-	"H+ih+dc4a1ba3M9Osb93zuMhG5jUR96Sv/lhhrmaAsP8bjmVHWRLGtN1T5JbSa8iZWe7/nSj3V3apUBr",
-	"orJQxKSUG6bqGnW+u8r9COkHVRCHXz9hJr/ai1laGxFKS3VlyKbw8q42/Yyrx+g7bAEvVNYEFRk9N3Lg",
-	"fGVX43cVUoKl9FJCY/nb9D9ugTVfCrZIYdSkWaZNQGzd1Jr7Eij31OtKZxbHc1e1hmn7BMecv12VnEKb",
-	"oU3DGhCOOZfykub3r1bDfI5HiA/IPvYLPOH7N0SyRaW6mb/fWzpq7uCte3dT8w+oBvwbmD2KGnvdUM74",
-	"U1XC9CYyTHFPc5KLui4yDkmucExrHX7ygsxcFF0hIWWKtQKMr3xVk+q5h0W+nI/lWm95X25b5y9C34KM",
-	"3QNBFOR9XSFBC7wfagjrI/qVmUrPyY1SeYz6OmQRwV+MR4XpbLZcFxcNs7GtONPyhxQS7th8HDiC7Wg+",
-	"7ibqGbs8ayI1l06poLvO0bd1A7eRi7pe21jfhy5yh9Loj3FZiFfHMN3RZ8IiBEvLEASV/PbkNyJhjrUj",
-	"BXn8GCd4/Hjqmv72tPnZHOfHj6Ni3L15S1gcuTHcvFGKcca0TigMrAsme5L+fXTM3V3YaL4j2AHi2Tlz",
-	"iFaDwam93+g9p4JGmXurgt8uzTXexs8ClPklVxPFcP9LX+yC9c/vCZNpnYWS5dm2Q9kIeqor32JYz68u",
-	"IPer1N791eqyu2zS1T/cxUeufQAQMZG1NiYPpgrCmUZEMrlukbglJK60lExvME+YV32yX6M+NT9V1hJn",
-	"Ba4yyzi5Q4sLqDLN1baVUnnJ5idBc5QFzHsGPRS1EPke+WFNV0UOjkl9/2D2J3j25+fZwbMnf5r9+eC7",
-	"gxSef/fy4IC+fE6fvHz2BJ7++bvnB/Bk/uLl7Gn29PnT2fOnz1989zJ99vzJ7PmLl396YO4AA7IFdOKz",
-	"Ukz+NxaoTo4+nCRnBtgaJ7Rgb2Bja2EaMvZVNmmKXBBWlOWTQ//T//TcbS8Vq3p4/+vEBb1PlloX6nB/",
-	"/+rqai/ssr9AZWqiRZku9/08nTKcRx9OqvAw6wuFO2ojfwwp4KY6UjjCbx9/OD0jRx9O9mqCmRxODvYO",
-	"9p5gLuMCOC3Y5HDyDH/C07PEfd/3SYQPv1xPJ/tLoDnaxM0fK9CSpf6TuqKLBcg9V27U/HT5dN+Lcftf",
-	"nCL5eujbfli5Z/9LQ9+ebemJji77X3wSq+HWjSxRzs4QdBgJxVCz/RlGII9tCipo3L8UfNyp/S/4POn9",
-	"fd+FZcY/4jPRnoF9b5SKt2xg6YteG1hbPVKq02VZ7H/B/yBNBmBZJ+guuNYNbN/W9e/+vOFp9MfuQJ36",
-	"cguIRlpizCPFGujx5P0TPAX2AJ1kyNd02zBti9VYlTMejqcHBzvV3R2n5m6bw7s3RZclDK3sejp5viOg",
-	"g/qshttyBJhXNCM+6BXnfnJ/c59wtG4bXkcsL0cInt8fBM2CJ29gQ94LTX7EB9/1dPLdfe7ECTciEM0J",
-	"tgySgXWPyM/8gosr7lsaIaBcrajcjD4+mi4UKlwlu6ROBAtTyn9GDb8NhG4etaMs6xC9FYZA6Vci2wxg",
-	"bKUWhQtSqpFWy4KMmyV0Bd9u9f0lRDxLrP3T67m5yGASSmlalnB9S57QFIcNCCcRvRQqWLF829yn7wtA",
-	"jbpJtPXgduRR9cdbg1dVZMrZiikvhH/jKd94irTTP7u/6U9BXrIUyBmsCiGpZPmG/MyrEPMb87ijLIv6",
-	"ljWP/lYeN52sk1RksACeOAaWzES28QleGxNcgH32dQSZ/S/NQjNWBJxY17+Y34z5vaqV313EbENOjjsS",
-	"ju3W5ryvNtg0qH5w+OmLfTeZR0H9rGmD2OGMYeL9Nm/6HOeaQ2RvFrIQunKAtIv6xoi+MaJbCTejD88Y",
-	"+Sb6+rAJXGjnzp76XCyx/HBUd0EZ80b5qsf3Tja++/6JvXesjx5kJPhggxzaaP7GIr6xiNuxiJ8gchjx",
-	"1DqmESG63d5DYxkGuidl7XKOaLbxzcucSqJgrJrjCEd0yo374Br3/aiL4sq+6SivS0NHNvBu33nfWN43",
-	"lvfvw/KOtjOapmBy65fRBWxWtKjeQ2pZ6kxcBZYEhMV6VXX1wK60ZOvv/SvKdDIX0kV8YK2AbmcNNN93",
-	"CaVav9Y5HDpfMDFF8GOgK4//ul/lSY1+bBshYl+dEr6nkU8H6D/XRsjQqIesvTLnffps2DIm+nZcv7ZR",
-	"He7voxf1Uii9P7mefmnZr8KPnysS+FLdFY4Urj9f/78AAAD//zKgbnPq0wAA",
+	"H4sIAAAAAAAC/+y9e3PctrIg/lVQc2+VY/+Gkl/JPXHVqftT7CRHGydxWUrO3rW9JxgSM4MjDsALgNJM",
+	"vPruW90ASJAEOBxp4pxs5S9bQzy6G41Go9GPj7NcbiopmDB69uLjrKKKbphhCv+ieS5rYTJewF8F07ni",
+	"leFSzF74b0QbxcVqNp9x+LWiZj2bzwTdsNmLsP98pth/11yxYvbCqJrNZzpfsw2Fgc2ugtbNSNtsJTM3",
+	"xJkd4vzV7HbkAy0KxbQeQvmjKHeEi7ysC0aMokLTHD5pcsPNmpg118R1JlwQKRiRS2LWncZkyVlZ6BOP",
+	"5H/XTO0CLN3kaZRuWxAzJUs2hPOl3Cy4YB4q1gDVLAgxkhRsiY3W1BCYAWD1DY0kmlGVr8lSqj2gWiBC",
+	"eJmoN7MX72aaiYIpXK2c8Wv871Ix9ivLDFUrZmYf5jHkloapzPBNBLVzR33FdF0aTbAt4rji10wQ6HVC",
+	"vq+1IQtGqCBvv3lJnj179iUgsqHGsMIxWRKrdvYQJ9t99mJWUMP85yGv0XIlFRVF1rR/+81LnP/CITi1",
+	"FdWaxTfLGXwh569SCPiOERbiwrAVrkOH+6FHZFO0Py/YUio2cU1s46MuSjj/77oqOTX5upJcmMi6EPxK",
+	"7OeoDAu6j8mwBoBO+woopWDQd4+zLz98fDJ/8vj2396dZf/L/fn5s9uJ6L9sxt1DgWjDvFaKiXyXrRSj",
+	"uFvWVAzp8dbxg17LuizIml7j4tMNinrXl0BfKzqvaVkDn/BcybNyJTWhjo0KtqR1aYifmNSiZFrjaI7b",
+	"CdekUvKaF6yYEy7IzZrna5JTbYfAduSGlyXwYK1ZkeK1OHYjm+k2JAnAdSd6IEL/usRo8dpDCbZFaZDl",
+	"pdQsM3LP8eRPHCoKEh4o7VmlDzusyOWaEZwcPtjDFmkngKfLckcMrmtBqCaU+KNpTviS7GRNbnBxSn6F",
+	"/R02QLUNAaLh4nTOUdi8KfINiBEh3kLKklGBxPP7bkgyseSrWjFNbtbMrN2Zp5iupNCMyMU/WW5g2f/H",
+	"xY8/EKnI90xrumJvaH5FmMhlkV5jN2nsBP+nlrDgG72qaH4VP65LvuERkL+nW76pN0TUmwVTsF7+fDCS",
+	"KGZqJVIA2RH38NmGboeTXqpa5Li47bQdRQ1YieuqpLsTcr4kG7r96+O5A0cTWpakYqLgYkXMViSVNJh7",
+	"P3iZkrUoJugwBhYsODV1xXK+5KwgzSgjkLhp9sHDxWHwtJpVAA4Xe8DhYho4gm0jPANbF76Qiq5YwDIn",
+	"5CcnufCrkVdMNAKOLHb4qVLsmstaN50SMOLU4+q1kIZllWJLHuGxC0cOTSixbZx43TgFJ5fCUC5YQbiw",
+	"QEvDrCRKwhRMOH6ZGR7RC6rZF89nt/u+Tlz9peyv+uiKT1ptbJTZLRk5F+Gr27BxtanTf8LlL5xb81Vm",
+	"fx4sJF9dwlGy5CUeM/+E9fNkqDUKgQ4h/MGj+UpQUyv24r14BH+RjFwYKgqqCvhlY3/6vi4Nv+Ar+Km0",
+	"P72WK55f8FWCmA2s0dsUdtvYf2C8uDg22+il4bWUV3UVIpR3bqWLHTl/lVpkO+ahjHnWXGXDW8Xl1t80",
+	"Du1hts1CJoBM0q6i0PCK7RQDaGm+xH+2S+QnulS/wj9VVUJvUy1jpAU+duct2gaczeCsqkqeUyDiW/cZ",
+	"voIQYPaWQNsWp3igvvgYgFgpWTFluB2UVlVWypyWmTbU4Ej/rthy9mL2b6etceXUdtenweSvodcFdgJ9",
+	"1Oo4Ga2qA8Z4A3qNHhEWIKDxE4oJK/ZQI+LCLiKwEgcRXLJrKszJbB7bk+0GfudmaultVRlL7979Kklw",
+	"YhsumLbqrW34QJOA9ATJSpCsqG2uSrlofvjsrKpaCuL3s6qy9EDVkHHUutiWa6MfIvq03UnhPOevTsi3",
+	"4dioZ0uwHS2YUzXgbFi6U8udYo3hyOHQjvhAE1xOsMTczhsyaM3MMTgO7wxrWYLWs5dXoPHfXNuQzeD3",
+	"SZ3/GCwW0jbNXNCKOMrZCwz+EtxcPutxzpBxnC3nhJz1+96NbWCUOMPciVdG19OOO0LHhoQ3ilYWQPfF",
+	"nqVc4A3MNrKw3lOaThR0UZjbzyGvIVR33mt790MUEvjQh+GrUuZXf6N6fYQ9v/BjDbcfTkPWjBZMkTXV",
+	"65NZTMsIt1c72pQtBg3x9k4WwVQnDYrHQm8PagU19GTWhzeulljSYz8UekxF7i4/4n9oSeAz7G1q/L0c",
+	"bBIct6gMXhAKuMrbC4KdCRrAwhtJNvb2TuDWfRCUL9vJ4+s0aY2+tgYDt0IOCVwhuT36NvhKbmMwfCW3",
+	"gy0gt0wfgz/k1v6HG7bRE+B75SCTuP6OfFQpuhsSGceeQmRAEFRXjbtBhCc+zNJaXs8WUt1N+vTEiiCt",
+	"PZlQGDUQvvMekbBpXWWOFSM2KdugN1D7hDcuNPrDxyjWocK3TDB1NDXbnkI626uEuAc834FoQSu9llG1",
+	"Yz5jSkmVGI5RLYWVfEvKS1aQVYNSRMjOZyVdsDI+WLCO2GpOpMgZcepYdDQgPtOorCUsL4Zv3KtNAxe5",
+	"oZo0PdGUrFkuRaGJ5iK3zVkl83WcHiPkDVDAVnOn63HdJTh+JFVZ299DzKW8WnQFZDC1NlGuvezih61e",
+	"hCjeKG64WGVwQMzJDbV/LaXKHJgFvusqt4hRWhtpaJl5FOJAtAbKBlWY2jBBuDCyjyyAA5YKRrQkS6ri",
+	"SNuJr673znnFdhk+MZCKcnX/qfsad8hsfi2mCEWAUhtqaisVQzCCVWuGJ1KQgm0o2Kc60uLC0N9AZmpD",
+	"A1F3D5nZHejYMlNuKl6yI4jJdVRFBJPis6fk4m9nnz95+o+nn38BS1UpuVJ0QxY7wzT5zFlyiDa7kj2M",
+	"yyMwtMVH/+K5f7PojhsbR8ta5WxDq+FQ9i3ECnDbjEC7IdW6ZEasGwAncS0Dvc+SndhnPgDtFddUa7ZZ",
+	"HGUxUgQr2lkK4iAp2F5mOhS9dppdiKLaqfoYJ3Jzcg4WuFLSyFyW2TVTmsvIw+ob14K4Fv4Mqfq/W2jx",
+	"UIO58aGoFnj9iEnxrZiuJdqhL7eipc2onmjxjWDn5p2yLl3i+3cHTSp4tN6CZFzUq47dZKnkhlBSYEcU",
+	"md8yc7ETOdrgj8Gk6QN/wwU+COqdyAMLDyxUyYoVU0e15PSp4q35dqoHOgIOkOM1fkYj4CtWGnr0205/",
+	"ghjsL/1CWmBJAQ11DLwLoxjd/OZA2mm+FkbtovYKOMAY3YCsxSujfc43a8aVx8GaQi0myHiv+Wptgpv1",
+	"GyXl8viYxGaJ4YAfrM5RQp+hdeIHZm6kunrDmDrGJbRiTE0XL8Hke0WLHXmqtpVLIVgOkhD7BStnFF0u",
+	"eW6RlwW7QL3sCEpVO1grs2BvhpKKLmRtCCVCFl4jjKtbCWcsRA2dV0yowZm1tbMsGAiEnNaw1PAuJmMn",
+	"QNsxo7klcGYZfJ9+bVvZ6ayjT6kYLcCWywSRC/dA7J6uEUmKfiXGKyxO2Yuq+QFclZI50xo07UOvG3gY",
+	"mBE6IeAIcDOLuwLcG9hDriiafPbdz/rh7wDvlHsctomRtzHzcZGAetr0YwzXnzxkO6oY8WKBGIn6ackM",
+	"S5HwIJok168P0WAV70+Wa6bwPf435Xg/yf0YqAH1N+b3+0JbVwnfXndhveQbfK0RVEhnAIoOVlJtsn1i",
+	"GRqFuGjAIJCEcVuYNilj3WuqjfUh4aJA07c9TnAe7INTpAFOXixg5J/9nWI4di6FZkLXurlg6LqqpDKs",
+	"iOEAjkfpuX5g22YuuQzGbm4xRpJas30jp6gUjO+IZTGxBKKmeWp1TlZD5PBBEs75XZSUHSBaQowBcuFb",
+	"BdQN/RsTgHDdEtoyDtc9zmmcKuczbWRVoSUqq0XTL0WmC9v6zPzUth0yFzXtuV1IBrMbD5OD/MZS1ipU",
+	"a6qJg4Ns6BXoHmjYsM4uQ5hhM2ZoYc3GOB+25QW0CrfA3k1aVytFC5YVrKS74aA/2c/Efh4bAFe8vcBK",
+	"wzLrxRhf9JaTvdPYyNASx4sIzR8kwS8khy0IN8iWQVzvPSMXDMeOCSfHRw+aoXCu6BL58RBtu9SREfE0",
+	"vJZgQybYxkLsBPoUeBNkaEa+OyWwc9beHPpT/BfTbgLf5g6T7JhOodCOfxACCaOoC/4ItktPuvcEcFRq",
+	"JqXYHjGS2rEJC+0bqgzPeYVXne/Y7ujX3v4E8Vt7wYx9iAo+2CtwFfYn1v2uP+bdboKTbrtD8AdX3gg6",
+	"Jdeo8XSBv2I7NJ3A1fkrenyvEjdu6nq9oJ6kjCnvrXMSAHSUB216gKWyAXjfSzYV060INDf8GrHFh5sQ",
+	"WebRRb96NCqhseVnaY5jSRmxOQYqIDWG6UZhYM4gVQEk8be0a2kOsdD0ENtLXi843DRTCW2bW5YKUGjV",
+	"kVyWJcuNbr2UtQ3ijK3BMciPUGQIxSFM2INkL7060xzylOgopA8i0WXrGv31NYP3xN/E0JqYbZ+RtYlR",
+	"afsRBh11AodjWO0ioxJuw85gwbxjPCu6ETdsS3NT7gjFBdiRG6YY0fViw41zVeiyk5FVFg4QfZMdmdG5",
+	"K9nwD8+KU/ynLnCoAL0hS/oH9nH4LnsmkA45nNWjkrKc8N4xIEYUgkmeraSSsOrchcD5OCl/aHaAdPpp",
+	"ufPgOqU4JDNiQP5L1iSnAo1LtWHN7U0qvBJBX5yB62BO58PaUoiVbMOszQy/PHrUR/zRI7fmXJMlu/Fx",
+	"o48eDcnx6JHdBFKbjh5xDJs9VeY8ctTgYzWq+Bazvvq034fSjTxlJd/0BveT4p7S2jEuoH9vAdDbmdsp",
+	"uIc8Ms1/1GwnYh7gE8Ub1/2Cb+qSmmO8uLNrWmbyminFC7ZfAbATowyn5Y9NN4yJZTnwaM6yHCM5J47F",
+	"LqGPDf7cZwVrXdb4ZsMKTg0rd6RSLGf2rIDLrW5gPCE20iFfU7FCm4aS9cq52ttxUFJDcDBGbNZiMMRQ",
+	"fsUla82FsTFoZiuylZJ1FRPrLvbKB7PCfZBRMEkFy46drQHmhjbAsKIj7SdS1g/6LYyZerafz5IWO1TH",
+	"WoudpVw3IjeuWWKIcabrPGcsGpEXs4U1qPYyj7Sx5G5AuM/VyoYkEJqbmpbhHoGwVyp23ZQklJcaZDbX",
+	"BNtB51Y7mlvcfLz4kpaaBZiFAczhvu5cxYOVb0naJ8XEh31kElCAh5wRcicIg0a/PLrqFqquQyiHEwcx",
+	"EO3HVBgEGEbL3RGUNjsQUaxSTOMRGz4oaPtVLsM8A+4M1jtt2Gb45mq7/iMhhd4mLXtSlFywbCMF20VT",
+	"63DBvsePacfGRGdUuFJ9++aiDvw9sLrzTOHG+9IXVzuQRW+a+J8jLH5/3N5ze5hhAZ+TWFkRSvKSA+y5",
+	"FNqoOjfvBUVzdrDZIp6P3nCXfuB46ZvEX1QiDx5uqPeC4oW3MXJHvbWWLGLR/YYx/86h69XKGgA6ko+x",
+	"98K14oLUghucawPrldkFq5hC98MT23JDdyAC8T3mV6YkWdSmK5MxEFwbEJf27R+mIXL5XlBDSka1Id9z",
+	"8BWD4bwPlOcZYT09GirEj5AVE0xzncU9NL+1XzHUxqG/dmE33r9bc21fi2H8Nlp8Z1gn08z//uw/X0CG",
+	"GZr9+jj78v87/fDx+e3DR4Mfn97+9a//p/vTs9u/PvzPf4+tlIedF0nIz1+5q+X5K7w/tM/FA9g/2VPh",
+	"hossymShc1uPt8hnQpqGgR52Delmzd4L8NMzEtK98IKau7FDX8QN9qLdHT2u6SxEz3DucT1QK7+HlCER",
+	"IdMTjXc+xodOzfGEALCQPsYfWpFlLexSei3YBlh451K5nDdJH2yytxcEMwKsqfeMdn8+/fyL2byN5G++",
+	"z+Yz9/VDhJN5sY1qh2wbu2y5DYIb44EmFd1pllBAEfaoH631gQuH3TC4pes1rz69pNCGL+ISzkcROqPN",
+	"VpwLG94H+we9IXbukVUuPz3cRoEeXpl1LAlUR1PAVu1qMtbzUIM4XybmhJ+wk77RpIB7m/PoLRldEhe6",
+	"oaScEhXd7APLaJ4rAqqHiEyyTMT4B5VbJ61v5zN3+Ouj6+Nu4Bhc/Tkb1wf/t5HkwbdfX5JTJzD1A6SW",
+	"GzpI9hC5tdoPXd9FQ6hLfWdzp7wX78UrtuSCw/cX70VBDT1dUM1zfVpreI8pqcjZyUqSFz5E+hU19L0Y",
+	"aFrJ7JRB1BSp6kXJc3j7irGnzTg2HOH9+3dweX///sPAjWuov7qpovLFTpBBgi9Zm8yZqzPFbqiKPZPr",
+	"JqUOjoy9R2edEzc2/ujGJ278uMyjVaX7qTWG6FdVCegHbKhd4ghYMqKNVF4X4dpDg+v7g3QHg6I33oRR",
+	"a6bJLxtavePCfCDZ+/rx42eMdHJN/OKOfODJXcUmGzKSqT/69gtE3N5r2NYomlV0FXuOf//+nWG0wtVH",
+	"fRmfGkDRxW4hTZqoHByqRcDTI70AFo6D4/URuQvby+fGjKOAn3AJsQ2oG62P0F3XK8h6cefl6mXOGKxS",
+	"bdYZ7O0oVhpY3K9MkzJvRbnQ3nELrDWwCVx2wQWY9lh+xQq0+LBNZXbzTne57CiaXnRwbRMC2ph1zFqF",
+	"Fn5IFFgV1KnifQvSYkc0M8ZHWbxlV2x3KdukV4fkC+qmr9GpjYqcGmiXwKzhtnVj9BffOaACpLSqfBYY",
+	"TAfg2eJFwxe+T3ojW5X3CJs4xhSd9CopQlAVIQR2SJHgDojCePdi/Rh6cMtY2JMvkj/Qy37imrSXJ/eC",
+	"H2JzuW6+bxhmF5U3miyotjGiSA+boiWQYrWmK5bQkMNHlomJUDoPMzjIvnMvetIF77uu4+C8iYJsG2eA",
+	"c5RTGHwBVsHLTM9D2M9k3/HcCwHmu3YEW5SoJrWxyih0qOo8donVGGhxBmZKtAqHB6NLkVCzWVPtc3YW",
+	"82AvT9IBfsOUQ2OJ5kKDfpC/tLGve5nb36eD26VLN+dzzPnEcuHVckKSuPnMxdPElkMKVIAKVrJVEyLv",
+	"wrG76Y/aBQI4flwuSy4YyWJ+slRrmXMURcEx4+ZgoB8/IsSagMnkEWJsHICN79M4MPlBhntTrA4BUrj0",
+	"TdSPjS/bwd9sLAMAqDyyAhHOEw9IuZcA1DlXN+dXz8UfhyFczAmIuWtaMmH8ja8dZJDvDNXWXnYz5yHx",
+	"MKXOjljg7cFyEE7Y407YhDqTBzqu0I1AvJDbzAaPRzXexXYB/B4NpoFe0Y1pM8s90GQht+hgiEeLDd7Y",
+	"A0saDg9GCwCmDAPcsV/qNLfAjE07rk3FuFCTzxrdpmWXlDoxZeqEBpNil8+CZHF3AqBn7GjLKrjL795L",
+	"alc9GR7m7ak2b5Og+jjF2PZPbaHoKiXoN7TCNOndnAnhLculKtJ2CmBUbpo6FUPzgm2H6U8mJ4AbqZlx",
+	"1r1t+CvEcOUSziEdeNp5RgjxykZLDyD5eltJzbSLQcaj3g3u9ETFbJIIbW1W8ApesiZWIUqmGMLeNc1T",
+	"3KLcJtb1A07TnWOLm7jkj8FSVXE4DrmpvHX0GYEisctbOKDBfSFxyfhGYblN88ebvmof3SidVr0UkMFd",
+	"K3Y6APsMXzOHb6aalQxvz1nntpFdsV3cCMBQNbvw3QIrHyaapGL3MHDdU2zFtWHta5N37Pk97PiN724a",
+	"O1OpJeD3VspGnws9nEM0PzkGGOWz5AriSeCpLooCNPpGo/XpG2gav1R0FpvYUg+8SPugA8Wygpd1nF/d",
+	"vN+9gml/aHQHXS9QMeHCOlEtsDRJNDpiZGobQDOK8GuL8Gt6NHyn7QZoChMrYJfuHH+QfdF3cB8RBxEG",
+	"jDHHcNWSJB05QIPkJEPpGFwwgpQeJ2PPFIPNVPix9/pX+RQpKWXOjjSCC7oGJX20Iw451o/MCvW2Klk0",
+	"/YSQJusYPyLkagw82tArG0LdXWCx8tPEgzWlvVdPGtq13TOgmD6e2D+cU4Kzkl2zMkXnIBQfKe4NOOgZ",
+	"YUdA1xuXVND5JezX6ocr0BKswbQPY5RbBtrN2MNtezVyecLbuzUyLNDOapnTX+9AQ/P81vL38OmuqiBu",
+	"l0Ujq/8euIvSqkIPWd84FsIKg3FwJ4iDYz8d7ON7rBT2vXGmox0mep9CAlTn9B3S5KfvmMEqhWROI5Vg",
+	"Sj/juCDGwZubXaudDrgvcYzTquLFtvfuaUdNWsePQjE8oNxgeygQ8EYsZl8x3Vn3wJhny0x18uueTKLM",
+	"ZTcNf6jThFNx7YskDgnV5PTYRytIsfcd2/0MbRGd2e18dr9n0hit3Yh7aP2mWd4ondENzz6bdbweDiQ5",
+	"rcC5hZaZe0xOsaaS1441sbl/e/7E2lpc6l1+ffb6jQMf3utKRlXW3HaSWGG76g+Dla0lkNggvgjbmprG",
+	"Pmdvw8HiNwnQwwfomzVzBa+CC/WgMkfrXNCO5x+kl3Fv4L3Py84PwqI44g/BqsYdon2qw849Dwh6TXnp",
+	"38g8tAnPXURu2tkYlQrhAPf2pAjPoqOKm8Huju+Olrv2yCSc68fKZQCOBoVK/7XxjOiKoAfacdYpYn0K",
+	"xnuE5iRl3os4lEvVEf4ufCrqWdGocz3BCN+CMe7Av6hRTDqxpGaBJmShLU7uptTZlUu4zvqajP374QlB",
+	"7iW/rH4hXJNHj8LN/ejRnPxSug8BSfD3hfsdHz8ePQqAbtXhqHEAqIB3f0E37GHj9J5c+k9rSRLsZrpK",
+	"gLSDXjLN+c2msF4Znt43jnyQ2dsStHC/WJ0zStHhJrbO4b3lt4QPoZqyey9SMUqN99/G1ozURIq+sysG",
+	"AgKT4UEDMRgL5t4vh9tX1Bt888t0yfO4N4RYaBDtwnq5QWOCjRPWMBix5gmnSVHzYCxopic8SfWADOaI",
+	"EtNXWErRbiGdaKkF/++aEV4wYeCT8ik9w2MWXz+cX8xQGY7fCd3A2CcY/j43hLAiVF9fdTemsetB6FM3",
+	"APdVY7P3iDZvx1R44Xyoa2444+DQGHGrdfzhuNmGGa27vnGTRfHewuBe5LnSVIk5ooW+uc6WSv7K4oZm",
+	"tM9HQvzdRHgVwt4TokPbd9i2Xnk7e3K5U3eT4CPpuhMnuB5XPnCgw2I83peECrvUNvS6E5USZ5ighT61",
+	"47cM42AexMyV9AbqV8SvCABT8Hja8XoxkvjOnva6CUG2s5PA67Npy22iuoqpNvvGMOntHdV9O+1kRb/V",
+	"66FjR6OfW0+9UsvIMLW4ocIwX2zNbiXXWzP7+ga9bqTCNJM67qBTsJxvoqbh9+/fFfnQGaPgK26LF9ea",
+	"BdVx3UC26rvlIldhuIm6d6Q5X5LH86D+tluNgl9zzRclwxZPbAt4kUbcGmXSdwH0mDBrjc2fTmi+rkWh",
+	"WGHW2hJWS9JcyWwNEO9mtmDmhjFBHmO7J1+Sz9DBTvNr9hCo6M7n2YsnX6J7hP3jcewAcFXKx6RJgeLE",
+	"W+/ifIwehnYMENxu1JOoLW+pGPuVpQXXyG6yXafsJWzpZN3+vbShgq5Y3Kd7swcm2xdXE1/yenQRha2L",
+	"ro2SO8JNfH5mKMinRJwoiD8LBsnlZsPNxrlhabkBfmpL39pJ/XC2yLo9mxq4/Ef0Zqy8M1fPBPSJdW26",
+	"ifMDRZ/TH+iGdck6J9TmFi1562fsaymSc5+6GMu4NdXbLG1gLkAd1RxYQiyKwoVBs0Btltlf4P6laA7i",
+	"7yQFbrb44nmkdF23KIo4DPBPTnfFNFPXcdKrBNt7HcL1hchZkW04iPqHbVx2sCuTbpfRaU3Ky2986KlK",
+	"GYySJdmt7rAbDST1vRhPjAx4T1Zs8DmIHw/G7JNzZq3i7EFrWKGf3r52WsZGqlg9gna7O41DMaM4u2ZF",
+	"cpFgzHuuhSonrcJ9oP99XR+8yhmoZX4vJy8Ch7zXBncDfLEN/Yrv8lbbfaft6FyxBcQPE98vcXn2vlre",
+	"p2Zzp/MhULkuE6FLGBE64es9ih12A76/iSF4sO2sUIpGXdRinPmVjKDsC302L7Qu3jlit0odIPABBNTC",
+	"DTUn3TJpn94fzlswh35Z8MXDin/0gf2dhQ0S2WOQWMSg4Gt0OYvme+AaSslXcjt1UXuy2y/svwBpEiR5",
+	"y5ZMsWioXvMJaACYDAraRl9/x50azl+FD+4w6oKVEi5nRh4uMv5AiwCUmY8sRc3L4uc2yVIvE7SiIl9H",
+	"ve4W0PEfVvGFBg2KlkjR2iBrKoR16xoMZy+M//AXy8jV959y6jwbLia27eeotuj2kGsB74LpgfITAnm5",
+	"KWGCkKrd/DVNfHS5kgXBedpCFK2KNazSHVRHxGqlsX2DH2yMFnRGo5gtzkeYKNCkdEK+xUwSAEsn9y6a",
+	"cpqkgJ0ya3VVSlrMMWkjPOYTO6vto5iplSsOuLIaUAeLdKDDIRELY0EKxwiNBqy1waz/2tBNFcv1BC0u",
+	"fQPCe8/0aOMIqXNCXlnzkvbGCzsJwZydasMK0kznLjjIE/AfY2i+hgayc7qlWX56VUvPla1Vm/r/5w0n",
+	"2n0HcLvClrau5ZxIUOJuOOQfXFPDrlk3vZQHw2tkPt1UFz1VC2E5JV5aeiQX4F3I7oHDcZu3wChkPcIf",
+	"eCq4eJ8Di3xeYK8YUw4qhvYe63yyIp8dlHzvDK85FVLwHHMzx7QkTIUzzU1gQhrreIiVc1zUs8jmitYp",
+	"baLeHBWTlUvnsw7hhi91wVdYVMsd9k/Dtq7a1YoZ7SQbhH67crvusYALzVwhIWCiUE5KFfFKiOkj7ZXl",
+	"QDbCLBcJ68838O0HZxuELUiuuC0r78hmGZpbcz5EbAO3C8INWUmmHT7dVF/6HfQ5waxXBdt+OHktVzy/",
+	"4Cscw3reANrWzWw41Jl3OnNOXtD2JbR1OYGbnzv+HHbSs6pyk6aLMUf1AUgAmyJw1O/Avf8GxG3GD0cb",
+	"YbdRb1E8T4HRIMsz0YZVxMUYJgoT96IJ4f5gOQpbEBtoEiNK3N/+NRf+eSl+QOTRIwEXBvdrop/OFTX5",
+	"uiOGJruZ9AWaNu598r5D9RbYOeZX+czPkV7GtqZyQnA0DVrFjYod8ZsCuDtQJl5ClLH33htWSEatyilR",
+	"LkqxWzM5JjhAcPuq7N0DYLgNhjqR7Y7pwQ89iVI5nxZ1sWIG8gnFTDtf4VdCiyBXNKQor5sCQFVFAKh+",
+	"ztcht7mJcil0vRmZyze453RBEfIIN4SF0P0KA6eB1Rn+jZWESK+M87M8OFjJO1UWTRzyIXpzd6SB1gs8",
+	"nUGmkemUwDPl/uRop74bo7f9j8rppVx1AfnEmR7HpFy4RjH59jUcHGEixIFLqz1amjyF6D4q8btP7dFk",
+	"2OpKJR++P5jTLV5kyXrA+4ZRwK9pmQgQDMzu1J6v1sUgFSaYJ6NaqXGJaAwloyIomdzDuvjhdwtF/Hkl",
+	"5dZnvfrg86D3NM1woGcnXSUbgnpv7yFA3/lQElJR7vxnWmExpKxzjU1bbsc2XbvAfSRcNGrSePrddSpy",
+	"1CdUwO/9svxXzGWnqxS75rJ2C9a4Lvorof11iQl4wgQNSfyjrsG/t0U6aT+/dKVDLZruTv7dz9bRlTBh",
+	"1O5fwJo+WPTXaHwaixt+6TUzZ6dyylXU3mSmnpWv7AkLfuDX2UYWY5knvvuZvPLPfJPOHc/Isbx1snAF",
+	"qKNZN167kkq+GWifk6f93nU6q6rxqROpNoaT24aHTp/K2Qf7c8zq9sbvX0z5S0ITQuSuEuSFEGxrEnVj",
+	"+2kFbhhh24ph0vAgQ0Q6DdFUhnLR4nhbzUpGNRuhcJj+0rWdSOTL7WtoPy1rSX9v2Up0X4MoiAlZS3Yv",
+	"NjtWYXuUcjT6FXUec5zH3hElHgdFz6+BmTt+BGKPvzFaeG/ACUp0H9NJGfucjOyXA0g+GCCCHiA/fuwg",
+	"e81XaxOg8WZPzvQ2TzpSv5Kat/VUSxjMrc0ahzuZ6nUPqPLw0Xw4lnd5vWa5wSK6rSufYuyQDPAwmX8N",
+	"+zN3epqNmuAEL3dG8qTPZ6FMj0baO7FG2xxv+LCMXgdDRnFtIoesYk19PQXv7m4I+AGLNkXdNZL+3r3U",
+	"XYHPVqRSQRyx82I/LT0688ANiBfjhIwHw5xZ55n/J4lpQzuOS84f7JMKFASOzEhyKQTLAWVbztd5rxhF",
+	"l0uen0z3l7rsxkZSQWRtVhL3KMNHKvsoJRVfcdFrykUuN75plF4NnLb+dnx+UER8nSHh8mcBjzANnnhc",
+	"r206WeIK57tMJNCBVTJfx6+eS2arx6YU+ZU0Nu4TCehbH2Z34UIbKnKWeF+wx4NtYr2MbEZ8TKxia5Qn",
+	"PMPxep/ZKv/xJbtmiq5Qb7A6NIzs+kUXkihWUqwD7aSptIFCYRuNVBZUSEfpOGV9nzG/NFesn8iKYQ3Y",
+	"zuLGQwQqzOAIZ35mFK/GFAv4brnGn7tUGwID9DGYNwVNAZoKAwhgoyC6cfQMXSWYxu2uNlLY7pDFzlOe",
+	"GLqanCsv2OGXdHVpxz64yGHIyL3aXBNiSBsnu/4+DfZPsOCOOC1IeyRXgNcoQUFLEyykoqWxrcx+Qt6i",
+	"sZ8qaEJ1reDc8K/luPRAXjgByJPHTkqQGy4KeTOUhGsqipLhNWmPNPLg2B4KY9eE8W/eTZ4k12zi5rGD",
+	"FR1DbWRVG21oMA2pqHZRKLQPYirDtx3CRrSq6MMbTLpAY1wzIWpgjcCqmHKU7aidhawXZXDcWsAHs+5F",
+	"c2zuPVh5aZlGrCHd0XGbuop7IIhjqJkw09ZMBxma74ZRM9fElerPOILBAetzJDwOXpVp2Bi6GoXfS/9x",
+	"mRvKn4g4iG7XwW5KsX+MNQeM1Fnt2CL1yWhRj8n6TibW79hu9F5Ih/ktgxyt9og+QHM9a8JdbUoJ0BhX",
+	"TKCHVdFL+zQ5+cxyyXLQksbzif59zUSQq3Lu/UQQlmWQXpQ36RCwbsvhXlAtQCW9IzwlPR44qcQmV2z3",
+	"QJMON0QrqzdZQe5SsgMpgLYVMEtWUtMy5djmIny4bjgDqeDDN2131hY/i213nC6wgt5xLs+SXXvoyJTX",
+	"0rA7zgVdD0q4jpH9qZSjoMF9RUXUkkmd8czePu14B946z9+M3Sabi+daahO70CSum9EsD0OVChOLizY8",
+	"yAISFi1BKkvBUtoH1SmV3F4owWvSFbBQfLWCfenCwQXi9n62oaKm5ftZk2sbIVLW8ZgVQdl3Rs7enEcR",
+	"nnKrhsXShsJ18/BrdC0MLydMwLYVV0wfOsHIhcRmoHCE9ph6gOL8ilUAguLfUbCb0i6a5LIsrRUlOIL8",
+	"C2Z3sw5ewliFYYLZDQObb3oqyCyFTYjtxAriipo4D2b7RkrOjV0k7T/bYFjb1zpSFixX+JjR6CpoRfXj",
+	"O/cdg1YHwxT4zi1lWcob//M0AbRQkhY51QmkZG1y2bt6U2PYpkIdynrhA7jNOP25XxAhTeb6AFNC9MhN",
+	"NiCq/2DRbH+EGTKoiw99NRPtHyjOUUvKGFUlZypzTxEKGpp0UeIG2CzhVAio4ye7qXNqhW6EAgDM3Pkc",
+	"Rid0ZfwzZA+VEI9rRq858AcIdaV9vrCWeW+YYuKBaZl4brMwII9gLCDSa7I9ot03P8OM8QfGYCHiYNtv",
+	"dsU73I95LNg2Z/aosmwyiSlH3pkC3QYWQAdbee+wFrwpyHi7cCMt2lUYGVrtvX+MrWw721gJlzHgQ/nh",
+	"UbAzpvO7T4V5bIRs77ugRdhhD3hPWbChY3xPAg+k13Cj9Tk48JPvMEO7gj1CN1TqITtyHAXe/l9fs2iZ",
+	"Wpf7z+X3NsyrVsIqImO1/VN6yN/Xu0ER6hssz/ZPJyvYNc/dA4w9u4tD4kcGj7z2YdNWRGqKPbsfu3X9",
+	"XaabprjIAM5KyhKBZc6PW6xwtCZcJrEjtqnbSTh44lpitgllDgowOkEW94AdfWofCfgYrhgW4aqcwXwQ",
+	"WHASVJKjhSUr6kd2QeHrNff/swvahNh1s1gnDBN+KyAdLUVcl2ncnXaWfMUM5aV2mQ7inA3REXGGzW1p",
+	"iCbQyyvpTPvffB0YO0vJr1jAXTasDtPYuxZRP3Hvgp6NPPUPMnATHgd62czM20xcw6jbIQ/bGPa8lCi2",
+	"UknruhK5CdZ/oG2KD9RP8AxBuJZMqZajYGyWGRle2lJwjJECGtyRCDpZ+94Cl6xI+bYtubnhuZK2YAF1",
+	"6UtCBIliGwrQqaAwZnrOMWK/tN99kmF/V9vrDt/w65TzEBcLi7f2iBhy/ZI4U9r+5MV38YznQjCV+TC5",
+	"fmYIwVQInG4clTBnY7AxmuiByYrniCiJOpXnQywH/sElVmR+HWQDvmK7U+u6aU/btsRVCL31TrE4BNWj",
+	"eqt91KCBuH90ubIIrI4C5+/peD+fwYGeuladD4t99vfAFYc7Hdw7m+xFcE9/oIdaw2cYItQE496gFhSc",
+	"qw9PCDkTNl+cj8sNy40OJocL1sj8W5y1qG39XRcTcPJexBNvoWVe3VO++WHGpRrciu89lR1kfKJPpzj1",
+	"1JWAqSwUMS3lwgbcvcQdH9O8MQNzkJ0c4zApcYF6RJcylgrmTmmiYaw4qcLZECLDxJQkxQ0YbvAoBVwW",
+	"gr2JDpocBy5vAZdBnoOhxgRmpWwjFctKiakKYs64SwPq2IYbTbDo7YrIKpcFs9W9vcGqnTDuGGLnqoWg",
+	"eJqyIDK8t5zQEEv94buQJK5PUD1y4pQgWG0sVIYn8N5ETp7Ml9DHZqZtixm04VU2JC+RXIVpV7/AEck2",
+	"HoKMq4RJt5vY4ujWtJPbwY49cygmsSrXQaab8yWaLjkGi3czAmMPUHRyVjRmy3a1XBS0P8JbE7kvFEdu",
+	"eFn6Fy7gAVW7F5NwlJ90jfH8mA4OpnhONlIb/zSAI+lmqDZHwme5FEaBZaZrMrZM4kJDvqfbszw3r6W8",
+	"gsy+D/FuI6RpMC3mPllqP5tFO5Pqlc+Y+kIHwde4IHr/Xdi2AxAcbRgmD92hp427fNsS1FI5V+Ymsgcv",
+	"+XOipeUHHIpo5laxU4ChyfJvp1uwJabAO8Ay2ZVhwanwLQy5N9YvoMkEETkYPnJsDKjYIeJAWsaV6jNB",
+	"qJEbnsd30x8rUUUyvURMMsZIYXs44zo2Q1toeBo1cckomYdkZgK2TvS90op2F59prUmVcSkGe+M2vqCp",
+	"k3B4XLgDPMuTekYPAITUZlQ1tcJIu44S0Mg3ubIelegO1wd04mGGQfz3gw1GODpQht0LqEHikGMCeDvO",
+	"yR0BkcqAcNGyj8ImTY77xK6P5i8YTxdgazgupiYNaApqTjy/AwDSaQQ6MExKJnAoGPZ5L6MmoUqgVWQe",
+	"3ORcdrpgdO60AJwlfL2DsWvFXM51FG5EdYNZKmrW/qCG5kPbJdjBnIfqr0xJW7R8HrgDsZJtbAL8zmVT",
+	"VrbwZTicSwRfoxYLDtuur246k4KxCs/jvlUm5r4eXtZ6F3OHexYEnk+hbvSmbglrV4rsuYYnLPCZ3SZ6",
+	"6lYCiK55UdMO/fShakXX8ARbeYpC4WH9ME1SHCwk4siNiYi9iT5qndqXIp7nI6xD0Bjdcbai8dyzTNju",
+	"bF3RG5E2ScWuKf6uNXHBuBTho9qW5ahbdBNZ3J8mBAcjmq/24xA+NKLyMHakuY2ET/RhwERHXGnixgwe",
+	"hqMHacuL97OzDu7Xmb1Hs2LfuJ7bf7Ij+OTP+sz3T2+f0d2THC92sdEMpW8DffAK4vGIseQDNHo1KVhA",
+	"YZC1ceZVnD5yVJ2QS0k29Ir1P1ipbW2Fmhf2RbdhWjwPdk1BD3taG4nxKHAlgodwlDeYFw0Snqomeet4",
+	"8sfhNc+tZlMfOiBHM2hxWLBTPKC+M1mrkU2fcPLFtg9OIsi+AxC2+c1BwSp445Bgk/2AjG2zTp7fSeHp",
+	"rbyEnCE/XjOleJGCVDM0b/fKfXrrnesbUZ7twy3XkQG4brUHTJzH2sRsQTNwSS74csmUjYrQhooC3muD",
+	"5lyQnClDOVjld/puBslzHy5KraEQWhPX+ujGyP5kR7FKTrMmwnLG7HmNxOkYD4ez392aOG3modo4DYQN",
+	"3QL2mJMtwcWuChKsqtNHpEDLiJXXh82j+a9sfBqsTeie4o3EWadMMb5Zf0TSoU7zk+BmdLvaK20/SZ6N",
+	"GrC7KXCiaYx3dnGGm6hKxOlV3dyGjc+PS/zj19q+SXq74clYCkR380+sIr7KuKSYoV1ETzcZdh5+IjLb",
+	"qakZqq96JLVDa79EWmt38xi8h/f1XkuU0A/0gIuZNdnQouA4eBw81Ms1qWq9Dt7soGcPiMlU259vMqtk",
+	"lU3ysvch+RYgB+sQrlTimVH+aN7rdFNOOOTHbl1hHG8656TrGu+7FVb5mDqburUkZGjXZiWXKM1wE9u7",
+	"GvqoNzeUeT9nV/dW1ogJQoliea3QrnBDd/srv2cmDqVPd2pH9lZbl+GzhdqJBiuQ0Oxj4R8UVj/kxh6R",
+	"kRF+jZS0Pj4yNo9v67v626Hj3HPiCJy5mwNAOc5vrW3Ls0qE16jYxUScdze5A4KpC/uETJRHW6pmt/wW",
+	"CxQ90keSrp0NrNdNFsZJoA2zEkaoORYK0wnMDHyvg0o4yia3xHdYbyLsy4vvW9PhtOAC32EPeGG2o7Zd",
+	"4wvlwPmdy9V83xAlQOVDihM66O9LoNTEFHtba7BE1tBsAx5wF8uhHA+yY+mXTdKphCIxyE2lpDRECri7",
+	"RHJaabST2NfggHF80NGnz0v1DVfanLkAkLdpd8swNDcksiWlvluhgtd00twl/Q2mFm8wnuHviTCQM0Hc",
+	"UM6IOxD+aFmkpfXNWUaCymClyZMvyMJVYqwUy7nuG4dvZF0WodfFNVN86Rwk2NbsCe7YhycGJN2ZjZf+",
+	"rYX8ENjDZBt5ghC2W/R3FiqJnRvl8hj3DdgiQr9xGYXNYxZsjHmxxvHx8MixuF87CO3W6UsEt4IXwnj5",
+	"O+d+4yOzmiCn1mwet6WMRU7ZAdrwuclBqw7gZvQYmeGOlc4k3DmVrzpphVvlOVAcpGJHTi8c3AEPTC8c",
+	"YoaFHCajh3jg2V5rNsTzoPvrmD7U4jY1N3YkDWcypbVZTElpHY/+ge6YU9sSBBqdEASV/PLkF2suRqH1",
+	"6BFO8OjR3DX95Wn3M0jNR4/isdyfKpu2Ty2CY7h5oxzjkq0OSqVhABONpx9/685QH0UNI/jw8yjapZ+j",
+	"556KHV1dkU+rr1jH6r2JCC1qrvG+YyMgmUe5mShG+59TWcVs/aZEGbXeXoAg3r3vFmFRPIhTYYJprrHs",
+	"2z9c7dxPS34PgY0XHIpJC+tBNRT6GwAJE8G1M3kwVVDubkKlu2GCN7+uyFx5rbjZXQD9vVGH/yOac/3b",
+	"Jl+Oy1bbPMs49c7IKyZ8SeI2u06tvQL5raQlqlz2tUiAoiXLE/L1lkJKNiek/vpg8R/s2V+eF4+fPfmP",
+	"xV8ef/44Z88///LxY/rlc/rky2dP2NO/fP78MXuy/OLLxdPi6fOni+dPn3/x+Zf5s+dPFs+/+PI/Hszm",
+	"Mw4gW0B9FcYXs/+ZnZUrmZ29Oc8uAdiWJrTikJLo9hatJ0sJ6CNRc5SCbEN5OXvhf/r/vXQ7yeWmHd7/",
+	"OnP1qWdrYyr94vT05ubmJOxyusKIuczIOl+f+nlu5z2Kn705b5wJrasHrmhr0TyZtaxwht/efn1x6ROA",
+	"NLkAZ49PHp88gfFlxQSt+OzF7Bn+hLtnjet+6pht9uLj7Xx2uma0NGv3x4YZxXP/Sd9QyFFy8k8bTQw/",
+	"XT899dry6Uen49yOfTsN339PP3aCKos9PfGh9vSjizvc0zo0Sp26YNKgw0QoxpqdLuT2gKZMB43TqOAd",
+	"Wp9+xFtg8vdTV7Yz/hFv43YPnPq0RPGWHSp9hKje236PHN5IkBV7v9XV6cf2YwAqFmU6dfX+TjGLZvix",
+	"NFSfasyCPvh5gLZNgH6K9ed3w593Io/+OByok1YM+D76NvnW1takpHRJ4gfZyPRsPms24nmB8tH0U5xp",
+	"DLq2fjO4yZ4+fuwli7uaBhxy6jbUzJ6Gkyvr9GeNnDhD0TKG2e189vxAQEfNj53yOBFgvqIF8TFHOPeT",
+	"Tzf3ucA8aSAziT0TEILnnw6CzvKR79iO/CAN+Qbv57fz2eefciXOhWFK0JJgS3siYfDJcIv8JK6EvBG+",
+	"JSgT9WZD1W7y9rHJc9/NKsWvqVPlmmZiNfuA4aA2Dq271c6KYsD0Vqli2nwli90IxTZ6VblieC3RWp2S",
+	"C0BhqEDfziOX/wFaxAbL+wdWIQs2C7U98PK4vadM6D3DU2XOI3YPtIeD4uUtdR1Qo5kt+k+UduThfWAf",
+	"C5+/8pPqeoGeiVL8KVP+lCmNTPn88bNPN/0FU9c8Z+SSbSqpqOLljvwkmuC5O8u4s6KIZintbv29Mg5s",
+	"JfBytWLg54r8mi1ksXNp4medCa6YvT4OFJnTj50/nSo5s34VsSQr8DuhZIUlyYdILHbk/NVAw7Hd+pL3",
+	"qx02bZ30Zi/efbT3L7hctNejPogDyTgP1rwvmz7EpeYY2wMiK2ka7xKL1J+C6E9BdC/lZvLmmaLfRG8f",
+	"3+LAdHBmz33N/44PHyZKQhPnAJQpd5TfdfseZeGH95/YfccmdIIAjvaDS57WI/OfIuJPEXE/EfEti2xG",
+	"3LVOaESY7rD70FSBgUF0Rcf1Am5ftiKEbV6XVAUO+fvMHGc4ojNufAqp8akvdVFaFYXP2rPl1pEmsoDH",
+	"vef9KfL+FHl/HJF3tl/QdBWTe9+MrthuQ6v2PsSY0qcLKgIjc/tb7FVCr2tTyJvgDQOhR+AjbwWRj0Pz",
+	"ctgo+rHW/b9Pbyg38Ezu6hXQpWFq2NkwWuIC85L1fm3rkw++YNH14Mcw9Dn66ymK9OTHPlFiXwdG/Wgj",
+	"+8qQaOTTo/jP7Str+GqJZ07zXvnuA5wXmqlrfxy1j3AvTk8xF+BaanM6u51/7D3QhR8/NLz5sTnEHI/e",
+	"frj9vwMAJZNuYxwhAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -30,6 +30,9 @@ type ServerInterface interface {
 	// Get a list of unconfirmed transactions currently in the transaction pool.
 	// (GET /v2/transactions/pending)
 	GetPendingTransactions(ctx echo.Context, params GetPendingTransactionsParams) error
+	// Stream the events of pending transactions.
+	// (GET /v2/transactions/pending/stream)
+	StreamPendingTransactionEvents(ctx echo.Context, params StreamPendingTransactionEventsParams) error
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
@@ -112,6 +115,40 @@ func (w *ServerInterfaceWrapper) GetPendingTransactions(ctx echo.Context) error
 	return err
 }
 
+// StreamPendingTransactionEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamPendingTransactionEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamPendingTransactionEventsParams
+	// ------------- Optional query parameter "sender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sender", ctx.QueryParams(), &params.Sender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sender: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamPendingTransactionEvents(ctx, params)
+	return err
+}
+
 // PendingTransactionInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context) error {
 	var err error
@@ -170,6 +207,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.POST(baseURL+"/v2/transactions", wrapper.RawTransaction, m...)
 	router.GET(baseURL+"/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
+	router.GET(baseURL+"/v2/transactions/pending/stream", wrapper.StreamPendingTransactionEvents, m...)
 	router.GET(baseURL+"/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
 
 }
@@ -177,188 +215,253 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNpLov4I3d1W2dUNJ/srFqkrdk+0kq4vtuCwlu3uWX4Ihe2aw4gAMAEoz8dP/",
-	"/goNgARJkMORFHuzzz/ZGuKj0Wg0Gv35cZKKVSE4cK0mRx8nBZV0BRok/kXTVJRcJywzf2WgUskKzQSf",
-	"HPlvRGnJ+GIynTDza0H1cjKdcLqCuo3pP51I+K1kErLJkZYlTCcqXcKKmoH1pjCtq5HWyUIkbohjO8TJ",
-	"y8n1wAeaZRKU6kL5I883hPE0LzMgWlKuaGo+KXLF9JLoJVPEdSaME8GBiDnRy0ZjMmeQZ2rfL/K3EuQm",
-	"WKWbvH9J1zWIiRQ5dOF8IVYzxsFDBRVQ1YYQLUgGc2y0pJqYGQysvqEWRAGV6ZLMhdwCqgUihBd4uZoc",
-	"vZ8o4BlI3K0U2CX+dy4BfodEU7kAPfkwjS1urkEmmq0iSztx2Jegylwrgm1xjQt2CZyYXvvkdak0mQGh",
-	"nLz77gV5/PjxM7OQFdUaMkdkvauqZw/XZLtPjiYZ1eA/d2mN5gshKc+Sqv27717g/KdugWNbUaUgfliO",
-	"zRdy8rJvAb5jhIQY17DAfWhQv+kRORT1zzOYCwkj98Q2vtNNCef/rLuSUp0uC8G4juwLwa/Efo7ysKD7",
-	"EA+rAGi0LwympBn0/WHy7MPHh9OHh9f/9v44+R/359PH1yOX/6IadwsGog3TUkrg6SZZSKB4WpaUd/Hx",
-	"ztGDWooyz8iSXuLm0xWyeteXmL6WdV7SvDR0wlIpjvOFUIQ6MspgTstcEz8xKXlu2JQZzVE7YYoUUlyy",
-	"DLKp4b5XS5YuSUqVHQLbkSuW54YGSwVZH63FVzdwmK5DlBi4boQPXNA/LzLqdW3BBKyRGyRpLhQkWmy5",
-	"nvyNQ3lGwgulvqvUbpcVOVsCwcnNB3vZIu64oek83xCN+5oRqggl/mqaEjYnG1GSK9ycnF1gf7cag7UV",
-	"MUjDzWnco+bw9qGvg4wI8mZC5EA5Is+fuy7K+JwtSgmKXC1BL92dJ0EVgisgYvYPSLXZ9v8+/fENEZK8",
-	"BqXoAt7S9IIAT0XWv8du0tgN/g8lzIav1KKg6UX8us7ZikVAfk3XbFWuCC9XM5Bmv/z9oAWRoEvJ+wCy",
-	"I26hsxVddyc9kyVPcXPraRuCmiElpoqcbvbJyZys6Pqbw6kDRxGa56QAnjG+IHrNe4U0M/d28BIpSp6N",
-	"kGG02bDg1lQFpGzOICPVKAOQuGm2wcP4bvDUklUAjh+kF5xqli3gcFhHaMYcXfOFFHQBAcnsk58c58Kv",
-	"WlwArxgcmW3wUyHhkolSVZ16YMSph8VrLjQkhYQ5i9DYqUOH4R62jWOvKyfgpIJryjhkhvMi0EKD5US9",
-	"MAUTDj9mulf0jCr46knfBV5/Hbn7c9He9cEdH7Xb2CixRzJyL5qv7sDGxaZG/xGPv3BuxRaJ/bmzkWxx",
-	"Zq6SOcvxmvmH2T+PhlIhE2ggwl88ii041aWEo3O+Z/4iCTnVlGdUZuaXlf3pdZlrdsoW5qfc/vRKLFh6",
-	"yhY9yKxgjb6msNvK/mPGi7NjvY4+Gl4JcVEW4YLSxqt0tiEnL/s22Y65K2EeV0/Z8FVxtvYvjV176HW1",
-	"kT1A9uKuoKbhBWwkGGhpOsd/1nOkJzqXv5t/iiI3vXUxj6HW0LG7b1E34HQGx0WRs5QaJL5zn81XwwTA",
-	"vhJo3eIAL9SjjwGIhRQFSM3soLQoklykNE+UphpH+ncJ88nR5N8OauXKge2uDoLJX5lep9jJyKNWxklo",
-	"Uewwxlsj16gBZmEYNH5CNmHZHkpEjNtNNKTEDAvO4ZJyvV+/Rxr8oDrA791MNb6tKGPx3Xpf9SKc2IYz",
-	"UFa8tQ3vKRKgniBaCaIVpc1FLmbVD/ePi6LGIH4/LgqLDxQNgaHUBWumtHqAy6f1SQrnOXm5T74Px0Y5",
-	"W/B8Yy4HK2qYu2Hubi13i1WKI7eGesR7iuB2Crlvtsajwcjwd0Fx+GZYitxIPVtpxTT+i2sbkpn5fVTn",
-	"PweJhbjtJy58RTnM2QcM/hK8XO63KKdLOE6Xs0+O231vRjZmlDjB3IhWBvfTjjuAxwqFV5IWFkD3xd6l",
-	"jOMLzDaysN6Sm45kdFGYgzMc0BpCdeOztvU8RCFBUmjB8DwX6cVfqFrewZmf+bG6xw+nIUugGUiypGq5",
-	"P4lJGeHxqkcbc8RMQ3y9k1kw1X61xLta3palZVTTYGkO3rhYYlGP/ZDpgYy8XX7E/9CcmM/mbBvWb4fd",
-	"J2fIwJQ9zs6CkJmnvH0g2JlMA1QxCLKyr3diXt07Qfminjy+T6P26FurMHA75BaBOyTWd34Mnot1DIbn",
-	"Yt05AmIN6i7ow4yDYqSGlRoB30sHmcD9d+ijUtJNF8k49hgkmwUa0VXhaeDhjW9mqTWvxzMhb8Z9WmyF",
-	"k1qfTKgZNWC+0xaSsGlZJI4UIzop26A1UG3CG2Ya7eFjGGtg4VTTPwALyox6F1hoDnTXWBCrguVwB6S/",
-	"jDL9GVXw+BE5/cvx04ePfnn09CtDkoUUC0lXZLbRoMh99zYjSm9yeNBdGb6OylzHR//qiddCNseNjaNE",
-	"KVNY0aI7lNVuWhHINiOmXRdrTTTjqisAxxzOMzCc3KKdWMW9Ae0lU0bCWs3uZDP6EJbVs2TEQZLBVmLa",
-	"dXn1NJtwiXIjy7t4yoKUQkb0a3jEtEhFnlyCVExETCVvXQviWnjxtmj/bqElV1QRMzeqfkuOAkWEsvSa",
-	"j+f7duizNa9xM8j57Xojq3PzjtmXJvK9JlGRAmSi15xkMCsXjZfQXIoVoSTDjnhHfw/6dMNT1KrdBZH2",
-	"P9NWjKOKX214GrzZzEblkC0am3D7t1kbK14/Z6e6pyLgGHS8ws/4rH8JuaZ3Lr+0J4jB/sJvpAWWZKYh",
-	"voJfscVSBwLmWynE/O5hjM0SAxQ/WPE8N326QvobkYFZbKnu4DKuB6tp3expSOF0JkpNKOEiA9SolCp+",
-	"TfeY5dEeiGZMHd78emkl7hkYQkppaVZbFgSNdB3OUXdMaGqpN0HUqB4rRmV+sq3sdNbkm0ugmXnVAydi",
-	"5kwFzoiBi6RoYdT+onNCQuQsNeAqpEhBKcgSp6LYCppvZ5mIHsATAo4AV7MQJcicylsDe3G5Fc4L2CRo",
-	"D1fk/g8/qwefAV4tNM23IBbbxNBbPficPagL9bjphwiuPXlIdlQC8TzXvC4Ng8hBQx8Kd8JJ7/61Iers",
-	"4u3RcgkSLTN/KMX7SW5HQBWofzC93xbasujx8nIPnTO2Qr0dp1woSAXPVHSwnCqdbGPLplHjNWZWEHDC",
-	"GCfGgXuEkldUaWtNZDxDJYi9TnAeK6CYKfoB7hVIzcg/e1m0O3Zq7kGuSlUJpqosCiE1ZLE1cFgPzPUG",
-	"1tVcYh6MXUm/WpBSwbaR+7AUjO+QZVdiEUR1pXR35vbu4lA1be75TRSVDSBqRAwBcupbBdgNPV16AGGq",
-	"RrQlHKZalFO510wnSouiMNxCJyWv+vWh6dS2PtY/1W27xEV1fW9nAszs2sPkIL+ymLU+TktqntA4MlnR",
-	"CyN74IPYmj27MJvDmCjGU0iGKN8cy1PTKjwCWw9pWSwkzSDJIKeb7qA/2c/Efh4aAHe8fvgIDYn1Z4lv",
-	"ek3J3n1gYGiB46mY8EjwC0nNETQvj5pAXO8tI2eAY8eYk6Oje9VQOFd0i/x4uGy71ZER8Ta8FNrsuCUH",
-	"hNgx9DHw9qChGvnmmMDOSf0sa0/xd1BugkqM2H2SDai+JdTj77SAHmWacwMOjkuLu7cYcJRr9nKxLWyk",
-	"78T2aPbeUqlZygp86vwAmzt/+bUniNqbSAaashwyEnywr8Ai7E+sI0Z7zJu9BEcpYbrgd7QwkeXkTKHE",
-	"0wT+Ajb45H5rPfzOAr/AO3jKRkY11xPlBAH1fkNGAg+bwJqmOt8YOU0vYUOuQAJR5WzFtLYum82XrhZF",
-	"Eg4QVXAPzOisOdY7zu/AGPPSKQ4VLK+7FdOJfRIMw3fWehc00OGeAoUQ+QjlUQcZUQhGGf5JIcyuM+ch",
-	"7N1IPSU1gHRMG0151e1/TzXQjCsgfxclSSnHF1epoRJphEQ5AeVHM4ORwKo5nYm/xhDksAL7kMQve3vt",
-	"he/tuT1niszhyrvVm4ZtdOztoRrnrVC6cbjuQFVojttJ5PpAzT/ee855ocVTtpuY3chjdvJta/DKXGDO",
-	"lFKOcM3yb80AWidzPWbtIY2MM6/juKOU+sHQsXXjvp+yVZnf1YbPKctLCf3WsfPz9/PV+fkH8p1t6Q3b",
-	"U0/kITqu6rCIubuNSomuNSRn5n0rBc2MgBDV7eMi+SKpnDNVFJyVMuD81Z1DyjetQL6xMJAZpLS0XsmO",
-	"azsIavdQtR+RF1u720ZhdCEj1eNlru2lHWJ1IUVZEFVtu6UCTTX8MarmeugYlN2JA9+g+mOfe5B5Juab",
-	"O7it7UBEQiFBIW8N1SvKfhXzMP7GMV+1URpWXQ207fpLz/vsXe87R/CccUhWgsMmGnLKOLzGj7Helr/3",
-	"dMabtq9vW3huwN8CqznPGGq8LX5xtwOG9rbyi7uDzW+P2zI+hJFHqFyDvCCUpDlD1ZvgSssy1eec4uM+",
-	"OGwR/wH/jOlX97zwTeL6pYj6xw11zin6jlRP/ihfnEOEL38H4LU+qlwsQOmWlDgHOOeuFeOk5EzjXCuz",
-	"X4ndsAIkGvH3bcsV3ZA5zVE79TtIQWalbjJXDJBQmuW5s4SYaYiYn3OqSQ6Gq75m/GyNw3lLoqcZDvpK",
-	"yIsKC/vR87AADoqpJO7n8L39ii5obvlL546G0ar2s9Wdm/HrKIoNvv3rCMz/c/+/jt4fJ/9Dk98Pk2f/",
-	"cfDh45PrB3udHx9df/PN/23+9Pj6mwf/9e+xnfKwx9z3HeQnL92b4uQlCo618rwD+ydTnK4YT6JEFpqI",
-	"W7RF7hvx1xPQg6ZaQS/hnOs1N4R0SXOWUX0zcmizuM5ZtKejRTWNjWipEfxadxTHbsFlSITJtFjjja/x",
-	"rmtQPFAGrTku9gXPy7zkditL5SxK6AfuXTTEfFoFQ9kkCEcEI2WW1PsXuT8fPf1qMq0jXKrvk+nEff0Q",
-	"oWSWrWNxTBmsY1K2OyB4MO4pUtCNAh3nHgh71BvFGsXDYVdgnmdqyYpPzymUZrM4h/Pete61vuYn3Lq9",
-	"mvODtqGNUzmL+aeHW0uADAq9jAVHNyQFbFXvJkDLXl9IcQl8Stg+7Ldfy9kClPeLyYHOMUgX7RtiTLRA",
-	"dQ4soXmqCLAeLmTUkzRGPyjcOm59PZ24y1/duTzuBo7B1Z6zMgT5v7Ug977/9owcOIap7tmQOjt0EAQV",
-	"0UI5P/+GJ4fhZjYlhI0pPOfn/CXMGWfm+9E5z6imBzOqWKoOSgXyOc0pT2F/IciRDx14STU95x1Jqzdr",
-	"SxC0QYpylrOUXIQScU2eNhI/+myk+UKYh2PbqN2VX91UUf5iJ0iumF6KUicu1DiRcEVlzGigqlBTHNkm",
-	"ChiadUrc2JYVu1BmN36c59GiUO2Qs+7yiyI3yw/IULmAKrNlRGkhvSxiBBQLDe7vG+EuBkmvfJx6qUCR",
-	"X1e0eM+4/kCS8/Lw8DGQRgzWr+7KNzS5KaChr7xRSFxbV4kLt+8aWGtJk4IuepQGGmiBu4/y8gof2XlO",
-	"sFsj9sv7tuJQ9QI8Pvo3wMKxcxwLLu7U9vI5Y+JLwE+4hdjGiBu1xfSm+xVEg914u1oRZZ1dKvUyMWc7",
-	"uiplSNzvTJVKYmGELG/GVmyBroIu68YMSLqE9AIyTAAAq0Jvpo3u3lPCCZqedTBlE2XYWA6M5kbV7gxI",
-	"WWTUieIthZLBsAKtva/iO7iAzZmog8F3iaNthnWqvoOKlBpIl4ZYw2PrxmhvvnPHQV1XUfjoSAyT8WRx",
-	"VNGF79N/kK3IeweHOEYUjbDDPkRQGUGEJf4eFNxgoWa8W5F+bHnmlTGzN18kr4bn/cQ1qR9PznMmXA1G",
-	"U9rvK8CsO+JKkRk1crtwCWNs6GLAxUpFF9AjIYfa9ZEBgg2NPA6y7d6L3nRi3r7QOvdNFGTbODFrjlIK",
-	"mC+GVPAx0/KX8jNZA45VoBLMA+cQNstRTKocyyzTobJh5bCJrfpAixMwSF4LHB6MJkZCyWZJlc9lgyl/",
-	"/FkeJQP8gaG4QwkYTgJXnyCvT6X49jy3fU47r0uXhsHnXvAJF8Kn5YjkCUbCR+/i2HYIjgJQBjks7MJt",
-	"Y08odVhwvUEGjh/n85xxIEnMa4gqJVJmkxHV14ybA4x8vEeIVQGT0SPEyDgAGw2TODB5I8KzyRe7AMld",
-	"WDP1Y6NJM/gb4hEY1o/WiDyiMCyc8R6Pbc8BqHM1q+6vlsMjDkMYnxLD5i5pbtice/HVg3TyAKDY2or6",
-	"d6bxB33i7IAG3l4sO63JXkU3WU0oM3mg4wLdAMQzsU5sCFZU4p2tZ4beo67FGBAWO5g248I9RWZije4W",
-	"eLVYV9YtsPTD4cEIXvhrppBesV/fbW6BGZp2WJqKUaFCknHqvIpc+sSJMVP3SDB95HI/SKJwIwBayo46",
-	"3ah7/G59pDbFk+5lXt9q0zo5kI/aiB3/viMU3aUe/HW1MFXaA6dCeAepkFm/nsIQKtNV/tauesFlnzV8",
-	"Y3RihIFcssfN14Z/QnR3rscroAFPPc8AIl7amKMOJN+uC2GkWxuTZBNUOKRYOVGCDbVUVmelGF/kUHlu",
-	"RtEUW7D3SfIYt0uuE075AcfJzrHN7XnkD8FSFHE4dnmpvHP4GYCi55TXcKAcfktIXJKKQViu++njbVu0",
-	"jx6UpntNMzVK8NaK3Q6GfLrWzK7NVEEO+HpOGq+N5CJm4z4/f68ARbNT3y3Q8mECFso3DwKfLQkLpjTU",
-	"1iYjwXpMf2o9PsW8b0LM+1enCzk363snRCXP2cRC2LGxzE++AvR5njOpdIKmuugSTKPvFGqfvjNN44+K",
-	"pleYTYHKsvglitNewCbJWF7G6dXN+8NLM+2bSnZQ5QwFE8YJ0HRJZpiyN+orOjC1dSceXPAru+BX9M7W",
-	"O+40mKZmYmnIpTnHn+RctG66IXYQIcAYcXR3rRelAxdoEOLb5Y7BA8MeTrxO94fMFJ3DlPmxt/pX+UDj",
-	"PmHOjjSwFnQN6nXOjTjkWD8yy9TrbP3RYFwudNJQfkTQVSl4lKYXNqCsucF8UelU4m5T9l09amjXdsuA",
-	"fPx4fPtwTghOcriEfLsTNEWMewUOekbYEdD1hmA4gffx2C7Vd3egRli10jaMUWrpSDdDhtv6aeTy59Vv",
-	"ayRYgzsX+T7aemckNE9vNX13TXdFkWSQQzTO7K9BIBktCswW4RvHAnrMYIxnsI6DYz9NYzn1u8r7knFt",
-	"86/eVWrH1jjjlx0mQByDgsKm6ts9fWT/GzPYpRDN/YvqIcrKODDIiHHw6mUXVCNpU1/PNU6LgmXrlt3T",
-	"jtqrHb8TjOEF5QbbgoGANmIRjBJUM/Flrcyz6dcbeaf2R2HmrJmeMpRpwqmY8sVDuoiqIpy34eoMaP4D",
-	"bH42bXE5k+vp5HZm0hiu3YhbcP222t4ontENz5rNGl4PO6KcFoUUlzRPnDG5jzSluHSkic297fkTS2tx",
-	"rnf27fGrtw786+kkzYHKpHrt9K4K2xV/mlXZHJs9B8QXJ1hSXenn7Gs42PwqMWBogL5agksEHzyoOxlr",
-	"a+eC4Cg6g/Q87g281bzs/CDsEgf8IaCo3CFqU531hmh6QNBLynJvI/PQ9nju4uLG3Y1RrhAOcGtPivAu",
-	"ulN20znd8dNRU9cWnhTONZCqfmWrMSgieNtdzryC0fSGpLqimG/WWkC6zImXK7QaJCpnadyeymcYYsOt",
-	"n4xpTLBxz3vajFiyHrcrXrJgLNNMjVBqt4AM5ogi0+cu7sPdTLgyWiVnv5VAWAZcm08ST2XroKL+1FnW",
-	"u9dpXKp0A1trfD38bWSMMNdy+8ZzMteQgBF65XTAfVlp/fxCK+uT+SFwP9jBuS+csXMlDjjmOfpw1GwD",
-	"FZZN75rREvrWklte/+aSPvfMES2hxVQyl+J3iKuqUMMXiQ712aUZerT+DnxESFltyakrgdWz9253n3QT",
-	"WpyaDok9VI87H7jgYJpbb42m3G61rWjT8GuPE0wYQXJgx68JxsHcibrJ6dWMxnIAGyHDwBSYXxp2cy2I",
-	"7+xx72w0zCX83ieB31jVltnEHwXIOnC7m0TshgKDnXa0qFBLBki1oUwwtb4+uRKRYUp+RbktjITWCDxK",
-	"rrd54HuF0JWQmLZHxU38GaRsFVUunZ+/z9KuOTdjC2bLApUKgrozbiBbT81SkavdY93patSczMnhNKhs",
-	"5XYjY5dMsVkO2OKhbTGjCqxSxXtu+C5mecD1UmHzRyOaL0ueScj0UlnEKkEqoQ6fN5Wjygz0FQAnh9ju",
-	"4TNyH110FLuEBwaL7n6eHD18hgZW+8dh7AJw9b+GuEk2D4Nc43SMPkp2DMO43aj7UW2ALdrYz7gGTpPt",
-	"OuYsYUvH67afpRXldAFxr9DVFphsX9xNtAW08MIzW3FMaSk2hPWEG4Omhj/1RJoZ9mfBIKlYrZheOUcO",
-	"JVaGnuqiMnZSP5wtX+bygXu4/Ef0hyq8O0jrEflp7T72foutGr3W3tAVNNE6JdTmaspZ7anoqxSQE58K",
-	"DhOkV3nRLW7MXGbpKOag4+KcFJJxjQ+LUs+Tr0m6pJKmhv3t94GbzL56EkkK30xOzHcD/JPjXYICeRlH",
-	"vewhey9DuL7kPhc8WRmOkj2oIzuDU9nruBV30enzExoeeqxQZkZJesmtbJAbDTj1rQiPDwx4S1Ks1rMT",
-	"Pe68sk9OmaWMkwctzQ799O6VkzJWQsbyu9bH3UkcErRkcIl++vFNMmPeci9kPmoXbgP95zWeepEzEMv8",
-	"We59COxi8QneBmjzCT0Tb2LtaVp6GjJX1OyDL5xxFhBb83Sb3eM21ZAanXeBynPocdD1KBEaAbAtjO32",
-	"Ar69iiEw+TR2qA9HzaXFKPO5iCzZl9CobDwuYjKit+q7QMwHw6BmbqgpaZYr+PQeNd4s0vXsMF88rPhH",
-	"G9jPzGwQyX4FPZsYlFKJbmdWfQ+cyyh5LtZjN7XFu/3G/hOgJoqSkuXZz3VukFalGkl5uow6i8xMx1/q",
-	"mprV4uxhjib4XVLOrTdCVzeBr5Rf/Gsm8t76hxg7z4rxkW3bxXPscluLqwFvgumB8hMa9DKdmwlCrDbT",
-	"LlRhfflCZATnqbPJ1vd6t+hSUBrjtxKUjt2L+MGGFqBGfW6o2FaoAJ6hHmOffG9r4i+BNHIFov7AZmmC",
-	"zNcJsKaessgFzabEjHP27fErYme1fWxlOFsZYmGv3cYq+v1zd3G0HfKtvYuIPrNqpTF1p9J0VcRSlJgW",
-	"Z74B5kEJrUv4sA6xs09eWp2G8i9mO4mhhzmTK8hINZ2TqpEmzH+0pukSlQUNltpP8uNLmniqVEEZ4aoc",
-	"YJU9Gs+dgdtVNbFFTaZEGMnhiilbCh0uoZkVpUoR5MQAnyWluTxZcm4pJSoVD6WwugnaPXDWC9IboKKQ",
-	"tRC/o/Ti3NR3rPByir2i2Szb5WI69YNtjo2qzNtrXwGacsFZirkkY1ezK6s+xjo7Iu1mPDLA+duoSeRw",
-	"RYvUVMEaDou9ZWs8I3SI65qHgq9mUy112D811u9eUk0WoJXjbJBNfa0lp6FmXIHLBo4V9gM+KWTD4o0c",
-	"MupEUcvJO5IRBmf3qBy+M9/eOIUURi1eMI5PTx8jYQMkrQ4Zqz5r815lmiwERlC4QxGu6b3ps4/JWjJY",
-	"f9j3VaJxDGswNsu23hHdoY69r4TzTTBtX5i2NqFe/XMjDs5OelwUbtL+SlxReUCveS+CIzbvytErQG41",
-	"fjjaALkNOjnhfWoIDS7RRQIK4kJjeqpStYJgjNBqKQpbEOsfHc2jFXUTfcU41DXMIxdEGr0ScGPwvPb0",
-	"U6mk2oqAo3jaGdAc/SJiDE1pZxS77VCtDXb+pEU68XP0b2NdUKuHcVQNasGN8k1VOt1QdyBMvKB55SQU",
-	"KY+FUpUTolxwTbNgVoxxGMbtE3I2L4DuMejKRLa7ltSenF1uor5UJbMyW4BOaJbF9AnP8SvBrz5dKawh",
-	"Lass3kVBUszM10xV2KU2N1EquCpXA3P5BrecLqhAF6GGsAqe32F0vJ5t8N9YCuv+nXHuQTv72HtfoKwK",
-	"n9tFbm6O1JF6DU0nii2S8ZjAO+X26Kinvhmh1/3vlNJzsWgC8okTlA1xuXCPYvztW3NxhPm7OnnZ7dVS",
-	"pddCd1Dh6wbjs7FKDNPkSj7qtDNnkHl5WAHRX2F0ipdfT1xLoOul9n61du2+6Ja0NxiLapc/QVMyyIJ6",
-	"Y9KtX5mNPkco4jr9Pl8y60pmPnd6j5MMO3I2jj2IUO+k2AXoB+8BTQrKnNNGzSy6mHXhXv3qwqFDV29w",
-	"exEuiKpXY/fDZV/Ak48DtpEdrZqMF+CSKhUSLpkovTuE95fzT0L7q6uJH8QV966/6zeDU31eNWiv0vbM",
-	"1f+xy3Rv8h9+tt6VBLiWm38CFW5n0zsVLWM5ixv1LJ1wFdU36bF35cuqKObFZbIS2VDA9A8/k5fetjTq",
-	"3vGEHEu3JDJXRS4aLP7KlYDwzYz0OXra167TcVEMT90TId6d3Dbcdfq+VFPmfA5p3d7682vrgIYqhMhb",
-	"JQhn5rDWPcWf2tGwV0BgXQDmug0Cm/uzZ4wlKBfkiK/VJAeqYADDYdY213Ykks/Wr0z7ccH28Uqs/Sln",
-	"6zSzyDwLoVhdnCdWonWky/EZVlkNLIbdsby/3yWkGisy1X5MEmCXBLpmsqD895fUsz2Kksoz29P/QJrZ",
-	"6STkLdFARXe8aJ0iB61qaHKNpKq3bSLM3nVm5pCUMPVDmB/mNFfxqmi9zq6tzCeBw0ok0XN8YSfZiGzf",
-	"bjnTwAeCZcOIjEcCWOfvf01kWr/2u0Vnp2bX8Kuik3ghSB5iSyvt7+BAUnlRo2SI+7UA7irDz2Oo2R4V",
-	"NZ9DqtnllkQXf10CD5IoTL0mGGGZB3kvWBVlgwlFd7dz1AAN5aEYhCdI7H9rcPpiRC9gc0+RBjVEaz1N",
-	"vXB/k1ySiAG8tYzgUQgV81K0pivnOMZURRmIBe8VbLtDnZW7t0psIOfccC5Pkk2JZ2DKeJnKUXOZrjtl",
-	"AsOAkb5cGN0yd/0aj5dYVVBVFdx9LspQL0hOIoWgXC5LTEtSWWt9VktQ/jefg8jOkrMLCOvYom0cUyi4",
-	"FlFlr9cjJwNyUif6O1q9CnNn+ZlZHcPRjfeN5IBG76c0F1j5qS/cqRk2Ubl53VPWORTFFKxEhXDNQbp6",
-	"33gz5EJBooV3rRuCYwgV1gP2RkhQvXUXLHC92VDf1elesf6MTZZBneNruEAiYUUNdDJIyto/5xCyX9jv",
-	"PsDV5+TaqtOu6DXZmlXVR+8w1UFiSPVz4m7L7YGzN1FvM85BJt7W3fYp5AaVof21kCIrU5cIJjgYlQlg",
-	"dMKyAVYS1Qyn3VV2lHw5ZgN/FaQhuIDNgdW/pEvKF0F6tRB6K9rbNQSZy1q7faea/7iSM1/YBSzuBM7P",
-	"qT2fTgoh8qTH4HrSTTTbPgMXLL0wYnZZ+733FNok99HOV3nUXC03PrFqUQCH7ME+IcfcRhp555pmpaPW",
-	"5PyeHpp/jbNmpc397BT7++c8HrKBSX3kLfmbH2aYqykwzO+WU9lBtqQxXfckuZX0KlJ2tutPN9rdpV0K",
-	"tCYqC0VMSrlhqq5R57ur3I+QflAFcfj1E2byq72YpbURobRUV4ZsCi+va9PPuHqMvsMW8EJlTVCR0XMj",
-	"B85ndjV+XSElWEovJTSWv03/4xZY86VgixRGTZpl2gTE1k2tuS+Bck+9qHRmcTx3VWuYtk9wzPnbVckp",
-	"tBnaNKwB4ZhzKS9p/unVapjP8RjxAdm7foEnfP+GSLaoVDfz93tFR80dvHXvbmr+FtWAfwWzR1FjrxvK",
-	"GX+qSpjeRIYp7mlOclHXRcYhyRWOaa3DD78iMxdFV0hImWKtAOMrX9Wkeu5hkS/nY7nWW96X29b5s9C3",
-	"IGP3QBAFeVNXSNAC74cawvqIfmam0nNyo1Qeo74OWUTwF+NRYTqbLdfFRcNsbCvOtPwhhYQ7Nh8HjmA7",
-	"mo+7iXrGLs+aSM2lUyrornP0bd3AbeSirtc21vehi9yhNPpjXBbi1TFMd/SZsAjB0jIEQSW/PvyVSJhj",
-	"7UhB9vZwgr29qWv666PmZ3Oc9/aiYtwn85awOHJjuHmjFOOMaZ1QGFgXTPYk/XvnmLu7sNF8R7ADxLNz",
-	"5hCtBoNTe7/RT5wKGmXurQp+uzTXeBs/C1Dml1xNFMP9z32xC9Y/vydMpnUWSpZn2w5lI+iprnyLYT2/",
-	"uIDcz1J79xery+6ySVf/cBcfufYBQMRE1tqYPJgqCGcaEcnkukXilpC40lIyvcE8YV71yX6J+tR8X1lL",
-	"nBW4yizj5A4tLqDKNFfbVkrlJZvvBc1RFjDvGfRQ1ELk++TbNV0VOTgm9c292X/C46+fZIePH/7n7OvD",
-	"p4cpPHn67PCQPntCHz57/BAeff30ySE8nH/1bPYoe/Tk0ezJoydfPX2WPn7ycPbkq2f/ec/cAQZkC+jE",
-	"Z6WY/A0LVCfHb0+SMwNsjRNasB9gY2thGjL2VTZpilwQVpTlkyP/0//23G0/Fat6eP/rxAW9T5ZaF+ro",
-	"4ODq6mo/7HKwQGVqokWZLg/8PJ0ynMdvT6rwMOsLhTtqI38MKeCmOlI4xm/vvj09I8dvT/ZrgpkcTQ73",
-	"D/cfYi7jAjgt2ORo8hh/wtOzxH0/8EmEjz5eTycHS6A52sTNHyvQkqX+k7qiiwXIfVdu1Px0+ejAi3EH",
-	"H50i+Xro20FYuefgY0Pfnm3piY4uBx99Eqvh1o0sUc7OYJa7iBl0vwd3TzjXj4hdQqF6044+JUpIp20r",
-	"JBPmJE1tdHsqgSLdC4nhWVqWPLUKbzsFcPzv6+O/oaXj9fHfyDfkcOqi9hQ+82LTW11SRQInmQW7qzJV",
-	"zzfHdcmSOsXt0fvIkyRaBhWPkKGPgMKrEWsOhtbqsHh0xY8Njz1Mnn34+PTr69id1C2/75EUGDNC1Gvh",
-	"Ez0h0lZ0/U0fytb2dOAafitBbupFrOh6EgLctX9FvNrmbFFK1CDWMfqVv66rhskU+e/TH98QIYnTKbyl",
-	"6UXowBcDx91nIUS+OJkLB1upRdGMnahw+AEzvyAUeIofHR7uVCC45VzUpSJXVp56/7quBk8RWNNU5xtC",
-	"8f7ZWFOTKmd1lqamKKBFkYQDRF/JAzP6+kYxx/ZdlYiR4D6sIzQMXztLewMdzjsK66ltN692kBGF4EPs",
-	"9g631tPIl93919jdrjBACmHONMPg0fo+ybtuiioo3uHA7bGP7JO/ixJFNlvHEmKpJnEGtCX5OZ2BN/Bv",
-	"y7GKaIWdvb32wvf23J4zReZwhRyUcmzYRsfeHhY+f7IjKxtUzTciMEadnV2G62zWa7quMvxRrGDBsczi",
-	"JZDgsfnk8OGfdoUnHL2LjKxJrCx9PZ08/RNv2Qk3UgvNCba0q3n8p13NKchLlgI5g1UhJJUs35CfeBWg",
-	"H6SL7LK/n/gFF1fcI8I8E8vVisqNk5BpxXNKHqRMGOQ/HcNsLUUjF6ULhTY8lD8njXLCfDH5cO0F/JGv",
-	"hqFmBzPMGDS2Kaigcf/TA40x6uAjmhN6fz9waVTiH9GsY9+sB96JLN6y8ar5qNcG1laPlOp0WRYHH/E/",
-	"+IYMwLJBi11wbdjGASaP23R/3vA0+mN3oHY96NjPBx+bZZYaCFXLUmfiKuiLBgtrbevOV1Xobfx9cEWZ",
-	"NhKC8wTEHLLdzhpofuASDbR+rWP7Ol8wYDH4sSVTFMLmgmm+1d7Rq1BCsdICKP1cZJsBbrNOZozjEQxZ",
-	"RK0Ksx+774MOYzhbgk297i25EQFMCzKTgmYpVZia1KXk6Lz6rm/5+GjJjeuTiJ0OwcSHdNepzBym7QUx",
-	"cdwxElawL0FGb5R0lVWh/cFSSQei5zQjPnlQQl7T3Gw4ZFiGS2LIXADyHy1RfH4R4DPf2Z/skn3uD58i",
-	"FN1mGq+jhtudrb7m/XPcQR1zo5onlGEAC+CJY0HJTGQbn6Be0iu9tk42beZ2UKUBjH68Ax3bP7dibZs+",
-	"7Ysa64sa64ui44sa68vuflFjfVHyfFHy/H+r5NlFsxOTIZ1mo1+UxISptDGvfbjROkysYvFhsylhuhK4",
-	"ulnbmd4n5AyDcKi5JeASJM2xso0KoupW6I6pyjQFyI7OedKAxDo9monv1/+13qbn5eHhYyCHD9p9lGZ5",
-	"HvLmbl8UZvGTTRr0DTmfnE86I0lYiUvIbMx5GJRge20d9n9V4/7YiW/CsNAlvYQqjIKocj5nKbMozwVf",
-	"ELoQteOV4duEC/yCFYxd9gLC9NTlfmGKXJnFu7S1zdiJpljelQBO6i3cau1ukUvc0G0Ib0cr93+MMXH/",
-	"64rgNw3oui2XHBy7wzK/sIxPwTI+O9P4s9sPA8Xfv6QM+eTwyZ92QaGa+I3Q5Dt08b+drFXl+Y5Fwt9U",
-	"ivJJ472irnZVDV0/8YqsnD7ffzAXAZaDcrdn7cl4dHCAsbZLofTBxNxtTS/H8OOHCmZfjWFSSHaJyR0/",
-	"XP+/AAAA//+u3heREOIAAA==",
+	"H4sIAAAAAAAC/+y9e3PctpIo/lXwm90qx9qhJD+SPXFVan9KnOR4YycuS8nZvbHvCYbEzCDiADwAKM3E",
+	"V9/9VjcAEiQBDkdSnJO9/svWEI/uRqPRaPTj/SyXm0oKJoyePXs/q6iiG2aYwr9onstamIwX8FfBdK54",
+	"ZbgUs2f+G9FGcbGazWccfq2oWc/mM0E3bPYs7D+fKfaPmitWzJ4ZVbP5TOdrtqEwsNlV0LoZaZutZOaG",
+	"OLNDvHg+uxn5QItCMa2HUP4gyh3hIi/rghGjqNA0h0+aXHOzJmbNNXGdCRdECkbkkph1pzFZclYW+tgj",
+	"+Y+aqV2ApZs8jdJNC2KmZMmGcH4lNwsumIeKNUA1C0KMJAVbYqM1NQRmAFh9QyOJZlTla7KUag+oFogQ",
+	"XibqzezZzzPNRMEUrlbO+BX+d6kY+41lhqoVM7N38xhyS8NUZvgmgtoLR33FdF0aTbAt4rjiV0wQ6HVM",
+	"XtXakAUjVJA333xFnjx58jkgsqHGsMIxWRKrdvYQJ9t99mxWUMP85yGv0XIlFRVF1rR/881XOP+5Q3Bq",
+	"K6o1i2+WM/hCXjxPIeA7RliIC8NWuA4d7ocekU3R/rxgS6nYxDWxje91UcL5/9BVyanJ15XkwkTWheBX",
+	"Yj9HZVjQfUyGNQB02ldAKQWD/nyaff7u/aP5o9Obf/n5LPtf7s9Pn9xMRP+rZtw9FIg2zGulmMh32Uox",
+	"irtlTcWQHm8cP+i1rMuCrOkVLj7doKh3fQn0taLzipY18AnPlTwrV1IT6tioYEtal4b4iUktSqY1jua4",
+	"nXBNKiWveMGKOeGCXK95viY51XYIbEeueVkCD9aaFSlei2M3spluQpIAXLeiByL0z0uMFq89lGBblAZZ",
+	"XkrNMiP3HE/+xKGiIOGB0p5V+rDDilysGcHJ4YM9bJF2Ani6LHfE4LoWhGpCiT+a5oQvyU7W5BoXp+SX",
+	"2N9hA1TbECAaLk7nHIXNmyLfgBgR4i2kLBkVSDy/74YkE0u+qhXT5HrNzNqdeYrpSgrNiFz8ynIDy/6f",
+	"5z98T6Qir5jWdMVe0/ySMJHLIr3GbtLYCf6rlrDgG72qaH4ZP65LvuERkF/RLd/UGyLqzYIpWC9/PhhJ",
+	"FDO1EimA7Ih7+GxDt8NJL1QtclzcdtqOogasxHVV0t0xebEkG7r94nTuwNGEliWpmCi4WBGzFUklDebe",
+	"D16mZC2KCTqMgQULTk1dsZwvOStIM8oIJG6affBwcRg8rWYVgMPFHnC4mAaOYNsIz8DWhS+koisWsMwx",
+	"+dFJLvxq5CUTjYAjix1+qhS74rLWTacEjDj1uHotpGFZpdiSR3js3JFDE0psGydeN07ByaUwlAtWEC4s",
+	"0NIwK4mSMAUTjl9mhkf0gmr22dPZzb6vE1d/KfurPrrik1YbG2V2S0bORfjqNmxcber0n3D5C+fWfJXZ",
+	"nwcLyVcXcJQseYnHzK+wfp4MtUYh0CGEP3g0XwlqasWevRVH8BfJyLmhoqCqgF829qdXdWn4OV/BT6X9",
+	"6aVc8fycrxLEbGCN3qaw28b+A+PFxbHZRi8NL6W8rKsQobxzK13syIvnqUW2Yx7KmGfNVTa8VVxs/U3j",
+	"0B5m2yxkAsgk7SoKDS/ZTjGAluZL/Ge7RH6iS/Ub/FNVJfQ21TJGWuBjd96ibcDZDM6qquQ5BSK+cZ/h",
+	"KwgBZm8JtG1xggfqs/cBiJWSFVOG20FpVWWlzGmZaUMNjvSvii1nz2b/ctIaV05sd30STP4Sep1jJ9BH",
+	"rY6T0ao6YIzXoNfoEWEBAho/oZiwYg81Ii7sIgIrcRDBJbuiwhzP5rE92W7gn91MLb2tKmPp3btfJQlO",
+	"bMMF01a9tQ0faBKQniBZCZIVtc1VKRfND5+cVVVLQfx+VlWWHqgaMo5aF9tybfRDRJ+2Oymc58XzY/Jt",
+	"ODbq2RJsRwvmVA04G5bu1HKnWGM4cji0Iz7QBJcTLDE384YMWjNzHxyHd4a1LEHr2csr0Pivrm3IZvD7",
+	"pM5/DhYLaZtmLmhFHOXsBQZ/CW4un/Q4Z8g4zpZzTM76fW/HNjBKnGFuxSuj62nHHaFjQ8JrRSsLoPti",
+	"z1Iu8AZmG1lY7yhNJwq6KMzt55DXEKpb77W9+yEKCXzow/BlKfPLv1K9voc9v/BjDbcfTkPWjBZMkTXV",
+	"6+NZTMsIt1c72pQtBg3x9k4WwVTHDYr3hd4e1Apq6PGsD29cLbGkx34o9JiK3F1+wP/QksBn2NvU+Hs5",
+	"2CQ4blEZvCAUcJW3FwQ7EzSAhTeSbOztncCt+yAov2onj6/TpDX62hoM3Ao5JHCF5Pbet8GXchuD4Uu5",
+	"HWwBuWX6PvhDbu1/uGEbPQG+5w4yievvyEeVorshkXHsKUQGBEF11bgbRHjiwyyt5fVsIdXtpE9PrAjS",
+	"2pMJhVED4TvvEQmb1lXmWDFik7INegO1T3jjQqM/fIxiHSp8ywRT96Zm21NIZ3uVEPeA5zsQLWil1zKq",
+	"dsxnTCmpEsMxqqWwkm9JeckKsmpQigjZ+aykC1bGBwvWEVvNiRQ5I04di44GxGcalbWE5cXwjXu1aeAi",
+	"11STpieakjXLpSg00VzktjmrZL6O02OEvAEK2GrudD2uuwTHj6Qqa/t7iLmUl4uugAym1ibKtRdd/LDV",
+	"sxDFa8UNF6sMDog5uab2r6VUmQOzwHdd5RYxSmsjDS0zj0IciNZA2aAKUxsmCBdG9pEFcMBSwYiWZElV",
+	"HGk78eXV3jkv2S7DJwZSUa7uPnVf4w6Zza/FFKEIUGpDTW2lYghGsGrN8EQKUrANBftUR1qcG/o7yExt",
+	"aCDq7iAzuwPdt8yUm4qX7B7E5DqqIoJJ8cljcv7Xs08fPf77408/g6WqlFwpuiGLnWGafOIsOUSbXcke",
+	"xuURGNrio3/21L9ZdMeNjaNlrXK2odVwKPsWYgW4bUag3ZBqXTIj1g2Ak7iWgd5nyU7sMx+A9pxrqjXb",
+	"LO5lMVIEK9pZCuIgKdheZjoUvXaaXYii2qn6Pk7k5uQcLHClpJG5LLMrpjSXkYfV164FcS38GVL1f7fQ",
+	"4qEGc+NDUS3w+hGT4lsxXUu0Q19sRUubUT3R4hvBzs07ZV26xPfvDppU8Gi9Bcm4qFcdu8lSyQ2hpMCO",
+	"KDK/ZeZ8J3K0wd8Hk6YP/A0X+CCodyIPLDywUCUrVkzdqyWnTxVvzbdTPdARcIAcL/EzGgGfs9LQe7/t",
+	"9CeIwf6VX0gLLCmgoY6Bd24Uo5vfHUg7zdfCqF3UXgEHGKMbkLV4ZbTP+WbNuPI4WFOoxQQZ7yVfrU1w",
+	"s36tpFzePyaxWWI44Aerc5TQZ2id+J6Za6kuXzOm7uMSWjGmpouXYPK9osWOPFXbyqUQLAdJiP2ClTOK",
+	"Lpc8t8jLgp2jXnYPSlU7WCuzYG+GkoouZG0IJUIWXiOMq1sJZyxEDZ1XTKjBmbW1sywYCISc1rDU8C4m",
+	"YydA2zGjuSVwZhl8n35tW9nprKNPqRgtwJbLBJEL90Dsnq4RSYp+JcYrLE7Zi6r5AVyVkjnTGjTtQ68b",
+	"eBiYEToh4AhwM4u7AtwZ2EOuKJp88t1P+uEfAO+Uexy2iZG3MfNxkYB62vRjDNefPGQ7qhjxYoEYifpp",
+	"yQxLkfAgmiTXrw/RYBXvTpYrpvA9/nfleD/J3RioAfV35ve7QltXCd9ed2G94Bt8rRFUSGcAig5WUm2y",
+	"fWIZGoW4aMAgkIRxW5g2KWPdS6qN9SHhokDTtz1OcB7sg1OkAU5eLGDkn/ydYjh2LoVmQte6uWDouqqk",
+	"MqyI4QCOR+m5vmfbZi65DMZubjFGklqzfSOnqBSM74hlMbEEoqZ5anVOVkPk8EESzvldlJQdIFpCjAFy",
+	"7lsF1A39GxOAcN0S2jIO1z3OaZwq5zNtZFWhJSqrRdMvRaZz2/rM/Ni2HTIXNe25XUgGsxsPk4P82lLW",
+	"KlRrqomDg2zoJegeaNiwzi5DmGEzZmhhzcY4H7blObQKt8DeTVpXK0ULlhWspLvhoD/az8R+HhsAV7y9",
+	"wErDMuvFGF/0lpO909jI0BLHiwjN7yXBLySHLQg3yJZBXO89IxcMx44JJ8dHD5qhcK7oEvnxEG271JER",
+	"8TS8kmBDJtjGQuwE+hR4E2RoRr49JbBz1t4c+lP8N9NuAt/mFpPsmE6h0I5/EAIJo6gL/gi2S0+69wRw",
+	"VGompdgeMZLasQkL7WuqDM95hVed79ju3q+9/Qnit/aCGfsQFXywV+Aq7E+s+11/zNvdBCfddofgD668",
+	"EXRKrlHj6QJ/yXZoOoGr85f0/r1K3Lip6/WCepIypry3znEA0L08aNMDLJUNwPtesqmYbkWgueFXiC0+",
+	"3ITIMo8u+tWjUQmNLT9Jcz+WlBGbY6ACUmOYbhQG5gxSFUASf0u7kuYQC00Psb3k9YLDTTOV0La5ZakA",
+	"hVYdyWVZstzo1ktZ2yDO2BrcB/kRigyhOIQJe5DspVdnmkOeEh2F9EEkumhdo7++YvCe+LsYWhOz7TOy",
+	"NjEqbT/CoKNO4HAfVrvIqITbsDNYMO8Yz4puxA3b0tyUO0JxAXbkmilGdL3YcONcFbrsZGSVhQNE32RH",
+	"ZnTuSjb8w7PiFP+pcxwqQG/Ikv6BfRy+i54JpEMOZ/WopCwnvHcMiBGFYJJnK6kkrDp3IXA+Tsofmh0g",
+	"nX5a7jy4TikOyYwYkP+WNcmpQONSbVhze5MKr0TQF2fgOpjT+bC2FGIl2zBrM8MvR0d9xI+O3JpzTZbs",
+	"2seNHh0NyXF0ZDeB1KajR9yHzZ4q8yJy1OBjNar4FrO++rTfh9KNPGUlX/cG95PintLaMS6gf2cB0NuZ",
+	"2ym4hzwyzX/UbCdiHuATxRvX/Zxv6pKa+3hxZ1e0zOQVU4oXbL8CYCdGGU7LH5puGBPLcuDRnGU5RnJO",
+	"HItdQB8b/LnPCta6rPHNhhWcGlbuSKVYzuxZAZdb3cB4TGykQ76mYoU2DSXrlXO1t+OgpIbgYIzYrMVg",
+	"iKH8ikvWmgtjY9DMVmQrJesqJtZd7JUPZoX7IKNgkgqWHTtbA8w1bYBhRUfaT6SsH/RbGDP1bD+fJS12",
+	"qI61FjtLuW5EblyzxBDjTNd5zlg0Ii9mC2tQ7WUeaWPJ3YBwn6uVDUkgNDc1LcM9AmGvVOy6KUkoLzXI",
+	"bK4JtoPOrXY0t7j5ePElLTULMAsDmMN93bmKByvfkrRPiokP+8gkoAAPOSPkThAGjX5576pbqLoOoRxO",
+	"HMRAtB9TYRBgGC1396C02YGIYpViGo/Y8EFB269yGeYZcGew3mnDNsM3V9v17wkp9CZp2ZOi5IJlGynY",
+	"Lppahwv2Cj+mHRsTnVHhSvXtm4s68PfA6s4zhRvvSl9c7UAWvW7if+5h8fvj9p7bwwwL+JzEyopQkpcc",
+	"YM+l0EbVuXkrKJqzg80W8Xz0hrv0A8dXvkn8RSXy4OGGeisoXngbI3fUW2vJIhbdbxjz7xy6Xq2sAaAj",
+	"+Rh7K1wrLkgtuMG5NrBemV2wiil0Pzy2LTd0ByIQ32N+Y0qSRW26MhkDwbUBcWnf/mEaIpdvBTWkZFQb",
+	"8oqDrxgM532gPM8I6+nRUCF+hKyYYJrrLO6h+a39iqE2Dv21C7vx/t2aa/taDOO30eI7wzqZZv73J//x",
+	"DDLM0Oy30+zzfzt59/7pzcOjwY+Pb7744v90f3py88XD//jX2Ep52HmRhPzFc3e1fPEc7w/tc/EA9g/2",
+	"VLjhIosyWejc1uMt8omQpmGgh11DulmztwL89IyEdC+8oOZ27NAXcYO9aHdHj2s6C9EznHtcD9TK7yBl",
+	"SETI9ETjrY/xoVNzPCEALKSP8YdWZFkLu5ReC7YBFt65VC7nTdIHm+ztGcGMAGvqPaPdn48//Ww2byP5",
+	"m++z+cx9fRfhZF5so9oh28YuW26D4MZ4oElFd5olFFCEPepHa33gwmE3DG7pes2rDy8ptOGLuITzUYTO",
+	"aLMVL4QN74P9g94QO/fIKpcfHm6jQA+vzDqWBKqjKWCrdjUZ63moQZwvE3PCj9lx32hSwL3NefSWjC6J",
+	"C91QUk6Jim72gWU0zxUB1UNEJlkmYvyDyq2T1jfzmTv89b3r427gGFz9ORvXB/+3keTBt19fkBMnMPUD",
+	"pJYbOkj2ELm12g9d30VDqEt9Z3OnvBVvxXO25ILD92dvRUENPVlQzXN9Umt4jympyNnxSpJnPkT6OTX0",
+	"rRhoWsnslEHUFKnqRclzePuKsafNODYc4e3bn+Hy/vbtu4Eb11B/dVNF5YudIIMEX7I2mTNXZ4pdUxV7",
+	"JtdNSh0cGXuPzjonbmz80Y1P3PhxmUerSvdTawzRr6oS0A/YULvEEbBkRBupvC7CtYcG1/d76Q4GRa+9",
+	"CaPWTJNfNrT6mQvzjmRv69PTJ4x0ck384o584MldxSYbMpKpP/r2C0Tc3mvY1iiaVXQVe45/+/Znw2iF",
+	"q4/6Mj41gKKL3UKaNFE5OFSLgKdHegEsHAfH6yNy57aXz40ZRwE/4RJiG1A3Wh+h265XkPXi1svVy5wx",
+	"WKXarDPY21GsNLC4X5kmZd6KcqG94xZYa2ATuOyCCzDtsfySFWjxYZvK7Oad7nLZUTS96ODaJgS0MeuY",
+	"tQot/JAosCqoU8X7FqTFjmhmjI+yeMMu2e5CtkmvDskX1E1fo1MbFTk10C6BWcNt68boL75zQAVIaVX5",
+	"LDCYDsCzxbOGL3yf9Ea2Ku89bOIYU3TSq6QIQVWEENghRYJbIArj3Yn1Y+jBLWNhT75I/kAv+4lr0l6e",
+	"3At+iM3Fuvm+YZhdVF5rsqDaxogiPWyKlkCK1ZquWEJDDh9ZJiZC6TzM4CD7zr3oSRe877qOg/MmCrJt",
+	"nAHOUU5h8AVYBS8zPQ9hP5N9x3MvBJjv2hFsUaKa1MYqo9ChqvPYJVZjoMUZmCnRKhwejC5FQs1mTbXP",
+	"2VnMg708SQf4HVMOjSWaCw36Qf7Sxr7uZW5/nw5uly7dnM8x5xPLhVfLCUni5jMXTxNbDilQASpYyVZN",
+	"iLwLx+6mP2oXCOD4YbksuWAki/nJUq1lzlEUBceMm4OBfnxEiDUBk8kjxNg4ABvfp3Fg8r0M96ZYHQKk",
+	"cOmbqB8bX7aDv9lYBgBQeWQFIpwnHpByLwGoc65uzq+eiz8OQ7iYExBzV7RkwvgbXzvIIN8Zqq297GbO",
+	"Q+JhSp0dscDbg+UgnLDHrbAJdSYPdFyhG4F4IbeZDR6ParyL7QL4PRpMA72iG9NmlnugyUJu0cEQjxYb",
+	"vLEHljQcHowWAEwZBrhjv9RpboEZm3Zcm4pxoSafNLpNyy4pdWLK1AkNJsUunwTJ4m4FQM/Y0ZZVcJff",
+	"vZfUrnoyPMzbU23eJkH1cYqx7Z/aQtFVStBvaIVp0rs5E8IblktVpO0UwKjcNHUqhuYF2w7Tn0xOADdS",
+	"M+Ose9vwV4jhyiWcQzrwtPOMEOK5jZYeQPL1tpKaaReDjEe9G9zpiYrZJBHa2qzgFbxkTaxClEwxhL1r",
+	"mqe4RblNrOsHnKY7xxY3cckfg6Wq4nAcclN54+gzAkVil7dwQIO7QuKS8Y3CcpPmj9d91T66UTqteikg",
+	"g7tW7HQA9hm+Zg7fTDUrGd6es85tI7tku7gRgKFqdu67BVY+TDRJxe5h4Lqn2Iprw9rXJu/Y80fY8Rvf",
+	"3TR2plJLwO+NlI0+F3o4h2h+cAwwymfJFcSTwFNdFAVo9I1G69M30DR+qegsNrGlHniR9kEHimUFL+s4",
+	"v7p5v3sO037f6A66XqBiwoV1olpgaZJodMTI1DaAZhThlxbhl/Te8J22G6ApTKyAXbpz/En2Rd/BfUQc",
+	"RBgwxhzDVUuSdOQADZKTDKVjcMEIUnocjz1TDDZT4cfe61/lU6SklDk70ggu6BqU9NGOOORYPzIr1Nuq",
+	"ZNH0E0KarGP8iJCrMfBoQy9tCHV3gcXKTxMP1pT2Xj1paNd2z4Bi+nhi/3BOCc5KdsXKFJ2DUHykuDfg",
+	"oGeEHQFdb1xSQeeXsF+rH65AS7AG0z6MUW4ZaDdjD7ft1cjlCW/v1siwQDurZU5/vQMNzfNby9/Dp7uq",
+	"grhdFo2s/lvgLkqrCj1kfeNYCCsMxsGdIA6O/XSwj+99pbDvjTMd7TDR+xQSoDqnb5EmP33HDFYpJHMa",
+	"qQRT+hnHBTEO3tzsWu10wH2JY5xWFS+2vXdPO2rSOn4vFMMDyg22hwIBb8Ri9hXTnXUPjHm2zFQnv+7x",
+	"JMpcdNPwhzpNOBXXvkjikFBNTo99tIIUe9+x3U/QFtGZ3cxnd3smjdHajbiH1q+b5Y3SGd3w7LNZx+vh",
+	"QJLTCpxbaJm5x+QUayp55VgTm/u35w+srcWl3sXXZy9fO/Dhva5kVGXNbSeJFbar/jRY2VoCiQ3ii7Ct",
+	"qWnsc/Y2HCx+kwA9fIC+XjNX8Cq4UA8qc7TOBe14/kF6GfcG3vu87PwgLIoj/hCsatwh2qc67NzzgKBX",
+	"lJf+jcxDm/DcReSmnY1RqRAOcGdPivAsuldxM9jd8d3RctcemYRz/VC5DMDRoFDpvzaeEV0R9EA7zjpB",
+	"rE/AeI/QHKfMexGHcqk6wt+FT0U9Kxp1ricY4Vswxi34FzWKSSeW1CzQhCy0xfHtlDq7cgnXWV+TsX8/",
+	"PCbIveSX1S+Ea3J0FG7uo6M5+aV0HwKS4O8L9zs+fhwdBUC36nDUOABUwLu/oBv2sHF6Ty79h7UkCXY9",
+	"XSVA2kEvmeb8ZlNYrwxP72tHPsjsbQlauF+szhml6HATW+fw3vJbwodQTdm956kYpcb7b2NrRmoiRd/Z",
+	"FQMBgcnwoIEYjAVz75fD7SvqDb75ZbrkedwbQiw0iHZhvdygMcHGCWsYjFjzhNOkqHkwFjTTE56kekAG",
+	"c0SJ6SsspWi3kE601IL/o2aEF0wY+KR8Ss/wmMXXD+cXM1SG43dCNzD2CYa/yw0hrAjV11fdjWnsehD6",
+	"1A3Afd7Y7D2izdsxFV44H+qaG844ODRG3GodfzhutmFG665v3GRRvLcwuBd5rjRVYo5ooW+us6WSv7G4",
+	"oRnt85EQfzcRXoWw94To0PYdtq1X3s6eXO7U3ST4SLruxAmux5UPHOiwGI/3JaHCLrUNve5EpcQZJmih",
+	"T+z4LcM4mAcxcyW9hvoV8SsCwBQ8nna8XowkvrOnvW5CkO3sJPD6bNpym6iuYqrNvjFMentLdd9OO1nR",
+	"b/V66NjR6OfWU6/UMjJMLa6pMMwXW7NbyfXWzL6+Qa9rqTDNpI476BQs55uoafjt25+LfOiMUfAVt8WL",
+	"a82C6rhuIFv13XKRqzDcRN070rxYktN5UH/brUbBr7jmi5Jhi0e2BbxII26NMum7AHpMmLXG5o8nNF/X",
+	"olCsMGttCaslaa5ktgaIdzNbMHPNmCCn2O7R5+QTdLDT/Io9BCq683n27NHn6B5h/ziNHQCuSvmYNClQ",
+	"nHjrXZyP0cPQjgGC2416HLXlLRVjv7G04BrZTbbrlL2ELZ2s27+XNlTQFYv7dG/2wGT74mriS16PLqKw",
+	"ddG1UXJHuInPzwwF+ZSIEwXxZ8EgudxsuNk4NywtN8BPbelbO6kfzhZZt2dTA5f/iN6MlXfm6pmAPrCu",
+	"TTdxfqDoc/o93bAuWeeE2tyiJW/9jH0tRfLCpy7GMm5N9TZLG5gLUEc1B5YQi6JwYdAsUJtl9he4fyma",
+	"g/g7ToGbLT57Gild1y2KIg4D/IPTXTHN1FWc9CrB9l6HcH0hclZkGw6i/mEblx3syqTbZXRak/LyGx96",
+	"qlIGo2RJdqs77EYDSX0nxhMjA96RFRt8DuLHgzH74JxZqzh70BpW6Mc3L52WsZEqVo+g3e5O41DMKM6u",
+	"WJFcJBjzjmuhykmrcBfo/1jXB69yBmqZ38vJi8Ah77XB3QBfbEO/4tu81XbfaTs6V2wB8cPE90tcnr2v",
+	"lnep2dzpfAhUrstE6BJGhE74eo9ih92A725iCB5sOyuUolEXtRhnfikjKPtCn80LrYt3jtitUgcIfAAB",
+	"tXBDzUm3TNqH94fzFsyhXxZ88bDiH31g/2Bhg0T2GCQWMSj4Gl3OovkeuIZS8qXcTl3Unuz2C/tPQJoE",
+	"Sd6wJVMsGqrXfAIaACaDgrbR199xp4YXz8MHdxh1wUoJlzMjDxcZf6JFAMrMR5ai5mXxU5tkqZcJWlGR",
+	"r6Nedwvo+Her+EKDBkVLpGhtkDUVwrp1DYazF8a/+4tl5Or7q5w6z4aLiW37Oaotuj3kWsC7YHqg/IRA",
+	"Xm5KmCCkajd/TRMfXa5kQXCethBFq2INq3QH1RGxWmls3+AHG6MFndEoZovzESYKNCkdk28xkwTA0sm9",
+	"i6acJilgp8xaXZWSFnNM2giP+cTOavsoZmrligOurAbUwSId6HBIxMJYkMJ9hEYD1tpg1n9t6KaK5XqC",
+	"Fhe+AeG9Z3q0cYTUOSbPrXlJe+OFnYRgzk61YQVppnMXHOQJ+I8xNF9DA9k53dIsP72qpefK1qpN/f/z",
+	"hhPtvgO4XWFLW9dyTiQocdcc8g+uqWFXrJteyoPhNTKfbqqLnqqFsJwSLy09kgvwNmT3wOG4zVtgFLIe",
+	"4Q88FVy8z4FFPs+xV4wpBxVDe491PlmRzw5KXjnDa06FFDzH3MwxLQlT4UxzE5iQxjoeYuUcF/Ussrmi",
+	"dUqbqDdHxWTl0vmsQ7jhS13wFRbVcof907Ctq3a1YkY7yQah367crnss4EIzV0gImCiUk1JFvBJi+kh7",
+	"ZTmQjTDLRcL68w18+97ZBmELkktuy8o7slmG5tacDxHbwO2CcENWkmmHTzfVl/4Z+hxj1quCbd8dv5Qr",
+	"np/zFY5hPW8AbetmNhzqzDudOScvaPsVtHU5gZufO/4cdtKzqnKTposxR/UBSACbInDU78C9/wbEbcYP",
+	"Rxtht1FvUTxPgdEgyzPRhlXExRgmChP3ognh/mA5ClsQG2gSI0rc3/4lF/55KX5A5NEjARcG92uin84V",
+	"Nfm6I4Ymu5n0BZo27n3yrkP1Ftg55lf5zM+RXsa2pnJCcDQNWsWNih3xmwK4O1AmvoIoY++9N6yQjFqV",
+	"U6JclGK3ZnJMcIDg9lXZuwfAcBsMdSLbHdODH3oSpXI+LepixQzkE4qZdr7Er4QWQa5oSFFeNwWAqooA",
+	"UP2cr0NucxPlUuh6MzKXb3DH6YIi5BFuCAuh+xUGTgOrM/wbKwmRXhnnZ3lwsJJ3qiyaOORD9ObuSAOt",
+	"F3g6g0wj0ymBZ8rdydFOfTtGb/vfK6eXctUF5ANnehyTcuEaxeTb13BwhIkQBy6t9mhp8hSi+6jE7z61",
+	"R5NhqyuVfPj+YE63eJEl6wHvG0YBv6JlIkAwMLtTe75aF4NUmGCejGqlxiWiMZSMiqBkcg/r4offLRTx",
+	"55WUW5/16oPPg97TNMOBnp10lWwI6r29hwB950NJSEW5859phcWQss41Nm25Hdt07QL3kXDRqEnj6XdX",
+	"qchRn1ABv/fL8l8yl52uUuyKy9otWOO66K+E9tclJuAJEzQk8Y+6Bv/RFumk/fzClQ61aLo7+Xc/WUdX",
+	"woRRu38Ca/pg0V+i8Wksbvgrr5k5O5VTrqL2JjP1rHxuT1jwA7/KNrIYyzzx3U/kuX/mm3TueEaO5a2T",
+	"hStAHc268dKVVPLNQPucPO0r1+msqsanTqTaGE5uGx46fSpnH+zPMavba79/MeUvCU0IkbtKkBdCsK1J",
+	"1I3tpxW4ZoRtK4ZJw4MMEek0RFMZykWL4201KxnVbITCYfpL13YikS+2L6H9tKwl/b1lK9F9DaIgJmQt",
+	"2b3Y7FiF7VHK0ehX1HnMcR57R5R4HBQ9vwZm7vgRiD3+ymjhvQEnKNF9TCdl7HMysl8OIPlggAh6gPz4",
+	"sYPsJV+tTYDG6z0509s86Uj9Smre1lMtYTC3Nmsc7niq1z2gysNH8+FY3uX1iuUGi+i2rnyKsUMywMNk",
+	"/jXsY+70NBs1wQle7ozkSZ/PQpkejbR3Yo22Od7wYRm9DoaM4tpEDlnFmvp6Ct7d3RDwAxZtirprJP29",
+	"e6m7Ap+tSKWCOGIviv209OjMAzcgXowTMh4Mc2adZ/5HEtOGdtwvOb+3TypQEDgyI8mlECwHlG05X+e9",
+	"YhRdLnl+PN1f6qIbG0kFkbVZSdyjDB+p7KOUVHzFRa8pF7nc+KZRejVw2vrb8flBEfF1hoTLnwU8wjR4",
+	"4nG9tulkiSuc7zKRQAdWyXwdv3ouma0em1LkV9LYuE8koG99mN2FC22oyFnifcEeD7aJ9TKyGfExsYqt",
+	"UZ7wDMfrfWar/MeX7IopukK9werQMLLrF11IolhJsQ60k6bSBgqFbTRSWVAhHaXjlPV9xvzSXLF+IiuG",
+	"NWA7ixsPEagwgyOc+ZlRvBpTLOC75Rp/7lJtCAzQx2DeFDQFaCoMIICNgujG0TN0lWAat7vaSGG7QxY7",
+	"T3li6Gpyrrxgh1/Q1YUd++AihyEj92pzTYghbZzs+vs02D/BgjvitCDtkVwBXqMEBS1NsJCKlsa2Mvsx",
+	"eYPGfqqgCdW1gnPDv5bj0gN54QQgj06dlCDXXBTyeigJ11QUJcNr0h5p5MGxPRTGrgnj37ybPEmu2cTN",
+	"YwcrOobayKo22tBgGlJR7aJQaB/EVIZvO4SNaFXRhzeYdIHGuGZC1MAagVUx5SjbUTsLWS/K4Li1gA9m",
+	"3Yvm2Nx7sPLSMo1YQ7p7x23qKu6BII6hZsJMWzMdZGi+HUbNXBNXqj/jCAYHrM894XHwqkzDxtDVKPxe",
+	"+o/L3FD+RMRBdLsOdlOK/WOsOWCkzmrHFqlPRot6TNZ3MrF+x3aj90I6zG8Z5Gi1R/QBmutZE+5qU0qA",
+	"xrhiAj2sil7ap8nJZ5ZLloOWNJ5P9G9rJoJclXPvJ4KwLIP0orxJh4B1Ww73gmoBKukt4Snp/YGTSmxy",
+	"yXYPNOlwQ7SyepMV5DYlO5ACaFsBs2QlNS1Tjm0uwofrhjOQCj5803ZnbfGz2HbH6QIr6C3n8izZtYeO",
+	"THklDbvlXND1oITrGNmfSjkKGtyXVEQtmdQZz+zt04534K3zxeux22Rz8VxLbWIXmsR1M5rlYahSYWJx",
+	"0YYHWUDCoiVIZSlYSvugOqWS2wsleE26AhaKr1awL104uEDc3s42VNS0fDtrcm0jRMo6HrMiKPvOyNnr",
+	"F1GEp9yqYbG0oXDdPPwaXQvDywkTsG3FFdOHTjByIbEZKByhPaYeoDi/YhWAoPh3FOymtIsmuSxLa0UJ",
+	"jiD/gtndrIOXMFZhmGB2zcDmm54KMkthE2I7sYK4oibOg9m+kZIXxi6S9p9tMKztax0pC5YrfMxodBW0",
+	"ovrxnfuOQauDYQp855ayLOW1/3maAFooSYuc6gRSsja57F29qTFsU6EOZb3wAdxmnP7cz4iQJnN9gCkh",
+	"euQ6GxDVf7Botj/CDBnUxYe+mon2DxTnqCVljKqSM5W5pwgFDU26KHEDbJZwKgTU8ZPd1Dm1QjdCAQBm",
+	"7nwOoxO6Mv4ZsodKiMc1o1cc+AOEutI+X1jLvNdMMfHAtEw8t1kYkEcwFhDpNdke0e6bn2DG+ANjsBBx",
+	"sO03u+Id7sc8FmybM3tUWTaZxJQj70yBbgMLoIOtvHdYC94UZLxduJEW7SqMDK323j/GVradbayEyxjw",
+	"ofzwKNgZ0/ndp8I8NkK2913QIuywB7ynLNjQMb4ngQfSa7jR+hwc+Ml3mKFdwR6hGyr1kB05jgJv/6+v",
+	"WLRMrcv95/J7G+ZVK2EVkbHa/ik95G/r3aAI9TWWZ/vVyQp2xXP3AGPP7uKQ+JHBI6992LQVkZpiz+7H",
+	"bl1/l+mmKS4ygLOSskRgmfPjFiscrQmXSeyIbep2Eg6euJaYbUKZgwKMTpDFPWBHn9pHAj6GK4ZFuCpn",
+	"MB8EFhwHleRoYcmK+pFdUPh6xf3/7II2IXbdLNYJw4TfCkhHSxHXZRp3p50lnzNDealdpoM4Z0N0RJxh",
+	"c1saogn08ko60/43XwfGzlLySxZwlw2rwzT2rkXUT9y7oGcjT/2DDNyEx4FeNjPzNhPXMOp2yMM2hj0v",
+	"JYqtVNK6rkRugvUfaJviA/UTPEMQriVTquUoGJtlRoaXthQcY6SABrckgk7WvrfAJStSvmlLbm54rqQt",
+	"WEBd+pIQQaLYhgJ0KiiMmZ5zjNhf2e8+ybC/q+11h2/4dcp5iIuFxVt7RAy5fkmcKW1/8uLbeMZzIZjK",
+	"fJhcPzOEYCoETjeOSpizMdgYTfTAZMVzRJREncrzIZYD/+ASKzK/DLIBX7LdiXXdtKdtW+IqhN56p1gc",
+	"gupRvdW+16CBuH90ubIIrO4Fzj/S8X4+gwM9da16MSz22d8DlxzudHDvbLIXwT39gR5qDZ9giFATjHuN",
+	"WlBwrj48JuRM2HxxPi43LDc6mBwuWCPzb3HWorb1d11MwPFbEU+8hZZ5dUf55ocZl2pwK77zVHaQ8Yk+",
+	"nOLUU1cCprJQxLSUcxtw9xXu+JjmjRmYg+zkGIdJiQvUI7qUsVQwt0oTDWPFSRXOhhAZJqYkKW7AcINH",
+	"KeCyEOxNdNDkOHB5C7gM8hwMNSYwK2UbqVhWSkxVEHPGXRpQxzbcaIJFb1dEVrksmK3u7Q1W7YRxxxA7",
+	"Vy0ExdOUBZHhveWEhljqD9+FJHF9guqRE6cEwWpjoTI8gfcmcvJkvoA+NjNtW8ygDa+yIXmJ5CpMu/oF",
+	"jki28RBkXCVMut3EFke3pp3cDnbfM4diEqtyHWS6ebFE0yXHYPFuRmDsAYpOzorGbNmulouC9kd4ayL3",
+	"heLINS9L/8IFPKBq92ISjvKjrjGeH9PBwRRPyUZq458GcCTdDNXmSPgkl8IosMx0TcaWSVxoyCu6Pctz",
+	"81LKS8js+xDvNkKaBtNi7pOl9rNZtDOpXvmMqS90EHyNC6L334VtOwDB0YZh8tAdetq4y7ctQS2Vc2Vu",
+	"Invwkj8nWlp+wKGIZm4VOwUYmiz/droFW2IKvAMsk10ZFpwK38KQe2P9AppMEJGD4SPHxoCKHSIOpGVc",
+	"qT4ThBq54Xl8N/25ElUk00vEJGOMFLaHM65jM7SFhqdRE5eMknlIZiZg60TfK61od/GZ1ppUGZdisDdu",
+	"4wuaOgmHx4U7wLM8qWf0AEBIbUZVUyuMtOsoAY18kyvrUYnucH1AJx5mGMR/N9hghHsHyrA7ATVIHHKf",
+	"AN6Mc3JHQKQyIJy37KOwSZPjPrHro/kLxtMF2BqOi6lJA5qCmhPP7wCAdBqBDgyTkgkcCoZ93suoSagS",
+	"aBWZBzc5l50uGJ07LQBnCV/vYOxaMZdzHYUbUd1gloqatT+oofnQdgl2MOeh+htT0hYtnwfuQKxkG5sA",
+	"v3PZlJUtfBkO5xLB16jFgsO266ubzqRgrMLzuG+Vibmvh5e13sXc4Z4FgedTqBu9qVvC2pUie67hCQt8",
+	"ZreJnrqVAKIrXtS0Qz99qFrRNTzBVp6iUHhY302TFAcLiThyYyJib6KPWqf2pYjn+QjrEDRGd5ytaDz3",
+	"LBO2O1tX9FqkTVKxa4q/a01cMC5F+Ki2ZTnqFt1EFnenCcHBiOar/TiED42oPIwdaW4j4RN9GDDREVea",
+	"uDGDh+HoQdry4t3srIP7dWbv0azYN67n9h/tCD75sz7z/dPbZ3T3JMeLXWw0Q+nbQB+8gng8Yiz5AI1e",
+	"TQoWUBhkbZx5FaePHFXH5EKSDb1k/Q9WaltboeaFfdFtmBbPg11T0MOe1kZiPApcieAhHOUN5kWDhKeq",
+	"Sd46nvxxeM1zq9nUhw7I0QxaHBbsFA+o70zWamTTJ5x8se2Dkwiy7wCEbX53ULAK3jgk2GQ/IGPbrJPn",
+	"d1J4eisvIWfID1dMKV6kINUMzdu9cp/eeuf6RpRn+3DLdWQArlvtARPnsTYxW9AMXJILvlwyZaMitKGi",
+	"gPfaoDkXJGfKUA5W+Z2+nUHyhQ8XpdZQCK2Ja33vxsj+ZPdilZxmTYTljNnzGonTMR4OZ7+9NXHazEO1",
+	"cRoIG7oF7DEnW4KLXRUkWFWnj0iBlhErrw+bR/Pf2Pg0WJvQPcUbibNOmWJ8s/6ApEOd5kfBzeh2tVfa",
+	"fpI8GzVgd1PgRNMY7+ziDDdRlYjTq7q5DRufH5f4x6+1fZP0dsPjsRSI7uafWEV8lXFJMUO7iJ5uMuw8",
+	"/ERktlNTM1Rf9Uhqh9Z+ibTW7uYxeA/v672WKKEf6AEXM2uyoUXBcfA4eKiXa1LVeh282UHPHhCTqbY/",
+	"32RWySqb5GXvQ/ItQA7WIVypxDOj/NG81+mmnHDIj926wjjedM5J1zXedyus8jF1NnVrScjQrs1KLlGa",
+	"4Sa2dzX0UW9uKPN+zq7urawRE4QSxfJaoV3hmu72V37PTBxKn+7Ujuytti7DZwu1Ew1WIKHZx8I/KKx+",
+	"yI09IiMj/BopaX3/yNg8vq3v6u+HjnPPiSNw5m4OAOU4v7W2Lc8qEV6jYhcTcd7d5BYIpi7sEzJR3ttS",
+	"Nbvl91ig6JE+knTtbGC9brIwTgJtmJUwQs2xUJhOYGbgex1UwlE2uSW+w3oTYV9evGpNh9OCC3yHPeCF",
+	"2Y7ado0vlAPnDy5X86ohSoDKuxQndNDfl0CpiSn2ttZgiayh2QY84C6WQzkeZMfSXzVJpxKKxCA3lZLS",
+	"ECng7hLJaaXRTmJfgwPG8UFHHz4v1TdcaXPmAkDepN0tw9DckMiWlPp2hQpe0klzl/R3mFq8xniGvyXC",
+	"QM4EcUM5I+5A+KNlkZbWN2cZCSqDlSaPPiMLV4mxUiznum8cvpZ1WYReF1dM8aVzkGBbsye4Yx+eGJB0",
+	"azZe+rcW8n1gD5Nt5AlC2G7RP1ioJHZulMtj3Ddgiwj9xmUUNo9ZsDHmxRrHx8Mjx+J+7SC0W6cvEdwK",
+	"Xgjj5e+c+42PzGqCnFqzedyWMhY5ZQdow+cmB606gJvRY2SGO1Y6k3DnVL7spBVuledAcZCK3XN64eAO",
+	"eGB64RAzLOQwGT3EA8/2WrMhngfdX8f0oRa3qbmxh8RNp7Q2iykprePRP9Adc2pbgkCjY4Kgkl8e/WLN",
+	"xSi0jo5wgqOjuWv6y+PuZ5CaR0fxWO4PlU3bpxbBMdy8UY5xyVYHpdIwgInG04+/cWeoj6KGEXz4eRTt",
+	"0s/Rc0/Fjq6uyIfVV6xj9d5EhBY113jfsRGQzKPcTBSj/U+prGK2flOijFpvL0AQ7953i7AoHsSpMME0",
+	"11j27e+udu6HJb+HwMYLDsWkhfWgGgr9DYCEieDamTyYKih3N6HS3TDBm19XZK68VtzszoH+3qjD/x7N",
+	"uf5tky/HZattnmWcemfkJRO+JHGbXafWXoH8VtISVS77WiQYMVKWx+TrLYWUbE5IffFg8e/syV+eFqdP",
+	"Hv374i+nn57m7Omnn5+e0s+f0kefP3nEHv/l06en7NHys88Xj4vHTx8vnj5++tmnn+dPnj5aPP3s839/",
+	"MJvPOIBsAfVVGJ/N/is7K1cyO3v9IrsAYFua0IpDSqKbG7SeLCWgj0TNUQqyDeXl7Jn/6f/30u04l5t2",
+	"eP/rzNWnnq2NqfSzk5Pr6+vjsMvJCiPmMiPrfH3i57mZ9yh+9vpF40xoXT1wRVuL5vGsZYUz/Pbm6/ML",
+	"nwCkyQU4Oz0+PX4E48uKCVrx2bPZE/wJd88a1/3EMdvs2fub+exkzWhp1u6PDTOK5/6TvqaQo+T4VxtN",
+	"DD9dPT7x2vLJe6fj3Ix9Ownff0/ed4Iqiz098aH25L2LO9zTOjRKnbhgUkA3+iT1LXPnhEsNHgk+Rbt0",
+	"kwJGY2JO+KlSXMJOmttC1E1iBfSGnsPznshpkNCDYRoC8ursv/BV9NXZf5EvyOnc+SxqvE3HprcBQw0L",
+	"vCgs2EN/Df3l7qxRNtsX1Nmzn2MGQadhV/Wi5Dmx2htuIeCPgMObEVsJhi+XMyvB8XRu5DHI2NPs83fv",
+	"P/3LTexMGujTDZGCiNWQ9EaSguuqpDsk2oZuv0iRbGt3B+Lwj5qpXYvEhm5nIcBDlT1S9cD7GF8H94mm",
+	"novzR+aa/Of5D98TqYgz3byG94zAvzoGjjvPQoh87Lbzwt7oVdWtrdXQ8N185qHAXfz49NSLLnf3DbbW",
+	"iduxwUw9g+mQiwApGliMh2FaYOulOYRdU229VtBrQdcLG00ecXGXVRYOMG6jHs7o6B31YLxtiH2r+Nsk",
+	"DuPwWTfWBJc6J6AKzrb9N8IBMaIQvIud3uHSeh75uLr/M1Z3qAyQSsKe5hiz0Z4n5bCMhfYqYLnz4CaC",
+	"YI/Jf8saVTZQxmvDGvkmFYYhNach18GcPk9yQ6HA7Ri/HB31ET86cmvONVmya5SgVGDDPjmOjo5hpZ4e",
+	"KMpGX0A6Fbom7Z1Dhhss1iu6bX33iJAiE2xlU2oHl82np4/+tBi+EJhfEnRNYnXpm/ns0z/xkr0QhilB",
+	"S4ItLTZP/rTYnDN1xXNGLtimkooqXu7Ij6IJGbR3DQwrHIq/H8WlkNfCEwKuifVmQ9XOaci0kTm1CEpq",
+	"j8qfQfR9q0Uf+1Th4BaB+qdVWH1CT7GavbvxCv7EW8NYs5OF3B7QlOmgcfrqgW9e+uQ9vtokfz9xZfbj",
+	"H/H1zN5ZT3wa0XjLzq3mPWThuen3yKnJ13h17P1WVyfv248BqFhE9cTV5z7BrPfhx9JQfaKxatHg5wHa",
+	"tmDRia6rqtwNf96JPPrjcKBOGuDEzyfvO392FwbxOFlQoWO/xW6rel2bQl4Hs+EDBtI3vEOmPw7RCBtF",
+	"P9a6//fJNeUGtBuXx5YuDVPDzobR8sQV0e792tatHHzBYpzBjz19qJI2/L97z3xDry864SUu/+iXstiN",
+	"SMpttuCC2jJXrXhrzXj24/BuczOPvLegF6V/7I8oj0aGuSSlLzc/uLHe3PHi1A/afRF5e0Iw0QgwzHoE",
+	"gmB/AnAcd4p2eNFJW+YnRC1dW/Pf76xRDSD6khbE54vIyCtawoJDHTunt3eo8XtrQ3+8+vIH6xsfTEH4",
+	"0m8+TSjmdenc7FQ8Gt4+rONGnaINwPUPBMCKicyJoGwhi50rrTNT9NpsbWBuX7idoCVMxyXfvdgH/7mN",
+	"gvtsgR9NcB9NcB+NNB9NcB9X96MJ7qOB6qOB6v9ZA9UhVqmYDtlaSuKqpC3/bB022BVu3NSDs5f6NiPG",
+	"M0ILd6mLZH5G2OcudTf83dQftNm/m2KSPiWZbY/poDHsHF2DdujY3zrHHpOvLYhUMZ90EMAS7LrkAnOr",
+	"8g2HUa3yZU8PLF2SAwNAzMgVI46LmtOFYHAiEgJUX+2FWl5yBh4PGJNppCRLqsiCrbnNctbVcy0hEwnU",
+	"9+q7P4hy59NV9Gnpan35OLQgtWVMh2xcrwZa7dSCUuOfD4PcR4BZyHvukRHou4bPDhYbLiB+dfbsdB7V",
+	"02NioKX5iUPlzmryYdkqcPXvLsbSA0eUA8fJ8W3sNvmf/mUrMOt81BA+agh/Qg3BHhgTDt47qwD2YSZt",
+	"TeIQO0N7JSdsyERTyqC55XXTfnHT2Fw6Af1YNYGbYwJnq7J5bjS7Ygo8L7EcVFsWboNBOy4NzbO3IutA",
+	"0tbj+KT9r41Jelufnj5h5PRhv482kAU1uJ4N+6I9Cz/ZAklfkLezt7PBSIptZFP6NEycbXvtHfb/a8b9",
+	"YZCDH5OIrOkVa0to6BpqC3NL8lJC6PRKtn7jGDgiJH7B8tgbBtcuTbjxBUq4SwFrV6WX37ursQwPlRft",
+	"Eu511uuxS9xPz9XlOMRJ79+meOj9z7XC3TYZ1v1rGGMy8aPI+BAi4w8XGh+VxH9uJfHp6dM/LULhS/H3",
+	"0pBvYDPc0dzi0nfmqTpkt9KifIps/1bXRtqEkSt4RDYxKz+/g4NAM3XlT882EOPZyQnWg1lLbU7wQt0N",
+	"0gg/vmtgfu9Pp0rxK4Dm5t3N/x0AmCWqTCA3AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
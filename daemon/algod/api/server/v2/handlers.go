@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -43,11 +44,14 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -63,6 +67,10 @@ const maxTealSourceBytes = 200_000
 // become quite large, allow up to 1mb
 const maxTealDryrunBytes = 1_000_000
 
+// A simulate request holds a few transaction groups along with their
+// configuration, allow up to 1mb
+const maxSimulateRequestBytes = 1_000_000
+
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
 	Node     NodeInterface
@@ -85,6 +93,8 @@ type LedgerForAPI interface {
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
 	Wait(r basics.Round) chan struct{}
 	GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	LookupHistorical(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error)
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
@@ -98,12 +108,16 @@ type NodeInterface interface {
 	GenesisID() string
 	GenesisHash() crypto.Digest
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
-	Simulate(txgroup []transactions.SignedTxn) (vb *ledgercore.ValidatedBlock, missingSignatures bool, err error)
+	Simulate(request simulation.Request) (result simulation.Result, err error)
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
-	StartCatchup(catchpoint string) error
+	StartCatchup(catchpoint string, source catchup.CatchpointCatchupSource) error
 	AbortCatchup(catchpoint string) error
+	RequestCatchpoint() (ledger.CatchpointGenerationStatus, error)
+	GetCatchpointGenerationStatus() (ledger.CatchpointGenerationStatus, bool)
+	PendingStateProofs(maxMissingSigners int) ([]stateproof.BuilderStatus, error)
+	PendingStateProofVoters(rnd basics.Round) ([]stateproof.VoterStatus, error)
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
 	ListParticipationKeys() ([]account.ParticipationRecord, error)
@@ -113,6 +127,12 @@ type NodeInterface interface {
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	SubscribeNewBlocks() (<-chan struct{}, func())
+	SubscribePendingTxEvents(filter pools.TxEventFilter) (<-chan pools.TxEvent, func(), error)
+	PeerTraffic() []network.PeerTraffic
+	GetPeerBans() []network.PeerBan
+	BanPeer(address string, duration time.Duration) network.PeerBan
+	UnbanPeer(address string) bool
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -351,24 +371,28 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	myLedger := v2.Node.LedgerForAPI()
+	rnd, err := queryRound(myLedger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, rnd, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
-	myLedger := v2.Node.LedgerForAPI()
-
 	// count total # of resources, if max limit is set
 	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
-		record, _, _, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+		record, _, _, err := myLedger.LookupAccount(rnd, addr)
 		if err != nil {
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return v2.ledgerLookupError(ctx, err)
 		}
 		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
 		if totalResults > maxResults {
@@ -387,9 +411,17 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		}
 	}
 
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
+	var record basics.AccountData
+	var lastRound basics.Round
+	var amountWithoutPendingRewards basics.MicroAlgos
+	if params.Round == nil {
+		record, lastRound, amountWithoutPendingRewards, err = myLedger.LookupLatest(addr)
+	} else {
+		lastRound = rnd
+		record, amountWithoutPendingRewards, err = myLedger.LookupHistorical(rnd, addr)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.ledgerLookupError(ctx, err)
 	}
 
 	// check against configured total limit on assets/apps
@@ -415,12 +447,34 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// queryRound returns the round given by an optional round query parameter, or the latest round if it isn't set.
+func queryRound(ledger LedgerForAPI, round *uint64) (basics.Round, error) {
+	latest := ledger.Latest()
+	if round == nil {
+		return latest, nil
+	}
+	if basics.Round(*round) > latest {
+		return 0, fmt.Errorf("round %d is greater than the latest round %d", *round, latest)
+	}
+	return basics.Round(*round), nil
+}
+
+// ledgerLookupError responds to a failed ledger lookup, telling apart the rounds that are too old to be
+// looked up by the ledger from the internal failures.
+func (v2 *Handlers) ledgerLookupError(ctx echo.Context, err error) error {
+	var roundOffsetErr *ledger.RoundOffsetError
+	if errors.As(err, &roundOffsetErr) {
+		return badRequest(ctx, err, errRoundNotAvailable, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, rnd basics.Round, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.ledgerLookupError(ctx, err)
 	}
 
 	if handle == protocol.CodecHandle {
@@ -924,9 +978,54 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, model.PostTransactionsResponse{TxId: txid.String()})
 }
 
+// PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
+type PreEncodedSimulateTxnResult struct {
+	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
+	MissingSignature         *bool                                   `codec:"missing-signature,omitempty"`
+	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
+	LogicSigBudgetConsumed   *uint64                                 `codec:"logic-sig-budget-consumed,omitempty"`
+	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	Trace                    *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
+}
+
+// PreEncodedSimulateTxnGroupResult mirrors model.SimulateTransactionGroupResult
+type PreEncodedSimulateTxnGroupResult struct {
+	Txns              []PreEncodedSimulateTxnResult `codec:"txn-results"`
+	FailureMessage    *string                       `codec:"failure-message,omitempty"`
+	FailedAt          *[]uint64                     `codec:"failed-at,omitempty"`
+	AppBudgetAdded    *uint64                       `codec:"app-budget-added,omitempty"`
+	AppBudgetConsumed *uint64                       `codec:"app-budget-consumed,omitempty"`
+}
+
+// PreEncodedSimulateResponse mirrors model.SimulateResponse
+type PreEncodedSimulateResponse struct {
+	Version         uint64                             `codec:"version"`
+	LastRound       uint64                             `codec:"last-round"`
+	TxnGroups       []PreEncodedSimulateTxnGroupResult `codec:"txn-groups"`
+	WouldSucceed    bool                               `codec:"would-succeed"`
+	EvalOverrides   *model.SimulationEvalOverrides     `codec:"eval-overrides,omitempty"`
+	ExecTraceConfig *model.SimulateTraceConfig         `codec:"exec-trace-config,omitempty"`
+}
+
+// PreEncodedSimulateRequestTransactionGroup mirrors model.SimulateRequestTransactionGroup
+type PreEncodedSimulateRequestTransactionGroup struct {
+	Txns []transactions.SignedTxn `codec:"txns"`
+}
+
+// PreEncodedSimulateRequest mirrors model.SimulateRequest
+type PreEncodedSimulateRequest struct {
+	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
+	Round                 basics.Round                                `codec:"round,omitempty"`
+	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
+	AllowUnnamedResources bool                                        `codec:"allow-unnamed-resources,omitempty"`
+	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
+	ExtraLogicSigBudget   uint64                                      `codec:"extra-logic-sig-budget,omitempty"`
+	ExecTraceConfig       *model.SimulateTraceConfig                  `codec:"exec-trace-config,omitempty"`
+}
+
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
 // (POST /v2/transactions/simulate)
-func (v2 *Handlers) SimulateTransaction(ctx echo.Context) error {
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
 	if !v2.Node.Config().EnableExperimentalAPI {
 		// Right now this is a redundant/useless check at runtime, since experimental APIs are not registered when EnableExperimentalAPI=false.
 		// However, this endpoint won't always be experimental, so I've left this here as a reminder to have some other flag guarding its usage.
@@ -943,36 +1042,81 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context) error {
 	}
 	proto := config.Consensus[stat.LastVersion]
 
-	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	handle, contentType, err := getCodecHandle((*model.Format)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	ctx.Request().Body = http.MaxBytesReader(nil, ctx.Request().Body, maxSimulateRequestBytes)
+	_, err = buf.ReadFrom(ctx.Request().Body)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	var res model.SimulationResponse
+	request, err := decodeSimulateRequest(buf.Bytes(), proto.MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 
 	// Simulate transaction
-	_, missingSignatures, err := v2.Node.Simulate(txgroup)
+	simulationResult, err := v2.Node.Simulate(request)
 	if err != nil {
-		var invalidTxErr *simulation.InvalidTxGroupError
-		var evalErr *simulation.EvalFailureError
+		var invalidTxErr simulation.InvalidTxGroupError
+		var invalidRequestErr simulation.InvalidRequestError
 		switch {
 		case errors.As(err, &invalidTxErr):
 			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
-		case errors.As(err, &evalErr):
-			res.FailureMessage = evalErr.Error()
+		case errors.As(err, &invalidRequestErr):
+			return badRequest(ctx, invalidRequestErr, invalidRequestErr.Error(), v2.Log)
 		default:
 			return internalError(ctx, err, err.Error(), v2.Log)
 		}
 	}
-	res.MissingSignatures = missingSignatures
 
-	// Return msgpack response
-	msgpack, err := encode(protocol.CodecHandle, &res)
+	response := convertSimulationResult(simulationResult)
+
+	data, err := encode(handle, &response)
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
 
-	return ctx.Blob(http.StatusOK, "application/msgpack", msgpack)
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// decodeSimulateRequest decodes a JSON or msgpack encoded simulation request
+// and checks that each of its transaction groups is well sized.
+func decodeSimulateRequest(data []byte, maxTxGroupSize int) (simulation.Request, error) {
+	var simulateRequest PreEncodedSimulateRequest
+	err := decode(protocol.JSONStrictHandle, data, &simulateRequest)
+	if err != nil {
+		simulateRequest = PreEncodedSimulateRequest{}
+		err = decode(protocol.CodecHandle, data, &simulateRequest)
+		if err != nil {
+			return simulation.Request{}, err
+		}
+	}
+
+	txgroups := make([][]transactions.SignedTxn, len(simulateRequest.TxnGroups))
+	for i, txgroup := range simulateRequest.TxnGroups {
+		if len(txgroup.Txns) == 0 {
+			return simulation.Request{}, errors.New("empty txgroup")
+		}
+		if len(txgroup.Txns) > maxTxGroupSize {
+			return simulation.Request{}, fmt.Errorf("max group size is %d", maxTxGroupSize)
+		}
+		txgroups[i] = txgroup.Txns
+	}
+
+	return simulation.Request{
+		TxnGroups:             txgroups,
+		Round:                 simulateRequest.Round,
+		AllowMoreLogging:      simulateRequest.AllowMoreLogging,
+		AllowUnnamedResources: simulateRequest.AllowUnnamedResources,
+		ExtraOpcodeBudget:     simulateRequest.ExtraOpcodeBudget,
+		ExtraLogicSigBudget:   simulateRequest.ExtraLogicSigBudget,
+		TraceConfig:           convertSimulateTraceConfig(simulateRequest.ExecTraceConfig),
+	}, nil
 }
 
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
@@ -1100,6 +1244,102 @@ func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// StreamLedgerStateDeltas writes every block added to the ledger, along with its
+// state delta, starting at the requested round. The stream ends when the client
+// disconnects, when the node shuts down, or shortly before the REST write
+// timeout would cut it off; clients resume by requesting the round after the
+// last entry they received.
+// (GET /v2/deltas/stream)
+func (v2 *Handlers) StreamLedgerStateDeltas(ctx echo.Context, params model.StreamLedgerStateDeltasParams) error {
+	handle, contentType, err := getCodecHandle((*model.Format)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	sync := params.Sync != nil && *params.Sync
+
+	// Subscribe before looking at the ledger, so that no block goes unnoticed.
+	newBlocks, unsubscribe := v2.Node.SubscribeNewBlocks()
+	defer unsubscribe()
+
+	ledger := v2.Node.LedgerForAPI()
+	next := ledger.Latest() + 1
+	if params.Round != nil {
+		next = basics.Round(*params.Round)
+	}
+	if next <= ledger.Latest() {
+		// The first round must still have its delta in memory.
+		_, err = ledger.GetStateDeltaForRound(next)
+		if err != nil {
+			return notFound(ctx, err, errFailedRetrievingStateDelta, v2.Log)
+		}
+	}
+
+	var deadline <-chan time.Time
+	if timeout := time.Duration(v2.Node.Config().RestWriteTimeoutSeconds) * time.Second; timeout > 0 {
+		// leave some time to finish writing the entry in progress
+		deadline = time.After(timeout - timeout/10)
+	}
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, contentType)
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	for {
+		for next <= ledger.Latest() {
+			entry, err := ledgerStateDeltaStreamEntry(ledger, next)
+			if err != nil {
+				// The status has been sent already, so all we can do is end the stream.
+				v2.Log.Warnf("StreamLedgerStateDeltas: unable to stream round %d: %v", next, err)
+				return nil
+			}
+			data, err := encodeStreamEntry(handle, entry)
+			if err != nil {
+				v2.Log.Warnf("StreamLedgerStateDeltas: unable to encode round %d: %v", next, err)
+				return nil
+			}
+			_, err = response.Write(data)
+			if err != nil {
+				return nil
+			}
+			response.Flush()
+
+			if sync {
+				err = v2.Node.SetSyncRound(uint64(next + 1))
+				if err != nil && err != catchup.ErrSyncRoundInvalid {
+					v2.Log.Warnf("StreamLedgerStateDeltas: unable to set sync round %d: %v", next+1, err)
+				}
+			}
+			next++
+		}
+
+		select {
+		case <-newBlocks:
+		case <-deadline:
+			return nil
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-v2.Shutdown:
+			return nil
+		}
+	}
+}
+
+// encodeStreamEntry encodes an entry of a streamed response: msgpack entries are
+// written back to back, and JSON entries one per line.
+func encodeStreamEntry(handle codec.Handle, entry interface{}) ([]byte, error) {
+	data, err := encode(handle, entry)
+	if err != nil || handle == protocol.CodecHandle {
+		return data, err
+	}
+	var line bytes.Buffer
+	err = json.Compact(&line, data)
+	if err != nil {
+		return nil, err
+	}
+	return append(line.Bytes(), '\n'), nil
+}
+
 // TransactionParams returns the suggested parameters for constructing a new transaction.
 // (GET /v2/transactions/params)
 func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
@@ -1285,15 +1525,19 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 }
 
 // startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, source catchup.CatchpointCatchupSource) error {
 	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
 	}
+	err = source.Validate()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	err = v2.Node.StartCatchup(catchpoint, source)
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1333,24 +1577,113 @@ func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPen
 	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), nil)
 }
 
+// PreEncodedPendingTransactionEvent mirrors model.PendingTransactionEvent
+type PreEncodedPendingTransactionEvent struct {
+	Type   string                 `codec:"type"`
+	Txid   string                 `codec:"txid"`
+	Txn    transactions.SignedTxn `codec:"txn"`
+	Round  uint64                 `codec:"round"`
+	Reason *string                `codec:"reason,omitempty"`
+}
+
+// StreamPendingTransactionEvents writes the events of the pending transactions
+// matching the filters as they happen. The stream ends when the client
+// disconnects or falls too far behind, when the node shuts down, or shortly
+// before the REST write timeout would cut it off.
+// (GET /v2/transactions/pending/stream)
+func (v2 *Handlers) StreamPendingTransactionEvents(ctx echo.Context, params model.StreamPendingTransactionEventsParams) error {
+	handle, contentType, err := getCodecHandle((*model.Format)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	var filter pools.TxEventFilter
+	if params.Sender != nil {
+		filter.Sender, err = basics.UnmarshalChecksumAddress(*params.Sender)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+	}
+	if params.ApplicationId != nil {
+		filter.AppID = basics.AppIndex(*params.ApplicationId)
+	}
+
+	events, unsubscribe, err := v2.Node.SubscribePendingTxEvents(filter)
+	if errors.Is(err, node.ErrFollowerNodeUnsupported) {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+	defer unsubscribe()
+
+	var deadline <-chan time.Time
+	if timeout := time.Duration(v2.Node.Config().RestWriteTimeoutSeconds) * time.Second; timeout > 0 {
+		// leave some time to finish writing the event in progress
+		deadline = time.After(timeout - timeout/10)
+	}
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, contentType)
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				v2.Log.Infof("StreamPendingTransactionEvents: subscriber fell behind, ending the stream")
+				return nil
+			}
+			entry := PreEncodedPendingTransactionEvent{
+				Type:  event.Type.String(),
+				Txid:  event.Txn.ID().String(),
+				Txn:   event.Txn,
+				Round: uint64(event.Round),
+			}
+			if event.Reason != "" {
+				entry.Reason = &event.Reason
+			}
+			data, err := encodeStreamEntry(handle, entry)
+			if err != nil {
+				v2.Log.Warnf("StreamPendingTransactionEvents: unable to encode event of %s: %v", entry.Txid, err)
+				return nil
+			}
+			_, err = response.Write(data)
+			if err != nil {
+				return nil
+			}
+			response.Flush()
+		case <-deadline:
+			return nil
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-v2.Shutdown:
+			return nil
+		}
+	}
+}
+
 // GetApplicationByID returns application information by app idx.
 // (GET /v2/applications/{application-id})
-func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
+func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64, params model.GetApplicationByIDParams) error {
 	appIdx := basics.AppIndex(applicationID)
 	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	lastRound, err := queryRound(ledger, params.Round)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
+	creator, ok, err := ledger.GetCreatorForRound(lastRound, basics.CreatableIndex(appIdx), basics.AppCreatable)
+	if err != nil {
+		return v2.ledgerLookupError(ctx, err)
 	}
 	if !ok {
 		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
 	}
 
-	lastRound := ledger.Latest()
-
 	record, err := ledger.LookupApplication(lastRound, creator, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.ledgerLookupError(ctx, err)
 	}
 
 	if record.AppParams == nil {
@@ -1426,7 +1759,10 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64,
 func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxByNameParams) error {
 	appIdx := basics.AppIndex(applicationID)
 	ledger := v2.Node.LedgerForAPI()
-	lastRound := ledger.Latest()
+	lastRound, err := queryRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
 
 	encodedBoxName := params.Name
 	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
@@ -1440,7 +1776,7 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint
 
 	value, err := ledger.LookupKv(lastRound, apps.MakeBoxKey(uint64(appIdx), string(boxName)))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.ledgerLookupError(ctx, err)
 	}
 	if value == nil {
 		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
@@ -1489,8 +1825,18 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr strin
 
 // StartCatchup Given a catchpoint, it starts catching up to this catchpoint
 // (POST /v2/catchup/{catchpoint})
-func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string) error {
-	return v2.startCatchup(ctx, catchpoint)
+func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string, params model.StartCatchupParams) error {
+	var source catchup.CatchpointCatchupSource
+	if params.CatchpointFile != nil {
+		source.CatchpointFile = *params.CatchpointFile
+	}
+	if params.CatchpointDeltas != nil {
+		source.DeltaFiles = *params.CatchpointDeltas
+	}
+	if params.BlocksDir != nil {
+		source.BlocksDir = *params.BlocksDir
+	}
+	return v2.startCatchup(ctx, catchpoint, source)
 }
 
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
@@ -1499,6 +1845,141 @@ func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.abortCatchup(ctx, catchpoint)
 }
 
+func convertCatchpointGenerationStatus(status ledger.CatchpointGenerationStatus) model.CatchpointGenerationResponse {
+	return model.CatchpointGenerationResponse{
+		Stage:         string(status.Stage),
+		RequestedAt:   uint64(status.RequestedAt.Unix()),
+		AccountsRound: roundToPtrOrNil(status.AccountsRound),
+		Round:         roundToPtrOrNil(status.Round),
+		TotalAccounts: numOrNil(status.TotalAccounts),
+		TotalKvs:      numOrNil(status.TotalKVs),
+		Label:         strOrNil(status.Label),
+		Error:         strOrNil(status.Error),
+	}
+}
+
+// StartCatchpointGeneration requests the generation of a catchpoint label and file.
+// (POST /v2/catchpoint)
+func (v2 *Handlers) StartCatchpointGeneration(ctx echo.Context) error {
+	// Select 200/201, or return an error
+	var code int
+	status, err := v2.Node.RequestCatchpoint()
+	switch {
+	case err == nil:
+		code = http.StatusCreated
+	case errors.Is(err, ledger.ErrCatchpointGenerationInProgress):
+		code = http.StatusOK
+	case errors.Is(err, ledger.ErrCatchpointTrackingDisabled):
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToStartCatchpointGeneration, err), v2.Log)
+	}
+	return ctx.JSON(code, convertCatchpointGenerationStatus(status))
+}
+
+// GetCatchpointGeneration returns the status of the last requested catchpoint generation.
+// (GET /v2/catchpoint)
+func (v2 *Handlers) GetCatchpointGeneration(ctx echo.Context) error {
+	status, ok := v2.Node.GetCatchpointGenerationStatus()
+	if !ok {
+		return notFound(ctx, nil, errNoCatchpointGenerationRequested, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, convertCatchpointGenerationStatus(status))
+}
+
+func convertPeerTraffic(peer network.PeerTraffic) model.NetworkPeer {
+	out := model.NetworkPeer{
+		Address:        peer.Address,
+		Outgoing:       peer.Outgoing,
+		Version:        peer.Version,
+		Features:       make([]string, len(peer.Features)),
+		InstanceName:   strOrNil(peer.InstanceName),
+		ConnectedSince: uint64(peer.ConnectedSince.Unix()),
+		MessageDelay:   numOrNil(uint64(peer.MessageDelay)),
+		PingRoundTrip:  numOrNil(uint64(peer.PingRoundTrip)),
+		Tags:           make([]model.NetworkPeerTagTraffic, len(peer.Tags)),
+	}
+	copy(out.Features, peer.Features)
+	for i, t := range peer.Tags {
+		out.Tags[i] = model.NetworkPeerTagTraffic{
+			Tag:                 string(t.Tag),
+			ReceivedMessages:    t.ReceivedMessages,
+			ReceivedBytes:       t.ReceivedBytes,
+			ReceivedMessageRate: t.ReceivedMessageRate,
+			ReceivedByteRate:    t.ReceivedByteRate,
+			SentMessages:        t.SentMessages,
+			SentBytes:           t.SentBytes,
+			SentMessageRate:     t.SentMessageRate,
+			SentByteRate:        t.SentByteRate,
+			HandledMessages:     t.HandledMessages,
+			HandleTime:          uint64(t.HandleTime),
+		}
+	}
+	return out
+}
+
+// GetNetworkPeers returns the connected peers and their traffic.
+// (GET /v2/debug/network/peers)
+func (v2 *Handlers) GetNetworkPeers(ctx echo.Context) error {
+	peers := v2.Node.PeerTraffic()
+	response := model.NetworkPeersResponse{
+		Peers: make([]model.NetworkPeer, len(peers)),
+	}
+	for i, peer := range peers {
+		response.Peers[i] = convertPeerTraffic(peer)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func convertPeerBan(ban network.PeerBan) model.PeerBan {
+	return model.PeerBan{
+		Address: ban.Address,
+		Reason:  ban.Reason,
+		Since:   uint64(ban.Since.Unix()),
+		Until:   uint64(ban.Until.Unix()),
+		Count:   uint64(ban.Count),
+	}
+}
+
+// GetPeerBans returns the banned peer addresses.
+// (GET /v2/peers/bans)
+func (v2 *Handlers) GetPeerBans(ctx echo.Context) error {
+	bans := v2.Node.GetPeerBans()
+	response := model.PeerBansResponse{
+		Bans: make([]model.PeerBan, len(bans)),
+	}
+	for i, ban := range bans {
+		response.Bans[i] = convertPeerBan(ban)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// BanPeer bans a peer address.
+// (POST /v2/peers/bans/{address})
+func (v2 *Handlers) BanPeer(ctx echo.Context, address string, params model.BanPeerParams) error {
+	if address == "" {
+		return badRequest(ctx, nil, errNoPeerAddressSpecified, v2.Log)
+	}
+	var duration time.Duration
+	if params.Duration != nil {
+		if *params.Duration > uint64(math.MaxInt64/time.Second) {
+			return badRequest(ctx, nil, errInvalidBanDuration, v2.Log)
+		}
+		duration = time.Duration(*params.Duration) * time.Second
+	}
+	ban := v2.Node.BanPeer(address, duration)
+	return ctx.JSON(http.StatusOK, convertPeerBan(ban))
+}
+
+// UnbanPeer lifts the ban of a peer address.
+// (DELETE /v2/peers/bans/{address})
+func (v2 *Handlers) UnbanPeer(ctx echo.Context, address string) error {
+	if !v2.Node.UnbanPeer(address) {
+		return notFound(ctx, nil, errPeerAddressNotBanned, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 // CompileResponseWithSourceMap overrides the sourcemap field in
 // the CompileResponse for JSON marshalling.
 type CompileResponseWithSourceMap struct {
@@ -1591,6 +2072,75 @@ func (v2 *Handlers) wrapStateproofError(ctx echo.Context, err error) error {
 	return internalError(ctx, err, err.Error(), v2.Log)
 }
 
+// defaultMaxMissingSigners is the number of missing signers returned per pending state proof
+// when the request doesn't set it.
+const defaultMaxMissingSigners = 10
+
+func convertStateProofVoters(voters []stateproof.VoterStatus) []model.StateProofVoter {
+	res := make([]model.StateProofVoter, len(voters))
+	for i, v := range voters {
+		res[i] = model.StateProofVoter{
+			Address: v.Address.String(),
+			Weight:  v.Weight,
+			Signed:  v.Signed,
+		}
+	}
+	return res
+}
+
+// GetPendingStateProofs returns the state proofs the node collects signatures for.
+// (GET /v2/stateproofs/pending)
+func (v2 *Handlers) GetPendingStateProofs(ctx echo.Context, params model.GetPendingStateProofsParams) error {
+	maxMissingSigners := defaultMaxMissingSigners
+	if params.MaxMissingSigners != nil {
+		if *params.MaxMissingSigners > math.MaxInt32 {
+			return badRequest(ctx, nil, errRequestedMaxMissingSignersTooHigh, v2.Log)
+		}
+		maxMissingSigners = int(*params.MaxMissingSigners)
+	}
+
+	statuses, err := v2.Node.PendingStateProofs(maxMissingSigners)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.PendingStateProofsResponse{
+		StateProofs: make([]model.PendingStateProof, len(statuses)),
+	}
+	for i, status := range statuses {
+		response.StateProofs[i] = model.PendingStateProof{
+			Round:              uint64(status.Round),
+			VotersRound:        uint64(status.VotersRound),
+			Voters:             status.Voters,
+			Signers:            status.Signers,
+			TotalWeight:        status.TotalWeight,
+			SignedWeight:       status.SignedWeight,
+			ProvenWeight:       status.ProvenWeight,
+			AcceptableWeight:   status.AcceptableWeight,
+			LastBroadcast:      string(status.LastBroadcast),
+			LastBroadcastError: strOrNil(status.LastBroadcastError),
+			MissingSigners:     convertStateProofVoters(status.MissingSigners),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetPendingStateProofVoters returns the voters of a state proof the node collects signatures for.
+// (GET /v2/stateproofs/pending/{round})
+func (v2 *Handlers) GetPendingStateProofVoters(ctx echo.Context, round uint64) error {
+	voters, err := v2.Node.PendingStateProofVoters(basics.Round(round))
+	if err != nil {
+		if errors.Is(err, stateproof.ErrNoBuilderForRound) {
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.PendingStateProofVotersResponse{
+		Round:  round,
+		Voters: convertStateProofVoters(voters),
+	})
+}
+
 // GetLightBlockHeaderProof Gets a proof of a light block header for a given round
 // (GET /v2/blocks/{round}/lightheader/proof)
 func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) error {
@@ -20,6 +20,11 @@ import (
 	"math"
 	"testing"
 
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +44,77 @@ func TestApplicationBoxesMaxKeys(t *testing.T) {
 	// Response size _not_ limited
 	require.Equal(t, uint64(math.MaxUint64), applicationBoxesMaxKeys(0, 0))
 }
+
+func TestDecodeSimulateRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txgroup := []transactions.SignedTxn{{Txn: transactions.Transaction{Type: protocol.PaymentTx}}}
+	simulateRequest := PreEncodedSimulateRequest{
+		TxnGroups:             []PreEncodedSimulateRequestTransactionGroup{{Txns: txgroup}, {Txns: txgroup}},
+		Round:                 7,
+		AllowMoreLogging:      true,
+		AllowUnnamedResources: true,
+		ExtraOpcodeBudget:     1000,
+		ExtraLogicSigBudget:   500,
+	}
+
+	// both JSON and msgpack bodies are accepted
+	for _, data := range [][]byte{protocol.EncodeJSON(simulateRequest), protocol.EncodeReflect(simulateRequest)} {
+		request, err := decodeSimulateRequest(data, 16)
+		require.NoError(t, err)
+		require.Equal(t, [][]transactions.SignedTxn{txgroup, txgroup}, request.TxnGroups)
+		require.Equal(t, basics.Round(7), request.Round)
+		require.True(t, request.AllowMoreLogging)
+		require.True(t, request.AllowUnnamedResources)
+		require.Equal(t, uint64(1000), request.ExtraOpcodeBudget)
+		require.Equal(t, uint64(500), request.ExtraLogicSigBudget)
+	}
+}
+
+func TestConvertSimulationEvalOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, convertSimulationEvalOverrides(simulation.ResultEvalOverrides{}))
+
+	maxLogCalls, maxLogSize := simulation.MaxLogCalls, simulation.MaxLogSize
+	converted := convertSimulationEvalOverrides(simulation.ResultEvalOverrides{
+		AllowMoreLogging:  true,
+		ExtraOpcodeBudget: 100,
+		MaxLogCalls:       &maxLogCalls,
+		MaxLogSize:        &maxLogSize,
+	})
+	require.NotNil(t, converted)
+	require.True(t, *converted.AllowMoreLogging)
+	require.Equal(t, uint64(100), *converted.ExtraOpcodeBudget)
+	require.Nil(t, converted.ExtraLogicSigBudget)
+	require.Equal(t, simulation.MaxLogCalls, *converted.MaxLogCalls)
+	require.Equal(t, simulation.MaxLogSize, *converted.MaxLogSize)
+}
+
+func TestConvertUnnamedResourcesAccessed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, convertUnnamedResourcesAccessed(nil))
+
+	addr1, addr2 := basics.Address{1}, basics.Address{2}
+	converted := convertUnnamedResourcesAccessed(&simulation.ResourceTracker{
+		Accounts: map[basics.Address]struct{}{addr2: {}, addr1: {}},
+		Assets:   map[basics.AssetIndex]struct{}{9: {}, 3: {}},
+		Boxes: map[simulation.BoxRef]struct{}{
+			{App: 2, Name: "a"}: {},
+			{App: 1, Name: "b"}: {},
+			{App: 1, Name: "a"}: {},
+		},
+	})
+	require.Equal(t, []string{addr1.String(), addr2.String()}, *converted.Accounts)
+	require.Equal(t, []uint64{3, 9}, *converted.Assets)
+	require.Nil(t, converted.Apps)
+	require.Equal(t, []model.BoxReference{
+		{App: 1, Name: []byte("a")},
+		{App: 1, Name: []byte("b")},
+		{App: 2, Name: []byte("a")},
+	}, *converted.Boxes)
+}
@@ -128,6 +128,13 @@ func (l *mockLedger) Wait(r basics.Round) chan struct{} {
 func (l *mockLedger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
 	panic("not implemented")
 }
+func (l *mockLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
+	panic("not implemented")
+}
+func (l *mockLedger) LookupHistorical(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error) {
+	ad, _, withoutRewards, err := l.LookupLatest(addr)
+	return ad, withoutRewards, err
+}
 func (l *mockLedger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
 	panic("not implemented")
 }
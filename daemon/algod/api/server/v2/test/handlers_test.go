@@ -23,8 +23,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -47,10 +50,14 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/stateproofmsg"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
@@ -111,6 +118,54 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestAccountInformationRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, false)
+	defer releasefunc()
+	latest := uint64(handler.Node.LedgerForAPI().Latest())
+	err := handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &latest})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	actualResponse := model.AccountResponse{}
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &actualResponse)
+	require.NoError(t, err)
+	require.Equal(t, poolAddrResponseGolden, actualResponse)
+
+	for _, exclude := range []model.AccountInformationParamsExclude{"all", "none"} {
+		exclude := exclude
+		next := latest + 1
+		c, rec = newReq(t)
+		err = handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &next, Exclude: &exclude})
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+	}
+}
+
+func TestApplicationByIDRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, false)
+	defer releasefunc()
+	latest := uint64(handler.Node.LedgerForAPI().Latest())
+	err := handler.GetApplicationByID(c, 1, model.GetApplicationByIDParams{Round: &latest})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	next := latest + 1
+	c, rec = newReq(t)
+	err = handler.GetApplicationByID(c, 1, model.GetApplicationByIDParams{Round: &next})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationBoxByName(c, 1, model.GetApplicationBoxByNameParams{Name: "str:box", Round: &next})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, false)
 	defer releasefunc()
@@ -156,6 +211,127 @@ func TestGetLedgerStateDelta(t *testing.T) {
 	require.Equal(t, poolDeltaResponseGolden.Totals, actualResponse.Totals)
 }
 
+func TestStreamLedgerStateDeltas(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, false)
+	defer releasefunc()
+	insertRounds(a, handler, 3)
+	mockNode := handler.Node.(*mockNode)
+
+	// The client is already gone, so each stream ends once it has caught up
+	// with the ledger.
+	gone, cancel := context.WithCancel(context.Background())
+	cancel()
+	streamReq := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(gone)
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(req, rec), rec
+	}
+
+	round := uint64(2)
+	c, rec := streamReq()
+	a.NoError(handler.StreamLedgerStateDeltas(c, model.StreamLedgerStateDeltasParams{Round: &round}))
+	a.Equal(200, rec.Code)
+	lines := bytes.Split(bytes.TrimSpace(rec.Body.Bytes()), []byte("\n"))
+	a.Len(lines, 2)
+	for i, line := range lines {
+		var entry v2.PreEncodedLedgerStateDeltaStreamEntry
+		a.NoError(protocol.DecodeJSON(line, &entry))
+		a.Equal(round+uint64(i), entry.Round)
+		a.Equal(basics.Round(entry.Round), entry.Block.Round())
+		a.Equal(poolDeltaResponseGolden.Totals, entry.Delta.Totals)
+	}
+
+	// msgpack entries are written back to back, and sync moves the sync round
+	// along with the stream.
+	mockNode.On("SetSyncRound", uint64(3)).Return(nil)
+	mockNode.On("SetSyncRound", uint64(4)).Return(nil)
+	format, sync := model.StreamLedgerStateDeltasParamsFormatMsgpack, true
+	c, rec = streamReq()
+	a.NoError(handler.StreamLedgerStateDeltas(c, model.StreamLedgerStateDeltasParams{Round: &round, Sync: &sync, Format: &format}))
+	a.Equal(200, rec.Code)
+	dec := codec.NewDecoderBytes(rec.Body.Bytes(), protocol.CodecHandle)
+	for i := uint64(0); i < 2; i++ {
+		var entry v2.PreEncodedLedgerStateDeltaStreamEntry
+		a.NoError(dec.Decode(&entry))
+		a.Equal(round+i, entry.Round)
+	}
+	mock.AssertExpectationsForObjects(t, mockNode)
+
+	// starting after the latest round streams nothing until a block is added
+	c, rec = streamReq()
+	a.NoError(handler.StreamLedgerStateDeltas(c, model.StreamLedgerStateDeltasParams{}))
+	a.Equal(200, rec.Code)
+	a.Empty(rec.Body.Bytes())
+
+	// the delta of the genesis round is not kept in memory
+	zero := uint64(0)
+	c, rec = streamReq()
+	a.NoError(handler.StreamLedgerStateDeltas(c, model.StreamLedgerStateDeltasParams{Round: &zero}))
+	a.Equal(404, rec.Code)
+}
+
+func TestStreamPendingTransactionEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, false)
+	defer releasefunc()
+	mockNode := handler.Node.(*mockNode)
+
+	streamReq := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(req, rec), rec
+	}
+
+	var sender basics.Address
+	sender[0] = 1
+	txn := transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.PaymentTx, Header: transactions.Header{Sender: sender}}}
+	filter := pools.TxEventFilter{Sender: sender, AppID: 7}
+	events := make(chan pools.TxEvent, 2)
+	events <- pools.TxEvent{Type: pools.TxEventAdmitted, Txn: txn, Round: 5}
+	events <- pools.TxEvent{Type: pools.TxEventRejected, Txn: txn, Round: 5, Reason: "overspend"}
+	// the channel is closed when the subscriber falls behind, which ends the stream
+	close(events)
+	mockNode.On("SubscribePendingTxEvents", filter).Return(events, nil).Once()
+
+	senderStr, appID := sender.String(), uint64(7)
+	c, rec := streamReq()
+	a.NoError(handler.StreamPendingTransactionEvents(c, model.StreamPendingTransactionEventsParams{Sender: &senderStr, ApplicationId: &appID}))
+	a.Equal(200, rec.Code)
+	lines := bytes.Split(bytes.TrimSpace(rec.Body.Bytes()), []byte("\n"))
+	a.Len(lines, 2)
+	var entry v2.PreEncodedPendingTransactionEvent
+	a.NoError(protocol.DecodeJSON(lines[0], &entry))
+	a.Equal("admitted", entry.Type)
+	a.Equal(txn.ID().String(), entry.Txid)
+	a.Equal(txn, entry.Txn)
+	a.Equal(uint64(5), entry.Round)
+	a.Nil(entry.Reason)
+	entry = v2.PreEncodedPendingTransactionEvent{}
+	a.NoError(protocol.DecodeJSON(lines[1], &entry))
+	a.Equal("rejected", entry.Type)
+	a.Equal("overspend", *entry.Reason)
+	mock.AssertExpectationsForObjects(t, mockNode)
+
+	// malformed filters are rejected before subscribing
+	badSender := "not an address"
+	c, rec = streamReq()
+	a.NoError(handler.StreamPendingTransactionEvents(c, model.StreamPendingTransactionEventsParams{Sender: &badSender}))
+	a.Equal(400, rec.Code)
+
+	// nodes without a transaction pool cannot stream its events
+	mockNode.On("SubscribePendingTxEvents", pools.TxEventFilter{}).Return(nil, node.ErrFollowerNodeUnsupported).Once()
+	c, rec = streamReq()
+	a.NoError(handler.StreamPendingTransactionEvents(c, model.StreamPendingTransactionEventsParams{}))
+	a.Equal(400, rec.Code)
+}
+
 func TestSyncRound(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -638,7 +814,7 @@ func TestPendingTransactionsByAddress(t *testing.T) {
 	pendingTransactionsByAddressTest(t, -1, "json", 400)
 }
 
-func prepareTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTransactionSimulator bool) (handler v2.Handlers, c echo.Context, rec *httptest.ResponseRecorder, releasefunc func()) {
+func prepareTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTransactionSimulator bool, encodeBody func(stxn transactions.SignedTxn) []byte) (handler v2.Handlers, c echo.Context, rec *httptest.ResponseRecorder, releasefunc func()) {
 	numAccounts := 5
 	numTransactions := 5
 	offlineAccounts := true
@@ -655,8 +831,7 @@ func prepareTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTran
 	e := echo.New()
 	var body io.Reader
 	if txnToUse >= 0 {
-		stxn := stxns[txnToUse]
-		bodyBytes := protocol.Encode(&stxn)
+		bodyBytes := encodeBody(stxns[txnToUse])
 		body = bytes.NewReader(bodyBytes)
 	}
 	req := httptest.NewRequest(http.MethodPost, "/", body)
@@ -666,7 +841,10 @@ func prepareTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTran
 }
 
 func postTransactionTest(t *testing.T, txnToUse, expectedCode int) {
-	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, expectedCode, false)
+	encodeBody := func(stxn transactions.SignedTxn) []byte {
+		return protocol.Encode(&stxn)
+	}
+	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, expectedCode, false, encodeBody)
 	defer releasefunc()
 	err := handler.RawTransaction(c)
 	require.NoError(t, err)
@@ -681,24 +859,45 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
-func simulateTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTransactionSimulator bool) {
-	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, expectedCode, enableTransactionSimulator)
+func simulateTransactionTest(t *testing.T, txnToUse int, format string, expectedCode int, enableTransactionSimulator bool) {
+	encodeBody := func(stxn transactions.SignedTxn) []byte {
+		request := v2.PreEncodedSimulateRequest{
+			TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{
+				{Txns: []transactions.SignedTxn{stxn}},
+			},
+		}
+		return protocol.EncodeReflect(&request)
+	}
+	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, expectedCode, enableTransactionSimulator, encodeBody)
 	defer releasefunc()
-	err := handler.SimulateTransaction(c)
+	err := handler.SimulateTransaction(c, model.SimulateTransactionParams{Format: (*model.SimulateTransactionParamsFormat)(&format)})
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode != http.StatusOK {
+		return
+	}
+
+	var response v2.PreEncodedSimulateResponse
+	if format == "json" {
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	} else {
+		require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &response))
+	}
+	require.Equal(t, simulation.ResultLatestVersion, response.Version)
 }
 
 func TestSimulateTransaction(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
-	simulateTransactionTest(t, -1, 400, true)
-	simulateTransactionTest(t, 0, 404, false)
-	simulateTransactionTest(t, 0, 200, true)
+	simulateTransactionTest(t, -1, "json", 400, true)
+	simulateTransactionTest(t, 0, "json", 404, false)
+	simulateTransactionTest(t, 0, "msgpack", 200, true)
+	simulateTransactionTest(t, 0, "json", 200, true)
+	simulateTransactionTest(t, 0, "bad format", 400, true)
 }
 
-func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
+func startCatchupTest(t *testing.T, catchpoint string, params model.StartCatchupParams, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
 	offlineAccounts := true
@@ -715,7 +914,7 @@ func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expected
 	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	err := handler.StartCatchup(c, catchpoint)
+	err := handler.StartCatchup(c, catchpoint, params)
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
 }
@@ -725,18 +924,36 @@ func TestStartCatchup(t *testing.T) {
 	t.Parallel()
 
 	goodCatchPoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
-	startCatchupTest(t, goodCatchPoint, nil, 201)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{}, nil, 201)
 
 	inProgressError := node.MakeCatchpointAlreadyInProgressError("catchpoint")
-	startCatchupTest(t, goodCatchPoint, inProgressError, 200)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{}, inProgressError, 200)
 
 	unableToStartError := node.MakeCatchpointUnableToStartError("running", "requested")
-	startCatchupTest(t, goodCatchPoint, unableToStartError, 400)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{}, unableToStartError, 400)
 
-	startCatchupTest(t, goodCatchPoint, errors.New("anothing else is internal"), 500)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{}, errors.New("anothing else is internal"), 500)
 
 	badCatchPoint := "bad catchpoint"
-	startCatchupTest(t, badCatchPoint, nil, 400)
+	startCatchupTest(t, badCatchPoint, model.StartCatchupParams{}, nil, 400)
+
+	// local catchpoint file and blocks directory
+	blocksDir := t.TempDir()
+	catchpointFile := filepath.Join(blocksDir, "5894690.tar")
+	require.NoError(t, os.WriteFile(catchpointFile, nil, 0644))
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointFile: &catchpointFile, BlocksDir: &blocksDir}, nil, 201)
+
+	missingFile := filepath.Join(blocksDir, "missing.tar")
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointFile: &missingFile}, nil, 400)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointFile: &blocksDir}, nil, 400)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{BlocksDir: &catchpointFile}, nil, 400)
+
+	// incremental catchpoint files on top of the local catchpoint file
+	deltaFiles := []string{catchpointFile}
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointFile: &catchpointFile, CatchpointDeltas: &deltaFiles}, nil, 201)
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointDeltas: &deltaFiles}, nil, 400)
+	missingDeltaFiles := []string{missingFile}
+	startCatchupTest(t, goodCatchPoint, model.StartCatchupParams{CatchpointFile: &catchpointFile, CatchpointDeltas: &missingDeltaFiles}, nil, 400)
 }
 
 func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
@@ -771,6 +988,318 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func TestCatchpointGeneration(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	request := func(method string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(method, "/", nil), rec), rec
+	}
+
+	requested := ledger.CatchpointGenerationStatus{
+		Stage:       ledger.CatchpointGenerationRequested,
+		RequestedAt: time.Unix(1000000, 0),
+	}
+	done := ledger.CatchpointGenerationStatus{
+		Stage:         ledger.CatchpointGenerationDone,
+		RequestedAt:   time.Unix(1000000, 0),
+		AccountsRound: 1000,
+		Round:         1320,
+		TotalAccounts: 10,
+		Label:         "1320#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA",
+	}
+
+	mockNode.On("RequestCatchpoint").Return(requested, nil).Once()
+	c, rec := request(http.MethodPost)
+	require.NoError(t, handler.StartCatchpointGeneration(c))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.JSONEq(t, `{"stage":"requested","requested-at":1000000}`, rec.Body.String())
+
+	mockNode.On("RequestCatchpoint").Return(requested, fmt.Errorf("wrapped: %w", ledger.ErrCatchpointGenerationInProgress)).Once()
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.StartCatchpointGeneration(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	mockNode.On("RequestCatchpoint").Return(ledger.CatchpointGenerationStatus{}, ledger.ErrCatchpointTrackingDisabled).Once()
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.StartCatchpointGeneration(c))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.On("RequestCatchpoint").Return(ledger.CatchpointGenerationStatus{}, errors.New("anything else is internal")).Once()
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.StartCatchpointGeneration(c))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	mockNode.On("GetCatchpointGenerationStatus").Return(done, true).Once()
+	c, rec = request(http.MethodGet)
+	require.NoError(t, handler.GetCatchpointGeneration(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status model.CatchpointGenerationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Equal(t, "done", status.Stage)
+	require.Equal(t, uint64(1000), *status.AccountsRound)
+	require.Equal(t, uint64(1320), *status.Round)
+	require.Equal(t, uint64(10), *status.TotalAccounts)
+	require.Nil(t, status.TotalKvs)
+	require.Equal(t, done.Label, *status.Label)
+	require.Nil(t, status.Error)
+
+	mockNode.On("GetCatchpointGenerationStatus").Return(ledger.CatchpointGenerationStatus{}, false).Once()
+	c, rec = request(http.MethodGet)
+	require.NoError(t, handler.GetCatchpointGeneration(c))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestPendingStateProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	request := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	missing := stateproof.VoterStatus{Address: basics.Address{0x01}, Weight: 700}
+	signer := stateproof.VoterStatus{Address: basics.Address{0x02}, Weight: 300, Signed: true}
+	statuses := []stateproof.BuilderStatus{{
+		Round:              512,
+		VotersRound:        256,
+		Voters:             2,
+		Signers:            1,
+		TotalWeight:        1000,
+		SignedWeight:       300,
+		ProvenWeight:       300,
+		AcceptableWeight:   1000,
+		LastBroadcast:      stateproof.BroadcastSendFailed,
+		LastBroadcastError: "rejected",
+		MissingSigners:     []stateproof.VoterStatus{missing},
+	}}
+
+	mockNode.On("PendingStateProofs", 10).Return(statuses, nil).Once()
+	c, rec := request()
+	require.NoError(t, handler.GetPendingStateProofs(c, model.GetPendingStateProofsParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.PendingStateProofsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.StateProofs, 1)
+	pending := response.StateProofs[0]
+	require.Equal(t, uint64(512), pending.Round)
+	require.Equal(t, uint64(256), pending.VotersRound)
+	require.Equal(t, uint64(1), pending.Signers)
+	require.Equal(t, uint64(300), pending.SignedWeight)
+	require.Equal(t, uint64(1000), pending.AcceptableWeight)
+	require.Equal(t, "send-failed", pending.LastBroadcast)
+	require.Equal(t, "rejected", *pending.LastBroadcastError)
+	require.Equal(t, []model.StateProofVoter{{Address: missing.Address.String(), Weight: 700}}, pending.MissingSigners)
+
+	maxMissingSigners := uint64(0)
+	mockNode.On("PendingStateProofs", 0).Return([]stateproof.BuilderStatus{}, nil).Once()
+	c, rec = request()
+	require.NoError(t, handler.GetPendingStateProofs(c, model.GetPendingStateProofsParams{MaxMissingSigners: &maxMissingSigners}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"state-proofs":[]}`, rec.Body.String())
+
+	maxMissingSigners = math.MaxUint64
+	c, rec = request()
+	require.NoError(t, handler.GetPendingStateProofs(c, model.GetPendingStateProofsParams{MaxMissingSigners: &maxMissingSigners}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.On("PendingStateProofs", 10).Return([]stateproof.BuilderStatus(nil), node.ErrFollowerNodeUnsupported).Once()
+	c, rec = request()
+	require.NoError(t, handler.GetPendingStateProofs(c, model.GetPendingStateProofsParams{}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.On("PendingStateProofVoters", basics.Round(512)).Return([]stateproof.VoterStatus{missing, signer}, nil).Once()
+	c, rec = request()
+	require.NoError(t, handler.GetPendingStateProofVoters(c, 512))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var votersResponse model.PendingStateProofVotersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &votersResponse))
+	require.Equal(t, model.PendingStateProofVotersResponse{
+		Round: 512,
+		Voters: []model.StateProofVoter{
+			{Address: missing.Address.String(), Weight: 700},
+			{Address: signer.Address.String(), Weight: 300, Signed: true},
+		},
+	}, votersResponse)
+
+	mockNode.On("PendingStateProofVoters", basics.Round(768)).Return([]stateproof.VoterStatus(nil), fmt.Errorf("%w: round 768", stateproof.ErrNoBuilderForRound)).Once()
+	c, rec = request()
+	require.NoError(t, handler.GetPendingStateProofVoters(c, 768))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestGetNetworkPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	request := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	peer := network.PeerTraffic{
+		Address:        "r1.example.com:4160",
+		Outgoing:       true,
+		Version:        "2.2",
+		Features:       []string{"ppzstd"},
+		ConnectedSince: time.Unix(1000000, 0),
+		MessageDelay:   3 * time.Millisecond,
+		Tags: []network.PeerTagTraffic{{
+			Tag:                 protocol.AgreementVoteTag,
+			ReceivedMessages:    20,
+			ReceivedBytes:       4000,
+			ReceivedMessageRate: 2,
+			ReceivedByteRate:    400,
+			SentMessages:        1,
+			SentBytes:           200,
+			HandledMessages:     20,
+			HandleTime:          5 * time.Millisecond,
+		}},
+	}
+	delay := uint64(3000000)
+	expected := model.NetworkPeer{
+		Address:        "r1.example.com:4160",
+		Outgoing:       true,
+		Version:        "2.2",
+		Features:       []string{"ppzstd"},
+		ConnectedSince: 1000000,
+		MessageDelay:   &delay,
+		Tags: []model.NetworkPeerTagTraffic{{
+			Tag:                 "AV",
+			ReceivedMessages:    20,
+			ReceivedBytes:       4000,
+			ReceivedMessageRate: 2,
+			ReceivedByteRate:    400,
+			SentMessages:        1,
+			SentBytes:           200,
+			HandledMessages:     20,
+			HandleTime:          5000000,
+		}},
+	}
+
+	mockNode.On("PeerTraffic").Return([]network.PeerTraffic{peer}).Once()
+	c, rec := request()
+	require.NoError(t, handler.GetNetworkPeers(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var peers model.NetworkPeersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+	require.Equal(t, []model.NetworkPeer{expected}, peers.Peers)
+
+	mockNode.On("PeerTraffic").Return(nil).Once()
+	c, rec = request()
+	require.NoError(t, handler.GetNetworkPeers(c))
+	require.JSONEq(t, `{"peers":[]}`, rec.Body.String())
+}
+
+func TestPeerBans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	request := func(method string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(method, "/", nil), rec), rec
+	}
+
+	since := time.Unix(1000000, 0)
+	ban := network.PeerBan{Address: "10.0.0.1", Reason: "invalid vote", Since: since, Until: since.Add(time.Hour), Count: 2}
+	expected := model.PeerBan{Address: "10.0.0.1", Reason: "invalid vote", Since: 1000000, Until: 1003600, Count: 2}
+
+	mockNode.On("GetPeerBans").Return([]network.PeerBan{ban}).Once()
+	c, rec := request(http.MethodGet)
+	require.NoError(t, handler.GetPeerBans(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var bans model.PeerBansResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &bans))
+	require.Equal(t, []model.PeerBan{expected}, bans.Bans)
+
+	mockNode.On("GetPeerBans").Return(nil).Once()
+	c, rec = request(http.MethodGet)
+	require.NoError(t, handler.GetPeerBans(c))
+	require.JSONEq(t, `{"bans":[]}`, rec.Body.String())
+
+	mockNode.On("BanPeer", "10.0.0.1", 10*time.Minute).Return(ban).Once()
+	duration := uint64(600)
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.BanPeer(c, "10.0.0.1", model.BanPeerParams{Duration: &duration}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var banned model.PeerBanResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &banned))
+	require.Equal(t, expected, banned)
+
+	mockNode.On("BanPeer", "10.0.0.1", time.Duration(0)).Return(ban).Once()
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.BanPeer(c, "10.0.0.1", model.BanPeerParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	duration = uint64(1) << 62
+	c, rec = request(http.MethodPost)
+	require.NoError(t, handler.BanPeer(c, "10.0.0.1", model.BanPeerParams{Duration: &duration}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.On("UnbanPeer", "10.0.0.1").Return(true).Once()
+	c, rec = request(http.MethodDelete)
+	require.NoError(t, handler.UnbanPeer(c, "10.0.0.1"))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	mockNode.On("UnbanPeer", "10.0.0.2").Return(false).Once()
+	c, rec = request(http.MethodDelete)
+	require.NoError(t, handler.UnbanPeer(c, "10.0.0.2"))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int,
 	enableDeveloperAPI bool, params model.TealCompileParams,
 	expectedSourcemap *logic.SourceMap,
@@ -21,10 +21,12 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -34,13 +36,18 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	basics_testing "github.com/algorand/go-algorand/data/basics/testing"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/util/db"
 )
 
@@ -180,6 +187,38 @@ func (m *mockNode) GetSyncRound() uint64 {
 	return uint64(args.Int(0))
 }
 
+func (m *mockNode) SubscribeNewBlocks() (<-chan struct{}, func()) {
+	return make(chan struct{}), func() {}
+}
+
+func (m *mockNode) SubscribePendingTxEvents(filter pools.TxEventFilter) (<-chan pools.TxEvent, func(), error) {
+	args := m.Called(filter)
+	events, _ := args.Get(0).(chan pools.TxEvent)
+	return events, func() {}, args.Error(1)
+}
+
+func (m *mockNode) PeerTraffic() []network.PeerTraffic {
+	args := m.Called()
+	peers, _ := args.Get(0).([]network.PeerTraffic)
+	return peers
+}
+
+func (m *mockNode) GetPeerBans() []network.PeerBan {
+	args := m.Called()
+	bans, _ := args.Get(0).([]network.PeerBan)
+	return bans
+}
+
+func (m *mockNode) BanPeer(address string, duration time.Duration) network.PeerBan {
+	args := m.Called(address, duration)
+	return args.Get(0).(network.PeerBan)
+}
+
+func (m *mockNode) UnbanPeer(address string) bool {
+	args := m.Called(address)
+	return args.Bool(0)
+}
+
 func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error {
 	m.id = id
 	m.keys = keys
@@ -221,8 +260,8 @@ func (m *mockNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) erro
 	return m.err
 }
 
-func (m *mockNode) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, error) {
-	return nil, false, m.err
+func (m *mockNode) Simulate(request simulation.Request) (simulation.Result, error) {
+	return simulation.Result{Version: simulation.ResultLatestVersion, WouldSucceed: true}, m.err
 }
 
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
@@ -286,7 +325,7 @@ func (m *mockNode) AssembleBlock(round basics.Round) (agreement.ValidatedBlock,
 	return nil, fmt.Errorf("assemble block not implemented")
 }
 
-func (m *mockNode) StartCatchup(catchpoint string) error {
+func (m *mockNode) StartCatchup(catchpoint string, source catchup.CatchpointCatchupSource) error {
 	return m.err
 }
 
@@ -294,6 +333,26 @@ func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) RequestCatchpoint() (ledger.CatchpointGenerationStatus, error) {
+	args := m.Called()
+	return args.Get(0).(ledger.CatchpointGenerationStatus), args.Error(1)
+}
+
+func (m *mockNode) GetCatchpointGenerationStatus() (ledger.CatchpointGenerationStatus, bool) {
+	args := m.Called()
+	return args.Get(0).(ledger.CatchpointGenerationStatus), args.Bool(1)
+}
+
+func (m *mockNode) PendingStateProofs(maxMissingSigners int) ([]stateproof.BuilderStatus, error) {
+	args := m.Called(maxMissingSigners)
+	return args.Get(0).([]stateproof.BuilderStatus), args.Error(1)
+}
+
+func (m *mockNode) PendingStateProofVoters(rnd basics.Round) ([]stateproof.VoterStatus, error) {
+	args := m.Called(rnd)
+	return args.Get(0).([]stateproof.VoterStatus), args.Error(1)
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -30,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
@@ -207,15 +209,15 @@ func computeAppIndexFromTxn(tx node.TxnWithStatus, l LedgerForAPI) *uint64 {
 
 // getCodecHandle converts a format string into the encoder + content type
 func getCodecHandle(formatPtr *model.Format) (codec.Handle, string, error) {
-	format := model.Json
+	format := model.PendingTransactionInformationParamsFormatJson
 	if formatPtr != nil {
 		format = model.PendingTransactionInformationParamsFormat(strings.ToLower(string(*formatPtr)))
 	}
 
 	switch format {
-	case model.Json:
+	case model.PendingTransactionInformationParamsFormatJson:
 		return protocol.JSONStrictHandle, "application/json", nil
-	case model.Msgpack:
+	case model.PendingTransactionInformationParamsFormatMsgpack:
 		fallthrough
 	case "msgp":
 		return protocol.CodecHandle, "application/msgpack", nil
@@ -344,6 +346,237 @@ func convertInnerTxn(txn *transactions.SignedTxnWithAD) PreEncodedTxInfo {
 	return response
 }
 
+func convertSimulateTraceConfig(config *model.SimulateTraceConfig) simulation.ExecTraceConfig {
+	if config == nil {
+		return simulation.ExecTraceConfig{}
+	}
+	return simulation.ExecTraceConfig{
+		Enable:  config.Enable != nil && *config.Enable,
+		Stack:   config.StackChange != nil && *config.StackChange,
+		Scratch: config.ScratchChange != nil && *config.ScratchChange,
+		State:   config.StateChange != nil && *config.StateChange,
+	}
+}
+
+func convertToSimulateTraceConfig(config simulation.ExecTraceConfig) *model.SimulateTraceConfig {
+	if config == (simulation.ExecTraceConfig{}) {
+		return nil
+	}
+	return &model.SimulateTraceConfig{
+		Enable:        &config.Enable,
+		StackChange:   &config.Stack,
+		ScratchChange: &config.Scratch,
+		StateChange:   &config.State,
+	}
+}
+
+func convertTealValue(tv basics.TealValue) model.TealValue {
+	return model.TealValue{
+		Type:  uint64(tv.Type),
+		Bytes: base64.StdEncoding.EncodeToString([]byte(tv.Bytes)),
+		Uint:  tv.Uint,
+	}
+}
+
+func convertOpcodeTraceUnit(unit simulation.OpcodeTraceUnit) model.SimulationOpcodeTraceUnit {
+	converted := model.SimulationOpcodeTraceUnit{Pc: unit.PC}
+	if len(unit.SpawnedInners) > 0 {
+		spawnedInners := append([]uint64(nil), unit.SpawnedInners...)
+		converted.SpawnedInners = &spawnedInners
+	}
+	if unit.StackPopCount > 0 {
+		popCount := unit.StackPopCount
+		converted.StackPopCount = &popCount
+	}
+	if len(unit.StackAdded) > 0 {
+		added := make([]model.TealValue, len(unit.StackAdded))
+		for i, tv := range unit.StackAdded {
+			added[i] = convertTealValue(tv)
+		}
+		converted.StackAdditions = &added
+	}
+	if len(unit.ScratchSlotChanges) > 0 {
+		changes := make([]model.ScratchChange, len(unit.ScratchSlotChanges))
+		for i, change := range unit.ScratchSlotChanges {
+			changes[i] = model.ScratchChange{
+				Slot:     change.Slot,
+				NewValue: convertTealValue(change.NewValue),
+			}
+		}
+		converted.ScratchChanges = &changes
+	}
+	if len(unit.StateChanges) > 0 {
+		changes := make([]model.ApplicationStateOperation, len(unit.StateChanges))
+		for i, change := range unit.StateChanges {
+			changes[i] = model.ApplicationStateOperation{
+				Operation:    string(change.Operation),
+				AppStateType: string(change.State),
+				AppId:        uint64(change.AppID),
+				Key:          []byte(change.Key),
+			}
+			if change.Operation == simulation.StateWrite && change.NewValue != (basics.TealValue{}) {
+				newValue := convertTealValue(change.NewValue)
+				changes[i].NewValue = &newValue
+			}
+			if !change.Account.IsZero() {
+				changes[i].Account = addrOrNil(change.Account)
+			}
+		}
+		converted.StateChanges = &changes
+	}
+	return converted
+}
+
+func convertProgramTrace(units []simulation.OpcodeTraceUnit) *[]model.SimulationOpcodeTraceUnit {
+	if len(units) == 0 {
+		return nil
+	}
+	converted := make([]model.SimulationOpcodeTraceUnit, len(units))
+	for i, unit := range units {
+		converted[i] = convertOpcodeTraceUnit(unit)
+	}
+	return &converted
+}
+
+func convertTxnTrace(trace *simulation.TransactionTrace) *model.SimulationTransactionExecTrace {
+	if trace == nil {
+		return nil
+	}
+	converted := model.SimulationTransactionExecTrace{
+		ApprovalProgramTrace:   convertProgramTrace(trace.ApprovalProgramTrace),
+		ClearStateProgramTrace: convertProgramTrace(trace.ClearStateProgramTrace),
+		LogicSigTrace:          convertProgramTrace(trace.LogicSigTrace),
+	}
+	if len(trace.InnerTraces) > 0 {
+		inners := make([]model.SimulationTransactionExecTrace, len(trace.InnerTraces))
+		for i := range trace.InnerTraces {
+			inners[i] = *convertTxnTrace(&trace.InnerTraces[i])
+		}
+		converted.InnerTrace = &inners
+	}
+	return &converted
+}
+
+// convertUnnamedResourcesAccessed lists the resources of a tracker in a
+// deterministic order.
+func convertUnnamedResourcesAccessed(tracker *simulation.ResourceTracker) *model.SimulateUnnamedResourcesAccessed {
+	if tracker == nil {
+		return nil
+	}
+	var converted model.SimulateUnnamedResourcesAccessed
+	if len(tracker.Accounts) != 0 {
+		accounts := make([]string, 0, len(tracker.Accounts))
+		for addr := range tracker.Accounts {
+			accounts = append(accounts, addr.String())
+		}
+		sort.Strings(accounts)
+		converted.Accounts = &accounts
+	}
+	if len(tracker.Assets) != 0 {
+		assets := make([]uint64, 0, len(tracker.Assets))
+		for aid := range tracker.Assets {
+			assets = append(assets, uint64(aid))
+		}
+		sort.Slice(assets, func(i, j int) bool { return assets[i] < assets[j] })
+		converted.Assets = &assets
+	}
+	if len(tracker.Apps) != 0 {
+		apps := make([]uint64, 0, len(tracker.Apps))
+		for aid := range tracker.Apps {
+			apps = append(apps, uint64(aid))
+		}
+		sort.Slice(apps, func(i, j int) bool { return apps[i] < apps[j] })
+		converted.Apps = &apps
+	}
+	if len(tracker.Boxes) != 0 {
+		boxes := make([]model.BoxReference, 0, len(tracker.Boxes))
+		for box := range tracker.Boxes {
+			boxes = append(boxes, model.BoxReference{App: uint64(box.App), Name: []byte(box.Name)})
+		}
+		sort.Slice(boxes, func(i, j int) bool {
+			if boxes[i].App != boxes[j].App {
+				return boxes[i].App < boxes[j].App
+			}
+			return string(boxes[i].Name) < string(boxes[j].Name)
+		})
+		converted.Boxes = &boxes
+	}
+	return &converted
+}
+
+func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResult {
+	result := PreEncodedSimulateTxnResult{
+		Txn:                      convertInnerTxn(&txnResult.Txn),
+		AppBudgetConsumed:        numOrNil(txnResult.AppBudgetConsumed),
+		LogicSigBudgetConsumed:   numOrNil(txnResult.LogicSigBudgetConsumed),
+		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnResult.UnnamedResourcesAccessed),
+		Trace:                    convertTxnTrace(txnResult.Trace),
+	}
+	if txnResult.MissingSignature {
+		missingSignature := true
+		result.MissingSignature = &missingSignature
+	}
+	return result
+}
+
+func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedSimulateTxnGroupResult {
+	txnResults := make([]PreEncodedSimulateTxnResult, len(txnGroupResult.Txns))
+	for i, txnResult := range txnGroupResult.Txns {
+		txnResults[i] = convertTxnResult(txnResult)
+	}
+
+	encoded := PreEncodedSimulateTxnGroupResult{
+		Txns:              txnResults,
+		FailureMessage:    strOrNil(txnGroupResult.FailureMessage),
+		AppBudgetAdded:    numOrNil(txnGroupResult.AppBudgetAdded),
+		AppBudgetConsumed: numOrNil(txnGroupResult.AppBudgetConsumed),
+	}
+
+	if len(txnGroupResult.FailedAt) > 0 {
+		failedAt := make([]uint64, len(txnGroupResult.FailedAt))
+		copy(failedAt, txnGroupResult.FailedAt)
+		encoded.FailedAt = &failedAt
+	}
+
+	return encoded
+}
+
+func convertSimulationEvalOverrides(overrides simulation.ResultEvalOverrides) *model.SimulationEvalOverrides {
+	if overrides == (simulation.ResultEvalOverrides{}) {
+		return nil
+	}
+	converted := &model.SimulationEvalOverrides{
+		ExtraOpcodeBudget:   numOrNil(overrides.ExtraOpcodeBudget),
+		ExtraLogicSigBudget: numOrNil(overrides.ExtraLogicSigBudget),
+		MaxLogCalls:         overrides.MaxLogCalls,
+		MaxLogSize:          overrides.MaxLogSize,
+	}
+	if overrides.AllowMoreLogging {
+		converted.AllowMoreLogging = &overrides.AllowMoreLogging
+	}
+	if overrides.AllowUnnamedResources {
+		converted.AllowUnnamedResources = &overrides.AllowUnnamedResources
+	}
+	return converted
+}
+
+func convertSimulationResult(result simulation.Result) PreEncodedSimulateResponse {
+	encodedSimulationResult := PreEncodedSimulateResponse{
+		Version:         result.Version,
+		LastRound:       uint64(result.LastRound),
+		WouldSucceed:    result.WouldSucceed,
+		TxnGroups:       make([]PreEncodedSimulateTxnGroupResult, len(result.TxnGroups)),
+		EvalOverrides:   convertSimulationEvalOverrides(result.EvalOverrides),
+		ExecTraceConfig: convertToSimulateTraceConfig(result.TraceConfig),
+	}
+
+	for i, txnGroup := range result.TxnGroups {
+		encodedSimulationResult.TxnGroups[i] = convertTxnGroupResult(txnGroup)
+	}
+
+	return encodedSimulationResult
+}
+
 // printableUTF8OrEmpty checks to see if the entire string is a UTF8 printable string.
 // If this is the case, the string is returned as is. Otherwise, the empty string is returned.
 func printableUTF8OrEmpty(in string) string {
@@ -30,6 +30,10 @@ var ErrStaleBlockAssemblyRequest = errors.New("AssembleBlock: requested block as
 // ErrPendingQueueReachedMaxCap indicates the current transaction pool has reached its max capacity
 var ErrPendingQueueReachedMaxCap = errors.New("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
 
+// ErrPendingTxEvicted is recorded as the status of transactions evicted from the pool
+// to make room for a group paying a higher fee per byte
+var ErrPendingTxEvicted = errors.New("TransactionPool.evict: transaction evicted by a group paying a higher fee per byte")
+
 // ErrNoPendingBlockEvaluator indicates there is no pending block evaluator to accept a new tx group
 var ErrNoPendingBlockEvaluator = errors.New("TransactionPool.ingest: no pending block evaluator")
 
@@ -0,0 +1,284 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"container/heap"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// txGroupFee is the effective fee of a transaction group: the total fee
+// paid by the group over its total encoded length.
+type txGroupFee struct {
+	fee    uint64
+	length uint64
+}
+
+// makeTxGroupFee computes the effective fee of the given transaction group.
+// The fee-free state proof transaction is given the highest possible priority.
+func makeTxGroupFee(txgroup []transactions.SignedTxn) txGroupFee {
+	if len(txgroup) == 1 {
+		t := txgroup[0].Txn
+		if t.Type == protocol.StateProofTx && t.Sender == transactions.StateProofSender && t.Fee.IsZero() {
+			return txGroupFee{fee: math.MaxUint64, length: 1}
+		}
+	}
+
+	var gf txGroupFee
+	for _, t := range txgroup {
+		gf.fee = basics.AddSaturate(gf.fee, t.Txn.Fee.Raw)
+		gf.length += uint64(t.GetEncodedLength())
+	}
+	if gf.length == 0 {
+		gf.length = 1
+	}
+	return gf
+}
+
+// less returns true if gf pays strictly less per byte than other.
+func (gf txGroupFee) less(other txGroupFee) bool {
+	// compare gf.fee / gf.length < other.fee / other.length without losing precision
+	hi1, lo1 := bits.Mul64(gf.fee, other.length)
+	hi2, lo2 := bits.Mul64(other.fee, gf.length)
+	return hi1 < hi2 || (hi1 == hi2 && lo1 < lo2)
+}
+
+// selectEvictions picks the pending groups to evict so that a group with the given fee
+// and count transactions could fit into a pool holding up to maxSize transactions.
+// Only groups paying strictly less per byte are considered, cheapest first and among
+// equally paying groups the most recent first. It returns nil if no eviction is needed
+// or if evicting the cheaper groups would not free enough room.
+func selectEvictions(groups [][]transactions.SignedTxn, fees []txGroupFee, pendingCount int, maxSize int, fee txGroupFee, count int) []int {
+	need := pendingCount + count - maxSize
+	if need <= 0 {
+		return nil
+	}
+
+	var candidates []int
+	available := 0
+	for i := range groups {
+		if fees[i].less(fee) {
+			candidates = append(candidates, i)
+			available += len(groups[i])
+		}
+	}
+	if available < need {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		fa, fb := fees[candidates[a]], fees[candidates[b]]
+		if fa.less(fb) {
+			return true
+		}
+		if fb.less(fa) {
+			return false
+		}
+		return candidates[a] > candidates[b]
+	})
+
+	freed := 0
+	for i, idx := range candidates {
+		freed += len(groups[idx])
+		if freed >= need {
+			candidates = candidates[:i+1]
+			break
+		}
+	}
+	sort.Ints(candidates)
+	return candidates
+}
+
+// txGroupHeap is a max-heap of transaction group indices, ordered by effective fee
+// and then by arrival order.
+type txGroupHeap struct {
+	idx  []int
+	fees []txGroupFee
+}
+
+func (h *txGroupHeap) Len() int { return len(h.idx) }
+
+func (h *txGroupHeap) Less(i, j int) bool {
+	fi, fj := h.fees[h.idx[i]], h.fees[h.idx[j]]
+	if fj.less(fi) {
+		return true
+	}
+	if fi.less(fj) {
+		return false
+	}
+	return h.idx[i] < h.idx[j]
+}
+
+func (h *txGroupHeap) Swap(i, j int) { h.idx[i], h.idx[j] = h.idx[j], h.idx[i] }
+
+func (h *txGroupHeap) Push(x interface{}) { h.idx = append(h.idx, x.(int)) }
+
+func (h *txGroupHeap) Pop() interface{} {
+	x := h.idx[len(h.idx)-1]
+	h.idx = h.idx[:len(h.idx)-1]
+	return x
+}
+
+// creatableRef identifies an app or an asset referenced by a transaction group.
+type creatableRef struct {
+	idx   basics.CreatableIndex
+	ctype basics.CreatableType
+}
+
+// txGroupCreatables returns the apps and assets referenced by the transactions of txgroup,
+// and whether txgroup creates an app or an asset.
+func txGroupCreatables(txgroup []transactions.SignedTxn) (refs []creatableRef, creates bool) {
+	addRef := func(idx basics.CreatableIndex, ctype basics.CreatableType) {
+		if idx != 0 {
+			refs = append(refs, creatableRef{idx: idx, ctype: ctype})
+		}
+	}
+	for _, stxn := range txgroup {
+		t := &stxn.Txn
+		switch t.Type {
+		case protocol.AssetConfigTx:
+			creates = creates || t.ConfigAsset == 0
+			addRef(basics.CreatableIndex(t.ConfigAsset), basics.AssetCreatable)
+		case protocol.AssetTransferTx:
+			addRef(basics.CreatableIndex(t.XferAsset), basics.AssetCreatable)
+		case protocol.AssetFreezeTx:
+			addRef(basics.CreatableIndex(t.FreezeAsset), basics.AssetCreatable)
+		case protocol.ApplicationCallTx:
+			creates = creates || t.ApplicationID == 0
+			addRef(basics.CreatableIndex(t.ApplicationID), basics.AppCreatable)
+			for _, aidx := range t.ForeignAssets {
+				addRef(basics.CreatableIndex(aidx), basics.AssetCreatable)
+			}
+			for _, aidx := range t.ForeignApps {
+				addRef(basics.CreatableIndex(aidx), basics.AppCreatable)
+			}
+		}
+	}
+	return refs, creates
+}
+
+// prioritizeTxGroups returns the order in which the given transaction groups should be
+// evaluated: highest effective fee first, while a group never moves ahead of an earlier
+// group that it might depend on. A group spending from or paying into an account follows
+// the earlier group that spent from it, and a group spending from an account also follows
+// the earlier groups that paid into it. Groups referencing the same app or asset keep their
+// arrival order, and so do the groups creating apps or assets along with the groups
+// referencing any app or asset, since the created ones might be among them.
+func prioritizeTxGroups(groups [][]transactions.SignedTxn, fees []txGroupFee) []int {
+	type accountDeps struct {
+		lastSpender int   // the last group spending from the account, or -1
+		receivers   []int // groups paying into the account since lastSpender
+	}
+	accounts := make(map[basics.Address]*accountDeps)
+	getAccount := func(addr basics.Address) *accountDeps {
+		a := accounts[addr]
+		if a == nil {
+			a = &accountDeps{lastSpender: -1}
+			accounts[addr] = a
+		}
+		return a
+	}
+
+	waiting := make([]int, len(groups))
+	next := make([][]int, len(groups))
+	addDep := func(from, to int) {
+		if from >= 0 && from != to {
+			next[from] = append(next[from], to)
+			waiting[to]++
+		}
+	}
+
+	lastCreator := -1 // the last group creating an app or an asset
+	lastReferrers := make(map[creatableRef]int)
+
+	for i, txgroup := range groups {
+		refs, creates := txGroupCreatables(txgroup)
+		if creates || len(refs) > 0 {
+			addDep(lastCreator, i)
+		}
+		if creates {
+			lastCreator = i
+		}
+		for _, ref := range refs {
+			if last, ok := lastReferrers[ref]; ok && last != i {
+				addDep(last, i)
+			}
+			lastReferrers[ref] = i
+		}
+
+		var spenders, receivers []basics.Address
+		for _, stxn := range txgroup {
+			spenders = append(spenders, stxn.Txn.Sender)
+			if !stxn.Txn.AssetSender.IsZero() {
+				spenders = append(spenders, stxn.Txn.AssetSender)
+			}
+			for _, addr := range []basics.Address{stxn.Txn.Receiver, stxn.Txn.CloseRemainderTo, stxn.Txn.AssetReceiver, stxn.Txn.AssetCloseTo} {
+				if !addr.IsZero() {
+					receivers = append(receivers, addr)
+				}
+			}
+		}
+
+		for _, addr := range spenders {
+			a := getAccount(addr)
+			if a.lastSpender == i {
+				continue
+			}
+			addDep(a.lastSpender, i)
+			for _, r := range a.receivers {
+				addDep(r, i)
+			}
+			a.lastSpender = i
+			a.receivers = nil
+		}
+		for _, addr := range receivers {
+			a := getAccount(addr)
+			if a.lastSpender == i || (len(a.receivers) > 0 && a.receivers[len(a.receivers)-1] == i) {
+				continue
+			}
+			addDep(a.lastSpender, i)
+			a.receivers = append(a.receivers, i)
+		}
+	}
+
+	h := &txGroupHeap{fees: fees}
+	for i := range groups {
+		if waiting[i] == 0 {
+			h.idx = append(h.idx, i)
+		}
+	}
+	heap.Init(h)
+
+	order := make([]int, 0, len(groups))
+	for h.Len() > 0 {
+		i := heap.Pop(h).(int)
+		order = append(order, i)
+		for _, j := range next[i] {
+			waiting[j]--
+			if waiting[j] == 0 {
+				heap.Push(h, j)
+			}
+		}
+	}
+	return order
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxGroupFeeLess(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.True(t, txGroupFee{fee: 1000, length: 200}.less(txGroupFee{fee: 1001, length: 200}))
+	require.True(t, txGroupFee{fee: 1000, length: 201}.less(txGroupFee{fee: 1000, length: 200}))
+	require.False(t, txGroupFee{fee: 2000, length: 400}.less(txGroupFee{fee: 1000, length: 200}))
+	require.False(t, txGroupFee{fee: 1000, length: 200}.less(txGroupFee{fee: 2000, length: 400}))
+	// no overflow on large fees
+	require.True(t, txGroupFee{fee: math.MaxUint64 - 1, length: 1000}.less(txGroupFee{fee: math.MaxUint64, length: 1000}))
+
+	stateProof := makeTxGroupFee([]transactions.SignedTxn{{Txn: transactions.Transaction{
+		Type:   protocol.StateProofTx,
+		Header: transactions.Header{Sender: transactions.StateProofSender},
+	}}})
+	require.True(t, txGroupFee{fee: math.MaxUint64 / 2, length: 1}.less(stateProof))
+}
+
+func TestSelectEvictions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	groups := [][]transactions.SignedTxn{make([]transactions.SignedTxn, 1), make([]transactions.SignedTxn, 2), make([]transactions.SignedTxn, 1), make([]transactions.SignedTxn, 1)}
+	fees := []txGroupFee{{fee: 30, length: 10}, {fee: 10, length: 10}, {fee: 20, length: 10}, {fee: 10, length: 10}}
+
+	// enough room
+	require.Nil(t, selectEvictions(groups, fees, 5, 6, txGroupFee{fee: 100, length: 10}, 1))
+	// the cheapest groups go first, the most recent first among equals
+	require.Equal(t, []int{3}, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 100, length: 10}, 1))
+	require.Equal(t, []int{1, 3}, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 100, length: 10}, 3))
+	require.Equal(t, []int{1, 2, 3}, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 100, length: 10}, 4))
+	// only strictly cheaper groups are evicted
+	require.Equal(t, []int{1, 3}, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 20, length: 10}, 3))
+	require.Nil(t, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 20, length: 10}, 4))
+	require.Nil(t, selectEvictions(groups, fees, 5, 5, txGroupFee{fee: 10, length: 10}, 1))
+}
+
+func TestPrioritizeTxGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a, b, c, d basics.Address
+	a[0], b[0], c[0], d[0] = 1, 2, 3, 4
+	pay := func(sender, receiver basics.Address) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver},
+		}}}
+	}
+
+	// independent groups are sorted by fee, ties by arrival
+	groups := [][]transactions.SignedTxn{pay(a, d), pay(b, d), pay(c, d)}
+	fees := []txGroupFee{{fee: 10, length: 1}, {fee: 30, length: 1}, {fee: 10, length: 1}}
+	require.Equal(t, []int{1, 0, 2}, prioritizeTxGroups(groups, fees))
+
+	// a group never moves ahead of an earlier group of the same sender,
+	// nor ahead of an earlier group funding its sender
+	groups = [][]transactions.SignedTxn{pay(a, b), pay(a, d), pay(b, d), pay(c, d)}
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 40, length: 1}, {fee: 30, length: 1}, {fee: 5, length: 1}}
+	require.Equal(t, []int{0, 1, 2, 3}, prioritizeTxGroups(groups, fees))
+
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 40, length: 1}, {fee: 30, length: 1}, {fee: 50, length: 1}}
+	require.Equal(t, []int{3, 0, 1, 2}, prioritizeTxGroups(groups, fees))
+
+	// nor ahead of an earlier group spending from an account it pays into
+	groups = [][]transactions.SignedTxn{pay(a, d), pay(b, a)}
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 40, length: 1}}
+	require.Equal(t, []int{0, 1}, prioritizeTxGroups(groups, fees))
+
+	axfer := func(sender, receiver basics.Address, asset basics.AssetIndex) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:                   protocol.AssetTransferTx,
+			Header:                 transactions.Header{Sender: sender},
+			AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: asset, AssetReceiver: receiver},
+		}}}
+	}
+	acfg := func(sender basics.Address, asset basics.AssetIndex) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:                 protocol.AssetConfigTx,
+			Header:               transactions.Header{Sender: sender},
+			AssetConfigTxnFields: transactions.AssetConfigTxnFields{ConfigAsset: asset},
+		}}}
+	}
+	appl := func(sender basics.Address, app basics.AppIndex, assets ...basics.AssetIndex) []transactions.SignedTxn {
+		return []transactions.SignedTxn{{Txn: transactions.Transaction{
+			Type:                     protocol.ApplicationCallTx,
+			Header:                   transactions.Header{Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: app, ForeignAssets: assets},
+		}}}
+	}
+
+	// an asset transfer never moves ahead of the opt-in of its receiver
+	groups = [][]transactions.SignedTxn{axfer(c, c, 7), axfer(b, c, 7), pay(d, b)}
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 40, length: 1}, {fee: 30, length: 1}}
+	require.Equal(t, []int{0, 1, 2}, prioritizeTxGroups(groups, fees))
+
+	// groups referencing the same app or asset keep their arrival order
+	groups = [][]transactions.SignedTxn{appl(a, 5), appl(b, 5), appl(c, 6, 7), axfer(d, d, 7)}
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 40, length: 1}, {fee: 20, length: 1}, {fee: 30, length: 1}}
+	require.Equal(t, []int{2, 3, 0, 1}, prioritizeTxGroups(groups, fees))
+
+	// creations keep their arrival order, and the later references follow them
+	groups = [][]transactions.SignedTxn{acfg(a, 0), appl(b, 0), axfer(c, c, 9), pay(d, d)}
+	fees = []txGroupFee{{fee: 10, length: 1}, {fee: 20, length: 1}, {fee: 40, length: 1}, {fee: 30, length: 1}}
+	require.Equal(t, []int{3, 0, 1, 2}, prioritizeTxGroups(groups, fees))
+}
@@ -46,8 +46,11 @@ import (
 // only if its fees are sufficiently high and its state changes are
 // consistent with the prior transactions in the queue.
 //
+// Once the pool is full, a new group is only accepted if it pays more per
+// byte than enough pending groups, which are then evicted to make room.
+//
 // TransactionPool.AssembleBlock constructs a valid block for
-// proposal given a deadline.
+// proposal given a deadline, ordering the pending groups by fee per byte.
 type TransactionPool struct {
 	// feePerByte is stored at the beginning of this struct to ensure it has a 64 bit aligned address. This is needed as it's being used
 	// with atomic operations which require 64 bit alignment on arm.
@@ -75,18 +78,20 @@ type TransactionPool struct {
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
 
-	// pendingMu protects pendingTxGroups and pendingTxids
-	pendingMu       deadlock.RWMutex
-	pendingTxGroups [][]transactions.SignedTxn
-	pendingTxids    map[transactions.Txid]transactions.SignedTxn
+	// pendingMu protects pendingTxGroups, pendingTxGroupFees and pendingTxids
+	pendingMu          deadlock.RWMutex
+	pendingTxGroups    [][]transactions.SignedTxn
+	pendingTxGroupFees []txGroupFee
+	pendingTxids       map[transactions.Txid]transactions.SignedTxn
 
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
 	// pendingTxGroups and pendingTxids.  This allows us to batch the
 	// changes in OnNewBlock() without preventing a concurrent call
 	// to PendingTxGroups() or Verified().
-	rememberedTxGroups [][]transactions.SignedTxn
-	rememberedTxids    map[transactions.Txid]transactions.SignedTxn
+	rememberedTxGroups    [][]transactions.SignedTxn
+	rememberedTxGroupFees []txGroupFee
+	rememberedTxids       map[transactions.Txid]transactions.SignedTxn
 
 	log logging.Logger
 
@@ -96,6 +101,9 @@ type TransactionPool struct {
 	// stateproofOverflowed indicates that a stateproof transaction was allowed to
 	// exceed the txPoolMaxSize. This flag is reset to false OnNewBlock
 	stateproofOverflowed bool
+
+	// txEvents reports the changes of state of pending transactions to subscribers
+	txEvents txEventSubscriptions
 }
 
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
@@ -175,8 +183,10 @@ func (pool *TransactionPool) Reset() {
 	defer pool.cond.Broadcast()
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	pool.pendingTxGroupFees = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
+	pool.rememberedTxGroupFees = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
@@ -238,11 +248,13 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 
 	if flush {
 		pool.pendingTxGroups = pool.rememberedTxGroups
+		pool.pendingTxGroupFees = pool.rememberedTxGroupFees
 		pool.stateproofOverflowed = false
 		pool.pendingTxids = pool.rememberedTxids
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
+		pool.pendingTxGroupFees = append(pool.pendingTxGroupFees, pool.rememberedTxGroupFees...)
 
 		for txid, txn := range pool.rememberedTxids {
 			pool.pendingTxids[txid] = txn
@@ -250,9 +262,50 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 	}
 
 	pool.rememberedTxGroups = nil
+	pool.rememberedTxGroupFees = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 }
 
+// evictionCandidates returns the indices of the pending groups that have to be evicted
+// to make room for txgroup, or nil if there is enough room already or if txgroup does not
+// pay more per byte than enough of the pending groups.
+func (pool *TransactionPool) evictionCandidates(txgroup []transactions.SignedTxn) []int {
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	return selectEvictions(pool.pendingTxGroups, pool.pendingTxGroupFees, len(pool.pendingTxids), pool.txPoolMaxSize, makeTxGroupFee(txgroup), len(txgroup))
+}
+
+// evict removes the pending groups at the given indices, as returned by evictionCandidates.
+// The caller is assumed to be holding pool.mu, and to recompute the pending block evaluator,
+// which might still hold the evicted transactions.
+func (pool *TransactionPool) evict(evicted []int) {
+	if len(evicted) == 0 {
+		return
+	}
+
+	pool.pendingMu.Lock()
+	defer pool.pendingMu.Unlock()
+
+	// build new slices, since PendingTxGroups hands out the current one
+	groups := make([][]transactions.SignedTxn, 0, len(pool.pendingTxGroups)-len(evicted))
+	fees := make([]txGroupFee, 0, len(pool.pendingTxGroups)-len(evicted))
+	for i, txgroup := range pool.pendingTxGroups {
+		if len(evicted) > 0 && evicted[0] == i {
+			evicted = evicted[1:]
+			for _, tx := range txgroup {
+				delete(pool.pendingTxids, tx.ID())
+				pool.statusCache.put(tx, ErrPendingTxEvicted.Error())
+			}
+			pool.txEvents.publish(TxEventEvicted, txgroup, pool.ledger.Latest()+1, ErrPendingTxEvicted.Error())
+			continue
+		}
+		groups = append(groups, txgroup)
+		fees = append(fees, pool.pendingTxGroupFees[i])
+	}
+	pool.pendingTxGroups = groups
+	pool.pendingTxGroupFees = fees
+}
+
 // PendingCount returns the number of transactions currently pending in the pool.
 func (pool *TransactionPool) PendingCount() int {
 	pool.pendingMu.RLock()
@@ -273,25 +326,35 @@ func (pool *TransactionPool) pendingCountNoLock() int {
 // checkPendingQueueSize tests to see if we can grow the pending group transaction list
 // by adding len(txnGroup) more transactions. The limits comes from the total number of transactions
 // and not from the total number of transaction groups.
-// As long as we haven't surpassed the size limit, we should be good to go.
+// As long as we haven't surpassed the size limit, or txnGroup pays enough to evict
+// cheaper groups, we should be good to go.
 func (pool *TransactionPool) checkPendingQueueSize(txnGroup []transactions.SignedTxn) error {
 	pendingSize := pool.pendingTxIDsCount()
 	txCount := len(txnGroup)
 	if pendingSize+txCount > pool.txPoolMaxSize {
 		// Allow the state proof transaction to go over the txPoolMaxSize if it already didn't
-		if len(txnGroup) == 1 && txnGroup[0].Txn.Type == protocol.StateProofTx {
-			pool.pendingMu.Lock()
-			defer pool.pendingMu.Unlock()
-			if !pool.stateproofOverflowed {
-				pool.stateproofOverflowed = true
-				return nil
-			}
+		if len(txnGroup) == 1 && txnGroup[0].Txn.Type == protocol.StateProofTx && pool.overflowStateProof() {
+			return nil
+		}
+		if pool.evictionCandidates(txnGroup) != nil {
+			return nil
 		}
 		return ErrPendingQueueReachedMaxCap
 	}
 	return nil
 }
 
+// overflowStateProof lets a state proof transaction go over the txPoolMaxSize, unless one already did.
+func (pool *TransactionPool) overflowStateProof() bool {
+	pool.pendingMu.Lock()
+	defer pool.pendingMu.Unlock()
+	if pool.stateproofOverflowed {
+		return false
+	}
+	pool.stateproofOverflowed = true
+	return true
+}
+
 // FeePerByte returns the current minimum microalgos per byte a transaction
 // needs to pay in order to get into the pool.
 func (pool *TransactionPool) FeePerByte() uint64 {
@@ -388,6 +451,7 @@ func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
 type poolIngestParams struct {
 	recomputing bool // if unset, perform fee checks and wait until ledger is caught up
 	stats       *telemetryspec.AssembleBlockMetrics
+	groupFee    *txGroupFee // the effective fee of the group, if already known
 }
 
 // remember attempts to add a transaction group to the pool.
@@ -400,10 +464,11 @@ func (pool *TransactionPool) remember(txgroup []transactions.SignedTxn) error {
 
 // add tries to add the transaction group to the pool, bypassing the fee
 // priority checks.
-func (pool *TransactionPool) add(txgroup []transactions.SignedTxn, stats *telemetryspec.AssembleBlockMetrics) error {
+func (pool *TransactionPool) add(txgroup []transactions.SignedTxn, groupFee *txGroupFee, stats *telemetryspec.AssembleBlockMetrics) error {
 	params := poolIngestParams{
 		recomputing: true,
 		stats:       stats,
+		groupFee:    groupFee,
 	}
 	return pool.ingest(txgroup, params)
 }
@@ -442,7 +507,13 @@ func (pool *TransactionPool) ingest(txgroup []transactions.SignedTxn, params poo
 		return err
 	}
 
+	groupFee := params.groupFee
+	if groupFee == nil {
+		gf := makeTxGroupFee(txgroup)
+		groupFee = &gf
+	}
 	pool.rememberedTxGroups = append(pool.rememberedTxGroups, txgroup)
+	pool.rememberedTxGroupFees = append(pool.rememberedTxGroupFees, *groupFee)
 	for _, t := range txgroup {
 		pool.rememberedTxids[t.ID()] = t
 	}
@@ -459,6 +530,7 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 	if err := pool.checkPendingQueueSize(txgroup); err != nil {
+		pool.txEvents.publish(TxEventRejected, txgroup, pool.ledger.Latest()+1, err.Error())
 		return err
 	}
 
@@ -467,13 +539,32 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 
 	err := pool.remember(txgroup)
 	if err != nil {
-		return fmt.Errorf("TransactionPool.Remember: %w", err)
+		err = fmt.Errorf("TransactionPool.Remember: %w", err)
+		pool.txEvents.publish(TxEventRejected, txgroup, pool.ledger.Latest()+1, err.Error())
+		return err
 	}
 
+	// remember might release pool.mu while waiting for OnNewBlock, which replaces the pending groups:
+	// the groups to evict are only picked once txgroup is remembered.
+	evicted := pool.evictionCandidates(txgroup)
+	pool.evict(evicted)
 	pool.rememberCommit(false)
+	if len(evicted) > 0 {
+		// The evicted groups might be in the pending block: rebuild it from the remaining
+		// groups by decreasing fee per byte, so that the groups replacing them get their space.
+		pool.recomputeBlockEvaluator(nil, 0)
+	}
+	pool.txEvents.publish(TxEventAdmitted, txgroup, pool.ledger.Latest()+1, "")
 	return nil
 }
 
+// SubscribeTxEvents returns a channel receiving the events of the pending transactions
+// matching filter, and a function that ends the subscription. The channel is closed when
+// the subscription ends, including when the subscriber falls too far behind.
+func (pool *TransactionPool) SubscribeTxEvents(filter TxEventFilter) (<-chan TxEvent, func()) {
+	return pool.txEvents.subscribe(filter)
+}
+
 // Lookup returns the error associated with a transaction that used
 // to be in the pool.  If no status information is available (e.g., because
 // it was too long ago, or the transaction committed successfully), then
@@ -515,6 +606,18 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 		pool.pendingMu.RUnlock()
 	}
 
+	if pool.txEvents.active() {
+		var committed []transactions.SignedTxn
+		pool.pendingMu.RLock()
+		for txid := range committedTxids {
+			if txn, ok := pool.pendingTxids[txid]; ok {
+				committed = append(committed, txn)
+			}
+		}
+		pool.pendingMu.RUnlock()
+		pool.txEvents.publish(TxEventCommitted, committed, block.Round(), "")
+	}
+
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 	defer pool.cond.Broadcast()
@@ -685,6 +788,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	// Grab the transactions to be played through the new block evaluator
 	pool.pendingMu.RLock()
 	txgroups := pool.pendingTxGroups
+	txgroupFees := pool.pendingTxGroupFees
 	pendingCount := pool.pendingCountNoLock()
 	pool.pendingMu.RUnlock()
 
@@ -723,8 +827,9 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 
 	firstTxnGrpTime := time.Now()
 
-	// Feed the transactions in order
-	for _, txgroup := range txgroups {
+	// Feed the transactions by decreasing fee per byte
+	for _, i := range prioritizeTxGroups(txgroups, txgroupFees) {
+		txgroup := txgroups[i]
 		if len(txgroup) == 0 {
 			asmStats.InvalidCount++
 			continue
@@ -733,7 +838,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 			asmStats.EarlyCommittedCount++
 			continue
 		}
-		err := pool.add(txgroup, &asmStats)
+		err := pool.add(txgroup, &txgroupFees[i], &asmStats)
 		if err != nil {
 			for _, tx := range txgroup {
 				pool.statusCache.put(tx, err.Error())
@@ -750,18 +855,22 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 				}
 				asmStats.ExpiredCount++
 				stats.ExpiredCount++
+				pool.txEvents.publish(TxEventExpired, txgroup, pool.pendingBlockEvaluator.Round(), err.Error())
 			case *ledgercore.LeaseInLedgerError:
 				asmStats.LeaseErrorCount++
 				stats.RemovedInvalidCount++
 				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+				pool.txEvents.publish(TxEventEvicted, txgroup, pool.pendingBlockEvaluator.Round(), err.Error())
 			case *transactions.MinFeeError:
 				asmStats.MinFeeErrorCount++
 				stats.RemovedInvalidCount++
 				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+				pool.txEvents.publish(TxEventEvicted, txgroup, pool.pendingBlockEvaluator.Round(), err.Error())
 			default:
 				asmStats.InvalidCount++
 				stats.RemovedInvalidCount++
 				pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
+				pool.txEvents.publish(TxEventEvicted, txgroup, pool.pendingBlockEvaluator.Round(), err.Error())
 			}
 		}
 	}
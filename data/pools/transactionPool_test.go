@@ -1612,3 +1612,243 @@ func generateProofForTesting(
 
 	return proof
 }
+
+func TestTxPoolEvictsLowerFeePerByte(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 7
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 4
+	cfg.EnableProcessBlockStats = false
+	ledger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	payment := func(sender int, fee uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   10,
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[numOfAccounts-1],
+				Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+			},
+		}
+		return tx.Sign(secrets[sender])
+	}
+
+	// fill the pool, with the cheapest transaction in the middle
+	fees := []uint64{proto.MinTxnFee + 2, proto.MinTxnFee, proto.MinTxnFee + 3, proto.MinTxnFee + 1}
+	var txns []transactions.SignedTxn
+	for i, fee := range fees {
+		txns = append(txns, payment(i, fee))
+		require.NoError(t, transactionPool.RememberOne(txns[i]))
+	}
+
+	// a transaction paying no more than the cheapest one is rejected
+	cheap := payment(4, proto.MinTxnFee)
+	require.ErrorIs(t, transactionPool.Test([]transactions.SignedTxn{cheap}), ErrPendingQueueReachedMaxCap)
+	require.ErrorIs(t, transactionPool.RememberOne(cheap), ErrPendingQueueReachedMaxCap)
+
+	// a transaction paying more evicts the cheapest one
+	expensive := payment(5, proto.MinTxnFee*10)
+	require.NoError(t, transactionPool.Test([]transactions.SignedTxn{expensive}))
+	require.NoError(t, transactionPool.RememberOne(expensive))
+	require.Equal(t, 4, transactionPool.PendingCount())
+
+	_, txErr, found := transactionPool.Lookup(txns[1].ID())
+	require.True(t, found)
+	require.Equal(t, ErrPendingTxEvicted.Error(), txErr)
+	for _, i := range []int{0, 2, 3} {
+		_, txErr, found = transactionPool.Lookup(txns[i].ID())
+		require.True(t, found)
+		require.Empty(t, txErr)
+	}
+
+	// the pending block no longer holds the evicted transaction, and orders the remaining ones
+	// by fee per byte without waiting for the next round
+	pending, err := transactionPool.AssembleBlock(1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	var pendingSenders []basics.Address
+	for _, txib := range pending.Block().Payset {
+		pendingSenders = append(pendingSenders, txib.Txn.Sender)
+	}
+	require.Equal(t, []basics.Address{addresses[5], addresses[2], addresses[0], addresses[3]}, pendingSenders)
+
+	// the assembled block orders the transactions by fee per byte
+	blk, err := transactionPool.AssembleDevModeBlock()
+	require.NoError(t, err)
+	var included []basics.Address
+	for _, txib := range blk.Block().Payset {
+		included = append(included, txib.Txn.Sender)
+	}
+	require.Equal(t, []basics.Address{addresses[5], addresses[2], addresses[0], addresses[3]}, included)
+}
+
+func TestTxPoolStateProofsIntoFullPool(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 2
+	cfg.EnableProcessBlockStats = false
+	ledger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	for i := 0; i < 2; i++ {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				LastValid:   10,
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[2],
+				Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+			},
+		}
+		require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[i])))
+	}
+
+	// the state proofs are not valid, but they are only rejected past the pool size checks,
+	// the second one after the first one let the pool overflow
+	stateProof := func(lastValid basics.Round) transactions.SignedTxn {
+		var stxn transactions.SignedTxn
+		stxn.Txn.Type = protocol.StateProofTx
+		stxn.Txn.Sender = transactions.StateProofSender
+		stxn.Txn.LastValid = lastValid
+		stxn.Txn.GenesisHash = ledger.GenesisHash()
+		return stxn
+	}
+	var errs []error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, lastValid := range []basics.Round{10, 11} {
+			errs = append(errs, transactionPool.RememberOne(stateProof(lastValid)))
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "the transaction pool is deadlocked")
+	}
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrPendingQueueReachedMaxCap)
+	}
+	require.Equal(t, 2, transactionPool.PendingCount())
+}
+
+func TestTxPoolEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	filtered, unsubscribeFiltered := transactionPool.SubscribeTxEvents(TxEventFilter{Sender: addresses[0]})
+	defer unsubscribeFiltered()
+	all, unsubscribeAll := transactionPool.SubscribeTxEvents(TxEventFilter{})
+	defer unsubscribeAll()
+
+	payment := func(sender int, lastValid basics.Round) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   lastValid,
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[numOfAccounts-1],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		return tx.Sign(secrets[sender])
+	}
+
+	committed := payment(0, 10)
+	other := payment(1, 10)
+	expiring := payment(0, 1)
+	require.NoError(t, transactionPool.RememberOne(committed))
+	require.Error(t, transactionPool.RememberOne(committed))
+	require.NoError(t, transactionPool.RememberOne(other))
+	require.NoError(t, transactionPool.RememberOne(expiring))
+
+	eval := newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(committed, transactions.ApplyData{}))
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), blk.Delta())
+
+	type expectedEvent struct {
+		eventType TxEventType
+		txid      transactions.Txid
+		round     basics.Round
+		reason    bool
+	}
+	check := func(events <-chan TxEvent, expected []expectedEvent) {
+		for _, e := range expected {
+			select {
+			case event := <-events:
+				require.Equal(t, e.eventType, event.Type)
+				require.Equal(t, e.txid, event.Txn.ID())
+				require.Equal(t, e.round, event.Round)
+				require.Equal(t, e.reason, event.Reason != "", event.Reason)
+			default:
+				require.Fail(t, "missing event", "%s of %s", e.eventType, e.txid)
+			}
+		}
+		require.Empty(t, events)
+	}
+	check(filtered, []expectedEvent{
+		{TxEventAdmitted, committed.ID(), 1, false},
+		{TxEventRejected, committed.ID(), 1, true},
+		{TxEventAdmitted, expiring.ID(), 1, false},
+		{TxEventCommitted, committed.ID(), 1, false},
+		{TxEventExpired, expiring.ID(), 2, true},
+	})
+	check(all, []expectedEvent{
+		{TxEventAdmitted, committed.ID(), 1, false},
+		{TxEventRejected, committed.ID(), 1, true},
+		{TxEventAdmitted, other.ID(), 1, false},
+		{TxEventAdmitted, expiring.ID(), 1, false},
+		{TxEventCommitted, committed.ID(), 1, false},
+		{TxEventExpired, expiring.ID(), 2, true},
+	})
+}
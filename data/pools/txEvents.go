@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// TxEventType identifies what happened to a pending transaction.
+type TxEventType int
+
+const (
+	// TxEventAdmitted is reported when a transaction is remembered by the pool.
+	TxEventAdmitted TxEventType = iota
+	// TxEventRejected is reported when Remember refuses a transaction.
+	TxEventRejected
+	// TxEventEvicted is reported when a pending transaction is dropped from the pool,
+	// either to make room for a higher fee or because it no longer evaluates after a new block.
+	TxEventEvicted
+	// TxEventExpired is reported when a pending transaction is dropped past its last valid round.
+	TxEventExpired
+	// TxEventCommitted is reported when a pending transaction is included in a new block.
+	TxEventCommitted
+)
+
+// String returns the name of the event type, as used by the REST API.
+func (t TxEventType) String() string {
+	switch t {
+	case TxEventAdmitted:
+		return "admitted"
+	case TxEventRejected:
+		return "rejected"
+	case TxEventEvicted:
+		return "evicted"
+	case TxEventExpired:
+		return "expired"
+	case TxEventCommitted:
+		return "committed"
+	default:
+		return "unknown"
+	}
+}
+
+// TxEvent describes a change of state of a single pending transaction.
+type TxEvent struct {
+	Type TxEventType
+	Txn  transactions.SignedTxn
+	// Round is the round of the committing block for TxEventCommitted, and the round
+	// the pool was evaluating for otherwise.
+	Round basics.Round
+	// Reason explains rejections, evictions and expiries.
+	Reason string
+}
+
+// TxEventFilter selects the events of a subscription. Zero fields match any transaction.
+type TxEventFilter struct {
+	Sender basics.Address
+	AppID  basics.AppIndex
+}
+
+func (f TxEventFilter) match(txn *transactions.SignedTxn) bool {
+	if !f.Sender.IsZero() && txn.Txn.Sender != f.Sender {
+		return false
+	}
+	if f.AppID != 0 && txn.Txn.ApplicationID != f.AppID {
+		return false
+	}
+	return true
+}
+
+// txEventSubscriptionQueueSize is the number of events a subscriber may fall behind
+// before its subscription is ended.
+const txEventSubscriptionQueueSize = 4096
+
+type txEventSubscription struct {
+	filter TxEventFilter
+	events chan TxEvent
+}
+
+// txEventSubscriptions fans the pool events out to subscribers. Publishing never blocks
+// the pool: a subscriber that falls too far behind is unsubscribed and its channel closed.
+type txEventSubscriptions struct {
+	mu          deadlock.Mutex
+	subscribers map[*txEventSubscription]struct{}
+}
+
+func (s *txEventSubscriptions) subscribe(filter TxEventFilter) (<-chan TxEvent, func()) {
+	sub := &txEventSubscription{
+		filter: filter,
+		events: make(chan TxEvent, txEventSubscriptionQueueSize),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscribers == nil {
+		s.subscribers = make(map[*txEventSubscription]struct{})
+	}
+	s.subscribers[sub] = struct{}{}
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[sub]; ok {
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+	return sub.events, unsubscribe
+}
+
+// active returns true if there is at least one subscriber, letting publishers
+// skip building events nobody listens to.
+func (s *txEventSubscriptions) active() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscribers) > 0
+}
+
+// publish reports the same event for every transaction of txgroup.
+func (s *txEventSubscriptions) publish(eventType TxEventType, txgroup []transactions.SignedTxn, round basics.Round, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.subscribers) == 0 {
+		return
+	}
+
+	for i := range txgroup {
+		for sub := range s.subscribers {
+			if !sub.filter.match(&txgroup[i]) {
+				continue
+			}
+			select {
+			case sub.events <- TxEvent{Type: eventType, Txn: txgroup[i], Round: round, Reason: reason}:
+			default:
+				delete(s.subscribers, sub)
+				close(sub.events)
+			}
+		}
+	}
+}
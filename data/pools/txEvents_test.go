@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxEventSubscriptions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var sender basics.Address
+	sender[0] = 1
+	appCall := transactions.SignedTxn{Txn: transactions.Transaction{
+		Header:                   transactions.Header{Sender: sender},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: 7},
+	}}
+	payment := transactions.SignedTxn{Txn: transactions.Transaction{Header: transactions.Header{Sender: sender}}}
+
+	var s txEventSubscriptions
+	require.False(t, s.active())
+	apps, unsubscribeApps := s.subscribe(TxEventFilter{AppID: 7})
+	senders, unsubscribeSenders := s.subscribe(TxEventFilter{Sender: sender})
+	defer unsubscribeSenders()
+	require.True(t, s.active())
+
+	s.publish(TxEventAdmitted, []transactions.SignedTxn{payment, appCall}, 1, "")
+	require.Len(t, apps, 1)
+	require.Len(t, senders, 2)
+	event := <-apps
+	require.Equal(t, TxEventAdmitted, event.Type)
+	require.Equal(t, appCall, event.Txn)
+
+	// unsubscribing closes the channel, and is idempotent
+	unsubscribeApps()
+	unsubscribeApps()
+	_, ok := <-apps
+	require.False(t, ok)
+
+	// a subscriber falling too far behind is dropped
+	for i := 0; i < txEventSubscriptionQueueSize; i++ {
+		s.publish(TxEventEvicted, []transactions.SignedTxn{payment}, 1, "reason")
+	}
+	require.False(t, s.active())
+	received := 0
+	for range senders {
+		received++
+	}
+	require.Equal(t, txEventSubscriptionQueueSize, received)
+}
@@ -37,7 +37,15 @@ func (cx *EvalContext) availableBox(name string, operation int, createSize uint6
 
 	dirty, ok := cx.available.boxes[boxRef{cx.appID, name}]
 	if !ok {
-		return nil, false, fmt.Errorf("invalid Box reference %v", name)
+		if cx.UnnamedResources == nil || !cx.UnnamedResources.AvailableBox(cx.appID, name) {
+			return nil, false, fmt.Errorf("invalid Box reference %v", name)
+		}
+		// An allowed unnamed box is treated as though it had been referenced,
+		// so it brings its share of the I/O budget with it.
+		if cx.available.boxes == nil {
+			cx.available.boxes = make(map[boxRef]bool)
+		}
+		cx.ioBudget += cx.Proto.BytesPerBoxReference
 	}
 
 	// Since the box is in cx.available, we know this GetBox call is cheap. It
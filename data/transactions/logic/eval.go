@@ -65,6 +65,41 @@ const maxLogSize = 1024
 // maxLogCalls is the limit of total log calls during a program execution
 const maxLogCalls = 32
 
+// EvalConstants contains the evaluation limits that are not consensus
+// parameters. They can be relaxed to evaluate programs outside of consensus,
+// e.g. when simulating.
+type EvalConstants struct {
+	// MaxLogSize is the limit of total log size from n log calls in a program
+	MaxLogSize int
+
+	// MaxLogCalls is the limit of total log calls during a program execution
+	MaxLogCalls int
+
+	// ExtraLogicSigBudget is added to the LogicSigMaxCost of every LogicSig
+	ExtraLogicSigBudget int
+}
+
+// RuntimeEvalConstants returns the limits enforced when evaluating programs
+// in consensus.
+func RuntimeEvalConstants() EvalConstants {
+	return EvalConstants{
+		MaxLogSize:  maxLogSize,
+		MaxLogCalls: maxLogCalls,
+	}
+}
+
+// UnnamedResourcePolicy decides whether a program may use an account, asset,
+// app, or box that its transaction did not make available. It is consulted
+// only after the usual availability rules have rejected the reference, so an
+// implementation can record exactly the resources that would have to be
+// added to the transaction. It must never be used in consensus evaluation.
+type UnnamedResourcePolicy interface {
+	AvailableAccount(addr basics.Address) bool
+	AvailableAsset(aid basics.AssetIndex) bool
+	AvailableApp(aid basics.AppIndex) bool
+	AvailableBox(app basics.AppIndex, name string) bool
+}
+
 // maxAppCallDepth is the limit on inner appl call depth
 // To be clear, 0 would prevent inner appls, 1 would mean inner app calls cannot
 // make inner appls. So the total app depth can be 1 higher than this number, if
@@ -301,6 +336,14 @@ type EvalParams struct {
 	// Total pool of app call budget in a group transaction (nil before budget pooling enabled)
 	PooledApplicationBudget *int
 
+	// EvalConstants optionally overrides the evaluation limits that are not
+	// consensus parameters. If nil, RuntimeEvalConstants() applies.
+	EvalConstants *EvalConstants
+
+	// UnnamedResources, if non-nil, may allow access to resources that are
+	// not otherwise available. Used when simulating, never in consensus.
+	UnnamedResources UnnamedResourcePolicy
+
 	// Total allowable inner txns in a group transaction (nil before inner pooling enabled)
 	pooledAllowedInners *int
 
@@ -460,6 +503,8 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		FeeCredit:               caller.FeeCredit,
 		Specials:                caller.Specials,
 		PooledApplicationBudget: caller.PooledApplicationBudget,
+		EvalConstants:           caller.EvalConstants,
+		UnnamedResources:        caller.UnnamedResources,
 		pooledAllowedInners:     caller.pooledAllowedInners,
 		available:               caller.available,
 		ioBudget:                caller.ioBudget,
@@ -601,6 +646,44 @@ func (cx *EvalContext) RunMode() RunMode {
 	return cx.runModeFlags
 }
 
+// GroupIndex returns the index of the transaction being evaluated
+func (cx *EvalContext) GroupIndex() int {
+	return cx.groupIndex
+}
+
+// PC returns the program counter of the current application or logic sig execution
+func (cx *EvalContext) PC() int {
+	return cx.pc
+}
+
+// AppID returns the ID of the currently executing app. For LogicSigs it returns 0.
+func (cx *EvalContext) AppID() basics.AppIndex {
+	return cx.appID
+}
+
+// GetOpSpec queries for the OpSpec w.r.t. current program byte.
+func (cx *EvalContext) GetOpSpec() OpSpec {
+	return opsByOpcode[cx.version][cx.program[cx.pc]]
+}
+
+// Stack returns a copy of the operand stack, bottom first.
+func (cx *EvalContext) Stack() []basics.TealValue {
+	values := make([]basics.TealValue, len(cx.stack))
+	for i, sv := range cx.stack {
+		values[i] = sv.toTealValue()
+	}
+	return values
+}
+
+// Scratch returns a copy of the scratch space.
+func (cx *EvalContext) Scratch() []basics.TealValue {
+	values := make([]basics.TealValue, len(cx.scratch))
+	for i, sv := range cx.scratch {
+		values[i] = sv.toTealValue()
+	}
+	return values
+}
+
 // StackType describes the type of a value on the operand stack
 type StackType byte
 
@@ -1012,9 +1095,17 @@ func (cx *EvalContext) Cost() int {
 	return cx.cost
 }
 
+// evalConstants returns the evaluation limits in effect.
+func (ep *EvalParams) evalConstants() EvalConstants {
+	if ep.EvalConstants != nil {
+		return *ep.EvalConstants
+	}
+	return RuntimeEvalConstants()
+}
+
 func (cx *EvalContext) remainingBudget() int {
 	if cx.runModeFlags == ModeSig {
-		return int(cx.Proto.LogicSigMaxCost) - cx.cost
+		return int(cx.Proto.LogicSigMaxCost) + cx.evalConstants().ExtraLogicSigBudget - cx.cost
 	}
 
 	// restrict clear state programs from using more than standard unpooled budget
@@ -4023,6 +4114,12 @@ func (cx *EvalContext) accountReference(account stackValue) (basics.Address, uin
 		}
 	}
 
+	// an unnamed account may be allowed when simulating, but like the app
+	// addresses above, it can't be encoded in EvalDelta
+	if err != nil && cx.UnnamedResources != nil && cx.UnnamedResources.AvailableAccount(addr) {
+		return addr, invalidIndex, nil
+	}
+
 	return addr, idx, err
 }
 
@@ -4389,6 +4486,9 @@ func appReference(cx *EvalContext, ref uint64, foreign bool) (basics.AppIndex, e
 		if ref <= uint64(len(cx.txn.Txn.ForeignApps)) {
 			return basics.AppIndex(cx.txn.Txn.ForeignApps[ref-1]), nil
 		}
+		if cx.UnnamedResources != nil && cx.UnnamedResources.AvailableApp(basics.AppIndex(ref)) {
+			return basics.AppIndex(ref), nil
+		}
 	} else {
 		// Old rules
 		if ref == 0 { // Even back when expected to be a real ID, ref = 0 was current app
@@ -4428,6 +4528,9 @@ func asaReference(cx *EvalContext, ref uint64, foreign bool) (basics.AssetIndex,
 		if ref < uint64(len(cx.txn.Txn.ForeignAssets)) {
 			return basics.AssetIndex(cx.txn.Txn.ForeignAssets[ref]), nil
 		}
+		if cx.UnnamedResources != nil && cx.UnnamedResources.AvailableAsset(basics.AssetIndex(ref)) {
+			return basics.AssetIndex(ref), nil
+		}
 	} else {
 		// Old rules
 		if foreign {
@@ -4606,13 +4709,14 @@ func opAcctParamsGet(cx *EvalContext) error {
 func opLog(cx *EvalContext) error {
 	last := len(cx.stack) - 1
 
-	if len(cx.txn.EvalDelta.Logs) >= maxLogCalls {
-		return fmt.Errorf("too many log calls in program. up to %d is allowed", maxLogCalls)
+	constants := cx.evalConstants()
+	if len(cx.txn.EvalDelta.Logs) >= constants.MaxLogCalls {
+		return fmt.Errorf("too many log calls in program. up to %d is allowed", constants.MaxLogCalls)
 	}
 	log := cx.stack[last]
 	cx.logSize += len(log.Bytes)
-	if cx.logSize > maxLogSize {
-		return fmt.Errorf("program logs too large. %d bytes >  %d bytes limit", cx.logSize, maxLogSize)
+	if cx.logSize > constants.MaxLogSize {
+		return fmt.Errorf("program logs too large. %d bytes >  %d bytes limit", cx.logSize, constants.MaxLogSize)
 	}
 	cx.txn.EvalDelta.Logs = append(cx.txn.EvalDelta.Logs, string(log.Bytes))
 	cx.stack = cx.stack[:last]
@@ -4729,6 +4833,9 @@ func (cx *EvalContext) availableAsset(sv stackValue) (basics.AssetIndex, error)
 			}
 		}
 	}
+	if cx.UnnamedResources != nil && cx.UnnamedResources.AvailableAsset(aid) {
+		return aid, nil
+	}
 
 	return basics.AssetIndex(0), fmt.Errorf("invalid Asset reference %d", aid)
 }
@@ -4761,6 +4868,9 @@ func (cx *EvalContext) availableApp(sv stackValue) (basics.AppIndex, error) {
 	if cx.appID == aid {
 		return aid, nil
 	}
+	if cx.UnnamedResources != nil && cx.UnnamedResources.AvailableApp(aid) {
+		return aid, nil
+	}
 
 	return 0, fmt.Errorf("invalid App reference %d", aid)
 }
@@ -2795,3 +2795,75 @@ int 77
 `
 	testApp(t, source, ep)
 }
+
+// allowingPolicy is an UnnamedResourcePolicy that allows everything, and
+// remembers what it was asked about.
+type allowingPolicy struct {
+	accounts []basics.Address
+	assets   []basics.AssetIndex
+	apps     []basics.AppIndex
+	boxes    []boxRef
+}
+
+func (p *allowingPolicy) AvailableAccount(addr basics.Address) bool {
+	p.accounts = append(p.accounts, addr)
+	return true
+}
+
+func (p *allowingPolicy) AvailableAsset(aid basics.AssetIndex) bool {
+	p.assets = append(p.assets, aid)
+	return true
+}
+
+func (p *allowingPolicy) AvailableApp(aid basics.AppIndex) bool {
+	p.apps = append(p.apps, aid)
+	return true
+}
+
+func (p *allowingPolicy) AvailableBox(app basics.AppIndex, name string) bool {
+	p.boxes = append(p.boxes, boxRef{app, name})
+	return true
+}
+
+func TestUnnamedResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	unnamed := basics.Address{0x01, 0x02}
+	ledger.NewAccount(unnamed, 177)
+	ledger.NewApp(tx.Sender, 888, basics.AppParams{})
+	ledger.NewApp(tx.Sender, 4000, basics.AppParams{})
+	ledger.NewAsset(tx.Sender, 5000, basics.AssetParams{Total: 6})
+
+	account := fmt.Sprintf("addr %s; balance; int 177; ==", unnamed)
+	asset := "int 5000; asset_params_get AssetTotal; assert; int 6; =="
+	app := "int 4000; app_params_get AppCreator; assert; txn Sender; =="
+	box := `byte "unnamed"; int 50; box_create`
+	local := fmt.Sprintf(`addr %s; byte "k"; int 1; app_local_put; int 1`, unnamed)
+
+	testApp(t, account, ep, "invalid Account reference")
+	testApp(t, asset, ep, "invalid Asset reference")
+	testApp(t, app, ep, "invalid App reference")
+	testApp(t, box, ep, "invalid Box reference")
+
+	policy := &allowingPolicy{}
+	ep.UnnamedResources = policy
+	testApp(t, account, ep)
+	testApp(t, asset, ep)
+	testApp(t, app, ep)
+	testApp(t, box, ep)
+	require.Equal(t, []basics.Address{unnamed}, policy.accounts)
+	require.Equal(t, []basics.AssetIndex{5000}, policy.assets)
+	require.Equal(t, []basics.AppIndex{4000}, policy.apps)
+	require.Equal(t, []boxRef{{888, "unnamed"}}, policy.boxes)
+
+	// named resources don't consult the policy
+	tx.ForeignAssets = []basics.AssetIndex{5000}
+	testApp(t, asset, ep)
+	require.Len(t, policy.assets, 1)
+
+	// local state of an unnamed account still can't be changed, because the
+	// change could not be encoded in the EvalDelta
+	testApp(t, local, ep, "invalid Account reference for mutation")
+}
@@ -5098,6 +5098,45 @@ func TestLog(t *testing.T) {
 	}
 }
 
+func TestEvalConstants(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var txn transactions.SignedTxn
+	txn.Txn.Type = protocol.ApplicationCallTx
+	ledger := NewLedger(nil)
+	ledger.NewApp(txn.Txn.Receiver, 0, basics.AppParams{})
+	ep := defaultEvalParams(txn)
+	ep.Proto = makeTestProtoV(LogicVersion)
+	ep.Ledger = ledger
+
+	manyLogs := fmt.Sprintf(`%s int 1`, strings.Repeat(`byte "a"; log; `, maxLogCalls+1))
+	bigLog := fmt.Sprintf(`byte "%s"; log; int 1`, strings.Repeat("a", maxLogSize+1))
+	testApp(t, manyLogs, ep, "too many log calls")
+	testApp(t, bigLog, ep, fmt.Sprintf(">  %d bytes limit", maxLogSize))
+
+	ep.EvalConstants = &EvalConstants{
+		MaxLogSize:  maxLogSize + 1,
+		MaxLogCalls: maxLogCalls + 1,
+	}
+	delta := testApp(t, manyLogs, ep)
+	require.Len(t, delta.Logs, maxLogCalls+1)
+	delta = testApp(t, bigLog, ep)
+	require.Len(t, delta.Logs, 1)
+
+	// a LogicSig that costs a little more than LogicSigMaxCost
+	sigEp := defaultEvalParams()
+	expensive := fmt.Sprintf(`int 0; loop: int 1; +; dup; int %d; <; bnz loop; pop; int 1`, sigEp.Proto.LogicSigMaxCost/6)
+	testLogic(t, expensive, LogicVersion, sigEp, "dynamic cost budget exceeded")
+
+	sigEp.EvalConstants = &EvalConstants{
+		MaxLogSize:          maxLogSize,
+		MaxLogCalls:         maxLogCalls,
+		ExtraLogicSigBudget: 1000,
+	}
+	testLogic(t, expensive, LogicVersion, sigEp)
+}
+
 func TestPcDetails(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -94,6 +94,7 @@ type GroupContext struct {
 	minAvmVersion    uint64
 	signedGroupTxns  []transactions.SignedTxn
 	ledger           logic.LedgerForSignature
+	evalConstants    *logic.EvalConstants
 }
 
 var errTxGroupInvalidFee = errors.New("txgroup fee requirement overflow")
@@ -169,7 +170,8 @@ func PrepareGroupContext(group []transactions.SignedTxn, contextHdr *bookkeeping
 func (g *GroupContext) Equal(other *GroupContext) bool {
 	return g.specAddrs == other.specAddrs &&
 		g.consensusVersion == other.consensusVersion &&
-		g.minAvmVersion == other.minAvmVersion
+		g.minAvmVersion == other.minAvmVersion &&
+		g.evalConstants == other.evalConstants
 }
 
 // txnBatchPrep verifies a SignedTxn having no obviously inconsistent data.
@@ -189,18 +191,24 @@ func txnBatchPrep(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContext,
 
 // TxnGroup verifies a []SignedTxn as being signed and having no obviously inconsistent data.
 func TxnGroup(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, cache VerifiedTransactionCache, ledger logic.LedgerForSignature) (groupCtx *GroupContext, err error) {
-	return txnGroup(stxs, contextHdr, cache, ledger, nil)
+	return txnGroup(stxs, contextHdr, cache, ledger, nil, nil)
 }
 
 // TxnGroupWithTracer verifies a []SignedTxn as being signed and having no obviously inconsistent data, while using a tracer.
 func TxnGroupWithTracer(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, cache VerifiedTransactionCache, ledger logic.LedgerForSignature, evalTracer logic.EvalTracer) (groupCtx *GroupContext, err error) {
-	return txnGroup(stxs, contextHdr, cache, ledger, evalTracer)
+	return txnGroup(stxs, contextHdr, cache, ledger, evalTracer, nil)
 }
 
-func txnGroup(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, cache VerifiedTransactionCache, ledger logic.LedgerForSignature, evalTracer logic.EvalTracer) (groupCtx *GroupContext, err error) {
+// TxnGroupWithEvalConstants verifies a []SignedTxn like TxnGroupWithTracer, but evaluates LogicSigs with the
+// given evaluation limits instead of the runtime ones. It is meant for simulation only.
+func TxnGroupWithEvalConstants(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, cache VerifiedTransactionCache, ledger logic.LedgerForSignature, evalTracer logic.EvalTracer, evalConstants *logic.EvalConstants) (groupCtx *GroupContext, err error) {
+	return txnGroup(stxs, contextHdr, cache, ledger, evalTracer, evalConstants)
+}
+
+func txnGroup(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, cache VerifiedTransactionCache, ledger logic.LedgerForSignature, evalTracer logic.EvalTracer, evalConstants *logic.EvalConstants) (groupCtx *GroupContext, err error) {
 	batchVerifier := crypto.MakeBatchVerifier()
 
-	if groupCtx, err = txnGroupBatchPrep(stxs, contextHdr, ledger, batchVerifier, evalTracer); err != nil {
+	if groupCtx, err = txnGroupBatchPrep(stxs, contextHdr, ledger, batchVerifier, evalTracer, evalConstants); err != nil {
 		return nil, err
 	}
 
@@ -217,11 +225,12 @@ func txnGroup(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader
 
 // txnGroupBatchPrep verifies a []SignedTxn having no obviously inconsistent data.
 // it is the caller responsibility to call batchVerifier.Verify()
-func txnGroupBatchPrep(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, ledger logic.LedgerForSignature, verifier *crypto.BatchVerifier, evalTracer logic.EvalTracer) (*GroupContext, error) {
+func txnGroupBatchPrep(stxs []transactions.SignedTxn, contextHdr *bookkeeping.BlockHeader, ledger logic.LedgerForSignature, verifier *crypto.BatchVerifier, evalTracer logic.EvalTracer, evalConstants *logic.EvalConstants) (*GroupContext, error) {
 	groupCtx, err := PrepareGroupContext(stxs, contextHdr, ledger)
 	if err != nil {
 		return nil, err
 	}
+	groupCtx.evalConstants = evalConstants
 
 	minFeeCount := uint64(0)
 	feesPaid := uint64(0)
@@ -452,6 +461,7 @@ func logicSigVerify(txn *transactions.SignedTxn, groupIndex int, groupCtx *Group
 		MinAvmVersion: &groupCtx.minAvmVersion,
 		SigLedger:     groupCtx.ledger,
 		Tracer:        evalTracer,
+		EvalConstants: groupCtx.evalConstants,
 	}
 	pass, cx, err := logic.EvalSignatureFull(groupIndex, &ep)
 	if err != nil {
@@ -511,7 +521,7 @@ func PaysetGroups(ctx context.Context, payset [][]transactions.SignedTxn, blkHea
 
 					batchVerifier := crypto.MakeBatchVerifierWithHint(len(payset))
 					for i, signTxnsGrp := range txnGroups {
-						groupCtxs[i], grpErr = txnGroupBatchPrep(signTxnsGrp, &blkHeader, ledger, batchVerifier, nil)
+						groupCtxs[i], grpErr = txnGroupBatchPrep(signTxnsGrp, &blkHeader, ledger, batchVerifier, nil, nil)
 						// abort only if it's a non-cache error.
 						if grpErr != nil {
 							return grpErr
@@ -861,7 +871,7 @@ func (sv *StreamVerifier) addVerificationTaskToThePoolNow(ue []*UnverifiedElemen
 		// TODO: separate operations here, and get the sig verification inside the LogicSig to the batch here
 		blockHeader := sv.nbw.getBlockHeader()
 		for _, ue := range ue {
-			groupCtx, err := txnGroupBatchPrep(ue.TxnGroup, blockHeader, sv.ledger, batchVerifier, nil)
+			groupCtx, err := txnGroupBatchPrep(ue.TxnGroup, blockHeader, sv.ledger, batchVerifier, nil, nil)
 			if err != nil {
 				// verification failed, no need to add the sig to the batch, report the error
 				sv.sendResult(ue.TxnGroup, ue.BacklogMessage, err)
@@ -466,6 +466,7 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 		// disconnect from peer.
 		handler.postProcessReportErrors(wi.verificationErr)
 		logging.Base().Warnf("Received a malformed tx group %v: %v", wi.unverifiedTxGroup, wi.verificationErr)
+		handler.net.ReportPeer(wi.rawmsg.Sender, network.PeerFaultInvalidTxn)
 		handler.net.Disconnect(wi.rawmsg.Sender)
 		return
 	}
@@ -607,6 +608,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 				break
 			}
 			logging.Base().Warnf("Received a non-decodable txn: %v", err)
+			handler.net.ReportPeer(rawmsg.Sender, network.PeerFaultInvalidTxn)
 			return network.OutgoingMessage{Action: network.Disconnect}
 		}
 		consumed = dec.Consumed()
@@ -616,12 +618,14 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 			if dec.Remaining() > 0 {
 				// if something else left in the buffer - this is an error, drop
 				transactionMessageTxGroupExcessive.Inc(nil)
+				handler.net.ReportPeer(rawmsg.Sender, network.PeerFaultInvalidTxn)
 				return network.OutgoingMessage{Action: network.Disconnect}
 			}
 		}
 	}
 	if ntx == 0 {
 		logging.Base().Warnf("Received empty tx group")
+		handler.net.ReportPeer(rawmsg.Sender, network.PeerFaultInvalidTxn)
 		return network.OutgoingMessage{Action: network.Disconnect}
 	}
 
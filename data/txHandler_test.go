@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
 	"strings"
@@ -48,6 +50,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
@@ -239,6 +242,47 @@ func TestTxHandlerProcessIncomingTxn(t *testing.T) {
 	}
 }
 
+type garbageSender struct{}
+
+func (m garbageSender) GetAddress() string {
+	return "http://10.0.0.1:4160"
+}
+
+func (m garbageSender) GetHTTPClient() *http.Client {
+	return nil
+}
+
+// TestTxHandlerGarbageBansPeer checks that peers sending undecodable, empty or oversized
+// transaction messages are reported, and get banned once they have sent enough of them.
+func TestTxHandlerGarbageBansPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	net, err := network.NewWebsocketNetwork(logging.TestingLog(t), cfg, nil, "test", "testnet", nil)
+	require.NoError(t, err)
+
+	// no duplicate filtering, so the same garbage can be sent repeatedly
+	handler := makeTestTxHandlerOrphanedWithContext(context.Background(), txBacklogSize, txBacklogSize, txHandlerConfig{false, false}, 0)
+	handler.net = net
+
+	_, oversized := makeRandomTransactions(config.MaxTxGroupSize + 1)
+	garbage := [][]byte{
+		{0xff, 0xff, 0xff},
+		{},
+		oversized,
+	}
+	for i := 0; len(net.GetPeerBans()) == 0; i++ {
+		require.Less(t, i, int(cfg.PeerBanThreshold), "peer sending garbage was not banned")
+		action := handler.processIncomingTxn(network.IncomingMessage{Data: garbage[i%len(garbage)], Sender: garbageSender{}})
+		require.Equal(t, network.OutgoingMessage{Action: network.Disconnect}, action)
+	}
+	bans := net.GetPeerBans()
+	require.Len(t, bans, 1)
+	require.Equal(t, "10.0.0.1", bans[0].Address)
+	require.Equal(t, network.PeerFaultInvalidTxn.String(), bans[0].Reason)
+}
+
 // BenchmarkTxHandlerProcessIncomingTxn is single-threaded ProcessIncomingTxn benchmark
 func BenchmarkTxHandlerProcessIncomingTxn(b *testing.B) {
 	deadlockDisable := deadlock.Opts.Disable
@@ -605,6 +649,7 @@ func TestTxHandlerProcessIncomingGroup(t *testing.T) {
 		t.Run(fmt.Sprintf("%d-%d", check.inputSize, check.numDecoded), func(t *testing.T) {
 			handler := TxHandler{
 				backlogQueue: make(chan *txBacklogMsg, 1),
+				net:          &mocks.MockNetwork{},
 			}
 			stxns, blob := makeRandomTransactions(check.inputSize)
 			action := handler.processIncomingTxn(network.IncomingMessage{Data: blob})
@@ -787,6 +832,7 @@ func makeTestTxHandlerOrphanedWithContext(ctx context.Context, backlogSize int,
 		msgCache:         makeSaltedCache(cacheSize),
 		txCanonicalCache: makeDigestCache(cacheSize),
 		cacheConfig:      txHandlerConfig,
+		net:              &mocks.MockNetwork{},
 	}
 	handler.msgCache.Start(ctx, refreshInterval)
 	return handler
@@ -2475,3 +2521,65 @@ func TestTxHandlerRestartWithBacklogAndTxPool(t *testing.T) { //nolint:parallelt
 		require.False(t, inBad, "invalid transaction accepted")
 	}
 }
+
+// TestTxHandlerReplayInvalidTxn replays a capture holding a transaction with an invalid signature:
+// the handler reports and disconnects the captured peer, which the replay network must tolerate.
+func TestTxHandlerReplayInvalidTxn(t *testing.T) { //nolint:paralleltest // Not parallel because it mutates global metrics
+	partitiontest.PartitionTest(t)
+
+	transactionMessagesTxnSigVerificationFailed = metrics.MakeCounter(metrics.TransactionMessagesTxnSigVerificationFailed)
+	transactionMessagesHandled = metrics.MakeCounter(metrics.TransactionMessagesHandled)
+	defer func() {
+		transactionMessagesTxnSigVerificationFailed = metrics.MakeCounter(metrics.TransactionMessagesTxnSigVerificationFailed)
+		transactionMessagesHandled = metrics.MakeCounter(metrics.TransactionMessagesHandled)
+	}()
+
+	const numUsers = 2
+	log := logging.TestingLog(t)
+	addresses, secrets, genesis := makeTestGenesisAccounts(t, numUsers)
+	genBal := bookkeeping.MakeGenesisBalances(genesis, sinkAddr, poolAddr)
+	cfg := config.GetDefaultLocal()
+	cfg.DisableNetworking = true
+	cfg.EnableTxBacklogRateLimiting = true
+	ledger, err := LoadLedger(log, t.Name()+"-mem", true, protocol.ConsensusCurrentVersion, genBal, genesisID, genesisHash, nil, cfg)
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	net, err := network.NewWebsocketNetwork(log, cfg, nil, genesisID, "test", nil)
+	require.NoError(t, err)
+
+	tp := pools.MakeTransactionPool(ledger.Ledger, cfg, logging.Base())
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+	handler, err := MakeTxHandler(TxHandlerOpts{tp, backlogPool, ledger, net, genesisID, genesisHash, cfg})
+	require.NoError(t, err)
+	handler.Start()
+	defer handler.Stop()
+
+	// a valid transaction followed by one with an invalid signature, from the same captured peer
+	stxns, _ := makeSignedTxnGroups(2, numUsers, 1, 0, addresses, secrets)
+	stxns[1][0].Sig[0]++
+	captureCfg := config.GetDefaultLocal()
+	captureCfg.NetworkMessageCaptureFile = filepath.Join(t.TempDir(), "network.capture")
+	capture, err := messagetracer.MakeMessageCapture(captureCfg)
+	require.NoError(t, err)
+	for i, grp := range stxns {
+		capture.Capture(messagetracer.CaptureRecord{
+			Time: int64(i + 1),
+			Peer: "r1.algorand.network:4160",
+			Tag:  protocol.TxnTag,
+			Data: protocol.Encode(&grp[0]),
+		})
+	}
+	capture.Close()
+
+	stats, err := net.ReplayCapture(context.Background(), []string{captureCfg.NetworkMessageCaptureFile}, network.ReplayOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Dispatched[protocol.TxnTag])
+
+	require.Eventually(t, func() bool {
+		return transactionMessagesTxnSigVerificationFailed.GetUint64Value() == 1 && transactionMessagesHandled.GetUint64Value() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, tp.PendingTxIDs(), 1)
+	require.Equal(t, stxns[0][0].ID(), tp.PendingTxIDs()[0])
+}
@@ -166,28 +166,64 @@ func prepareNormalizedBalancesV6(bals []encoded.BalanceRecordV6, proto config.Co
 			normalizedAccountBalances[i].AccountHashes[0] = store.AccountHashBuilderV6(balance.Address, &normalizedAccountBalances[i].AccountData, balance.AccountData)
 			curHashIdx++
 		}
-		if len(balance.Resources) > 0 {
-			normalizedAccountBalances[i].Resources = make(map[basics.CreatableIndex]store.ResourcesData, len(balance.Resources))
-			normalizedAccountBalances[i].EncodedResources = make(map[basics.CreatableIndex][]byte, len(balance.Resources))
-			for cidx, res := range balance.Resources {
-				var resData store.ResourcesData
-				err = protocol.Decode(res, &resData)
-				if err != nil {
-					return nil, err
-				}
-				normalizedAccountBalances[i].AccountHashes[curHashIdx], err = store.ResourcesHashBuilderV6(&resData, balance.Address, basics.CreatableIndex(cidx), resData.UpdateRound, res)
-				if err != nil {
-					return nil, err
-				}
-				normalizedAccountBalances[i].Resources[basics.CreatableIndex(cidx)] = resData
-				normalizedAccountBalances[i].EncodedResources[basics.CreatableIndex(cidx)] = res
-				curHashIdx++
+		err = prepareNormalizedResourcesV6(&normalizedAccountBalances[i], balance, curHashIdx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// prepareNormalizedDeltaBalancesV6 prepares the balance records of an incremental catchpoint file. The records without
+// account data carry the changed resources of accounts whose own data didn't change, so only their resources are prepared.
+func prepareNormalizedDeltaBalancesV6(bals []encoded.BalanceRecordV6, proto config.ConsensusParams) (normalizedAccountBalances []store.NormalizedAccountBalance, err error) {
+	normalizedAccountBalances = make([]store.NormalizedAccountBalance, len(bals))
+	for i, balance := range bals {
+		if len(balance.AccountData) > 0 {
+			var normalized []store.NormalizedAccountBalance
+			normalized, err = prepareNormalizedBalancesV6(bals[i:i+1], proto)
+			if err != nil {
+				return nil, err
 			}
+			normalizedAccountBalances[i] = normalized[0]
+			continue
+		}
+		normalizedAccountBalances[i].Address = balance.Address
+		normalizedAccountBalances[i].AccountHashes = make([][]byte, len(balance.Resources))
+		normalizedAccountBalances[i].PartialBalance = true
+		err = prepareNormalizedResourcesV6(&normalizedAccountBalances[i], balance, 0)
+		if err != nil {
+			return nil, err
 		}
 	}
 	return
 }
 
+// prepareNormalizedResourcesV6 decodes the resources of the given balance record into the normalized account balance,
+// and writes their hashes into its AccountHashes, starting at curHashIdx.
+func prepareNormalizedResourcesV6(normalizedAccountBalance *store.NormalizedAccountBalance, balance encoded.BalanceRecordV6, curHashIdx int) (err error) {
+	if len(balance.Resources) == 0 {
+		return nil
+	}
+	normalizedAccountBalance.Resources = make(map[basics.CreatableIndex]store.ResourcesData, len(balance.Resources))
+	normalizedAccountBalance.EncodedResources = make(map[basics.CreatableIndex][]byte, len(balance.Resources))
+	for cidx, res := range balance.Resources {
+		var resData store.ResourcesData
+		err = protocol.Decode(res, &resData)
+		if err != nil {
+			return err
+		}
+		normalizedAccountBalance.AccountHashes[curHashIdx], err = store.ResourcesHashBuilderV6(&resData, balance.Address, basics.CreatableIndex(cidx), resData.UpdateRound, res)
+		if err != nil {
+			return err
+		}
+		normalizedAccountBalance.Resources[basics.CreatableIndex(cidx)] = resData
+		normalizedAccountBalance.EncodedResources[basics.CreatableIndex(cidx)] = res
+		curHashIdx++
+	}
+	return nil
+}
+
 // makeCompactResourceDeltas takes an array of StateDeltas containing AccountDeltas ( one array entry per round ), and compacts the resource portions of the AccountDeltas into a single
 // data structure that contains all the resources deltas changes. While doing that, the function eliminate any intermediate resources changes.
 // It counts the number of changes each account get modified across the round range by specifying it in the nAcctDeltas field of the resourcesDeltas.
@@ -294,11 +330,14 @@ func makeCompactResourceDeltas(stateDeltas []ledgercore.StateDelta, baseRound ba
 // resourcesLoadOld updates the entries on the deltas.oldResource map that matches the provided addresses.
 // The round number of the persistedAccountData is not updated by this function, and the caller is responsible
 // for populating this field.
-func (a *compactResourcesDeltas) resourcesLoadOld(tx *sql.Tx, knownAddresses map[basics.Address]int64) (err error) {
+func (a *compactResourcesDeltas) resourcesLoadOld(tx store.TransactionScope, knownAddresses map[basics.Address]int64) (err error) {
 	if len(a.misses) == 0 {
 		return nil
 	}
-	arw := store.NewAccountsSQLReaderWriter(tx)
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 
 	defer func() {
 		a.misses = nil
@@ -458,11 +497,14 @@ func makeCompactAccountDeltas(stateDeltas []ledgercore.StateDelta, baseRound bas
 // accountsLoadOld updates the entries on the deltas.old map that matches the provided addresses.
 // The round number of the persistedAccountData is not updated by this function, and the caller is responsible
 // for populating this field.
-func (a *compactAccountDeltas) accountsLoadOld(tx *sql.Tx) (err error) {
+func (a *compactAccountDeltas) accountsLoadOld(tx store.TransactionScope) (err error) {
 	if len(a.misses) == 0 {
 		return nil
 	}
-	arw := store.NewAccountsSQLReaderWriter(tx)
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 	defer func() {
 		a.misses = nil
 	}()
@@ -595,11 +637,14 @@ func makeCompactOnlineAccountDeltas(accountDeltas []ledgercore.AccountDeltas, ba
 // accountsLoadOld updates the entries on the deltas.old map that matches the provided addresses.
 // The round number of the persistedAccountData is not updated by this function, and the caller is responsible
 // for populating this field.
-func (a *compactOnlineAccountDeltas) accountsLoadOld(tx *sql.Tx) (err error) {
+func (a *compactOnlineAccountDeltas) accountsLoadOld(tx store.TransactionScope) (err error) {
 	if len(a.misses) == 0 {
 		return nil
 	}
-	arw := store.NewAccountsSQLReaderWriter(tx)
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 	defer func() {
 		a.misses = nil
 	}()
@@ -693,7 +738,7 @@ func accountDataToOnline(address basics.Address, ad *ledgercore.AccountData, pro
 
 // accountsNewRound is a convenience wrapper for accountsNewRoundImpl
 func accountsNewRound(
-	tx *sql.Tx,
+	tx store.TransactionScope,
 	updates compactAccountDeltas, resources compactResourcesDeltas, kvPairs map[string]modifiedKvValue, creatables map[basics.CreatableIndex]ledgercore.ModifiedCreatable,
 	proto config.ConsensusParams, lastUpdateRound basics.Round,
 ) (updatedAccounts []store.PersistedAccountData, updatedResources map[basics.Address][]store.PersistedResourcesData, updatedKVs map[string]store.PersistedKVData, err error) {
@@ -702,7 +747,7 @@ func accountsNewRound(
 	hasKvPairs := len(kvPairs) > 0
 	hasCreatables := len(creatables) > 0
 
-	writer, err := store.MakeAccountsSQLWriter(tx, hasAccounts, hasResources, hasKvPairs, hasCreatables)
+	writer, err := tx.MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables)
 	if err != nil {
 		return
 	}
@@ -712,13 +757,13 @@ func accountsNewRound(
 }
 
 func onlineAccountsNewRound(
-	tx *sql.Tx,
+	tx store.TransactionScope,
 	updates compactOnlineAccountDeltas,
 	proto config.ConsensusParams, lastUpdateRound basics.Round,
 ) (updatedAccounts []store.PersistedOnlineAccountData, err error) {
 	hasAccounts := updates.len() > 0
 
-	writer, err := store.MakeOnlineAccountsSQLWriter(tx, hasAccounts)
+	writer, err := tx.MakeOnlineAccountsOptimizedWriter(hasAccounts)
 	if err != nil {
 		return
 	}
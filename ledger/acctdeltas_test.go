@@ -256,10 +256,10 @@ func TestAccountDBRound(t *testing.T) {
 		resourceUpdatesCnt := makeCompactResourceDeltas([]ledgercore.StateDelta{{Accts: updates}}, basics.Round(oldBase), true, baseAccounts, baseResources)
 		updatesOnlineCnt := makeCompactOnlineAccountDeltas([]ledgercore.AccountDeltas{updates}, basics.Round(oldBase), baseOnlineAccounts)
 
-		err = updatesCnt.accountsLoadOld(tx)
+		err = updatesCnt.accountsLoadOld(store.MakeTransactionScopeTest(tx))
 		require.NoError(t, err)
 
-		err = updatesOnlineCnt.accountsLoadOld(tx)
+		err = updatesOnlineCnt.accountsLoadOld(store.MakeTransactionScopeTest(tx))
 		require.NoError(t, err)
 
 		knownAddresses := make(map[basics.Address]int64)
@@ -267,7 +267,7 @@ func TestAccountDBRound(t *testing.T) {
 			knownAddresses[delta.oldAcct.Addr] = delta.oldAcct.Rowid
 		}
 
-		err = resourceUpdatesCnt.resourcesLoadOld(tx, knownAddresses)
+		err = resourceUpdatesCnt.resourcesLoadOld(store.MakeTransactionScopeTest(tx), knownAddresses)
 		require.NoError(t, err)
 
 		err = arw.AccountsPutTotals(totals, false)
@@ -277,7 +277,7 @@ func TestAccountDBRound(t *testing.T) {
 		require.NoError(t, err)
 		expectedOnlineRoundParams = append(expectedOnlineRoundParams, onlineRoundParams)
 
-		updatedAccts, updatesResources, updatedKVs, err := accountsNewRound(tx, updatesCnt, resourceUpdatesCnt, nil, ctbsWithDeletes, proto, basics.Round(i))
+		updatedAccts, updatesResources, updatedKVs, err := accountsNewRound(store.MakeTransactionScopeTest(tx), updatesCnt, resourceUpdatesCnt, nil, ctbsWithDeletes, proto, basics.Round(i))
 		require.NoError(t, err)
 		require.Equal(t, updatesCnt.len(), len(updatedAccts))
 		numResUpdates := 0
@@ -287,7 +287,7 @@ func TestAccountDBRound(t *testing.T) {
 		require.Equal(t, resourceUpdatesCnt.len(), numResUpdates)
 		require.Empty(t, updatedKVs)
 
-		updatedOnlineAccts, err := onlineAccountsNewRound(tx, updatesOnlineCnt, proto, basics.Round(i))
+		updatedOnlineAccts, err := onlineAccountsNewRound(store.MakeTransactionScopeTest(tx), updatesOnlineCnt, proto, basics.Round(i))
 		require.NoError(t, err)
 
 		err = arw.UpdateAccountsRound(basics.Round(i))
@@ -404,7 +404,7 @@ func TestAccountDBInMemoryAcct(t *testing.T) {
 			)
 			require.Equal(t, 1, len(outAccountDeltas.misses))
 
-			err = outAccountDeltas.accountsLoadOld(tx)
+			err = outAccountDeltas.accountsLoadOld(store.MakeTransactionScopeTest(tx))
 			require.NoError(t, err)
 
 			knownAddresses := make(map[basics.Address]int64)
@@ -412,10 +412,10 @@ func TestAccountDBInMemoryAcct(t *testing.T) {
 				knownAddresses[delta.oldAcct.Addr] = delta.oldAcct.Rowid
 			}
 
-			err = outResourcesDeltas.resourcesLoadOld(tx, knownAddresses)
+			err = outResourcesDeltas.resourcesLoadOld(store.MakeTransactionScopeTest(tx), knownAddresses)
 			require.NoError(t, err)
 
-			updatedAccts, updatesResources, updatedKVs, err := accountsNewRound(tx, outAccountDeltas, outResourcesDeltas, nil, nil, proto, basics.Round(lastRound))
+			updatedAccts, updatesResources, updatedKVs, err := accountsNewRound(store.MakeTransactionScopeTest(tx), outAccountDeltas, outResourcesDeltas, nil, nil, proto, basics.Round(lastRound))
 			require.NoError(t, err)
 			require.Equal(t, 1, len(updatedAccts)) // we store empty even for deleted accounts
 			require.Equal(t,
@@ -924,7 +924,7 @@ func benchmarkWriteCatchpointStagingBalancesSub(b *testing.B, ascendingOrder boo
 		normalizedAccountBalances, err := prepareNormalizedBalancesV6(chunk.Balances, proto)
 		require.NoError(b, err)
 		b.StartTimer()
-		err = l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		err = store.DbPairTest(l.trackerDBs).Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 			crw := store.NewCatchpointSQLReaderWriter(tx)
 			err = crw.WriteCatchpointStagingBalances(ctx, normalizedAccountBalances)
 			return
@@ -937,7 +937,7 @@ func benchmarkWriteCatchpointStagingBalancesSub(b *testing.B, ascendingOrder boo
 		last64KDuration := time.Since(last64KStart) - last64KAccountCreationTime
 		fmt.Printf("%-82s%-7d (last 64k) %-6d ns/account       %d accounts/sec\n", b.Name(), last64KSize, (last64KDuration / time.Duration(last64KSize)).Nanoseconds(), int(float64(last64KSize)/float64(last64KDuration.Seconds())))
 	}
-	stats, err := l.trackerDBs.Wdb.Vacuum(context.Background())
+	stats, err := store.DbPairTest(l.trackerDBs).Wdb.Vacuum(context.Background())
 	require.NoError(b, err)
 	fmt.Printf("%-82sdb fragmentation   %.1f%%\n", b.Name(), float32(stats.PagesBefore-stats.PagesAfter)*100/float32(stats.PagesBefore))
 	b.ReportMetric(float64(b.N)/float64((time.Since(accountsWritingStarted)-accountsGenerationDuration).Seconds()), "accounts/sec")
@@ -2309,19 +2309,19 @@ func TestAccountOnlineQueries(t *testing.T) {
 		updatesCnt := makeCompactAccountDeltas([]ledgercore.StateDelta{updates}, oldBase, true, baseAccounts)
 		updatesOnlineCnt := makeCompactOnlineAccountDeltas([]ledgercore.AccountDeltas{updates.Accts}, oldBase, baseOnlineAccounts)
 
-		err = updatesCnt.accountsLoadOld(tx)
+		err = updatesCnt.accountsLoadOld(store.MakeTransactionScopeTest(tx))
 		require.NoError(t, err)
 
-		err = updatesOnlineCnt.accountsLoadOld(tx)
+		err = updatesOnlineCnt.accountsLoadOld(store.MakeTransactionScopeTest(tx))
 		require.NoError(t, err)
 
 		err = arw.AccountsPutTotals(totals, false)
 		require.NoError(t, err)
-		updatedAccts, _, _, err := accountsNewRound(tx, updatesCnt, compactResourcesDeltas{}, nil, nil, proto, rnd)
+		updatedAccts, _, _, err := accountsNewRound(store.MakeTransactionScopeTest(tx), updatesCnt, compactResourcesDeltas{}, nil, nil, proto, rnd)
 		require.NoError(t, err)
 		require.Equal(t, updatesCnt.len(), len(updatedAccts))
 
-		updatedOnlineAccts, err := onlineAccountsNewRound(tx, updatesOnlineCnt, proto, rnd)
+		updatedOnlineAccts, err := onlineAccountsNewRound(store.MakeTransactionScopeTest(tx), updatesOnlineCnt, proto, rnd)
 		require.NoError(t, err)
 		require.NotEmpty(t, updatedOnlineAccts)
 
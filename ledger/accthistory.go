@@ -0,0 +1,461 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// accountsHistory is the tracker maintaining the per-round history of the accounts, resources, kvs and creatables
+// on archival nodes. It allows looking up the account states of any round since the history was started, while the
+// accountUpdates tracker only covers the rounds in the MaxAcctLookback range.
+//
+// The history of the committed rounds is kept in the tracker database, and the history of the following rounds in
+// memory. The history tables are written before the accountUpdates tracker writes the accounts tables, so that the
+// first modification of an entry can save its value as of the history base round from the accounts tables.
+type accountsHistory struct {
+	// enabled is set for archival ledgers that enable the accounts history in their config.
+	// When not set, the tracker does nothing.
+	enabled bool
+
+	dbs    store.TrackerStore
+	ledger ledgerForTracker
+	log    logging.Logger
+
+	// baseRound is the round from which the history was started. Earlier rounds cannot be looked up.
+	baseRound basics.Round
+
+	// dbRound is the latest round written to the history tables.
+	dbRound basics.Round
+
+	// deltas holds the history of the rounds following dbRound.
+	deltas []accountsHistoryRound
+
+	// mu synchronizes the access to dbRound and deltas.
+	mu deadlock.RWMutex
+}
+
+// accountsHistoryRound holds the values of the entries modified in a single round, in the encoding
+// of the history tables.
+type accountsHistoryRound struct {
+	accounts   map[basics.Address]store.BaseAccountData
+	resources  map[accountCreatable]store.ResourcesData
+	kvs        map[string][]byte // nil == deleted
+	creatables map[basics.CreatableIndex]ledgercore.ModifiedCreatable
+}
+
+func makeAccountsHistoryRound(delta ledgercore.StateDelta) accountsHistoryRound {
+	round := accountsHistoryRound{
+		accounts:   make(map[basics.Address]store.BaseAccountData, delta.Accts.Len()),
+		resources:  make(map[accountCreatable]store.ResourcesData),
+		kvs:        make(map[string][]byte, len(delta.KvMods)),
+		creatables: delta.Creatables,
+	}
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, acct := delta.Accts.GetByIdx(i)
+		var data store.BaseAccountData
+		data.SetCoreAccountData(&acct)
+		round.accounts[addr] = data
+	}
+	for _, res := range delta.Accts.GetAllAssetResources() {
+		data := store.MakeResourcesData(0)
+		data.SetAssetData(res.Params, res.Holding)
+		round.resources[accountCreatable{address: res.Addr, index: basics.CreatableIndex(res.Aidx)}] = data
+	}
+	for _, res := range delta.Accts.GetAllAppResources() {
+		data := store.MakeResourcesData(0)
+		data.SetAppData(res.Params, res.State)
+		round.resources[accountCreatable{address: res.Addr, index: basics.CreatableIndex(res.Aidx)}] = data
+	}
+	for key, kv := range delta.KvMods {
+		round.kvs[key] = kv.Data
+	}
+	return round
+}
+
+// initialize initializes the accountsHistory structure
+func (h *accountsHistory) initialize(cfg config.Local) {
+	h.enabled = cfg.Archival && cfg.EnableAccountHistory
+}
+
+// loadFromDisk is the 2nd level initialization, and is required before the accountsHistory becomes functional.
+// If the history tables are not in sync with the tracker database round, the history is restarted from that round.
+func (h *accountsHistory) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	h.dbs = l.trackerDB()
+	h.ledger = l
+	h.log = l.trackerLog()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dbRound = dbRound
+	h.deltas = nil
+	if !h.enabled {
+		return nil
+	}
+
+	return h.dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) error {
+		hrw, err := tx.MakeAccountsHistoryReaderWriter()
+		if err != nil {
+			return err
+		}
+		defer hrw.Close()
+
+		baseRound, rnd, ok, err := hrw.AccountsHistoryRounds(ctx)
+		if err != nil {
+			return err
+		}
+		if ok && rnd == dbRound {
+			h.baseRound = baseRound
+			return nil
+		}
+		if ok {
+			h.log.Infof("accountsHistory: restarting the accounts history from round %d, as it was written up to round %d", dbRound, rnd)
+		}
+		h.baseRound = dbRound
+		return hrw.ResetAccountsHistory(ctx, dbRound)
+	})
+}
+
+func (h *accountsHistory) close() {
+}
+
+func (h *accountsHistory) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !h.enabled {
+		return
+	}
+	round := makeAccountsHistoryRound(delta)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if blk.Round() != h.dbRound+basics.Round(len(h.deltas))+1 {
+		h.log.Panicf("accountsHistory: newBlock %d too far in the future, dbRound %d, deltas %d", blk.Round(), h.dbRound, len(h.deltas))
+	}
+	h.deltas = append(h.deltas, round)
+}
+
+func (h *accountsHistory) committedUpTo(rnd basics.Round) (retRound, lookback basics.Round) {
+	return rnd, basics.Round(0)
+}
+
+func (h *accountsHistory) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (h *accountsHistory) prepareCommit(dcc *deferredCommitContext) error {
+	if !h.enabled {
+		return nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if dcc.oldBase != h.dbRound || dcc.offset > uint64(len(h.deltas)) {
+		return fmt.Errorf("accountsHistory: unable to commit rounds %d-%d, dbRound %d, deltas %d", dcc.oldBase+1, dcc.newBase, h.dbRound, len(h.deltas))
+	}
+	dcc.historyDeltas = h.deltas[:dcc.offset]
+	return nil
+}
+
+func (h *accountsHistory) commitRound(ctx context.Context, tx store.TransactionScope, dcc *deferredCommitContext) error {
+	if !h.enabled {
+		return nil
+	}
+	hrw, err := tx.MakeAccountsHistoryReaderWriter()
+	if err != nil {
+		return err
+	}
+	defer hrw.Close()
+
+	for i, delta := range dcc.historyDeltas {
+		rnd := dcc.oldBase + basics.Round(i) + 1
+		for addr, data := range delta.accounts {
+			err = hrw.InsertAccountHistory(ctx, h.baseRound, rnd, addr, data)
+			if err != nil {
+				return fmt.Errorf("accountsHistory: unable to write account %v of round %d : %w", addr, rnd, err)
+			}
+		}
+		for res, data := range delta.resources {
+			err = hrw.InsertResourceHistory(ctx, h.baseRound, rnd, res.address, res.index, data)
+			if err != nil {
+				return fmt.Errorf("accountsHistory: unable to write resource %d of account %v of round %d : %w", res.index, res.address, rnd, err)
+			}
+		}
+		for key, value := range delta.kvs {
+			err = hrw.InsertKvHistory(ctx, h.baseRound, rnd, key, value)
+			if err != nil {
+				return fmt.Errorf("accountsHistory: unable to write kv of round %d : %w", rnd, err)
+			}
+		}
+		for cidx, creatable := range delta.creatables {
+			err = hrw.InsertCreatableHistory(ctx, cidx, creatable.Ctype, creatable.Creator)
+			if err != nil {
+				return fmt.Errorf("accountsHistory: unable to write creatable %d of round %d : %w", cidx, rnd, err)
+			}
+		}
+	}
+	return hrw.UpdateAccountsHistoryRound(ctx, dcc.newBase)
+}
+
+func (h *accountsHistory) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	if !h.enabled {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.deltas = h.deltas[dcc.offset:]
+	h.dbRound = dcc.newBase
+}
+
+func (h *accountsHistory) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (h *accountsHistory) handleUnorderedCommit(*deferredCommitContext) {
+}
+
+// roundDeltas returns the in-memory history of the rounds following the tracker database round, up to rnd, and the
+// round at which the history tables have to be looked up for the entries that weren't modified in these rounds.
+func (h *accountsHistory) roundDeltas(rnd basics.Round) (deltas []accountsHistoryRound, dbRound basics.Round, err error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if rnd < h.baseRound {
+		return nil, 0, &RoundOffsetError{round: rnd, dbRound: h.baseRound}
+	}
+	if rnd > h.dbRound+basics.Round(len(h.deltas)) {
+		return nil, 0, fmt.Errorf("round %d too high: dbRound %d, deltas %d", rnd, h.dbRound, len(h.deltas))
+	}
+	if rnd <= h.dbRound {
+		return nil, rnd, nil
+	}
+	// the returned rounds are never modified, so they can be used after releasing the lock.
+	return h.deltas[:rnd-h.dbRound], h.dbRound, nil
+}
+
+// lookupWithoutRewards returns the account data of addr as of the end of round rnd, along with the rewards
+// parameters needed to apply the pending rewards as of that round.
+func (h *accountsHistory) lookupWithoutRewards(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	deltas, dbRound, err := h.roundDeltas(rnd)
+	if err != nil {
+		return ledgercore.AccountData{}, "", 0, err
+	}
+	hdr, err := h.ledger.BlockHdr(rnd)
+	if err != nil {
+		return ledgercore.AccountData{}, "", 0, err
+	}
+
+	base, found := lookupAccountInHistoryDeltas(deltas, addr)
+	if !found {
+		err = h.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) error {
+			hr, err := tx.MakeAccountsHistoryReader()
+			if err != nil {
+				return err
+			}
+			defer hr.Close()
+			base, err = hr.LookupAccountHistory(ctx, addr, dbRound)
+			return err
+		})
+		if err != nil {
+			return ledgercore.AccountData{}, "", 0, err
+		}
+	}
+	return base.GetLedgerCoreAccountData(), hdr.CurrentProtocol, hdr.RewardsLevel, nil
+}
+
+// lookupAllResources returns the account data of addr as of the end of round rnd including all its resources, along
+// with the account balance before applying the pending rewards as of that round.
+func (h *accountsHistory) lookupAllResources(rnd basics.Round, addr basics.Address) (data basics.AccountData, withoutRewards basics.MicroAlgos, err error) {
+	deltas, dbRound, err := h.roundDeltas(rnd)
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+	hdr, err := h.ledger.BlockHdr(rnd)
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	base, found := lookupAccountInHistoryDeltas(deltas, addr)
+	resources := make(map[basics.CreatableIndex]store.ResourcesData)
+	for i := len(deltas) - 1; i >= 0; i-- {
+		for res, resData := range deltas[i].resources {
+			if _, has := resources[res.index]; res.address == addr && !has {
+				resources[res.index] = resData
+			}
+		}
+	}
+	err = h.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) error {
+		hr, err := tx.MakeAccountsHistoryReader()
+		if err != nil {
+			return err
+		}
+		defer hr.Close()
+		if !found {
+			base, err = hr.LookupAccountHistory(ctx, addr, dbRound)
+			if err != nil {
+				return err
+			}
+		}
+		persisted, err := hr.LookupAllResourcesHistory(ctx, addr, dbRound)
+		if err != nil {
+			return err
+		}
+		for aidx, resData := range persisted {
+			if _, has := resources[aidx]; !has {
+				resources[aidx] = resData
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	ledgercore.AssignAccountData(&data, base.GetLedgerCoreAccountData())
+	for aidx, resData := range resources {
+		if resData.IsEmpty() {
+			continue
+		}
+		prd := store.PersistedResourcesData{Aidx: aidx, Data: resData}
+		ledgercore.AssignAccountResourceToAccountData(aidx, prd.AccountResource(), &data)
+	}
+	withoutRewards = data.MicroAlgos
+	data = data.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel)
+	return data, withoutRewards, nil
+}
+
+// lookupResource returns the resource aidx of addr as of the end of round rnd.
+func (h *accountsHistory) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
+	deltas, dbRound, err := h.roundDeltas(rnd)
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+
+	var data store.ResourcesData
+	found := false
+	key := accountCreatable{address: addr, index: aidx}
+	for i := len(deltas) - 1; i >= 0 && !found; i-- {
+		data, found = deltas[i].resources[key]
+	}
+	if !found {
+		err = h.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) error {
+			hr, err := tx.MakeAccountsHistoryReader()
+			if err != nil {
+				return err
+			}
+			defer hr.Close()
+			data, err = hr.LookupResourceHistory(ctx, addr, aidx, dbRound)
+			return err
+		})
+		if err != nil {
+			return ledgercore.AccountResource{}, err
+		}
+	}
+
+	prd := store.PersistedResourcesData{Aidx: aidx, Data: data}
+	res := prd.AccountResource()
+	switch ctype {
+	case basics.AssetCreatable:
+		return ledgercore.AccountResource{AssetParams: res.AssetParams, AssetHolding: res.AssetHolding}, nil
+	case basics.AppCreatable:
+		return ledgercore.AccountResource{AppParams: res.AppParams, AppLocalState: res.AppLocalState}, nil
+	default:
+		return ledgercore.AccountResource{}, fmt.Errorf("unknown creatable type %d", ctype)
+	}
+}
+
+// lookupKv returns the value of key as of the end of round rnd, or nil if the key didn't exist.
+func (h *accountsHistory) lookupKv(rnd basics.Round, key string) ([]byte, error) {
+	deltas, dbRound, err := h.roundDeltas(rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(deltas) - 1; i >= 0; i-- {
+		if value, ok := deltas[i].kvs[key]; ok {
+			return value, nil
+		}
+	}
+	var value []byte
+	err = h.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) error {
+		hr, err := tx.MakeAccountsHistoryReader()
+		if err != nil {
+			return err
+		}
+		defer hr.Close()
+		value, err = hr.LookupKvHistory(ctx, key, dbRound)
+		return err
+	})
+	return value, err
+}
+
+// getCreatorForRound returns the creator of the creatable cidx, if it existed at the end of round rnd.
+func (h *accountsHistory) getCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
+	if _, _, err = h.roundDeltas(rnd); err != nil {
+		return basics.Address{}, false, err
+	}
+
+	// the creator of a creatable never changes, so it can be taken from any round.
+	h.mu.RLock()
+	for _, delta := range h.deltas {
+		if mc, has := delta.creatables[cidx]; has && mc.Ctype == ctype {
+			creator, ok = mc.Creator, true
+			break
+		}
+	}
+	h.mu.RUnlock()
+	if !ok {
+		err = h.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) error {
+			hr, err := tx.MakeAccountsHistoryReader()
+			if err != nil {
+				return err
+			}
+			defer hr.Close()
+			creator, ok, err = hr.LookupCreatorHistory(ctx, cidx, ctype)
+			return err
+		})
+		if err != nil || !ok {
+			return basics.Address{}, false, err
+		}
+	}
+
+	// check that the creatable existed at the end of rnd.
+	res, err := h.lookupResource(rnd, creator, cidx, ctype)
+	if err != nil {
+		return basics.Address{}, false, err
+	}
+	if res.AssetParams == nil && res.AppParams == nil {
+		return basics.Address{}, false, nil
+	}
+	return creator, true, nil
+}
+
+// lookupAccountInHistoryDeltas returns the latest account data of addr in the given rounds, if it was modified in any of them.
+func lookupAccountInHistoryDeltas(deltas []accountsHistoryRound, addr basics.Address) (data store.BaseAccountData, found bool) {
+	for i := len(deltas) - 1; i >= 0; i-- {
+		if data, found = deltas[i].accounts[addr]; found {
+			return
+		}
+	}
+	return store.BaseAccountData{}, false
+}
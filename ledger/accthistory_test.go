@@ -0,0 +1,232 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func newHistoryLedger(t *testing.T, balances bookkeeping.GenesisBalances, enableHistory bool) *Ledger {
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusFuture, balances, "test", genHash)
+	require.NoError(t, err)
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableAccountHistory = enableHistory
+	cfg.MaxAcctLookback = 2
+	l, err := OpenLedger(logging.Base(), dbName, true, ledgercore.InitState{
+		Block:       genBlock,
+		Accounts:    balances.Balances,
+		GenesisHash: genHash,
+	}, cfg)
+	require.NoError(t, err)
+	return l
+}
+
+// TestAccountsHistoryLookups checks that the ledger answers the account, resource, kv and creator lookups
+// of the rounds older than the accounts tracker lookback from the accounts history.
+func TestAccountsHistoryLookups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newHistoryLedger(t, genBalances, true)
+	defer l.Close()
+
+	type roundState struct {
+		accounts       []ledgercore.AccountData
+		withoutRewards []basics.MicroAlgos
+		full           basics.AccountData
+		box            []byte
+	}
+	var states []roundState
+	var appIndex basics.AppIndex
+	boxKey := func() string { return apps.MakeBoxKey(uint64(appIndex), "adam") }
+	record := func() {
+		rnd := l.Latest()
+		var state roundState
+		for _, addr := range addrs[:3] {
+			data, validThrough, withoutRewards, err := l.LookupAccount(rnd, addr)
+			require.NoError(t, err)
+			require.Equal(t, rnd, validThrough)
+			state.accounts = append(state.accounts, data)
+			state.withoutRewards = append(state.withoutRewards, withoutRewards)
+		}
+		var err error
+		state.full, _, _, err = l.LookupLatest(addrs[0])
+		require.NoError(t, err)
+		state.box, err = l.LookupKv(rnd, boxKey())
+		require.NoError(t, err)
+		require.Len(t, states, int(rnd))
+		states = append(states, state)
+	}
+	record()
+
+	// round 1: create the app
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{Type: "appl", Sender: addrs[0], ApprovalProgram: boxAppSource})
+	vb := endBlock(t, l, eval)
+	appIndex = vb.Block().Payset[0].ApplyData.ApplicationID
+	record()
+
+	// round 2: fund the app
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: appIndex.Address(), Amount: 1_000_000})
+	endBlock(t, l, eval)
+	record()
+
+	// round 3: create a box
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: appIndex,
+		Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("adam")}},
+	}
+	eval = nextBlock(t, l)
+	txn(t, l, eval, call.Args("create", "adam"))
+	endBlock(t, l, eval)
+	record()
+
+	// round 4: delete the box and make a payment
+	eval = nextBlock(t, l)
+	txn(t, l, eval, call.Args("delete", "adam"))
+	txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[1], Receiver: addrs[2], Amount: 12345})
+	endBlock(t, l, eval)
+	record()
+
+	require.Nil(t, states[2].box)
+	require.Len(t, states[3].box, 24)
+	require.Nil(t, states[4].box)
+
+	check := func() {
+		for rnd, state := range states {
+			rnd := basics.Round(rnd)
+			for i, addr := range addrs[:3] {
+				data, validThrough, withoutRewards, err := l.LookupAccount(rnd, addr)
+				require.NoError(t, err)
+				require.Equal(t, rnd, validThrough)
+				require.Equal(t, state.accounts[i], data)
+				require.Equal(t, state.withoutRewards[i], withoutRewards)
+			}
+			full, _, err := l.LookupHistorical(rnd, addrs[0])
+			require.NoError(t, err)
+			require.Equal(t, state.full, full)
+
+			box, err := l.LookupKv(rnd, boxKey())
+			require.NoError(t, err)
+			require.Equal(t, state.box, box)
+
+			creator, ok, err := l.GetCreatorForRound(rnd, basics.CreatableIndex(appIndex), basics.AppCreatable)
+			require.NoError(t, err)
+			require.Equal(t, rnd > 0, ok)
+			if ok {
+				require.Equal(t, addrs[0], creator)
+				app, err := l.LookupApplication(rnd, addrs[0], appIndex)
+				require.NoError(t, err)
+				require.Equal(t, state.full.AppParams[appIndex], *app.AppParams)
+			}
+		}
+	}
+
+	// the rounds are answered from the in-memory history, then from the history tables.
+	check()
+	for i := 0; i < 10; i++ {
+		endBlock(t, l, nextBlock(t, l))
+	}
+	commitRoundLookback(2, l)
+	_, _, _, _, err := l.accts.lookupWithoutRewards(1, addrs[0], true)
+	var roundOffsetErr *RoundOffsetError
+	require.True(t, errors.As(err, &roundOffsetErr))
+	check()
+
+	// the history survives reloading the ledger.
+	require.NoError(t, l.reloadLedger())
+	check()
+}
+
+// TestAccountsHistoryDisabled checks that the rounds older than the accounts tracker lookback
+// are not available when the accounts history is disabled.
+func TestAccountsHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newHistoryLedger(t, genBalances, false)
+	defer l.Close()
+
+	for i := 0; i < 10; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[1], Receiver: addrs[2], Amount: 1000})
+		endBlock(t, l, eval)
+	}
+	commitRoundLookback(2, l)
+
+	var roundOffsetErr *RoundOffsetError
+	_, _, _, err := l.LookupAccount(1, addrs[1])
+	require.True(t, errors.As(err, &roundOffsetErr))
+	_, err = l.LookupKv(1, "key")
+	require.True(t, errors.As(err, &roundOffsetErr))
+	_, _, err = l.LookupHistorical(1, addrs[1])
+	require.True(t, errors.As(err, &roundOffsetErr))
+
+	data, _, err := l.LookupHistorical(l.Latest(), addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, lookup(t, l, addrs[1]), data)
+
+	// the rounds within the accounts tracker lookback are answered with their resources.
+	rnd := l.Latest()
+	before := lookup(t, l, addrs[1])
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{
+		Type:        "acfg",
+		Sender:      addrs[1],
+		AssetParams: basics.AssetParams{Total: 1000, Manager: addrs[1]},
+	})
+	endBlock(t, l, eval)
+	created := lookup(t, l, addrs[1])
+	require.Len(t, created.AssetParams, 1)
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[1], Receiver: addrs[2], Amount: 1000})
+	endBlock(t, l, eval)
+
+	data, _, err = l.LookupHistorical(rnd, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, before, data)
+	data, withoutRewards, err := l.LookupHistorical(rnd+1, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, created, data)
+	_, _, expectedWithoutRewards, err := l.LookupAccount(rnd+1, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, expectedWithoutRewards, withoutRewards)
+}
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -40,7 +39,6 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
-	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/metrics"
 )
 
@@ -154,7 +152,7 @@ type modifiedKvValue struct {
 
 type accountUpdates struct {
 	// Connection to the database.
-	dbs db.Pair
+	dbs store.TrackerStore
 
 	// Prepared SQL statements for fast accounts DB lookups.
 	accountsq store.AccountsReader
@@ -912,6 +910,18 @@ func (au *accountUpdates) onlineTotals(rnd basics.Round) (basics.MicroAlgos, err
 	return totals.Online.Money, nil
 }
 
+// Totals returns the totals of all accounts at the end of round rnd.
+func (au *accountUpdates) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.AccountTotals{}, err
+	}
+
+	return au.roundTotals[offset], nil
+}
+
 // latestTotalsImpl returns the totals of all accounts for the most recent round, as well as the round number
 func (au *accountUpdates) latestTotalsImpl() (basics.Round, ledgercore.AccountTotals, error) {
 	offset := len(au.deltas)
@@ -928,8 +938,12 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 
 	start := time.Now()
 	ledgerAccountsinitCount.Inc(nil)
-	err = au.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err = au.dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) error {
+		arw, err0 := tx.MakeAccountsReaderWriter()
+		if err0 != nil {
+			return err0
+		}
+
 		totals, err0 := arw.AccountsTotals(ctx, false)
 		if err0 != nil {
 			return err0
@@ -944,7 +958,7 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 		return
 	}
 
-	au.accountsq, err = store.AccountsInitDbQueries(au.dbs.Rdb.Handle)
+	au.accountsq, err = au.dbs.MakeAccountsOptimizedReader()
 	if err != nil {
 		return
 	}
@@ -1275,6 +1289,56 @@ func (au *accountUpdates) lookupLatest(addr basics.Address) (data basics.Account
 	}
 }
 
+// lookupAllResources returns the account data of addr, including all its resources, as of the end of round rnd,
+// along with the rewards version and level of that round. The rewards are not applied to the returned account data.
+func (au *accountUpdates) lookupAllResources(rnd basics.Round, addr basics.Address) (data basics.AccountData, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	var offset uint64
+	var persistedData store.PersistedAccountData
+	var persistedResources []store.PersistedResourcesData
+	var resourceDbRound basics.Round
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, err = au.roundOffset(rnd)
+		if err != nil {
+			return
+		}
+
+		// The account and its resources are read from the on-disk DB without holding the lock, and
+		// the deltas up to rnd are applied to them if the DB round didn't change in the meantime.
+		au.accountsMu.RUnlock()
+		persistedData, err = au.accountsq.LookupAccount(addr)
+		if err == nil {
+			persistedResources, resourceDbRound, err = au.accountsq.LookupAllResources(addr)
+		}
+		au.accountsMu.RLock()
+		if err != nil {
+			return basics.AccountData{}, "", 0, err
+		}
+
+		if persistedData.Round == currentDbRound && resourceDbRound == currentDbRound && au.cachedDBRound == currentDbRound {
+			ledgercore.AssignAccountData(&data, persistedData.AccountData.GetLedgerCoreAccountData())
+			for _, prd := range persistedResources {
+				ledgercore.AssignAccountResourceToAccountData(prd.Aidx, prd.AccountResource(), &data)
+			}
+			for i := uint64(0); i < offset; i++ {
+				data = au.deltas[i].Accts.ApplyToBasicsAccountData(addr, data)
+			}
+			return data, au.versions[offset], au.roundTotals[offset].RewardsLevel, nil
+		}
+
+		if persistedData.Round < currentDbRound || resourceDbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupAllResources: database round %d is behind in-memory round %d", persistedData.Round, currentDbRound)
+			return basics.AccountData{}, "", 0, &StaleDatabaseRoundError{databaseRound: persistedData.Round, memoryRound: currentDbRound}
+		}
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
 func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType, synchronized bool) (data ledgercore.AccountResource, validThrough basics.Round, err error) {
 	needUnlock := false
 	if synchronized {
@@ -1656,7 +1720,7 @@ func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 
 // commitRound is called within the same transaction for all trackers it
 // receives current offset and dbRound
-func (au *accountUpdates) commitRound(ctx context.Context, tx *sql.Tx, dcc *deferredCommitContext) (err error) {
+func (au *accountUpdates) commitRound(ctx context.Context, tx store.TransactionScope, dcc *deferredCommitContext) (err error) {
 	offset := dcc.offset
 	dbRound := dcc.oldBase
 
@@ -1668,7 +1732,7 @@ func (au *accountUpdates) commitRound(ctx context.Context, tx *sql.Tx, dcc *defe
 		}
 	}()
 
-	_, err = db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(accountsUpdatePerRoundHighWatermark*time.Duration(offset)))
+	_, err = tx.ResetTransactionWarnDeadline(ctx, time.Now().Add(accountsUpdatePerRoundHighWatermark*time.Duration(offset)))
 	if err != nil {
 		return err
 	}
@@ -1696,7 +1760,10 @@ func (au *accountUpdates) commitRound(ctx context.Context, tx *sql.Tx, dcc *defe
 		dcc.stats.OldAccountPreloadDuration = time.Duration(time.Now().UnixNano()) - dcc.stats.OldAccountPreloadDuration
 	}
 
-	arw := store.NewAccountsSQLReaderWriter(tx)
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 
 	err = arw.AccountsPutTotals(dcc.roundTotals, false)
 	if err != nil {
@@ -1962,7 +2029,7 @@ func (au *accountUpdates) vacuumDatabase(ctx context.Context) (err error) {
 	}()
 
 	ledgerVacuumCount.Inc(nil)
-	vacuumStats, err := au.dbs.Wdb.Vacuum(ctx)
+	vacuumStats, err := au.dbs.Vacuum(ctx)
 	close(vacuumExitCh)
 	vacuumLoggingAbort.Wait()
 
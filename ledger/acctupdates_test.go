@@ -220,8 +220,8 @@ func (ml *mockLedgerForTracker) BlockHdr(rnd basics.Round) (bookkeeping.BlockHea
 	return ml.blocks[int(rnd)].block.BlockHeader, nil
 }
 
-func (ml *mockLedgerForTracker) trackerDB() db.Pair {
-	return ml.dbs
+func (ml *mockLedgerForTracker) trackerDB() store.TrackerStore {
+	return store.CreateTrackerSQLStore(ml.dbs)
 }
 
 func (ml *mockLedgerForTracker) blockDB() db.Pair {
@@ -264,7 +264,7 @@ func (au *accountUpdates) allBalances(rnd basics.Round) (bals map[basics.Address
 		return
 	}
 
-	err = au.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = store.DbPairTest(au.dbs).Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var err0 error
 		bals, err0 = accountsAll(tx)
 		return err0
@@ -1184,7 +1184,7 @@ func TestListCreatables(t *testing.T) {
 	// sync with the database
 	var updates compactAccountDeltas
 	var resUpdates compactResourcesDeltas
-	_, _, _, err = accountsNewRound(tx, updates, resUpdates, nil, ctbsWithDeletes, proto, basics.Round(1))
+	_, _, _, err = accountsNewRound(store.MakeTransactionScopeTest(tx), updates, resUpdates, nil, ctbsWithDeletes, proto, basics.Round(1))
 	require.NoError(t, err)
 	// nothing left in cache
 	au.creatables = make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable)
@@ -1200,7 +1200,7 @@ func TestListCreatables(t *testing.T) {
 	// ******* Results are obtained from the database and from the cache *******
 	// ******* Deletes are in the database and in the cache              *******
 	// sync with the database. This has deletes synced to the database.
-	_, _, _, err = accountsNewRound(tx, updates, resUpdates, nil, au.creatables, proto, basics.Round(1))
+	_, _, _, err = accountsNewRound(store.MakeTransactionScopeTest(tx), updates, resUpdates, nil, au.creatables, proto, basics.Round(1))
 	require.NoError(t, err)
 	// get new creatables in the cache. There will be deleted in the cache from the previous batch.
 	au.creatables = randomCreatableSampling(3, ctbsList, randomCtbs,
@@ -1579,7 +1579,7 @@ func BenchmarkLargeMerkleTrieRebuild(b *testing.B) {
 		}
 
 		err := ml.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-			_, _, _, err = accountsNewRound(tx, updates, compactResourcesDeltas{}, nil, nil, proto, basics.Round(1))
+			_, _, _, err = accountsNewRound(store.MakeTransactionScopeTest(tx), updates, compactResourcesDeltas{}, nil, nil, proto, basics.Round(1))
 			return
 		})
 		require.NoError(b, err)
@@ -2352,9 +2352,9 @@ func TestAcctUpdatesResources(t *testing.T) {
 
 				err := au.prepareCommit(dcc)
 				require.NoError(t, err)
-				err = ml.trackers.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+				err = store.DbPairTest(ml.trackers.dbs).Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 					arw := store.NewAccountsSQLReaderWriter(tx)
-					err = au.commitRound(ctx, tx, dcc)
+					err = au.commitRound(ctx, store.MakeTransactionScopeTest(tx), dcc)
 					if err != nil {
 						return err
 					}
@@ -2636,9 +2636,9 @@ func auCommitSync(t *testing.T, rnd basics.Round, au *accountUpdates, ml *mockLe
 
 			err := au.prepareCommit(dcc)
 			require.NoError(t, err)
-			err = ml.trackers.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			err = store.DbPairTest(ml.trackers.dbs).Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 				arw := store.NewAccountsSQLReaderWriter(tx)
-				err = au.commitRound(ctx, tx, dcc)
+				err = au.commitRound(ctx, store.MakeTransactionScopeTest(tx), dcc)
 				if err != nil {
 					return err
 				}
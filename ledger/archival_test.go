@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/internal"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
 	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -75,7 +77,7 @@ func (wl *wrappedLedger) Latest() basics.Round {
 	return wl.l.Latest()
 }
 
-func (wl *wrappedLedger) trackerDB() db.Pair {
+func (wl *wrappedLedger) trackerDB() store.TrackerStore {
 	return wl.l.trackerDB()
 }
 
@@ -241,6 +243,80 @@ func TestArchivalRestart(t *testing.T) {
 	require.Equal(t, basics.Round(0), earliest)
 }
 
+func TestArchivalBlockArchive(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Start in archival mode with the block archive enabled, add enough blocks for a segment
+	// to be archived, ensure all blocks are still there, restart and check again.
+
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	dbPrefix := filepath.Join(t.TempDir(), dbName)
+
+	genesisInitState := getInitState()
+	const inMem = false // the archive is only used with persistent storage
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.BlockArchiveRounds = 1
+
+	l, err := OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, l.blockArchive)
+
+	blk := genesisInitState.Block
+	blocks := []bookkeeping.Block{blk}
+
+	// the trackers' history is never archived, regardless of BlockArchiveRounds
+	proto := config.Consensus[blk.CurrentProtocol]
+	maxBlocks := int(proto.MaxTxnLife+proto.DeeperBlockHeaderHistory+proto.CatchpointLookback) + blockdb.ArchiveSegmentRounds + 10
+	for i := 0; i < maxBlocks; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{Round: blk.Round()}))
+		blocks = append(blocks, blk)
+	}
+	l.WaitForCommit(blk.Round())
+	require.Eventually(t, func() bool {
+		return l.blockArchive.NextRound() == blockdb.ArchiveSegmentRounds
+	}, 10*time.Second, 10*time.Millisecond)
+
+	checkBlocks := func(l *Ledger) {
+		var earliest basics.Round
+		err := l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			earliest, err = blockdb.BlockEarliest(tx)
+			return
+		})
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(blockdb.ArchiveSegmentRounds), earliest)
+
+		for _, rnd := range []basics.Round{0, 1, blockdb.ArchiveSegmentRounds - 1, blockdb.ArchiveSegmentRounds, blk.Round()} {
+			b, err := l.Block(rnd)
+			require.NoError(t, err)
+			require.Equal(t, blocks[rnd].Hash(), b.Hash())
+
+			hdr, err := l.BlockHdr(rnd)
+			require.NoError(t, err)
+			require.Equal(t, blocks[rnd].BlockHeader, hdr)
+
+			b, cert, err := l.BlockCert(rnd)
+			require.NoError(t, err)
+			require.Equal(t, blocks[rnd].Hash(), b.Hash())
+			require.Equal(t, rnd, cert.Round)
+
+			encodedBlk, _, err := l.EncodedBlockCert(rnd)
+			require.NoError(t, err)
+			require.Equal(t, protocol.Encode(&blocks[rnd]), encodedBlk)
+		}
+	}
+	checkBlocks(l)
+
+	// close and reopen, ensure the archived blocks are not considered missing
+	l.Close()
+	l, err = OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	checkBlocks(l)
+}
+
 func makeUnsignedAssetCreateTx(firstValid, lastValid basics.Round, total uint64, defaultFrozen bool, manager string, reserve string, freeze string, clawback string, unitName string, assetName string, url string, metadataHash []byte) (transactions.Transaction, error) {
 	var tx transactions.Transaction
 	var err error
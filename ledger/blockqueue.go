@@ -26,6 +26,7 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -50,6 +51,11 @@ type blockQueue struct {
 	cond    *sync.Cond
 	running bool
 	closed  chan struct{}
+
+	// archiveTrigger wakes up the archiver after blocks were committed,
+	// and archiverDone is closed once the archiver has exited.
+	archiveTrigger chan struct{}
+	archiverDone   chan struct{}
 }
 
 func newBlockQueue(l *Ledger) (*blockQueue, error) {
@@ -89,6 +95,12 @@ func (bq *blockQueue) start() error {
 		return err
 	}
 
+	if bq.l.blockArchive != nil {
+		bq.archiveTrigger = make(chan struct{}, 1)
+		bq.archiverDone = make(chan struct{})
+		go bq.archiver(bq.archiveTrigger, bq.archiverDone)
+	}
+
 	go bq.syncer()
 	return nil
 }
@@ -109,6 +121,16 @@ func (bq *blockQueue) stop() {
 	if closechan != nil {
 		<-closechan
 	}
+
+	// the syncer is gone, so nothing would trigger the archiver anymore.
+	bq.mu.Lock()
+	archiveTrigger, archiverDone := bq.archiveTrigger, bq.archiverDone
+	bq.archiveTrigger, bq.archiverDone = nil, nil
+	bq.mu.Unlock()
+	if archiveTrigger != nil {
+		close(archiveTrigger)
+		<-archiverDone
+	}
 }
 
 func (bq *blockQueue) syncer() {
@@ -175,10 +197,117 @@ func (bq *blockQueue) syncer() {
 			}
 
 			bq.mu.Lock()
+			if bq.archiveTrigger != nil {
+				select {
+				case bq.archiveTrigger <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// archiver moves old blocks into the block archive whenever it is triggered, until the trigger is closed.
+func (bq *blockQueue) archiver(trigger <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	for range trigger {
+		for {
+			archived, err := bq.archiveSegment()
+			if err != nil {
+				bq.l.log.Warnf("blockQueue.archiver: %v", err)
+			}
+			if !archived {
+				break
+			}
+			// stop promptly rather than working through a large backlog.
+			select {
+			case _, ok := <-trigger:
+				if !ok {
+					return
+				}
+			default:
+			}
 		}
 	}
 }
 
+// archiveSegment moves the oldest segment of blocks from the blocks database into the block archive,
+// if all of its rounds are older than the configured number of rounds to keep. It returns true if
+// a segment was archived.
+func (bq *blockQueue) archiveSegment() (bool, error) {
+	archive := bq.l.blockArchive
+
+	bq.mu.Lock()
+	committed := bq.lastCommitted
+	bq.mu.Unlock()
+
+	hdr, err := bq.getBlockHdr(committed)
+	if err != nil {
+		return false, err
+	}
+
+	// never archive the blocks the trackers may still need to read from the database.
+	proto := config.Consensus[hdr.CurrentProtocol]
+	keep := basics.Round(bq.l.cfg.BlockArchiveRounds)
+	minKeep := basics.Round(proto.MaxTxnLife + proto.DeeperBlockHeaderHistory + proto.CatchpointLookback)
+	if keep < minKeep {
+		keep = minKeep
+	}
+
+	first := archive.NextRound()
+	last := first + blockdb.ArchiveSegmentRounds - 1
+	if committed < keep || last >= committed-keep {
+		return false, nil
+	}
+
+	start := time.Now()
+	ledgerArchiveSegmentCount.Inc(nil)
+	sw, err := archive.MakeSegmentWriter(first)
+	if err != nil {
+		return false, err
+	}
+	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for rnd := first; rnd <= last; rnd++ {
+			blk, cert, err0 := blockdb.BlockGetEncodedCert(tx, rnd)
+			if err0 != nil {
+				return err0
+			}
+			err0 = sw.Append(blk, cert)
+			if err0 != nil {
+				return err0
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		sw.Abort()
+		if _, ok := err.(ledgercore.ErrNoEntry); ok {
+			// the database does not hold these rounds, e.g. after a catchpoint catchup.
+			return false, nil
+		}
+		return false, err
+	}
+	err = sw.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockdb.BlockForgetBefore(tx, last+1)
+	})
+	ledgerArchiveSegmentMicros.AddMicrosecondsSince(start, nil)
+	if err != nil {
+		// the blocks are served from the archive already; the next segment would delete them.
+		return true, fmt.Errorf("blockForgetBefore(%d): %v", last+1, err)
+	}
+	return true, nil
+}
+
+// archived returns true if the block of round r was moved to the block archive.
+func (bq *blockQueue) archived(r basics.Round) bool {
+	return bq.l.blockArchive != nil && bq.l.blockArchive.Contains(r)
+}
+
 func (bq *blockQueue) waitCommit(r basics.Round) {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
@@ -278,6 +407,10 @@ func (bq *blockQueue) getBlock(r basics.Round) (blk bookkeeping.Block, err error
 		return
 	}
 
+	if bq.archived(r) {
+		return bq.l.blockArchive.Get(r)
+	}
+
 	start := time.Now()
 	ledgerGetblockCount.Inc(nil)
 	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -300,6 +433,10 @@ func (bq *blockQueue) getBlockHdr(r basics.Round) (hdr bookkeeping.BlockHeader,
 		return
 	}
 
+	if bq.archived(r) {
+		return bq.l.blockArchive.GetHdr(r)
+	}
+
 	start := time.Now()
 	ledgerGetblockhdrCount.Inc(nil)
 	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -326,6 +463,10 @@ func (bq *blockQueue) getEncodedBlockCert(r basics.Round) (blk []byte, cert []by
 		return
 	}
 
+	if bq.archived(r) {
+		return bq.l.blockArchive.GetEncodedCert(r)
+	}
+
 	start := time.Now()
 	ledgerGeteblockcertCount.Inc(nil)
 	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -348,6 +489,10 @@ func (bq *blockQueue) getBlockCert(r basics.Round) (blk bookkeeping.Block, cert
 		return
 	}
 
+	if bq.archived(r) {
+		return bq.l.blockArchive.GetCert(r)
+	}
+
 	start := time.Now()
 	ledgerGetblockcertCount.Inc(nil)
 	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -366,6 +511,8 @@ var ledgerSyncBlockputCount = metrics.NewCounter("ledger_blockq_sync_put_count",
 var ledgerSyncBlockputMicros = metrics.NewCounter("ledger_blockq_sync_put_micros", "µs spent to sync block queue")
 var ledgerSyncBlockforgetCount = metrics.NewCounter("ledger_blockq_sync_forget_count", "calls")
 var ledgerSyncBlockforgetMicros = metrics.NewCounter("ledger_blockq_sync_forget_micros", "µs spent")
+var ledgerArchiveSegmentCount = metrics.NewCounter("ledger_blockq_archive_segment_count", "calls to archive a segment of blocks")
+var ledgerArchiveSegmentMicros = metrics.NewCounter("ledger_blockq_archive_segment_micros", "µs spent to archive a segment of blocks")
 var ledgerGetblockCount = metrics.NewCounter("ledger_blockq_getblock_count", "calls")
 var ledgerGetblockMicros = metrics.NewCounter("ledger_blockq_getblock_micros", "µs spent")
 var ledgerGetblockhdrCount = metrics.NewCounter("ledger_blockq_getblockhdr_count", "calls")
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"sync/atomic"
 
 	"github.com/algorand/go-deadlock"
@@ -26,6 +25,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
 )
 
 // notifier is a struct that encapsulates a single-shot channel; it will only be signaled once.
@@ -116,7 +116,7 @@ func (b *bulletin) prepareCommit(dcc *deferredCommitContext) error {
 	return nil
 }
 
-func (b *bulletin) commitRound(context.Context, *sql.Tx, *deferredCommitContext) error {
+func (b *bulletin) commitRound(context.Context, store.TransactionScope, *deferredCommitContext) error {
 	return nil
 }
 
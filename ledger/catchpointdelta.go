@@ -0,0 +1,459 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/store"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/msgp/msgp"
+)
+
+// catchpointDeltaHashLength is the length of the merkle trie hashes listed in incremental catchpoint files.
+const catchpointDeltaHashLength = 4 + crypto.DigestSize
+
+// catchpointFileDeltaChunk is a chunk of an incremental catchpoint file. Balance records without account data
+// carry the changed resources of accounts whose own data didn't change.
+type catchpointFileDeltaChunk struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Balances         []encoded.BalanceRecordV6     `codec:"bl,allocbound=BalancesPerCatchpointFileChunk"`
+	KVs              []encoded.KVRecordV6          `codec:"kv,allocbound=BalancesPerCatchpointFileChunk"`
+	DeletedAccounts  []basics.Address              `codec:"da,allocbound=BalancesPerCatchpointFileChunk"`
+	DeletedResources []encoded.ResourceKeyRecordV6 `codec:"dr,allocbound=ResourcesPerCatchpointFileChunk"`
+	DeletedKVs       [][]byte                      `codec:"dk,allocbound=BalancesPerCatchpointFileChunk,allocbound=encoded.KVRecordV6MaxKeyLength"`
+	RemovedHashes    [][]byte                      `codec:"rh,allocbound=ResourcesPerCatchpointFileChunk,allocbound=catchpointDeltaHashLength"`
+	numResources     int
+}
+
+func (chunk catchpointFileDeltaChunk) empty() bool {
+	return len(chunk.Balances) == 0 && len(chunk.KVs) == 0 && len(chunk.DeletedAccounts) == 0 &&
+		len(chunk.DeletedResources) == 0 && len(chunk.DeletedKVs) == 0 && len(chunk.RemovedHashes) == 0
+}
+
+func (chunk catchpointFileDeltaChunk) full() bool {
+	return len(chunk.Balances) >= BalancesPerCatchpointFileChunk || chunk.numResources >= ResourcesPerCatchpointFileChunk ||
+		len(chunk.KVs) >= BalancesPerCatchpointFileChunk
+}
+
+// catchpointDeltaEntryKind is the kind of a catchpoint entry that is hashed into the merkle trie.
+//
+//msgp:ignore catchpointDeltaEntryKind
+type catchpointDeltaEntryKind int
+
+const (
+	catchpointDeltaAccount catchpointDeltaEntryKind = iota
+	catchpointDeltaResource
+	catchpointDeltaKV
+)
+
+// catchpointDeltaKey identifies a catchpoint entry hashed into the merkle trie.
+//
+//msgp:ignore catchpointDeltaKey
+type catchpointDeltaKey struct {
+	kind catchpointDeltaEntryKind
+	addr basics.Address
+	cidx basics.CreatableIndex
+	key  string
+}
+
+// catchpointDeltaWriter writes the chunks of an incremental catchpoint file into a data file that is later
+// repacked together with the file header.
+type catchpointDeltaWriter struct {
+	file            *os.File
+	compressor      io.WriteCloser
+	tar             *tar.Writer
+	chunk           catchpointFileDeltaChunk
+	chunkNum        uint64
+	biggestChunkLen uint64
+}
+
+func makeCatchpointDeltaWriter(dataPath string) (*catchpointDeltaWriter, error) {
+	file, err := os.OpenFile(dataPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	compressor, err := catchpointStage1Encoder(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &catchpointDeltaWriter{
+		file:       file,
+		compressor: compressor,
+		tar:        tar.NewWriter(compressor),
+	}, nil
+}
+
+// flush writes the pending chunk, if there is one.
+func (dw *catchpointDeltaWriter) flush() error {
+	if dw.chunk.empty() {
+		return nil
+	}
+	dw.chunkNum++
+	encodedChunk := protocol.Encode(&dw.chunk)
+	err := dw.tar.WriteHeader(&tar.Header{
+		Name: fmt.Sprintf("delta.%d.msgpack", dw.chunkNum),
+		Mode: 0600,
+		Size: int64(len(encodedChunk)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dw.tar.Write(encodedChunk)
+	if err != nil {
+		return err
+	}
+	if chunkLen := uint64(len(encodedChunk)); dw.biggestChunkLen < chunkLen {
+		dw.biggestChunkLen = chunkLen
+	}
+	dw.chunk = catchpointFileDeltaChunk{}
+	return nil
+}
+
+// flushIfFull writes the pending chunk once it has reached one of its size limits.
+func (dw *catchpointDeltaWriter) flushIfFull() error {
+	if !dw.chunk.full() {
+		return nil
+	}
+	return dw.flush()
+}
+
+func (dw *catchpointDeltaWriter) close() error {
+	err := dw.tar.Close()
+	if err != nil {
+		return err
+	}
+	err = dw.compressor.Close()
+	if err != nil {
+		return err
+	}
+	return dw.file.Close()
+}
+
+// MakeCatchpointDeltaFile writes into deltaFile an incremental catchpoint file that turns the content of the
+// baseFile catchpoint into the content of the catchpointFile catchpoint. It compares the merkle trie hashes of
+// the entries of both files : the incremental file holds the accounts, resources and kvs whose hashes are new,
+// the entries that were deleted, and the hashes that are no longer part of the trie. Both files have to be
+// full catchpoint files of version 6. The hashes of the base catchpoint entries are kept in memory while
+// the file is being built.
+func MakeCatchpointDeltaFile(ctx context.Context, baseFile string, catchpointFile string, deltaFile string) (header CatchpointFileHeader, err error) {
+	baseHashes := make(map[catchpointDeltaKey][]byte)
+	baseHeader, err := readCatchpointFileChunks(ctx, baseFile, func(chunk *catchpointFileChunkV6) error {
+		return addCatchpointChunkHashes(chunk, baseHashes)
+	})
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+
+	dataPath := deltaFile + ".data"
+	dw, err := makeCatchpointDeltaWriter(dataPath)
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+	defer os.Remove(dataPath)
+
+	upserted := make(map[catchpointDeltaKey]struct{})
+	var lastAddr basics.Address
+	var accountChanged, first = false, true
+	targetHeader, err := readCatchpointFileChunks(ctx, catchpointFile, func(chunk *catchpointFileChunkV6) error {
+		for _, balance := range chunk.Balances {
+			if first || balance.Address != lastAddr {
+				first = false
+				lastAddr = balance.Address
+				var accountData store.BaseAccountData
+				err := protocol.Decode(balance.AccountData, &accountData)
+				if err != nil {
+					return err
+				}
+				key := catchpointDeltaKey{kind: catchpointDeltaAccount, addr: balance.Address}
+				accountChanged = !matchCatchpointDeltaHash(baseHashes, key, store.AccountHashBuilderV6(balance.Address, &accountData, balance.AccountData))
+				if accountChanged {
+					upserted[key] = struct{}{}
+				}
+			}
+
+			record := encoded.BalanceRecordV6{Address: balance.Address, ExpectingMoreEntries: balance.ExpectingMoreEntries}
+			if accountChanged {
+				record.AccountData = balance.AccountData
+			}
+			for cidx, res := range balance.Resources {
+				var resData store.ResourcesData
+				err := protocol.Decode(res, &resData)
+				if err != nil {
+					return err
+				}
+				hash, err := store.ResourcesHashBuilderV6(&resData, balance.Address, basics.CreatableIndex(cidx), resData.UpdateRound, res)
+				if err != nil {
+					return err
+				}
+				key := catchpointDeltaKey{kind: catchpointDeltaResource, addr: balance.Address, cidx: basics.CreatableIndex(cidx)}
+				if matchCatchpointDeltaHash(baseHashes, key, hash) {
+					continue
+				}
+				upserted[key] = struct{}{}
+				if record.Resources == nil {
+					record.Resources = make(map[uint64]msgp.Raw)
+				}
+				record.Resources[cidx] = res
+			}
+			if len(record.Resources) == 0 && (!accountChanged || balance.ExpectingMoreEntries) {
+				continue
+			}
+			dw.chunk.Balances = append(dw.chunk.Balances, record)
+			dw.chunk.numResources += len(record.Resources)
+			err := dw.flushIfFull()
+			if err != nil {
+				return err
+			}
+		}
+		for _, kv := range chunk.KVs {
+			key := catchpointDeltaKey{kind: catchpointDeltaKV, key: string(kv.Key)}
+			if matchCatchpointDeltaHash(baseHashes, key, store.KvHashBuilderV6(string(kv.Key), kv.Value)) {
+				continue
+			}
+			upserted[key] = struct{}{}
+			dw.chunk.KVs = append(dw.chunk.KVs, kv)
+			err := dw.flushIfFull()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		dw.close()
+		return CatchpointFileHeader{}, err
+	}
+
+	err = writeCatchpointDeltaDeletions(dw, baseHashes, upserted)
+	if err == nil {
+		err = dw.flush()
+	}
+	closeErr := dw.close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+
+	header = targetHeader
+	header.BaseCatchpoint = baseHeader.Catchpoint
+	header.TotalChunks = dw.chunkNum
+	err = repackCatchpoint(ctx, header, dw.biggestChunkLen, dataPath, deltaFile)
+	if err != nil {
+		os.Remove(deltaFile)
+		return CatchpointFileHeader{}, err
+	}
+	return header, nil
+}
+
+// matchCatchpointDeltaHash checks whether the base catchpoint has the same hash for the given entry, and
+// removes the matched entry from baseHashes so that the entries left there are the ones that are gone.
+func matchCatchpointDeltaHash(baseHashes map[catchpointDeltaKey][]byte, key catchpointDeltaKey, hash []byte) bool {
+	baseHash, ok := baseHashes[key]
+	if !ok || !bytes.Equal(baseHash, hash) {
+		return false
+	}
+	delete(baseHashes, key)
+	return true
+}
+
+// addCatchpointChunkHashes adds the merkle trie hashes of the entries in the given chunk to hashes.
+func addCatchpointChunkHashes(chunk *catchpointFileChunkV6, hashes map[catchpointDeltaKey][]byte) error {
+	for _, balance := range chunk.Balances {
+		if !balance.ExpectingMoreEntries {
+			var accountData store.BaseAccountData
+			err := protocol.Decode(balance.AccountData, &accountData)
+			if err != nil {
+				return err
+			}
+			hashes[catchpointDeltaKey{kind: catchpointDeltaAccount, addr: balance.Address}] = store.AccountHashBuilderV6(balance.Address, &accountData, balance.AccountData)
+		}
+		for cidx, res := range balance.Resources {
+			var resData store.ResourcesData
+			err := protocol.Decode(res, &resData)
+			if err != nil {
+				return err
+			}
+			hash, err := store.ResourcesHashBuilderV6(&resData, balance.Address, basics.CreatableIndex(cidx), resData.UpdateRound, res)
+			if err != nil {
+				return err
+			}
+			hashes[catchpointDeltaKey{kind: catchpointDeltaResource, addr: balance.Address, cidx: basics.CreatableIndex(cidx)}] = hash
+		}
+	}
+	for _, kv := range chunk.KVs {
+		hashes[catchpointDeltaKey{kind: catchpointDeltaKV, key: string(kv.Key)}] = store.KvHashBuilderV6(string(kv.Key), kv.Value)
+	}
+	return nil
+}
+
+// writeCatchpointDeltaDeletions writes the base catchpoint entries that were not matched by the target catchpoint :
+// their hashes are removed from the trie, and the ones that were not replaced by a new version are deleted.
+// The entries are sorted so that the same pair of catchpoints always yields the same file.
+func writeCatchpointDeltaDeletions(dw *catchpointDeltaWriter, baseHashes map[catchpointDeltaKey][]byte, upserted map[catchpointDeltaKey]struct{}) error {
+	removedHashes := make([][]byte, 0, len(baseHashes))
+	var deletedAccounts []basics.Address
+	var deletedResources []encoded.ResourceKeyRecordV6
+	var deletedKVs [][]byte
+	for key, hash := range baseHashes {
+		removedHashes = append(removedHashes, hash)
+		if _, ok := upserted[key]; ok {
+			continue
+		}
+		switch key.kind {
+		case catchpointDeltaAccount:
+			deletedAccounts = append(deletedAccounts, key.addr)
+		case catchpointDeltaResource:
+			deletedResources = append(deletedResources, encoded.ResourceKeyRecordV6{Address: key.addr, Aidx: uint64(key.cidx)})
+		case catchpointDeltaKV:
+			deletedKVs = append(deletedKVs, []byte(key.key))
+		}
+	}
+	sort.Slice(removedHashes, func(i, j int) bool { return bytes.Compare(removedHashes[i], removedHashes[j]) < 0 })
+	sort.Slice(deletedAccounts, func(i, j int) bool { return bytes.Compare(deletedAccounts[i][:], deletedAccounts[j][:]) < 0 })
+	sort.Slice(deletedResources, func(i, j int) bool {
+		if c := bytes.Compare(deletedResources[i].Address[:], deletedResources[j].Address[:]); c != 0 {
+			return c < 0
+		}
+		return deletedResources[i].Aidx < deletedResources[j].Aidx
+	})
+	sort.Slice(deletedKVs, func(i, j int) bool { return bytes.Compare(deletedKVs[i], deletedKVs[j]) < 0 })
+
+	// the pending chunk is written first, so that the deletions get chunks of their own.
+	err := dw.flush()
+	if err != nil {
+		return err
+	}
+	for len(removedHashes) > 0 || len(deletedAccounts) > 0 || len(deletedResources) > 0 || len(deletedKVs) > 0 {
+		var n int
+		n = minInt(len(deletedResources), ResourcesPerCatchpointFileChunk)
+		dw.chunk.DeletedResources, deletedResources = deletedResources[:n], deletedResources[n:]
+		n = minInt(len(deletedAccounts), BalancesPerCatchpointFileChunk)
+		if len(deletedResources) > 0 {
+			// the staged resources are found through their account row : an account is deleted no sooner
+			// than the chunk holding its last deleted resource.
+			next := deletedResources[0].Address
+			n = sort.Search(n, func(i int) bool { return bytes.Compare(deletedAccounts[i][:], next[:]) >= 0 })
+		}
+		dw.chunk.DeletedAccounts, deletedAccounts = deletedAccounts[:n], deletedAccounts[n:]
+		n = minInt(len(deletedKVs), BalancesPerCatchpointFileChunk)
+		dw.chunk.DeletedKVs, deletedKVs = deletedKVs[:n], deletedKVs[n:]
+		n = minInt(len(removedHashes), ResourcesPerCatchpointFileChunk)
+		dw.chunk.RemovedHashes, removedHashes = removedHashes[:n], removedHashes[n:]
+		err = dw.flush()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCatchpointFileChunks reads the version 6 catchpoint file at the given path, which could be either a plain
+// tar file or a gzip compressed one, and passes each of its balances chunks to chunkFn.
+func readCatchpointFileChunks(ctx context.Context, filename string, chunkFn func(chunk *catchpointFileChunkV6) error) (header CatchpointFileHeader, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+	defer file.Close()
+
+	bufReader := bufio.NewReader(file)
+	var source io.Reader = bufReader
+	if magic, err := bufReader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(bufReader)
+		if err != nil {
+			return CatchpointFileHeader{}, fmt.Errorf("unable to decompress catchpoint file %s : %w", filename, err)
+		}
+		defer gzipReader.Close()
+		source = gzipReader
+	}
+
+	seenHeader := false
+	tarReader := tar.NewReader(source)
+	for {
+		if err = ctx.Err(); err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		tarHeader, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		sectionBytes, err := io.ReadAll(tarReader)
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		if tarHeader.Name == "content.msgpack" {
+			err = protocol.Decode(sectionBytes, &header)
+			if err != nil {
+				return CatchpointFileHeader{}, err
+			}
+			if header.Version != CatchpointFileVersionV6 {
+				return CatchpointFileHeader{}, fmt.Errorf("catchpoint file %s has version %d, while version %d is required", filename, header.Version, CatchpointFileVersionV6)
+			}
+			if header.BaseCatchpoint != "" {
+				return CatchpointFileHeader{}, fmt.Errorf("catchpoint file %s is an incremental catchpoint file", filename)
+			}
+			seenHeader = true
+			continue
+		}
+		if !seenHeader {
+			return CatchpointFileHeader{}, fmt.Errorf("catchpoint file %s does not start with its content header", filename)
+		}
+		if !strings.HasPrefix(tarHeader.Name, "balances.") || !strings.HasSuffix(tarHeader.Name, ".msgpack") {
+			continue
+		}
+		var chunk catchpointFileChunkV6
+		err = protocol.Decode(sectionBytes, &chunk)
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		err = chunkFn(&chunk)
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+	}
+	if !seenHeader {
+		return CatchpointFileHeader{}, fmt.Errorf("catchpoint file %s has no content header", filename)
+	}
+	return header, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/store"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCatchpointDeltaAfterTxns(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	dl := NewDoubleLedger(t, genBalances, protocol.ConsensusFuture)
+	defer dl.Close()
+
+	boxApp := dl.fundedApp(addrs[1], 1_000_000, boxAppSource)
+	callBox := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[2],
+		ApplicationID: boxApp,
+	}
+	boxCall := func(args ...string) *txntest.Txn {
+		call := callBox.Args(args...)
+		call.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte(args[1])}}
+		return call
+	}
+	dl.txns(boxCall("create", "xxx"), boxCall("create", "yyy"))
+
+	vb := dl.fullBlock(&txntest.Txn{
+		Type:        "acfg",
+		Sender:      addrs[3],
+		AssetParams: basics.AssetParams{Total: 1000, Manager: addrs[3]},
+	})
+	asaIndex := vb.Block().Payset[0].ApplyData.ConfigAsset
+	dl.txn(&txntest.Txn{
+		Type:          "axfer",
+		Sender:        addrs[4],
+		XferAsset:     asaIndex,
+		AssetReceiver: addrs[4],
+	})
+
+	pay := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   100000,
+	}
+	// fund enough accounts for most of the base catchpoint to remain unchanged.
+	var pays []*txntest.Txn
+	for i := 0; i < 200; i++ {
+		newacctpay := pay
+		newacctpay.Receiver = ledgertesting.RandomAddress()
+		pays = append(pays, &newacctpay)
+	}
+	closed := pays[0].Receiver
+	dl.txns(pays...)
+	for i := 0; i < 40; i++ {
+		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
+	}
+
+	tempDir := t.TempDir()
+	rdb := store.DbPairTest(dl.validator.trackerDB()).Rdb
+	baseFilePath := filepath.Join(tempDir, "base.catchpoint.tar.gz")
+	baseHeader := testWriteCatchpoint(t, rdb, filepath.Join(tempDir, "base.data"), baseFilePath, 0)
+
+	// add an account, close another one, update and delete boxes and close an asset holding.
+	added := ledgertesting.RandomAddress()
+	addpay := pay
+	addpay.Receiver = added
+	dl.txns(
+		&addpay,
+		&txntest.Txn{
+			Type:             "pay",
+			Sender:           closed,
+			Receiver:         addrs[0],
+			CloseRemainderTo: addrs[0],
+		},
+		boxCall("set", "xxx", strings.Repeat("f", 24)),
+		boxCall("delete", "yyy"),
+		&txntest.Txn{
+			Type:          "axfer",
+			Sender:        addrs[4],
+			XferAsset:     asaIndex,
+			AssetReceiver: addrs[3],
+			AssetCloseTo:  addrs[3],
+		},
+	)
+	for i := 0; i < 40; i++ {
+		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
+	}
+
+	catchpointFilePath := filepath.Join(tempDir, "target.catchpoint.tar.gz")
+	targetHeader := testWriteCatchpoint(t, rdb, filepath.Join(tempDir, "target.data"), catchpointFilePath, 0)
+
+	deltaFilePath := filepath.Join(tempDir, "delta.catchpoint.tar.gz")
+	deltaHeader, err := MakeCatchpointDeltaFile(context.Background(), baseFilePath, catchpointFilePath, deltaFilePath)
+	require.NoError(t, err)
+	require.Equal(t, baseHeader.Catchpoint, deltaHeader.BaseCatchpoint)
+	require.Equal(t, targetHeader.Catchpoint, deltaHeader.Catchpoint)
+	require.Equal(t, targetHeader.TotalAccounts, deltaHeader.TotalAccounts)
+	require.Equal(t, targetHeader.Totals, deltaHeader.Totals)
+
+	deltaInfo, err := os.Stat(deltaFilePath)
+	require.NoError(t, err)
+	catchpointInfo, err := os.Stat(catchpointFilePath)
+	require.NoError(t, err)
+	require.Less(t, deltaInfo.Size(), catchpointInfo.Size()/2)
+
+	// testNewLedgerFromCatchpoint verifies that the trie built from the base and the delta matches the target one.
+	l := testNewLedgerFromCatchpoint(t, rdb, baseFilePath, deltaFilePath)
+	defer l.Close()
+
+	v, err := l.LookupKv(l.Latest(), apps.MakeBoxKey(uint64(boxApp), "xxx"))
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("f", 24), string(v))
+	values, err := l.LookupKeysByPrefix(l.Latest(), "bx:", 10)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	ad, _, err := l.LookupWithoutRewards(0, added)
+	require.NoError(t, err)
+	require.Equal(t, basics.MicroAlgos{Raw: 100_000}, ad.MicroAlgos)
+	ad, _, err = l.LookupWithoutRewards(0, closed)
+	require.NoError(t, err)
+	require.Equal(t, basics.MicroAlgos{}, ad.MicroAlgos)
+
+	holding, err := l.LookupAsset(0, addrs[4], basics.AssetIndex(asaIndex))
+	require.NoError(t, err)
+	require.Nil(t, holding.AssetHolding)
+	holding, err = l.LookupAsset(0, addrs[3], basics.AssetIndex(asaIndex))
+	require.NoError(t, err)
+	require.NotNil(t, holding.AssetHolding)
+	require.Equal(t, uint64(1000), holding.AssetHolding.Amount)
+}
+
+func TestCatchpointDeltaDeletionsOrder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the first account has a full chunk of deleted resources, so the resource of the second one is in the
+	// next chunk, along with the deletion of the second and third accounts.
+	addrs := []basics.Address{{0x01}, {0x02}, {0x03}}
+	baseHashes := make(map[catchpointDeltaKey][]byte)
+	hash := func() []byte {
+		h := make([]byte, 8)
+		binary.BigEndian.PutUint64(h, uint64(len(baseHashes)))
+		return h
+	}
+	for _, addr := range addrs {
+		baseHashes[catchpointDeltaKey{kind: catchpointDeltaAccount, addr: addr}] = hash()
+	}
+	for cidx := 1; cidx <= ResourcesPerCatchpointFileChunk; cidx++ {
+		baseHashes[catchpointDeltaKey{kind: catchpointDeltaResource, addr: addrs[0], cidx: basics.CreatableIndex(cidx)}] = hash()
+	}
+	baseHashes[catchpointDeltaKey{kind: catchpointDeltaResource, addr: addrs[1], cidx: 1}] = hash()
+
+	dataPath := filepath.Join(t.TempDir(), "delta.data")
+	dw, err := makeCatchpointDeltaWriter(dataPath)
+	require.NoError(t, err)
+	err = writeCatchpointDeltaDeletions(dw, baseHashes, nil)
+	require.NoError(t, err)
+	err = dw.close()
+	require.NoError(t, err)
+
+	file, err := os.Open(dataPath)
+	require.NoError(t, err)
+	defer file.Close()
+	decompressor, err := catchpointStage1Decoder(file)
+	require.NoError(t, err)
+	var chunks []catchpointFileDeltaChunk
+	tarReader := tar.NewReader(decompressor)
+	for {
+		_, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		chunkBytes, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		var chunk catchpointFileDeltaChunk
+		err = protocol.Decode(chunkBytes, &chunk)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+
+	require.Len(t, chunks, 2)
+	require.Len(t, chunks[0].DeletedResources, ResourcesPerCatchpointFileChunk)
+	require.Equal(t, []basics.Address{addrs[0]}, chunks[0].DeletedAccounts)
+	require.Equal(t, []encoded.ResourceKeyRecordV6{{Address: addrs[1], Aidx: 1}}, chunks[1].DeletedResources)
+	require.Equal(t, addrs[1:], chunks[1].DeletedAccounts)
+	removed := 0
+	for _, chunk := range chunks {
+		removed += len(chunk.RemovedHashes)
+	}
+	require.Equal(t, len(baseHashes), removed)
+}
+
+func TestCatchupAccessorDeltaBaseMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 10)
+	l, err := OpenLedger(log, t.Name(), true, genesisInitState, config.GetDefaultLocal())
+	require.NoError(t, err)
+	defer l.Close()
+	catchpointAccessor := MakeCatchpointCatchupAccessor(l, log)
+	err = catchpointAccessor.ResetStagingBalances(context.Background(), true)
+	require.NoError(t, err)
+
+	delta := CatchpointFileHeader{
+		Version:        CatchpointFileVersionV6,
+		Catchpoint:     "200#QGMCMMUPV74AXXVKSNPRN73XMJG44ZJTZHU25HDG7JH5OHMM6N3Q",
+		BaseCatchpoint: "100#QGMCMMUPV74AXXVKSNPRN73XMJG44ZJTZHU25HDG7JH5OHMM6N3Q",
+	}
+
+	// an incremental catchpoint file can't be loaded on its own.
+	var progress CatchpointCatchupAccessorProgress
+	err = catchpointAccessor.ProcessStagingBalances(context.Background(), "content.msgpack", protocol.Encode(&delta), &progress)
+	require.ErrorContains(t, err, "has to follow its base catchpoint file")
+
+	// nor on top of a different catchpoint.
+	base := CatchpointFileHeader{
+		Version:    CatchpointFileVersionV6,
+		Catchpoint: "150#QGMCMMUPV74AXXVKSNPRN73XMJG44ZJTZHU25HDG7JH5OHMM6N3Q",
+	}
+	err = catchpointAccessor.ProcessStagingBalances(context.Background(), "content.msgpack", protocol.Encode(&base), &progress)
+	require.NoError(t, err)
+	err = catchpointAccessor.ProcessStagingBalances(context.Background(), "content.msgpack", protocol.Encode(&delta), &progress)
+	require.ErrorContains(t, err, "rather than to")
+
+	// and its chunks can't be processed without its header.
+	err = catchpointAccessor.ProcessStagingBalances(context.Background(), "delta.1.msgpack", []byte{0x80}, &progress)
+	require.ErrorContains(t, err, "content chunk of the incremental catchpoint file was missing")
+}
@@ -36,4 +36,8 @@ type CatchpointFileHeader struct {
 	TotalKVs          uint64                   `codec:"kvsCount"`
 	Catchpoint        string                   `codec:"catchpoint"`
 	BlockHeaderDigest crypto.Digest            `codec:"blockHeaderDigest"`
+
+	// BaseCatchpoint is the label of the catchpoint that an incremental catchpoint file applies to.
+	// It's empty for full catchpoint files.
+	BaseCatchpoint string `codec:"baseCatchpoint"`
 }
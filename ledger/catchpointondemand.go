@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ErrCatchpointTrackingDisabled is returned when a catchpoint is requested on a node that doesn't track the accounts merkle trie.
+var ErrCatchpointTrackingDisabled = errors.New("catchpoint tracking is disabled on this node; set CatchpointTracking and CatchpointInterval to enable it")
+
+// ErrCatchpointGenerationInProgress is returned when a catchpoint is requested while a previously requested one is still being generated.
+var ErrCatchpointGenerationInProgress = errors.New("a catchpoint generation is already in progress")
+
+// CatchpointGenerationStage is the stage of a catchpoint generation requested on demand
+//
+//msgp:ignore CatchpointGenerationStage
+type CatchpointGenerationStage string
+
+const (
+	// CatchpointGenerationRequested indicates that the generation waits for the next accounts commit to snapshot the accounts
+	CatchpointGenerationRequested CatchpointGenerationStage = "requested"
+	// CatchpointGenerationWritingData indicates that the accounts snapshot is being written into the catchpoint data file
+	CatchpointGenerationWritingData CatchpointGenerationStage = "writing-data"
+	// CatchpointGenerationWaitingForRound indicates that the data file is ready and the generation waits for the catchpoint round to be committed
+	CatchpointGenerationWaitingForRound CatchpointGenerationStage = "waiting-for-round"
+	// CatchpointGenerationDone indicates that the catchpoint label and file were created
+	CatchpointGenerationDone CatchpointGenerationStage = "done"
+	// CatchpointGenerationFailed indicates that the generation failed; Error holds the reason
+	CatchpointGenerationFailed CatchpointGenerationStage = "failed"
+)
+
+// CatchpointGenerationStatus reports the progress of a catchpoint generation requested on demand
+type CatchpointGenerationStatus struct {
+	Stage CatchpointGenerationStage
+	// RequestedAt is the time the generation was requested
+	RequestedAt time.Time
+	// AccountsRound is the round of the accounts snapshot, set once the snapshot is taken
+	AccountsRound basics.Round
+	// Round is the catchpoint round, which is the accounts round plus the catchpoint lookback
+	Round basics.Round
+	// TotalAccounts and TotalKVs are the number of accounts and kvs written into the data file so far
+	TotalAccounts uint64
+	TotalKVs      uint64
+	// Label is the created catchpoint label
+	Label string
+	// Error is the reason of a failed generation
+	Error string
+}
+
+func (s *CatchpointGenerationStatus) finished() bool {
+	return s.Stage == CatchpointGenerationDone || s.Stage == CatchpointGenerationFailed
+}
+
+func (s *CatchpointGenerationStatus) fail(err error) {
+	s.Stage = CatchpointGenerationFailed
+	s.Error = err.Error()
+}
+
+// RequestCatchpoint requests the generation of a catchpoint label and file for the round of the next accounts commit,
+// regardless of the catchpoint interval and of whether the node generates catchpoint files. The label is created once
+// the round following it by the catchpoint lookback is committed, and it becomes the last catchpoint label of the node.
+// Requesting a catchpoint while another one is generated returns the status of the latter and ErrCatchpointGenerationInProgress.
+func (ct *catchpointTracker) RequestCatchpoint() (CatchpointGenerationStatus, error) {
+	if !ct.catchpointEnabled() {
+		return CatchpointGenerationStatus{}, ErrCatchpointTrackingDisabled
+	}
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	if ct.onDemandCatchpoint != nil && !ct.onDemandCatchpoint.finished() {
+		return *ct.onDemandCatchpoint, ErrCatchpointGenerationInProgress
+	}
+	ct.onDemandCatchpoint = &CatchpointGenerationStatus{
+		Stage:       CatchpointGenerationRequested,
+		RequestedAt: time.Now(),
+	}
+	return *ct.onDemandCatchpoint, nil
+}
+
+// GetCatchpointGenerationStatus returns the status of the last catchpoint generation requested on demand.
+// It returns false if no catchpoint was requested since the node started.
+func (ct *catchpointTracker) GetCatchpointGenerationStatus() (CatchpointGenerationStatus, bool) {
+	ct.catchpointsMu.RLock()
+	defer ct.catchpointsMu.RUnlock()
+	if ct.onDemandCatchpoint == nil {
+		return CatchpointGenerationStatus{}, false
+	}
+	return *ct.onDemandCatchpoint, true
+}
+
+// produceOnDemandCatchpointTask determines whether the given commit range takes the accounts snapshot of the requested
+// catchpoint ( firstStage ), or includes its catchpoint round ( round ).
+func (ct *catchpointTracker) produceOnDemandCatchpointTask(dbRound basics.Round, dcr *deferredCommitRange, reenableCatchpointsRound basics.Round) (firstStage bool, round basics.Round) {
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	status := ct.onDemandCatchpoint
+	if status == nil {
+		return false, 0
+	}
+	newBase := dcr.oldBase + basics.Round(dcr.offset)
+	switch status.Stage {
+	case CatchpointGenerationWritingData:
+		// no data file is being written at this point. If the commit that was meant to take the snapshot
+		// didn't make it to the database, take it again; otherwise, it's being completed.
+		if dbRound >= status.AccountsRound {
+			return false, 0
+		}
+		status.Stage = CatchpointGenerationRequested
+		fallthrough
+	case CatchpointGenerationRequested:
+		// same as for the periodic catchpoints, avoid generating catchpoints prior to the reenableCatchpointsRound.
+		if reenableCatchpointsRound == 0 || newBase+basics.Round(dcr.catchpointLookback) < reenableCatchpointsRound {
+			return false, 0
+		}
+		status.Stage = CatchpointGenerationWritingData
+		status.AccountsRound = newBase
+		status.Round = newBase + basics.Round(dcr.catchpointLookback)
+		status.TotalAccounts = 0
+		status.TotalKVs = 0
+		return true, 0
+	case CatchpointGenerationWaitingForRound:
+		if status.Round > dcr.oldBase && status.Round <= newBase {
+			return false, status.Round
+		}
+	}
+	return false, 0
+}
+
+// insertOnDemandCatchpointRound adds the requested catchpoint round to the sorted catchpoint rounds of the commit range.
+func insertOnDemandCatchpointRound(rounds []basics.Round, round basics.Round) []basics.Round {
+	i := sort.Search(len(rounds), func(i int) bool { return rounds[i] >= round })
+	if i < len(rounds) && rounds[i] == round {
+		return rounds
+	}
+	rounds = append(rounds, 0)
+	copy(rounds[i+1:], rounds[i:])
+	rounds[i] = round
+	return rounds
+}
+
+// updateOnDemandCatchpointProgress updates the number of accounts and kvs written into the data file of the requested catchpoint.
+func (ct *catchpointTracker) updateOnDemandCatchpointProgress(accountsRound basics.Round, totalAccounts, totalKVs uint64) {
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	status := ct.onDemandCatchpoint
+	if status == nil || status.Stage != CatchpointGenerationWritingData || status.AccountsRound != accountsRound {
+		return
+	}
+	status.TotalAccounts = totalAccounts
+	status.TotalKVs = totalKVs
+}
+
+// finishOnDemandFirstStage records the outcome of the accounts snapshot of the requested catchpoint. The snapshot round
+// is the one of the actual commit, which might be earlier than the one planned by produceOnDemandCatchpointTask.
+func (ct *catchpointTracker) finishOnDemandFirstStage(accountsRound basics.Round, catchpointLookback uint64, err error) {
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	status := ct.onDemandCatchpoint
+	if status == nil || status.Stage != CatchpointGenerationWritingData {
+		return
+	}
+	if err != nil {
+		status.fail(fmt.Errorf("unable to write the catchpoint data file for round %d : %w", accountsRound, err))
+		return
+	}
+	status.Stage = CatchpointGenerationWaitingForRound
+	status.AccountsRound = accountsRound
+	status.Round = accountsRound + basics.Round(catchpointLookback)
+}
+
+// finishOnDemandCatchpoint records the outcome of the creation of the requested catchpoint.
+func (ct *catchpointTracker) finishOnDemandCatchpoint(round basics.Round, err error) {
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	status := ct.onDemandCatchpoint
+	if status == nil || status.Stage != CatchpointGenerationWaitingForRound || status.Round != round {
+		return
+	}
+	if err == nil {
+		labelRound, _, parseErr := ledgercore.ParseCatchpointLabel(ct.lastCatchpointLabel)
+		if parseErr != nil || labelRound != round {
+			err = fmt.Errorf("no catchpoint label was created for round %d", round)
+		}
+	}
+	if err != nil {
+		status.fail(err)
+		return
+	}
+	status.Stage = CatchpointGenerationDone
+	status.Label = ct.lastCatchpointLabel
+}
+
+// cancelOnDemandFirstStage returns the requested catchpoint to the requested stage when the commit that was meant
+// to take its accounts snapshot was abandoned.
+func (ct *catchpointTracker) cancelOnDemandFirstStage() {
+	ct.catchpointsMu.Lock()
+	defer ct.catchpointsMu.Unlock()
+	status := ct.onDemandCatchpoint
+	if status == nil || status.Stage != CatchpointGenerationWritingData {
+		return
+	}
+	status.Stage = CatchpointGenerationRequested
+	status.AccountsRound = 0
+	status.Round = 0
+}
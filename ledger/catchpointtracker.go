@@ -45,7 +45,6 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
-	"github.com/algorand/go-algorand/util/db"
 )
 
 const (
@@ -103,7 +102,7 @@ type catchpointTracker struct {
 	log logging.Logger
 
 	// Connection to the database.
-	dbs             db.Pair
+	dbs             store.TrackerStore
 	catchpointStore catchpointStore
 
 	// The last catchpoint label that was written to the database. Should always align with what's in the database.
@@ -142,8 +141,12 @@ type catchpointTracker struct {
 	// catchpoint files even before the protocol upgrade took place.
 	forceCatchpointFileWriting bool
 
-	// catchpointsMu protects `roundDigest`, `reenableCatchpointsRound` and
-	// `lastCatchpointLabel`.
+	// onDemandCatchpoint is the status of the last catchpoint generation requested
+	// through RequestCatchpoint, or nil if none was requested.
+	onDemandCatchpoint *CatchpointGenerationStatus
+
+	// catchpointsMu protects `roundDigest`, `reenableCatchpointsRound`,
+	// `lastCatchpointLabel` and `onDemandCatchpoint`.
 	catchpointsMu deadlock.RWMutex
 }
 
@@ -194,7 +197,7 @@ func (ct *catchpointTracker) GetLastCatchpointLabel() string {
 	return ct.lastCatchpointLabel
 }
 
-func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, updatingBalancesDuration time.Duration) error {
+func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, updatingBalancesDuration time.Duration, generateCatchpointFile bool) error {
 	ct.log.Infof("finishing catchpoint's first stage dbRound: %d", dbRound)
 
 	var totalKVs uint64
@@ -202,7 +205,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 	var totalChunks uint64
 	var biggestChunkLen uint64
 
-	if ct.enableGeneratingCatchpointFiles {
+	if generateCatchpointFile {
 		// Generate the catchpoint file. This is done inline so that it will
 		// block any new accounts from being written. generateCatchpointData()
 		// expects that the accounts data would not be modified in the
@@ -216,9 +219,13 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		}
 	}
 
-	f := func(ctx context.Context, tx *sql.Tx) error {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		err := ct.recordFirstStageInfo(ctx, tx, dbRound, totalKVs, totalAccounts, totalChunks, biggestChunkLen)
+	f := func(ctx context.Context, tx store.TransactionScope) error {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		err = ct.recordFirstStageInfo(ctx, tx, dbRound, totalKVs, totalAccounts, totalChunks, biggestChunkLen)
 		if err != nil {
 			return err
 		}
@@ -226,7 +233,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		// Clear the db record.
 		return crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateWritingFirstStageInfo, 0)
 	}
-	return ct.dbs.Wdb.Atomic(f)
+	return ct.dbs.Transaction(f)
 }
 
 // Possibly finish generating first stage catchpoint db record and data file after
@@ -250,7 +257,7 @@ func (ct *catchpointTracker) finishFirstStageAfterCrash(dbRound basics.Round) er
 		return err
 	}
 
-	return ct.finishFirstStage(context.Background(), dbRound, 0)
+	return ct.finishFirstStage(context.Background(), dbRound, 0, ct.enableGeneratingCatchpointFiles)
 }
 
 func (ct *catchpointTracker) finishCatchpointsAfterCrash(catchpointLookback uint64) error {
@@ -270,7 +277,7 @@ func (ct *catchpointTracker) finishCatchpointsAfterCrash(catchpointLookback uint
 		}
 
 		err = ct.finishCatchpoint(
-			context.Background(), record.Round, record.BlockHash, catchpointLookback)
+			context.Background(), record.Round, record.BlockHash, catchpointLookback, false)
 		if err != nil {
 			return err
 		}
@@ -319,7 +326,10 @@ func (ct *catchpointTracker) recoverFromCrash(dbRound basics.Round) error {
 func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) (err error) {
 	ct.log = l.trackerLog()
 	ct.dbs = l.trackerDB()
-	ct.catchpointStore = store.NewCatchpointSQLReaderWriter(l.trackerDB().Wdb.Handle)
+	ct.catchpointStore, err = l.trackerDB().MakeCatchpointReaderWriter()
+	if err != nil {
+		return err
+	}
 
 	ct.roundDigest = nil
 	ct.catchpointDataWriting = 0
@@ -327,14 +337,14 @@ func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Rou
 	ct.catchpointDataSlowWriting = make(chan struct{}, 1)
 	close(ct.catchpointDataSlowWriting)
 
-	err = ct.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = ct.dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) error {
 		return ct.initializeHashes(ctx, tx, dbRound)
 	})
 	if err != nil {
 		return err
 	}
 
-	ct.accountsq, err = store.AccountsInitDbQueries(ct.dbs.Rdb.Handle)
+	ct.accountsq, err = ct.dbs.MakeAccountsOptimizedReader()
 	if err != nil {
 		return
 	}
@@ -448,26 +458,32 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 		return nil
 	}
 
-	if hasIntermediateFirstStageRound {
+	// a catchpoint requested on demand always produces a catchpoint file.
+	onDemandFirstStage, onDemandRound := ct.produceOnDemandCatchpointTask(dbRound, dcr, reenableCatchpointsRound)
+	generateCatchpointFiles := ct.enableGeneratingCatchpointFiles || onDemandFirstStage
+
+	if hasIntermediateFirstStageRound || onDemandFirstStage {
 		dcr.catchpointFirstStage = true
 
-		if ct.enableGeneratingCatchpointFiles {
+		if generateCatchpointFiles {
 			// store non-zero ( all ones ) into the catchpointWriting atomic variable to indicate that a catchpoint is being written ( or, queued to be written )
 			atomic.StoreInt32(&ct.catchpointDataWriting, int32(-1))
 			ct.catchpointDataSlowWriting = make(chan struct{}, 1)
-			if hasMultipleIntermediateFirstStageRounds {
+			if hasMultipleIntermediateFirstStageRounds || onDemandFirstStage {
 				close(ct.catchpointDataSlowWriting)
 			}
 		}
 	}
 
 	dcr.catchpointDataWriting = &ct.catchpointDataWriting
-	dcr.enableGeneratingCatchpointFiles = ct.enableGeneratingCatchpointFiles
+	dcr.enableGeneratingCatchpointFiles = generateCatchpointFiles
+	dcr.catchpointOnDemandFirstStage = onDemandFirstStage
+	dcr.catchpointOnDemandRound = onDemandRound
 
 	{
 		rounds := calculateCatchpointRounds(
 			dcr.oldBase+1, dcr.oldBase+basics.Round(dcr.offset), ct.catchpointInterval)
-		dcr.catchpointSecondStage = (len(rounds) > 0)
+		dcr.catchpointSecondStage = (len(rounds) > 0) || (onDemandRound != 0)
 	}
 
 	return dcr
@@ -485,24 +501,34 @@ func (ct *catchpointTracker) prepareCommit(dcc *deferredCommitContext) error {
 	return nil
 }
 
-func (ct *catchpointTracker) commitRound(ctx context.Context, tx *sql.Tx, dcc *deferredCommitContext) (err error) {
+func (ct *catchpointTracker) commitRound(ctx context.Context, tx store.TransactionScope, dcc *deferredCommitContext) (err error) {
 	treeTargetRound := basics.Round(0)
 	offset := dcc.offset
 	dbRound := dcc.oldBase
 
 	defer func() {
 		if err != nil && dcc.catchpointFirstStage &&
-			ct.enableGeneratingCatchpointFiles {
+			dcc.enableGeneratingCatchpointFiles {
 			atomic.StoreInt32(&ct.catchpointDataWriting, 0)
 		}
+		if err != nil && dcc.catchpointOnDemandFirstStage {
+			ct.cancelOnDemandFirstStage()
+		}
 	}()
 
-	crw := store.NewCatchpointSQLReaderWriter(tx)
-	arw := store.NewAccountsSQLReaderWriter(tx)
+	crw, err := tx.MakeCatchpointReaderWriter()
+	if err != nil {
+		return err
+	}
+
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 
 	if ct.catchpointEnabled() {
-		var mc *store.MerkleCommitter
-		mc, err = store.MakeMerkleCommitter(tx, false)
+		var mc store.MerkleCommitter
+		mc, err = tx.MakeMerkleCommitter(false)
 		if err != nil {
 			return
 		}
@@ -709,7 +735,8 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 
 // Create a catchpoint (a label and possibly a file with db record) and remove
 // the unfinished catchpoint record.
-func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound basics.Round, round basics.Round, dataInfo store.CatchpointFirstStageInfo, blockHash crypto.Digest) error {
+// A catchpoint requested on demand gets a file even when the node doesn't generate catchpoint files.
+func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound basics.Round, round basics.Round, dataInfo store.CatchpointFirstStageInfo, blockHash crypto.Digest, onDemand bool) error {
 	startTime := time.Now()
 	label := ledgercore.MakeCatchpointLabel(
 		round, blockHash, dataInfo.TrieBalancesHash, dataInfo.Totals).String()
@@ -728,7 +755,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 	ct.lastCatchpointLabel = label
 	ct.catchpointsMu.Unlock()
 
-	if !ct.enableGeneratingCatchpointFiles {
+	if !ct.enableGeneratingCatchpointFiles && !onDemand {
 		return nil
 	}
 
@@ -777,9 +804,13 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		return err
 	}
 
-	err = ct.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		err = ct.recordCatchpointFile(ctx, tx, round, relCatchpointFilePath, fileInfo.Size())
+	err = ct.dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		err = ct.recordCatchpointFile(ctx, crw, round, relCatchpointFilePath, fileInfo.Size(), onDemand)
 		if err != nil {
 			return err
 		}
@@ -802,7 +833,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 
 // Try create a catchpoint (a label and possibly a file with db record) and remove
 // the unfinished catchpoint record.
-func (ct *catchpointTracker) finishCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest, catchpointLookback uint64) error {
+func (ct *catchpointTracker) finishCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest, catchpointLookback uint64, onDemand bool) error {
 	accountsRound := round - basics.Round(catchpointLookback)
 
 	ct.log.Infof("finishing catchpoint round: %d accountsRound: %d", round, accountsRound)
@@ -815,7 +846,7 @@ func (ct *catchpointTracker) finishCatchpoint(ctx context.Context, round basics.
 	if !exists {
 		return ct.catchpointStore.DeleteUnfinishedCatchpoint(ctx, round)
 	}
-	return ct.createCatchpoint(ctx, accountsRound, round, dataInfo, blockHash)
+	return ct.createCatchpoint(ctx, accountsRound, round, dataInfo, blockHash, onDemand)
 }
 
 // Calculate catchpoint round numbers in [min, max]. `catchpointInterval` must be
@@ -845,7 +876,11 @@ func (ct *catchpointTracker) calculateCatchpointRounds(dcc *deferredCommitContex
 	if dcc.catchpointLookback+1 > uint64(min) {
 		min = basics.Round(dcc.catchpointLookback) + 1
 	}
-	return calculateCatchpointRounds(min, dcc.newBase, ct.catchpointInterval)
+	rounds := calculateCatchpointRounds(min, dcc.newBase, ct.catchpointInterval)
+	if dcc.catchpointOnDemandRound >= min && dcc.catchpointOnDemandRound <= dcc.newBase {
+		rounds = insertOnDemandCatchpointRound(rounds, dcc.catchpointOnDemandRound)
+	}
+	return rounds
 }
 
 // Delete old first stage catchpoint records and data files.
@@ -869,21 +904,28 @@ func (ct *catchpointTracker) pruneFirstStageRecordsData(ctx context.Context, max
 
 func (ct *catchpointTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
 	if dcc.catchpointFirstStage {
-		err := ct.finishFirstStage(ctx, dcc.newBase, dcc.updatingBalancesDuration)
+		err := ct.finishFirstStage(ctx, dcc.newBase, dcc.updatingBalancesDuration, dcc.enableGeneratingCatchpointFiles)
 		if err != nil {
 			ct.log.Warnf(
 				"error finishing catchpoint's first stage dcc.newBase: %d err: %v",
 				dcc.newBase, err)
 		}
+		if dcc.catchpointOnDemandFirstStage {
+			ct.finishOnDemandFirstStage(dcc.newBase, dcc.catchpointLookback, err)
+		}
 	}
 
 	// Generate catchpoints for rounds in (dcc.oldBase, dcc.newBase].
 	for _, round := range ct.calculateCatchpointRounds(dcc) {
+		onDemand := round == dcc.catchpointOnDemandRound
 		err := ct.finishCatchpoint(
-			ctx, round, dcc.committedRoundDigests[round-dcc.oldBase-1], dcc.catchpointLookback)
+			ctx, round, dcc.committedRoundDigests[round-dcc.oldBase-1], dcc.catchpointLookback, onDemand)
 		if err != nil {
 			ct.log.Warnf("error creating catchpoint round: %d err: %v", round, err)
 		}
+		if onDemand {
+			ct.finishOnDemandCatchpoint(round, err)
+		}
 	}
 
 	// Prune first stage catchpoint records from the database.
@@ -903,13 +945,16 @@ func (ct *catchpointTracker) postCommitUnlocked(ctx context.Context, dcc *deferr
 // scheduled catchpoint writing that deferred commit.
 func (ct *catchpointTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
 	// if the node is configured to generate catchpoint files, we might need to update the catchpointWriting variable.
-	if ct.enableGeneratingCatchpointFiles {
+	if dcc.enableGeneratingCatchpointFiles {
 		// determine if this was a catchpoint round
 		if dcc.catchpointFirstStage {
 			// it was a catchpoint round, so update the catchpointWriting to indicate that we're done.
 			atomic.StoreInt32(&ct.catchpointDataWriting, 0)
 		}
 	}
+	if dcc.catchpointOnDemandFirstStage {
+		ct.cancelOnDemandFirstStage()
+	}
 }
 
 // close terminates the tracker, reclaiming any resources
@@ -1090,7 +1135,7 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, account
 	var catchpointWriter *catchpointWriter
 	start := time.Now()
 	ledgerGeneratecatchpointCount.Inc(nil)
-	err = ct.dbs.Rdb.AtomicContext(ctx, func(dbCtx context.Context, tx *sql.Tx) (err error) {
+	err = ct.dbs.SnapshotContext(ctx, func(dbCtx context.Context, tx store.SnapshotScope) (err error) {
 		catchpointWriter, err = makeCatchpointWriter(dbCtx, catchpointDataFilePath, tx, ResourcesPerCatchpointFileChunk)
 		if err != nil {
 			return
@@ -1102,11 +1147,12 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, account
 			// accumulate the actual time we've spent writing in this step.
 			catchpointGenerationStats.CPUTime += uint64(time.Since(writeStepStartTime).Nanoseconds())
 			stepCancelFunction()
+			ct.updateOnDemandCatchpointProgress(accountsRound, catchpointWriter.totalAccounts, catchpointWriter.totalKVs)
 			if more && err == nil {
 				// we just wrote some data, but there is more to be written.
 				// go to sleep for while.
 				// before going to sleep, extend the transaction timeout so that we won't get warnings:
-				_, err0 := db.ResetTransactionWarnDeadline(dbCtx, tx, time.Now().Add(1*time.Second))
+				_, err0 := tx.ResetTransactionWarnDeadline(dbCtx, time.Now().Add(1*time.Second))
 				if err0 != nil {
 					ct.log.Warnf("catchpointTracker: generateCatchpoint: failed to reset transaction warn deadline : %v", err0)
 				}
@@ -1166,15 +1212,19 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, account
 	return catchpointWriter.totalKVs, catchpointWriter.totalAccounts, catchpointWriter.chunkNum, catchpointWriter.biggestChunkLen, nil
 }
 
-func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx *sql.Tx, accountsRound basics.Round, totalKVs uint64, totalAccounts uint64, totalChunks uint64, biggestChunkLen uint64) error {
-	arw := store.NewAccountsSQLReaderWriter(tx)
+func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx store.TransactionScope, accountsRound basics.Round, totalKVs uint64, totalAccounts uint64, totalChunks uint64, biggestChunkLen uint64) error {
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
+
 	accountTotals, err := arw.AccountsTotals(ctx, false)
 	if err != nil {
 		return err
 	}
 
 	{
-		mc, err := store.MakeMerkleCommitter(tx, false)
+		mc, err := tx.MakeMerkleCommitter(false)
 		if err != nil {
 			return err
 		}
@@ -1193,7 +1243,11 @@ func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx *sql.T
 		return err
 	}
 
-	crw := store.NewCatchpointSQLReaderWriter(tx)
+	crw, err := tx.MakeCatchpointReaderWriter()
+	if err != nil {
+		return err
+	}
+
 	info := store.CatchpointFirstStageInfo{
 		Totals:           accountTotals,
 		TotalAccounts:    totalAccounts,
@@ -1213,9 +1267,13 @@ func makeCatchpointDataFilePath(accountsRound basics.Round) string {
 // after a successful insert operation to the database, it would delete up to 2 old entries, as needed.
 // deleting 2 entries while inserting single entry allow us to adjust the size of the backing storage and have the
 // database and storage realign.
-func (ct *catchpointTracker) recordCatchpointFile(ctx context.Context, e db.Executable, round basics.Round, relCatchpointFilePath string, fileSize int64) (err error) {
-	crw := store.NewCatchpointSQLReaderWriter(e)
-	if ct.catchpointFileHistoryLength != 0 {
+// The file of a catchpoint requested on demand is stored even if the node doesn't keep any catchpoint files history.
+func (ct *catchpointTracker) recordCatchpointFile(ctx context.Context, crw catchpointStore, round basics.Round, relCatchpointFilePath string, fileSize int64, onDemand bool) (err error) {
+	catchpointFileHistoryLength := ct.catchpointFileHistoryLength
+	if onDemand && catchpointFileHistoryLength == 0 {
+		catchpointFileHistoryLength = 1
+	}
+	if catchpointFileHistoryLength != 0 {
 		err = crw.StoreCatchpoint(ctx, round, relCatchpointFilePath, "", fileSize)
 		if err != nil {
 			ct.log.Warnf("catchpointTracker.recordCatchpointFile() unable to save catchpoint: %v", err)
@@ -1228,11 +1286,11 @@ func (ct *catchpointTracker) recordCatchpointFile(ctx context.Context, e db.Exec
 			return
 		}
 	}
-	if ct.catchpointFileHistoryLength == -1 {
+	if catchpointFileHistoryLength == -1 {
 		return
 	}
 	var filesToDelete map[basics.Round]string
-	filesToDelete, err = crw.GetOldestCatchpointFiles(ctx, 2, ct.catchpointFileHistoryLength)
+	filesToDelete, err = crw.GetOldestCatchpointFiles(ctx, 2, catchpointFileHistoryLength)
 	if err != nil {
 		return fmt.Errorf("unable to delete catchpoint file, getOldestCatchpointFiles failed : %v", err)
 	}
@@ -1257,9 +1315,13 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 	ledgerGetcatchpointCount.Inc(nil)
 	// TODO: we need to generalize this, check @cce PoC PR, he has something
 	//       somewhat broken for some KVs..
-	err := ct.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		dbFileName, _, fileSize, err = crw.GetCatchpoint(ctx, round)
+	err := ct.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) (err error) {
+		cr, err := tx.MakeCatchpointReader()
+		if err != nil {
+			return err
+		}
+
+		dbFileName, _, fileSize, err = cr.GetCatchpoint(ctx, round)
 		return
 	})
 	ledgerGetcatchpointMicros.AddMicrosecondsSince(start, nil)
@@ -1278,7 +1340,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 			// the database told us that we have this file.. but we couldn't find it.
 			// delete it from the database.
 			err := ct.recordCatchpointFile(
-				context.Background(), ct.dbs.Wdb.Handle, round, "", 0)
+				context.Background(), ct.catchpointStore, round, "", 0, false)
 			if err != nil {
 				ct.log.Warnf("catchpointTracker.GetCatchpointStream() unable to delete missing catchpoint entry: %v", err)
 				return nil, err
@@ -1304,8 +1366,8 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		}
 
 		err = ct.recordCatchpointFile(
-			context.Background(), ct.dbs.Wdb.Handle, round, relCatchpointFilePath,
-			fileInfo.Size())
+			context.Background(), ct.catchpointStore, round, relCatchpointFilePath,
+			fileInfo.Size(), false)
 		if err != nil {
 			ct.log.Warnf("catchpointTracker.GetCatchpointStream() unable to save missing catchpoint entry: %v", err)
 		}
@@ -1320,8 +1382,12 @@ func (ct *catchpointTracker) catchpointEnabled() bool {
 
 // initializeHashes initializes account/resource/kv hashes.
 // as part of the initialization, it tests if a hash table matches to account base and updates the former.
-func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx *sql.Tx, rnd basics.Round) error {
-	arw := store.NewAccountsSQLReaderWriter(tx)
+func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx store.TransactionScope, rnd basics.Round) error {
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
+
 	hashRound, err := arw.AccountsHashRound(ctx)
 	if err != nil {
 		return err
@@ -1341,7 +1407,7 @@ func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx *sql.Tx, r
 	}
 
 	// create the merkle trie for the balances
-	committer, err := store.MakeMerkleCommitter(tx, false)
+	committer, err := tx.MakeMerkleCommitter(false)
 	if err != nil {
 		return fmt.Errorf("initializeHashes was unable to makeMerkleCommitter: %v", err)
 	}
@@ -1360,7 +1426,7 @@ func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx *sql.Tx, r
 
 	if rootHash.IsZero() {
 		ct.log.Infof("initializeHashes rebuilding merkle trie for round %d", rnd)
-		accountBuilderIt := store.MakeOrderedAccountsIter(tx, trieRebuildAccountChunkSize)
+		accountBuilderIt := tx.MakeOrderedAccountsIter(trieRebuildAccountChunkSize)
 		defer accountBuilderIt.Close(ctx)
 		startTrieBuildTime := time.Now()
 		trieHashCount := 0
@@ -1431,7 +1497,7 @@ func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx *sql.Tx, r
 
 		// Now add the kvstore hashes
 		pendingTrieHashes = 0
-		kvs, err := store.MakeKVsIter(ctx, tx)
+		kvs, err := tx.MakeKVsIter(ctx)
 		if err != nil {
 			return err
 		}
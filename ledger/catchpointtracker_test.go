@@ -302,7 +302,7 @@ func TestRecordCatchpointFile(t *testing.T) {
 			context.Background(), accountsRound, time.Second)
 		require.NoError(t, err)
 
-		err = ct.createCatchpoint(context.Background(), accountsRound, round, store.CatchpointFirstStageInfo{BiggestChunkLen: biggestChunkLen}, crypto.Digest{})
+		err = ct.createCatchpoint(context.Background(), accountsRound, round, store.CatchpointFirstStageInfo{BiggestChunkLen: biggestChunkLen}, crypto.Digest{}, false)
 		require.NoError(t, err)
 	}
 
@@ -366,7 +366,7 @@ func BenchmarkLargeCatchpointDataWriting(b *testing.B) {
 				i++
 			}
 
-			_, _, _, err = accountsNewRound(tx, updates, compactResourcesDeltas{}, nil, nil, proto, basics.Round(1))
+			_, _, _, err = accountsNewRound(store.MakeTransactionScopeTest(tx), updates, compactResourcesDeltas{}, nil, nil, proto, basics.Round(1))
 			if err != nil {
 				return
 			}
@@ -580,7 +580,7 @@ func (bt *blockingTracker) prepareCommit(*deferredCommitContext) error {
 }
 
 // commitRound is not used by the blockingTracker
-func (bt *blockingTracker) commitRound(context.Context, *sql.Tx, *deferredCommitContext) error {
+func (bt *blockingTracker) commitRound(context.Context, store.TransactionScope, *deferredCommitContext) error {
 	return nil
 }
 
@@ -1482,3 +1482,117 @@ func TestHashContract(t *testing.T) {
 		}
 	}
 }
+
+// TestOnDemandCatchpoint tests that a catchpoint requested on demand gets a label and a file
+// on a node that neither reaches a catchpoint interval round nor generates catchpoint files.
+func TestOnDemandCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testProtocolVersion :=
+		protocol.ConsensusVersion("test-protocol-TestOnDemandCatchpoint")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 16
+	protoParams.EnableOnlineAccountCatchpoints = true
+	config.Consensus[testProtocolVersion] = protoParams
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+
+	ml := makeMockLedgerForTracker(t, false, 1, testProtocolVersion, accts)
+	defer ml.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 1000
+	cfg.CatchpointTracking = 1
+	cfg.CatchpointFileHistoryLength = 0
+	ct := newCatchpointTracker(t, ml, cfg, ".")
+	defer ct.close()
+	require.False(t, ct.enableGeneratingCatchpointFiles)
+
+	ct.dbDirectory = t.TempDir()
+
+	_, requested := ct.GetCatchpointGenerationStatus()
+	require.False(t, requested)
+
+	addBlock := func(rnd basics.Round) {
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: rnd,
+				UpgradeState: bookkeeping.UpgradeState{
+					CurrentProtocol: testProtocolVersion,
+				},
+			},
+		}
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 0, 0)
+		ml.trackers.newBlock(blk, delta)
+		ml.trackers.committedUpTo(rnd)
+		ml.addMockBlock(blockEntry{block: blk}, delta)
+		ml.trackers.waitAccountsWriting()
+		for ct.IsWritingCatchpointDataFile() {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	rnd := basics.Round(0)
+	for ; rnd < 10; rnd++ {
+		addBlock(rnd + 1)
+	}
+	require.Empty(t, ct.GetLastCatchpointLabel())
+
+	status, err := ct.RequestCatchpoint()
+	require.NoError(t, err)
+	require.Equal(t, CatchpointGenerationRequested, status.Stage)
+
+	status, err = ct.RequestCatchpoint()
+	require.ErrorIs(t, err, ErrCatchpointGenerationInProgress)
+	require.Equal(t, CatchpointGenerationRequested, status.Stage)
+
+	for ; rnd < 100; rnd++ {
+		addBlock(rnd + 1)
+		status, requested = ct.GetCatchpointGenerationStatus()
+		require.True(t, requested)
+		require.NotEqual(t, CatchpointGenerationFailed, status.Stage, status.Error)
+		if status.Stage == CatchpointGenerationDone {
+			break
+		}
+	}
+	require.Equal(t, CatchpointGenerationDone, status.Stage)
+	require.NotZero(t, status.AccountsRound)
+	require.Equal(t, status.AccountsRound+basics.Round(protoParams.CatchpointLookback), status.Round)
+	require.NotZero(t, status.TotalAccounts)
+	require.Equal(t, ct.GetLastCatchpointLabel(), status.Label)
+
+	labelRound, _, err := ledgercore.ParseCatchpointLabel(status.Label)
+	require.NoError(t, err)
+	require.Equal(t, status.Round, labelRound)
+
+	stream, err := ct.GetCatchpointStream(status.Round)
+	require.NoError(t, err)
+	size, err := stream.Size()
+	require.NoError(t, err)
+	require.NotZero(t, size)
+	stream.Close()
+
+	// another catchpoint could be requested once the previous one is done.
+	status, err = ct.RequestCatchpoint()
+	require.NoError(t, err)
+	require.Equal(t, CatchpointGenerationRequested, status.Stage)
+}
+
+func TestOnDemandCatchpointTrackingDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+	ml := makeMockLedgerForTracker(t, false, 1, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointTracking = -1
+	ct := newCatchpointTracker(t, ml, cfg, ".")
+	defer ct.close()
+
+	_, err := ct.RequestCatchpoint()
+	require.ErrorIs(t, err, ErrCatchpointTrackingDisabled)
+}
@@ -19,7 +19,6 @@ package ledger
 import (
 	"archive/tar"
 	"context"
-	"database/sql"
 	"fmt"
 	"io"
 	"os"
@@ -47,7 +46,7 @@ const (
 // has the option of throttling the CPU utilization in between the calls.
 type catchpointWriter struct {
 	ctx                  context.Context
-	tx                   *sql.Tx
+	tx                   store.SnapshotScope
 	filePath             string
 	totalAccounts        uint64
 	totalKVs             uint64
@@ -58,21 +57,10 @@ type catchpointWriter struct {
 	chunkNum             uint64
 	writtenBytes         int64
 	biggestChunkLen      uint64
-	accountsIterator     accountsBatchIter
+	accountsIterator     store.EncodedAccountsBatchIter
 	maxResourcesPerChunk int
 	accountsDone         bool
-	kvRows               kvIter
-}
-
-type kvIter interface {
-	Next() bool
-	KeyValue() ([]byte, []byte, error)
-	Close()
-}
-
-type accountsBatchIter interface {
-	Next(ctx context.Context, tx *sql.Tx, accountCount int, resourceCount int) ([]encoded.BalanceRecordV6, uint64, error)
-	Close()
+	kvRows               store.KVsIter
 }
 
 type catchpointFileBalancesChunkV5 struct {
@@ -92,8 +80,11 @@ func (chunk catchpointFileChunkV6) empty() bool {
 	return len(chunk.Balances) == 0 && len(chunk.KVs) == 0
 }
 
-func makeCatchpointWriter(ctx context.Context, filePath string, tx *sql.Tx, maxResourcesPerChunk int) (*catchpointWriter, error) {
-	arw := store.NewAccountsSQLReaderWriter(tx)
+func makeCatchpointWriter(ctx context.Context, filePath string, tx store.SnapshotScope, maxResourcesPerChunk int) (*catchpointWriter, error) {
+	arw, err := tx.MakeAccountsReader()
+	if err != nil {
+		return nil, err
+	}
 
 	totalAccounts, err := arw.TotalAccounts(ctx)
 	if err != nil {
@@ -128,7 +119,7 @@ func makeCatchpointWriter(ctx context.Context, filePath string, tx *sql.Tx, maxR
 		file:                 file,
 		compressor:           compressor,
 		tar:                  tar,
-		accountsIterator:     store.MakeEncodedAccoutsBatchIter(),
+		accountsIterator:     tx.MakeEncodedAccoutsBatchIter(),
 		maxResourcesPerChunk: maxResourcesPerChunk,
 	}
 	return res, nil
@@ -268,9 +259,9 @@ func (cw *catchpointWriter) asyncWriter(chunks chan catchpointFileChunkV6, respo
 // all of the account chunks first, and then the kv chunks. Even if the accounts
 // are evenly divisible by BalancesPerCatchpointFileChunk, it must not return an
 // empty chunk between accounts and kvs.
-func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) error {
+func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx store.SnapshotScope) error {
 	if !cw.accountsDone {
-		balances, numAccounts, err := cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk, cw.maxResourcesPerChunk)
+		balances, numAccounts, err := cw.accountsIterator.Next(ctx, BalancesPerCatchpointFileChunk, cw.maxResourcesPerChunk)
 		if err != nil {
 			return err
 		}
@@ -286,7 +277,7 @@ func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) er
 
 	// Create the *Rows iterator JIT
 	if cw.kvRows == nil {
-		rows, err := store.MakeKVsIter(ctx, tx)
+		rows, err := tx.MakeKVsIter(ctx)
 		if err != nil {
 			return err
 		}
@@ -129,9 +129,9 @@ func TestBasicCatchpointWriter(t *testing.T) {
 	au.close()
 	fileName := filepath.Join(temporaryDirectory, "15.data")
 
-	readDb := ml.trackerDB().Rdb
+	readDb := store.DbPairTest(ml.trackerDB()).Rdb
 	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		writer, err := makeCatchpointWriter(context.Background(), fileName, tx, ResourcesPerCatchpointFileChunk)
+		writer, err := makeCatchpointWriter(context.Background(), fileName, store.MakeSnapshotScopeTest(tx), ResourcesPerCatchpointFileChunk)
 		if err != nil {
 			return err
 		}
@@ -187,6 +187,7 @@ func TestBasicCatchpointWriter(t *testing.T) {
 
 func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepath string, maxResourcesPerChunk int) CatchpointFileHeader {
 	var totalAccounts uint64
+	var totalKVs uint64
 	var totalChunks uint64
 	var biggestChunkLen uint64
 	var accountsRnd basics.Round
@@ -196,7 +197,7 @@ func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepat
 	}
 
 	err := rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		writer, err := makeCatchpointWriter(context.Background(), datapath, tx, maxResourcesPerChunk)
+		writer, err := makeCatchpointWriter(context.Background(), datapath, store.MakeSnapshotScopeTest(tx), maxResourcesPerChunk)
 		arw := store.NewAccountsSQLReaderWriter(tx)
 
 		if err != nil {
@@ -210,6 +211,7 @@ func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepat
 			}
 		}
 		totalAccounts = writer.totalAccounts
+		totalKVs = writer.totalKVs
 		totalChunks = writer.chunkNum
 		biggestChunkLen = writer.biggestChunkLen
 		accountsRnd, err = arw.AccountsRound()
@@ -230,6 +232,7 @@ func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepat
 		Totals:            totals,
 		TotalAccounts:     totalAccounts,
 		TotalChunks:       totalChunks,
+		TotalKVs:          totalKVs,
 		Catchpoint:        catchpointLabel,
 		BlockHeaderDigest: blockHeaderDigest,
 	}
@@ -285,7 +288,7 @@ func TestCatchpointReadDatabaseOverflowSingleAccount(t *testing.T) {
 	require.NoError(t, err)
 	au.close()
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
-	readDb := ml.trackerDB().Rdb
+	readDb := store.DbPairTest(ml.trackerDB()).Rdb
 
 	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 		expectedTotalAccounts := uint64(1)
@@ -293,8 +296,8 @@ func TestCatchpointReadDatabaseOverflowSingleAccount(t *testing.T) {
 		totalResources := 0
 		totalChunks := 0
 		var expectedTotalResources int
-		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk)
-		err = cw.tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
+		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, store.MakeSnapshotScopeTest(tx), maxResourcesPerChunk)
+		err = tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
 		if err != nil {
 			return err
 		}
@@ -372,7 +375,7 @@ func TestCatchpointReadDatabaseOverflowAccounts(t *testing.T) {
 	require.NoError(t, err)
 	au.close()
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
-	readDb := ml.trackerDB().Rdb
+	readDb := store.DbPairTest(ml.trackerDB()).Rdb
 
 	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 		arw := store.NewAccountsSQLReaderWriter(tx)
@@ -383,9 +386,9 @@ func TestCatchpointReadDatabaseOverflowAccounts(t *testing.T) {
 		totalAccountsWritten := uint64(0)
 		totalResources := 0
 		var expectedTotalResources int
-		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk)
+		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, store.MakeSnapshotScopeTest(tx), maxResourcesPerChunk)
 		require.NoError(t, err)
-		err = cw.tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
+		err = tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
 		if err != nil {
 			return err
 		}
@@ -444,9 +447,9 @@ func TestFullCatchpointWriterOverflowAccounts(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
 	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
 	const maxResourcesPerChunk = 5
-	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, maxResourcesPerChunk)
+	testWriteCatchpoint(t, store.DbPairTest(ml.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, maxResourcesPerChunk)
 
-	l := testNewLedgerFromCatchpoint(t, ml.trackerDB().Rdb, catchpointFilePath)
+	l := testNewLedgerFromCatchpoint(t, store.DbPairTest(ml.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 
 	// verify that the account data aligns with what we originally stored :
@@ -463,7 +466,7 @@ func TestFullCatchpointWriterOverflowAccounts(t *testing.T) {
 	// now manually construct the MT and ensure the reading makeOrderedAccountsIter works as expected:
 	// no errors on read, hashes match
 	ctx := context.Background()
-	tx, err := l.trackerDBs.Wdb.Handle.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	tx, err := store.DbPairTest(l.trackerDBs).Wdb.Handle.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	require.NoError(t, err)
 	defer tx.Rollback()
 
@@ -521,7 +524,7 @@ func TestFullCatchpointWriterOverflowAccounts(t *testing.T) {
 	require.Equal(t, h1, h2)
 }
 
-func testNewLedgerFromCatchpoint(t *testing.T, catchpointWriterReadAccess db.Accessor, filepath string) *Ledger {
+func testNewLedgerFromCatchpoint(t *testing.T, catchpointWriterReadAccess db.Accessor, filepath string, deltaFilepaths ...string) *Ledger {
 	// create a ledger.
 	var initState ledgercore.InitState
 	initState.Block.CurrentProtocol = protocol.ConsensusCurrentVersion
@@ -533,47 +536,16 @@ func testNewLedgerFromCatchpoint(t *testing.T, catchpointWriterReadAccess db.Acc
 	err = accessor.ResetStagingBalances(context.Background(), true)
 	require.NoError(t, err)
 
-	// load the file from disk.
-	fileContent, err := os.ReadFile(filepath)
-	require.NoError(t, err)
-	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
-	require.NoError(t, err)
-	tarReader := tar.NewReader(gzipReader)
+	// load the catchpoint file and then its incremental files from disk.
 	var catchupProgress CatchpointCatchupAccessorProgress
-	defer gzipReader.Close()
-	for {
-		header, err := tarReader.Next()
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			require.NoError(t, err)
-			break
-		}
-		balancesBlockBytes := make([]byte, header.Size)
-		readComplete := int64(0)
-
-		for readComplete < header.Size {
-			bytesRead, err := tarReader.Read(balancesBlockBytes[readComplete:])
-			readComplete += int64(bytesRead)
-			if err != nil {
-				if err == io.EOF {
-					if readComplete == header.Size {
-						break
-					}
-					require.NoError(t, err)
-				}
-				break
-			}
-		}
-		err = accessor.ProcessStagingBalances(context.Background(), header.Name, balancesBlockBytes, &catchupProgress)
-		require.NoError(t, err)
+	for _, path := range append([]string{filepath}, deltaFilepaths...) {
+		testLoadCatchpointFile(t, accessor, path, &catchupProgress)
 	}
 
 	err = accessor.BuildMerkleTrie(context.Background(), nil)
 	require.NoError(t, err)
 
-	err = l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = store.DbPairTest(l.trackerDBs).Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		crw := store.NewCatchpointSQLReaderWriter(tx)
 		err := crw.ApplyCatchpointStagingBalances(ctx, 0, 0)
 		return err
@@ -606,12 +578,49 @@ func testNewLedgerFromCatchpoint(t *testing.T, catchpointWriterReadAccess db.Acc
 	// Skip invariant check for tests using mocks that do _not_ update
 	// balancesTrie by checking for zero value stats.
 	if ws != (merkletrie.Stats{}) {
-		require.Equal(t, ws, balanceTrieStats(l.trackerDBs.Rdb), "Invariant broken - Catchpoint writer and reader merkle tries should _always_ agree")
+		require.Equal(t, ws, balanceTrieStats(store.DbPairTest(l.trackerDBs).Rdb), "Invariant broken - Catchpoint writer and reader merkle tries should _always_ agree")
 	}
 
 	return l
 }
 
+func testLoadCatchpointFile(t *testing.T, accessor CatchpointCatchupAccessor, filepath string, catchupProgress *CatchpointCatchupAccessorProgress) {
+	fileContent, err := os.ReadFile(filepath)
+	require.NoError(t, err)
+	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	defer gzipReader.Close()
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			break
+		}
+		balancesBlockBytes := make([]byte, header.Size)
+		readComplete := int64(0)
+
+		for readComplete < header.Size {
+			bytesRead, err := tarReader.Read(balancesBlockBytes[readComplete:])
+			readComplete += int64(bytesRead)
+			if err != nil {
+				if err == io.EOF {
+					if readComplete == header.Size {
+						break
+					}
+					require.NoError(t, err)
+				}
+				break
+			}
+		}
+		err = accessor.ProcessStagingBalances(context.Background(), header.Name, balancesBlockBytes, catchupProgress)
+		require.NoError(t, err)
+	}
+}
+
 func TestFullCatchpointWriter(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	// t.Parallel() NO! config.Consensus is modified
@@ -640,9 +649,9 @@ func TestFullCatchpointWriter(t *testing.T) {
 
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
 	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
-	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	testWriteCatchpoint(t, store.DbPairTest(ml.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 
-	l := testNewLedgerFromCatchpoint(t, ml.trackerDB().Rdb, catchpointFilePath)
+	l := testNewLedgerFromCatchpoint(t, store.DbPairTest(ml.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 	// verify that the account data aligns with what we originally stored :
 	for addr, acct := range accts {
@@ -688,10 +697,10 @@ func TestExactAccountChunk(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 	require.EqualValues(t, cph.TotalChunks, 1)
 
-	l := testNewLedgerFromCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointFilePath)
+	l := testNewLedgerFromCatchpoint(t, store.DbPairTest(dl.generator.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 }
 
@@ -739,10 +748,10 @@ func TestCatchpointAfterTxns(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 	require.EqualValues(t, 2, cph.TotalChunks)
 
-	l := testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
+	l := testNewLedgerFromCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 	values, err := l.LookupKeysByPrefix(l.Latest(), "bx:", 10)
 	require.NoError(t, err)
@@ -755,12 +764,12 @@ func TestCatchpointAfterTxns(t *testing.T) {
 	dl.fullBlock(&newacctpay)
 
 	// Write and read back in, and ensure even the last effect exists.
-	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph = testWriteCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 	require.EqualValues(t, cph.TotalChunks, 2) // Still only 2 chunks, as last was in a recent block
 
 	// Drive home the point that `last` is _not_ included in the catchpoint by inspecting balance read from catchpoint.
 	{
-		l = testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
+		l = testNewLedgerFromCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointFilePath)
 		defer l.Close()
 		_, _, algos, err := l.LookupLatest(last)
 		require.NoError(t, err)
@@ -771,10 +780,10 @@ func TestCatchpointAfterTxns(t *testing.T) {
 		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
 	}
 
-	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph = testWriteCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 	require.EqualValues(t, cph.TotalChunks, 3)
 
-	l = testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
+	l = testNewLedgerFromCatchpoint(t, store.DbPairTest(dl.validator.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 	values, err = l.LookupKeysByPrefix(l.Latest(), "bx:", 10)
 	require.NoError(t, err)
@@ -860,10 +869,10 @@ func TestCatchpointAfterBoxTxns(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, store.DbPairTest(dl.generator.trackerDB()).Rdb, catchpointDataFilePath, catchpointFilePath, 0)
 	require.EqualValues(t, 2, cph.TotalChunks)
 
-	l := testNewLedgerFromCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointFilePath)
+	l := testNewLedgerFromCatchpoint(t, store.DbPairTest(dl.generator.trackerDB()).Rdb, catchpointFilePath)
 	defer l.Close()
 
 	values, err := l.LookupKeysByPrefix(l.Latest(), "bx:", 10)
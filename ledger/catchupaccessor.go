@@ -37,7 +37,6 @@ import (
 	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
-	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/metrics"
 )
 
@@ -55,6 +54,12 @@ type CatchpointCatchupAccessor interface {
 	// SetLabel set the catchpoint catchup label
 	SetLabel(ctx context.Context, label string) (err error)
 
+	// GetSource returns the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+	GetSource(ctx context.Context) (catchpointFile string, deltaFiles []string, blocksDir string, err error)
+
+	// SetSource set the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+	SetSource(ctx context.Context, catchpointFile string, deltaFiles []string, blocksDir string) (err error)
+
 	// ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 	ResetStagingBalances(ctx context.Context, newCatchup bool) (err error)
 
@@ -103,19 +108,25 @@ type stagingWriter interface {
 }
 
 type stagingWriterImpl struct {
-	wdb db.Accessor
+	wdb store.TrackerStore
 }
 
 func (w *stagingWriterImpl) writeBalances(ctx context.Context, balances []store.NormalizedAccountBalance) error {
-	return w.wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	return w.wdb.Batch(func(ctx context.Context, tx store.BatchScope) (err error) {
+		crw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
 		return crw.WriteCatchpointStagingBalances(ctx, balances)
 	})
 }
 
 func (w *stagingWriterImpl) writeKVs(ctx context.Context, kvrs []encoded.KVRecordV6) error {
-	return w.wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	return w.wdb.Batch(func(ctx context.Context, tx store.BatchScope) (err error) {
+		crw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
 
 		keys := make([][]byte, len(kvrs))
 		values := make([][]byte, len(kvrs))
@@ -131,17 +142,22 @@ func (w *stagingWriterImpl) writeKVs(ctx context.Context, kvrs []encoded.KVRecor
 }
 
 func (w *stagingWriterImpl) writeCreatables(ctx context.Context, balances []store.NormalizedAccountBalance) error {
-	return w.wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	return w.wdb.Batch(func(ctx context.Context, tx store.BatchScope) error {
+		crw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
 		return crw.WriteCatchpointStagingCreatable(ctx, balances)
 	})
 }
 
 func (w *stagingWriterImpl) writeHashes(ctx context.Context, balances []store.NormalizedAccountBalance) error {
-	return w.wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		err := crw.WriteCatchpointStagingHashes(ctx, balances)
-		return err
+	return w.wdb.Batch(func(ctx context.Context, tx store.BatchScope) error {
+		crw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
+		return crw.WriteCatchpointStagingHashes(ctx, balances)
 	})
 }
 
@@ -204,10 +220,12 @@ type CatchupAccessorClientLedger interface {
 
 // MakeCatchpointCatchupAccessor creates a CatchpointCatchupAccessor given a ledger
 func MakeCatchpointCatchupAccessor(ledger *Ledger, log logging.Logger) CatchpointCatchupAccessor {
+	catchpointStore, _ := ledger.trackerDB().MakeCatchpointReaderWriter()
+
 	return &catchpointCatchupAccessorImpl{
 		ledger:          ledger,
-		catchpointStore: store.NewCatchpointSQLReaderWriter(ledger.trackerDB().Wdb.Handle),
-		stagingWriter:   &stagingWriterImpl{wdb: ledger.trackerDB().Wdb},
+		catchpointStore: catchpointStore,
+		stagingWriter:   &stagingWriterImpl{wdb: ledger.trackerDB()},
 		log:             log,
 	}
 }
@@ -258,16 +276,57 @@ func (c *catchpointCatchupAccessorImpl) SetLabel(ctx context.Context, label stri
 	return
 }
 
+// GetSource returns the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+func (c *catchpointCatchupAccessorImpl) GetSource(ctx context.Context) (catchpointFile string, deltaFiles []string, blocksDir string, err error) {
+	catchpointFile, err = c.catchpointStore.ReadCatchpointStateString(ctx, store.CatchpointStateCatchupFile)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", store.CatchpointStateCatchupFile, err)
+	}
+	joinedDeltaFiles, err := c.catchpointStore.ReadCatchpointStateString(ctx, store.CatchpointStateCatchupDeltaFiles)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", store.CatchpointStateCatchupDeltaFiles, err)
+	}
+	if joinedDeltaFiles != "" {
+		deltaFiles = strings.Split(joinedDeltaFiles, "\n")
+	}
+	blocksDir, err = c.catchpointStore.ReadCatchpointStateString(ctx, store.CatchpointStateCatchupBlocksDir)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", store.CatchpointStateCatchupBlocksDir, err)
+	}
+	return
+}
+
+// SetSource set the local catchpoint file, incremental catchpoint files and blocks directory used by the catchpoint catchup
+func (c *catchpointCatchupAccessorImpl) SetSource(ctx context.Context, catchpointFile string, deltaFiles []string, blocksDir string) (err error) {
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupFile, catchpointFile)
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupFile, err)
+	}
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupDeltaFiles, strings.Join(deltaFiles, "\n"))
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupDeltaFiles, err)
+	}
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupBlocksDir, blocksDir)
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupBlocksDir, err)
+	}
+	return
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
-	wdb := c.ledger.trackerDB().Wdb
+	dbs := c.ledger.trackerDB()
 	if !newCatchup {
 		c.ledger.setSynchronousMode(ctx, c.ledger.synchronousMode)
 	}
 	start := time.Now()
 	ledgerResetstagingbalancesCount.Inc(nil)
-	err = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
 		err = crw.ResetCatchpointStagingBalances(ctx, newCatchup)
 		if err != nil {
 			return fmt.Errorf("unable to reset catchpoint catchup balances : %v", err)
@@ -287,6 +346,18 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 			if err != nil {
 				return err
 			}
+			err = crw.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupFile, "")
+			if err != nil {
+				return err
+			}
+			err = crw.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupDeltaFiles, "")
+			if err != nil {
+				return err
+			}
+			err = crw.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupBlocksDir, "")
+			if err != nil {
+				return err
+			}
 			err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupState, 0)
 			if err != nil {
 				return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupState, err)
@@ -310,6 +381,11 @@ type CatchpointCatchupAccessorProgress struct {
 	Version            uint64
 	TotalAccountHashes uint64
 
+	// Catchpoint is the label of the last catchpoint file whose header was processed, and BaseCatchpoint is the label
+	// of the catchpoint it applies to when it's an incremental catchpoint file.
+	Catchpoint     string
+	BaseCatchpoint string
+
 	// Having the cachedTrie here would help to accelerate the catchup process since the trie maintain an internal cache of nodes.
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
 	cachedTrie *merkletrie.Trie
@@ -328,6 +404,9 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 	if strings.HasPrefix(sectionName, "balances.") && strings.HasSuffix(sectionName, ".msgpack") {
 		return c.processStagingBalances(ctx, bytes, progress)
 	}
+	if strings.HasPrefix(sectionName, "delta.") && strings.HasSuffix(sectionName, ".msgpack") {
+		return c.processStagingDelta(ctx, bytes, progress)
+	}
 	// we want to allow undefined sections to support backward compatibility.
 	c.log.Warnf("CatchpointCatchupAccessorImpl::ProcessStagingBalances encountered unexpected section name '%s' of length %d, which would be ignored", sectionName, len(bytes))
 	return nil
@@ -335,14 +414,17 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 
 // processStagingContent deserialize the given bytes as a temporary staging balances content
 func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
-	if progress.SeenHeader {
-		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: content chunk already seen")
-	}
 	var fileHeader CatchpointFileHeader
 	err = protocol.Decode(bytes, &fileHeader)
 	if err != nil {
 		return err
 	}
+	if fileHeader.BaseCatchpoint != "" {
+		return c.processStagingDeltaContent(ctx, fileHeader, progress)
+	}
+	if progress.SeenHeader {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: content chunk already seen")
+	}
 	switch fileHeader.Version {
 	case CatchpointFileVersionV5:
 	case CatchpointFileVersionV6:
@@ -353,12 +435,19 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 	// the following fields are now going to be ignored. We could add these to the database and validate these
 	// later on:
 	// TotalAccounts, TotalAccounts, Catchpoint, BlockHeaderDigest, BalancesRound
-	wdb := c.ledger.trackerDB().Wdb
+	dbs := c.ledger.trackerDB()
 	start := time.Now()
 	ledgerProcessstagingcontentCount.Inc(nil)
-	err = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
 
 		err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupBlockRound, uint64(fileHeader.BlocksRound))
 		if err != nil {
@@ -381,16 +470,81 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 
 		progress.TotalChunks = fileHeader.TotalChunks
 		progress.Version = fileHeader.Version
+		progress.Catchpoint = fileHeader.Catchpoint
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
 	return err
 }
 
+// processStagingDeltaContent processes the header of an incremental catchpoint file, which has to follow the
+// complete content of the catchpoint it applies to.
+func (c *catchpointCatchupAccessorImpl) processStagingDeltaContent(ctx context.Context, fileHeader CatchpointFileHeader, progress *CatchpointCatchupAccessorProgress) (err error) {
+	if !progress.SeenHeader {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: incremental catchpoint file for '%s' has to follow its base catchpoint file", fileHeader.BaseCatchpoint)
+	}
+	if fileHeader.BaseCatchpoint != progress.Catchpoint {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: incremental catchpoint file applies to '%s' rather than to '%s'", fileHeader.BaseCatchpoint, progress.Catchpoint)
+	}
+	if fileHeader.Version != CatchpointFileVersionV6 || progress.Version != CatchpointFileVersionV6 {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to process incremental catchpoint - version %d on top of version %d is not supported", fileHeader.Version, progress.Version)
+	}
+	if progress.ProcessedAccounts != progress.TotalAccounts || progress.ProcessedKVs != progress.TotalKVs {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: catchpoint '%s' was not completely processed", progress.Catchpoint)
+	}
+
+	dbs := c.ledger.trackerDB()
+	start := time.Now()
+	ledgerProcessstagingcontentCount.Inc(nil)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupBlockRound, uint64(fileHeader.BlocksRound))
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupBlockRound, err)
+		}
+		err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupHashRound, uint64(fileHeader.BlocksRound))
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupHashRound, err)
+		}
+		// the removed hashes are looked up one by one, so index them.
+		err = crw.CreateCatchpointStagingHashesIndex(ctx)
+		if err != nil {
+			return err
+		}
+		err = arw.AccountsPutTotals(fileHeader.Totals, true)
+		return
+	})
+	ledgerProcessstagingcontentMicros.AddMicrosecondsSince(start, nil)
+	if err == nil {
+		// an incremental catchpoint file doesn't tell how many of the accounts it updates, so
+		// the accounts and kvs are reported as processed once its header is.
+		progress.TotalAccounts = fileHeader.TotalAccounts
+		progress.ProcessedAccounts = fileHeader.TotalAccounts
+		progress.TotalKVs = fileHeader.TotalKVs
+		progress.ProcessedKVs = fileHeader.TotalKVs
+		progress.TotalChunks = fileHeader.TotalChunks
+		progress.Catchpoint = fileHeader.Catchpoint
+		progress.BaseCatchpoint = fileHeader.BaseCatchpoint
+	}
+	return err
+}
+
 // processStagingBalances deserialize the given bytes as a temporary staging balances
 func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
 	if !progress.SeenHeader {
 		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingBalances: content chunk was missing")
 	}
+	if progress.BaseCatchpoint != "" {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingBalances: balances chunk found in an incremental catchpoint file")
+	}
 
 	start := time.Now()
 	ledgerProcessstagingbalancesCount.Inc(nil)
@@ -624,6 +778,76 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 	return err
 }
 
+// processStagingDelta applies a chunk of an incremental catchpoint file on top of the staging balances : it removes
+// the replaced and deleted entries along with their hashes, and writes the new and updated entries and their hashes.
+func (c *catchpointCatchupAccessorImpl) processStagingDelta(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
+	if progress.BaseCatchpoint == "" {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDelta: content chunk of the incremental catchpoint file was missing")
+	}
+
+	var chunk catchpointFileDeltaChunk
+	err = protocol.Decode(bytes, &chunk)
+	if err != nil {
+		return err
+	}
+	if chunk.empty() {
+		return fmt.Errorf("processStagingDelta received an empty chunk")
+	}
+
+	normalizedAccountBalances, err := prepareNormalizedDeltaBalancesV6(chunk.Balances, c.ledger.GenesisProto())
+	if err != nil {
+		return fmt.Errorf("processStagingDelta failed to prepare normalized balances : %w", err)
+	}
+
+	keys := make([][]byte, len(chunk.KVs))
+	values := make([][]byte, len(chunk.KVs))
+	hashes := make([][]byte, len(chunk.KVs))
+	for i, kv := range chunk.KVs {
+		keys[i] = kv.Key
+		values[i] = kv.Value
+		hashes[i] = store.KvHashBuilderV6(string(kv.Key), kv.Value)
+	}
+
+	start := time.Now()
+	dbs := c.ledger.trackerDB()
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		err = crw.DeleteCatchpointStagingHashes(ctx, chunk.RemovedHashes)
+		if err != nil {
+			return err
+		}
+		err = crw.DeleteCatchpointStagingEntries(ctx, chunk.DeletedAccounts, chunk.DeletedResources, chunk.DeletedKVs)
+		if err != nil {
+			return err
+		}
+		err = crw.UpdateCatchpointStagingBalances(ctx, normalizedAccountBalances)
+		if err != nil {
+			return err
+		}
+		err = crw.WriteCatchpointStagingHashes(ctx, normalizedAccountBalances)
+		if err != nil {
+			return err
+		}
+		return crw.UpdateCatchpointStagingKVs(ctx, keys, values, hashes)
+	})
+	if err != nil {
+		return fmt.Errorf("processStagingDelta failed to apply the incremental catchpoint chunk : %w", err)
+	}
+	progress.BalancesWriteDuration += time.Since(start)
+
+	progress.ProcessedBytes += uint64(len(bytes))
+	removedAccountHashes, _ := countHashes(chunk.RemovedHashes)
+	for _, acctBal := range normalizedAccountBalances {
+		progress.TotalAccountHashes += uint64(len(acctBal.AccountHashes))
+	}
+	progress.TotalAccountHashes -= removedAccountHashes
+	return nil
+}
+
 // countHashes disambiguates the 2 hash types included in the merkle trie:
 // * accounts + createables (assets + apps)
 // * KVs
@@ -642,12 +866,19 @@ func countHashes(hashes [][]byte) (accountCount, kvCount uint64) {
 
 // BuildMerkleTrie would process the catchpointpendinghashes and insert all the items in it into the merkle trie
 func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, progressUpdates func(uint64, uint64)) (err error) {
-	wdb := c.ledger.trackerDB().Wdb
-	rdb := c.ledger.trackerDB().Rdb
-	err = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	dbs := c.ledger.trackerDB()
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
 		// creating the index can take a while, so ensure we don't generate false alerts for no good reason.
-		db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(120*time.Second))
+		_, err = tx.ResetTransactionWarnDeadline(ctx, time.Now().Add(120*time.Second))
+		if err != nil {
+			return err
+		}
+
 		return crw.CreateCatchpointStagingHashesIndex(ctx)
 	})
 	if err != nil {
@@ -667,8 +898,8 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 		defer wg.Done()
 		defer close(writerQueue)
 
-		err := rdb.Atomic(func(transactionCtx context.Context, tx *sql.Tx) (err error) {
-			it := store.MakeCatchpointPendingHashesIterator(trieRebuildAccountChunkSize, tx)
+		err := dbs.Snapshot(func(transactionCtx context.Context, tx store.SnapshotScope) (err error) {
+			it := tx.MakeCatchpointPendingHashesIterator(trieRebuildAccountChunkSize)
 			var hashes [][]byte
 			for {
 				hashes, err = it.Next(transactionCtx)
@@ -688,7 +919,7 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 			}
 			// disable the warning for over-long atomic operation execution. It's meaningless here since it's
 			// co-dependent on the other go-routine.
-			db.ResetTransactionWarnDeadline(transactionCtx, tx, time.Now().Add(5*time.Second))
+			tx.ResetTransactionWarnDeadline(transactionCtx, time.Now().Add(5*time.Second))
 			return err
 		})
 		if err != nil {
@@ -703,11 +934,11 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 		uncommitedHashesCount := 0
 		keepWriting := true
 		accountHashesWritten, kvHashesWritten := uint64(0), uint64(0)
-		var mc *store.MerkleCommitter
+		var mc store.MerkleCommitter
 
-		err := wdb.Atomic(func(transactionCtx context.Context, tx *sql.Tx) (err error) {
+		err := dbs.Transaction(func(transactionCtx context.Context, tx store.TransactionScope) (err error) {
 			// create the merkle trie for the balances
-			mc, err = store.MakeMerkleCommitter(tx, true)
+			mc, err = tx.MakeMerkleCommitter(true)
 			if err != nil {
 				return
 			}
@@ -734,8 +965,8 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 				continue
 			}
 
-			err = rdb.Atomic(func(transactionCtx context.Context, tx *sql.Tx) (err error) {
-				mc, err = store.MakeMerkleCommitter(tx, true)
+			err = dbs.Transaction(func(transactionCtx context.Context, tx store.TransactionScope) (err error) {
+				mc, err = tx.MakeMerkleCommitter(true)
 				if err != nil {
 					return
 				}
@@ -764,10 +995,10 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 			}
 
 			if uncommitedHashesCount >= trieRebuildCommitFrequency {
-				err = wdb.Atomic(func(transactionCtx context.Context, tx *sql.Tx) (err error) {
+				err = dbs.Transaction(func(transactionCtx context.Context, tx store.TransactionScope) (err error) {
 					// set a long 30-second window for the evict before warning is generated.
-					db.ResetTransactionWarnDeadline(transactionCtx, tx, time.Now().Add(30*time.Second))
-					mc, err = store.MakeMerkleCommitter(tx, true)
+					_, err = tx.ResetTransactionWarnDeadline(transactionCtx, time.Now().Add(30*time.Second))
+					mc, err = tx.MakeMerkleCommitter(true)
 					if err != nil {
 						return
 					}
@@ -794,10 +1025,10 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 			return
 		}
 		if uncommitedHashesCount > 0 {
-			err = wdb.Atomic(func(transactionCtx context.Context, tx *sql.Tx) (err error) {
+			err = dbs.Transaction(func(transactionCtx context.Context, tx store.TransactionScope) (err error) {
 				// set a long 30-second window for the evict before warning is generated.
-				db.ResetTransactionWarnDeadline(transactionCtx, tx, time.Now().Add(30*time.Second))
-				mc, err = store.MakeMerkleCommitter(tx, true)
+				_, err = tx.ResetTransactionWarnDeadline(transactionCtx, time.Now().Add(30*time.Second))
+				mc, err = tx.MakeMerkleCommitter(true)
 				if err != nil {
 					return
 				}
@@ -835,7 +1066,7 @@ func (c *catchpointCatchupAccessorImpl) GetCatchupBlockRound(ctx context.Context
 
 // VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
 func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error) {
-	rdb := c.ledger.trackerDB().Rdb
+	dbs := c.ledger.trackerDB()
 	var balancesHash crypto.Digest
 	var blockRound basics.Round
 	var totals ledgercore.AccountTotals
@@ -855,10 +1086,14 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 
 	start := time.Now()
 	ledgerVerifycatchpointCount.Inc(nil)
-	err = rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
+
 		// create the merkle trie for the balances
-		mc, err0 := store.MakeMerkleCommitter(tx, true)
+		mc, err0 := tx.MakeMerkleCommitter(true)
 		if err0 != nil {
 			return fmt.Errorf("unable to make MerkleCommitter: %v", err0)
 		}
@@ -905,11 +1140,15 @@ func (c *catchpointCatchupAccessorImpl) StoreBalancesRound(ctx context.Context,
 		catchpointLookback = config.Consensus[blk.CurrentProtocol].MaxBalLookback
 	}
 	balancesRound := blk.Round() - basics.Round(catchpointLookback)
-	wdb := c.ledger.trackerDB().Wdb
+	dbs := c.ledger.trackerDB()
 	start := time.Now()
 	ledgerStorebalancesroundCount.Inc(nil)
-	err = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
 		err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupBalancesRound, uint64(balancesRound))
 		if err != nil {
 			return fmt.Errorf("CatchpointCatchupAccessorImpl::StoreBalancesRound: unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupBalancesRound, err)
@@ -1002,12 +1241,19 @@ func (c *catchpointCatchupAccessorImpl) CompleteCatchup(ctx context.Context) (er
 
 // finishBalances concludes the catchup of the balances(tracker) database.
 func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err error) {
-	wdb := c.ledger.trackerDB().Wdb
+	dbs := c.ledger.trackerDB()
 	start := time.Now()
 	ledgerCatchpointFinishBalsCount.Inc(nil)
-	err = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		crw := store.NewCatchpointSQLReaderWriter(tx)
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
 
 		var balancesRound, hashRound uint64
 		var totals ledgercore.AccountTotals
@@ -1054,7 +1300,7 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 				DbPathPrefix:      c.ledger.catchpoint.dbDirectory,
 				BlockDb:           c.ledger.blockDBs,
 			}
-			_, err = store.RunMigrations(ctx, tx, tp, c.ledger.log, 6 /*target database version*/)
+			_, err = tx.RunMigrations(ctx, tp, c.ledger.log, 6 /*target database version*/)
 			if err != nil {
 				return err
 			}
@@ -1090,6 +1336,16 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		err = crw.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupFile, "")
+		if err != nil {
+			return err
+		}
+
+		err = crw.WriteCatchpointStateString(ctx, store.CatchpointStateCatchupBlocksDir, "")
+		if err != nil {
+			return err
+		}
+
 		if hashRound != 0 {
 			err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupHashRound, 0)
 			if err != nil {
@@ -199,8 +199,23 @@ func TestCatchupAccessorFoo(t *testing.T) {
 	require.Equal(t, calabel, label)
 	t.Logf("catchpoint label %#v", label)
 
+	err = catchpointAccessor.SetSource(context.Background(), "/catchpoints/98.tar", []string{"/catchpoints/198.delta.tar", "/catchpoints/298.delta.tar"}, "/blocks")
+	require.NoError(t, err, "catchpointAccessor.SetSource")
+
+	catchpointFile, deltaFiles, blocksDir, err := catchpointAccessor.GetSource(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetSource")
+	require.Equal(t, "/catchpoints/98.tar", catchpointFile)
+	require.Equal(t, []string{"/catchpoints/198.delta.tar", "/catchpoints/298.delta.tar"}, deltaFiles)
+	require.Equal(t, "/blocks", blocksDir)
+
 	err = catchpointAccessor.ResetStagingBalances(context.Background(), false)
 	require.NoError(t, err, "ResetStagingBalances")
+
+	catchpointFile, deltaFiles, blocksDir, err = catchpointAccessor.GetSource(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetSource")
+	require.Empty(t, catchpointFile)
+	require.Empty(t, deltaFiles)
+	require.Empty(t, blocksDir)
 }
 
 func TestBuildMerkleTrie(t *testing.T) {
@@ -193,3 +193,63 @@ func BenchmarkUnmarshalKVRecordV6(b *testing.B) {
 		}
 	}
 }
+
+func TestMarshalUnmarshalResourceKeyRecordV6(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := ResourceKeyRecordV6{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingResourceKeyRecordV6(t *testing.T) {
+	protocol.RunEncodingTest(t, &ResourceKeyRecordV6{})
+}
+
+func BenchmarkMarshalMsgResourceKeyRecordV6(b *testing.B) {
+	v := ResourceKeyRecordV6{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgResourceKeyRecordV6(b *testing.B) {
+	v := ResourceKeyRecordV6{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalResourceKeyRecordV6(b *testing.B) {
+	v := ResourceKeyRecordV6{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
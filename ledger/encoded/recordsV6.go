@@ -62,3 +62,11 @@ type KVRecordV6 struct {
 	Key   []byte `codec:"k,allocbound=KVRecordV6MaxKeyLength"`
 	Value []byte `codec:"v,allocbound=KVRecordV6MaxValueLength"`
 }
+
+// ResourceKeyRecordV6 is the encoded key of an account resource, listing the resources deleted in incremental catchpoint files.
+type ResourceKeyRecordV6 struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address basics.Address `codec:"a,allocbound=crypto.DigestSize"`
+	Aidx    uint64         `codec:"i"`
+}
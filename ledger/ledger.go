@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/apply"
 	"github.com/algorand/go-algorand/ledger/internal"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
 	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -48,9 +50,13 @@ type Ledger struct {
 	// Database connections to the DBs storing blocks and tracker state.
 	// We use potentially different databases to avoid SQLite contention
 	// during catchup.
-	trackerDBs db.Pair
+	trackerDBs store.TrackerStore
 	blockDBs   db.Pair
 
+	// blockArchive holds the blocks that were moved out of the blocks database
+	// into immutable segment files. It is nil unless the archive is enabled.
+	blockArchive *blockdb.BlockArchive
+
 	// blockQ is the buffer of added blocks that will be flushed to
 	// persistent storage
 	blockQ *blockQueue
@@ -76,6 +82,7 @@ type Ledger struct {
 	genesisProtoVersion protocol.ConsensusVersion
 
 	// State-machine trackers
+	history     accountsHistory
 	accts       accountUpdates
 	acctsOnline onlineAccounts
 	catchpoint  catchpointTracker
@@ -110,6 +117,9 @@ func OpenLedger(
 		verifiedCacheSize = cfg.TxPoolSize
 		log.Warnf("The VerifiedTranscationsCacheSize in the config file was misconfigured to have smaller size then the TxPoolSize; The verified cache size was adjusted from %d to %d.", cfg.VerifiedTranscationsCacheSize, cfg.TxPoolSize)
 	}
+	if cfg.EnableAccountHistory && !cfg.Archival {
+		log.Warnf("The EnableAccountHistory in the config file is only supported by Archival nodes; The accounts history would not be maintained.")
+	}
 
 	l := &Ledger{
 		log:                            log,
@@ -138,13 +148,20 @@ func OpenLedger(
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
 		return nil, err
 	}
-	l.trackerDBs.Rdb.SetLogger(log)
-	l.trackerDBs.Wdb.SetLogger(log)
+	l.trackerDBs.SetLogger(log)
 	l.blockDBs.Rdb.SetLogger(log)
 	l.blockDBs.Wdb.SetLogger(log)
 
 	l.setSynchronousMode(context.Background(), l.synchronousMode)
 
+	if cfg.Archival && cfg.BlockArchiveRounds > 0 && !dbMem {
+		l.blockArchive, err = blockdb.OpenBlockArchive(dbPathPrefix + ".blockarchive")
+		if err != nil {
+			err = fmt.Errorf("OpenLedger.openBlockArchive %v", err)
+			return nil, err
+		}
+	}
+
 	start := time.Now()
 	ledgerInitblocksdbCount.Inc(nil)
 	err = l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -204,6 +221,7 @@ func (l *Ledger) reloadLedger() error {
 
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
+		&l.history,     // record the accounts history; must precede accts, which overwrites the accounts it reads from
 		&l.accts,       // update the balances
 		&l.catchpoint,  // catchpoints tracker : update catchpoint labels, create catchpoint files
 		&l.acctsOnline, // update online account balances history
@@ -213,6 +231,7 @@ func (l *Ledger) reloadLedger() error {
 		&l.metrics,     // provides metrics reporting support
 	}
 
+	l.history.initialize(l.cfg)
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
 	l.catchpoint.initialize(l.cfg, l.dbPathPrefix)
@@ -273,7 +292,7 @@ func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	return
 }
 
-func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs db.Pair, blockDBs db.Pair, err error) {
+func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs store.TrackerStore, blockDBs db.Pair, err error) {
 	// Backwards compatibility: we used to store both blocks and tracker
 	// state in a single SQLite db file.
 	var trackerDBFilename string
@@ -297,7 +316,7 @@ func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs db.Pair, blockDBs
 	outErr := make(chan error, 2)
 	go func() {
 		var lerr error
-		trackerDBs, lerr = db.OpenPair(trackerDBFilename, dbMem)
+		trackerDBs, lerr = store.OpenTrackerSQLStore(trackerDBFilename, dbMem)
 		outErr <- lerr
 	}()
 
@@ -328,7 +347,7 @@ func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.Sync
 		return
 	}
 
-	err = l.trackerDBs.Wdb.SetSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
+	err = l.trackerDBs.SetSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
 	if err != nil {
 		l.log.Warnf("ledger.setSynchronousMode unable to set synchronous mode on trackers db: %v", err)
 		return
@@ -353,9 +372,15 @@ func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchi
 			return err
 		}
 
+		// blocks moved to the block archive are no longer in the DB
+		archived := basics.Round(0)
+		if l.blockArchive != nil {
+			archived = l.blockArchive.NextRound()
+		}
+
 		// Detect possible problem - archival node needs all block but have only subsequence of them
 		// So reset the DB and init it again
-		if earliest != basics.Round(0) {
+		if earliest > archived {
 			l.log.Warnf("resetting blocks DB (earliest block is %v)", earliest)
 			err := blockdb.BlockResetDB(tx)
 			if err != nil {
@@ -424,13 +449,33 @@ func (l *Ledger) GetLastCatchpointLabel() string {
 	return l.catchpoint.GetLastCatchpointLabel()
 }
 
+// RequestCatchpoint requests the generation of a catchpoint label and file, taking the accounts
+// snapshot at the next accounts commit. See catchpointTracker.RequestCatchpoint for details.
+func (l *Ledger) RequestCatchpoint() (CatchpointGenerationStatus, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.RequestCatchpoint()
+}
+
+// GetCatchpointGenerationStatus returns the status of the last catchpoint generation requested
+// through RequestCatchpoint, and false if none was requested.
+func (l *Ledger) GetCatchpointGenerationStatus() (CatchpointGenerationStatus, bool) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointGenerationStatus()
+}
+
 // GetCreatorForRound takes a CreatableIndex and a CreatableType and tries to
 // look up a creator address, setting ok to false if the query succeeded but no
 // creator was found.
 func (l *Ledger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
-	return l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	creator, ok, err = l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	if l.useAccountsHistory(err) {
+		return l.history.getCreatorForRound(rnd, cidx, ctype)
+	}
+	return creator, ok, err
 }
 
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
@@ -490,6 +535,39 @@ func (l *Ledger) LookupLatest(addr basics.Address) (basics.AccountData, basics.R
 	return data, rnd, withoutRewards, nil
 }
 
+// LookupHistorical returns the account state (including resources) for a given address, for a given round,
+// with the rewards applied up to that round, along with the account balance before applying them.
+// Rounds older than the accounts tracker lookback are answered by the accounts history, and a
+// RoundOffsetError is returned for the rounds that aren't covered by either of them.
+func (l *Ledger) LookupHistorical(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	if rnd == l.blockQ.latest() {
+		data, _, withoutRewards, err := l.accts.lookupLatest(addr)
+		return data, withoutRewards, err
+	}
+	data, rewardsVersion, rewardsLevel, err := l.accts.lookupAllResources(rnd, addr)
+	if l.useAccountsHistory(err) {
+		return l.history.lookupAllResources(rnd, addr)
+	}
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	// Intentionally apply (pending) rewards up to rnd, remembering the old value
+	withoutRewards := data.MicroAlgos
+	data = data.WithUpdatedRewards(config.Consensus[rewardsVersion], rewardsLevel)
+	return data, withoutRewards, nil
+}
+
+// useAccountsHistory returns true if the accounts tracker lookup failed with err because the round
+// is older than its lookback, and the lookup can be answered by the accounts history.
+func (l *Ledger) useAccountsHistory(err error) bool {
+	var roundOffsetErr *RoundOffsetError
+	return l.history.enabled && errors.As(err, &roundOffsetErr)
+}
+
 // LookupAccount uses the accounts tracker to return the account state (without
 // resources) for a given address, for a given round. The returned account values
 // reflect the changes of all blocks up to and including the returned round number.
@@ -501,6 +579,10 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	defer l.trackerMu.RUnlock()
 
 	data, rnd, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	if l.useAccountsHistory(err) {
+		rnd = round
+		data, rewardsVersion, rewardsLevel, err = l.history.lookupWithoutRewards(round, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
 	}
@@ -530,6 +612,9 @@ func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basi
 
 	// Intentionally apply (pending) rewards up to rnd.
 	res, _, err := l.accts.LookupResource(rnd, addr, aidx, ctype)
+	if l.useAccountsHistory(err) {
+		res, err = l.history.lookupResource(rnd, addr, aidx, ctype)
+	}
 	if err != nil {
 		return ledgercore.AccountResource{}, err
 	}
@@ -542,7 +627,11 @@ func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	return l.accts.LookupKv(rnd, key)
+	value, err := l.accts.LookupKv(rnd, key)
+	if l.useAccountsHistory(err) {
+		return l.history.lookupKv(rnd, key)
+	}
+	return value, err
 }
 
 // LookupKeysByPrefix searches keys with specific prefix, up to `maxKeyNum`
@@ -577,6 +666,10 @@ func (l *Ledger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (le
 	var result ledgercore.AccountData
 
 	result, validThrough, err := l.accts.LookupWithoutRewards(rnd, addr)
+	if l.useAccountsHistory(err) {
+		validThrough = rnd
+		result, _, _, err = l.history.lookupWithoutRewards(rnd, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), err
 	}
@@ -591,6 +684,13 @@ func (l *Ledger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	return l.accts.LatestTotals()
 }
 
+// Totals returns the totals of all accounts at the end of round rnd.
+func (l *Ledger) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.Totals(rnd)
+}
+
 // OnlineTotals returns the online totals of all accounts at the end of round rnd.
 func (l *Ledger) OnlineTotals(rnd basics.Round) (basics.MicroAlgos, error) {
 	l.trackerMu.RLock()
@@ -765,7 +865,7 @@ func (l *Ledger) GetCatchpointStream(round basics.Round) (ReadCloseSizer, error)
 }
 
 // ledgerForTracker methods
-func (l *Ledger) trackerDB() db.Pair {
+func (l *Ledger) trackerDB() store.TrackerStore {
 	return l.trackerDBs
 }
 
@@ -5,6 +5,7 @@ package ledger
 import (
 	"github.com/algorand/msgp/msgp"
 
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/encoded"
 )
 
@@ -41,6 +42,14 @@ import (
 //           |-----> (*) Msgsize
 //           |-----> (*) MsgIsZero
 //
+// catchpointFileDeltaChunk
+//             |-----> (*) MarshalMsg
+//             |-----> (*) CanMarshalMsg
+//             |-----> (*) UnmarshalMsg
+//             |-----> (*) CanUnmarshalMsg
+//             |-----> (*) Msgsize
+//             |-----> (*) MsgIsZero
+//
 
 // MarshalMsg implements msgp.Marshaler
 func (z CatchpointCatchupState) MarshalMsg(b []byte) (o []byte) {
@@ -92,8 +101,8 @@ func (z CatchpointCatchupState) MsgIsZero() bool {
 func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 10 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 11 bits */
 	if (*z).Totals.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -106,30 +115,34 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 		zb0001Len--
 		zb0001Mask |= 0x8
 	}
-	if (*z).BlockHeaderDigest.MsgIsZero() {
+	if (*z).BaseCatchpoint == "" {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
-	if (*z).BlocksRound.MsgIsZero() {
+	if (*z).BlockHeaderDigest.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if (*z).Catchpoint == "" {
+	if (*z).BlocksRound.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if (*z).TotalChunks == 0 {
+	if (*z).Catchpoint == "" {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if (*z).TotalKVs == 0 {
+	if (*z).TotalChunks == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
-	if (*z).Version == 0 {
+	if (*z).TotalKVs == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
+	if (*z).Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 	if zb0001Len != 0 {
@@ -149,31 +162,36 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 			o = (*z).BalancesRound.MarshalMsg(o)
 		}
 		if (zb0001Mask & 0x10) == 0 { // if not empty
+			// string "baseCatchpoint"
+			o = append(o, 0xae, 0x62, 0x61, 0x73, 0x65, 0x43, 0x61, 0x74, 0x63, 0x68, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+			o = msgp.AppendString(o, (*z).BaseCatchpoint)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not empty
 			// string "blockHeaderDigest"
 			o = append(o, 0xb1, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74)
 			o = (*z).BlockHeaderDigest.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x20) == 0 { // if not empty
+		if (zb0001Mask & 0x40) == 0 { // if not empty
 			// string "blocksRound"
 			o = append(o, 0xab, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 			o = (*z).BlocksRound.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x40) == 0 { // if not empty
+		if (zb0001Mask & 0x80) == 0 { // if not empty
 			// string "catchpoint"
 			o = append(o, 0xaa, 0x63, 0x61, 0x74, 0x63, 0x68, 0x70, 0x6f, 0x69, 0x6e, 0x74)
 			o = msgp.AppendString(o, (*z).Catchpoint)
 		}
-		if (zb0001Mask & 0x80) == 0 { // if not empty
+		if (zb0001Mask & 0x100) == 0 { // if not empty
 			// string "chunksCount"
 			o = append(o, 0xab, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalChunks)
 		}
-		if (zb0001Mask & 0x100) == 0 { // if not empty
+		if (zb0001Mask & 0x200) == 0 { // if not empty
 			// string "kvsCount"
 			o = append(o, 0xa8, 0x6b, 0x76, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalKVs)
 		}
-		if (zb0001Mask & 0x200) == 0 { // if not empty
+		if (zb0001Mask & 0x400) == 0 { // if not empty
 			// string "version"
 			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendUint64(o, (*z).Version)
@@ -272,6 +290,14 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).BaseCatchpoint, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "BaseCatchpoint")
+				return
+			}
+		}
 		if zb0001 > 0 {
 			err = msgp.ErrTooManyArrayFields(zb0001)
 			if err != nil {
@@ -349,6 +375,12 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					err = msgp.WrapError(err, "BlockHeaderDigest")
 					return
 				}
+			case "baseCatchpoint":
+				(*z).BaseCatchpoint, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "BaseCatchpoint")
+					return
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -369,13 +401,13 @@ func (_ *CatchpointFileHeader) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CatchpointFileHeader) Msgsize() (s int) {
-	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize()
+	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize() + 15 + msgp.StringPrefixSize + len((*z).BaseCatchpoint)
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *CatchpointFileHeader) MsgIsZero() bool {
-	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero())
+	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero()) && ((*z).BaseCatchpoint == "")
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -769,3 +801,581 @@ func (z *catchpointFileChunkV6) Msgsize() (s int) {
 func (z *catchpointFileChunkV6) MsgIsZero() bool {
 	return (len((*z).Balances) == 0) && (len((*z).KVs) == 0)
 }
+
+// MarshalMsg implements msgp.Marshaler
+func (z *catchpointFileDeltaChunk) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0007Len := uint32(6)
+	var zb0007Mask uint8 /* 8 bits */
+	if len((*z).Balances) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x2
+	}
+	if len((*z).DeletedAccounts) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x4
+	}
+	if len((*z).DeletedKVs) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x8
+	}
+	if len((*z).DeletedResources) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x10
+	}
+	if len((*z).KVs) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x20
+	}
+	if len((*z).RemovedHashes) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x80
+	}
+	// variable map header, size zb0007Len
+	o = append(o, 0x80|uint8(zb0007Len))
+	if zb0007Len != 0 {
+		if (zb0007Mask & 0x2) == 0 { // if not empty
+			// string "bl"
+			o = append(o, 0xa2, 0x62, 0x6c)
+			if (*z).Balances == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Balances)))
+			}
+			for zb0001 := range (*z).Balances {
+				o = (*z).Balances[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0007Mask & 0x4) == 0 { // if not empty
+			// string "da"
+			o = append(o, 0xa2, 0x64, 0x61)
+			if (*z).DeletedAccounts == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).DeletedAccounts)))
+			}
+			for zb0003 := range (*z).DeletedAccounts {
+				o = (*z).DeletedAccounts[zb0003].MarshalMsg(o)
+			}
+		}
+		if (zb0007Mask & 0x8) == 0 { // if not empty
+			// string "dk"
+			o = append(o, 0xa2, 0x64, 0x6b)
+			if (*z).DeletedKVs == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).DeletedKVs)))
+			}
+			for zb0005 := range (*z).DeletedKVs {
+				o = msgp.AppendBytes(o, (*z).DeletedKVs[zb0005])
+			}
+		}
+		if (zb0007Mask & 0x10) == 0 { // if not empty
+			// string "dr"
+			o = append(o, 0xa2, 0x64, 0x72)
+			if (*z).DeletedResources == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).DeletedResources)))
+			}
+			for zb0004 := range (*z).DeletedResources {
+				o = (*z).DeletedResources[zb0004].MarshalMsg(o)
+			}
+		}
+		if (zb0007Mask & 0x20) == 0 { // if not empty
+			// string "kv"
+			o = append(o, 0xa2, 0x6b, 0x76)
+			if (*z).KVs == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).KVs)))
+			}
+			for zb0002 := range (*z).KVs {
+				o = (*z).KVs[zb0002].MarshalMsg(o)
+			}
+		}
+		if (zb0007Mask & 0x80) == 0 { // if not empty
+			// string "rh"
+			o = append(o, 0xa2, 0x72, 0x68)
+			if (*z).RemovedHashes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).RemovedHashes)))
+			}
+			for zb0006 := range (*z).RemovedHashes {
+				o = msgp.AppendBytes(o, (*z).RemovedHashes[zb0006])
+			}
+		}
+	}
+	return
+}
+
+func (_ *catchpointFileDeltaChunk) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileDeltaChunk)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *catchpointFileDeltaChunk) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0007 int
+	var zb0008 bool
+	zb0007, zb0008, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0009 int
+			var zb0010 bool
+			zb0009, zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Balances")
+				return
+			}
+			if zb0009 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0009), uint64(BalancesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "Balances")
+				return
+			}
+			if zb0010 {
+				(*z).Balances = nil
+			} else if (*z).Balances != nil && cap((*z).Balances) >= zb0009 {
+				(*z).Balances = ((*z).Balances)[:zb0009]
+			} else {
+				(*z).Balances = make([]encoded.BalanceRecordV6, zb0009)
+			}
+			for zb0001 := range (*z).Balances {
+				bts, err = (*z).Balances[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Balances", zb0001)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0011 int
+			var zb0012 bool
+			zb0011, zb0012, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "KVs")
+				return
+			}
+			if zb0011 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0011), uint64(BalancesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "KVs")
+				return
+			}
+			if zb0012 {
+				(*z).KVs = nil
+			} else if (*z).KVs != nil && cap((*z).KVs) >= zb0011 {
+				(*z).KVs = ((*z).KVs)[:zb0011]
+			} else {
+				(*z).KVs = make([]encoded.KVRecordV6, zb0011)
+			}
+			for zb0002 := range (*z).KVs {
+				bts, err = (*z).KVs[zb0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0013 int
+			var zb0014 bool
+			zb0013, zb0014, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "DeletedAccounts")
+				return
+			}
+			if zb0013 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0013), uint64(BalancesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "DeletedAccounts")
+				return
+			}
+			if zb0014 {
+				(*z).DeletedAccounts = nil
+			} else if (*z).DeletedAccounts != nil && cap((*z).DeletedAccounts) >= zb0013 {
+				(*z).DeletedAccounts = ((*z).DeletedAccounts)[:zb0013]
+			} else {
+				(*z).DeletedAccounts = make([]basics.Address, zb0013)
+			}
+			for zb0003 := range (*z).DeletedAccounts {
+				bts, err = (*z).DeletedAccounts[zb0003].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "DeletedAccounts", zb0003)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0015 int
+			var zb0016 bool
+			zb0015, zb0016, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "DeletedResources")
+				return
+			}
+			if zb0015 > ResourcesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0015), uint64(ResourcesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "DeletedResources")
+				return
+			}
+			if zb0016 {
+				(*z).DeletedResources = nil
+			} else if (*z).DeletedResources != nil && cap((*z).DeletedResources) >= zb0015 {
+				(*z).DeletedResources = ((*z).DeletedResources)[:zb0015]
+			} else {
+				(*z).DeletedResources = make([]encoded.ResourceKeyRecordV6, zb0015)
+			}
+			for zb0004 := range (*z).DeletedResources {
+				bts, err = (*z).DeletedResources[zb0004].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "DeletedResources", zb0004)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0017 int
+			var zb0018 bool
+			zb0017, zb0018, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "DeletedKVs")
+				return
+			}
+			if zb0017 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0017), uint64(BalancesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "DeletedKVs")
+				return
+			}
+			if zb0018 {
+				(*z).DeletedKVs = nil
+			} else if (*z).DeletedKVs != nil && cap((*z).DeletedKVs) >= zb0017 {
+				(*z).DeletedKVs = ((*z).DeletedKVs)[:zb0017]
+			} else {
+				(*z).DeletedKVs = make([][]byte, zb0017)
+			}
+			for zb0005 := range (*z).DeletedKVs {
+				var zb0019 int
+				zb0019, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "DeletedKVs", zb0005)
+					return
+				}
+				if zb0019 > encoded.KVRecordV6MaxKeyLength {
+					err = msgp.ErrOverflow(uint64(zb0019), uint64(encoded.KVRecordV6MaxKeyLength))
+					return
+				}
+				(*z).DeletedKVs[zb0005], bts, err = msgp.ReadBytesBytes(bts, (*z).DeletedKVs[zb0005])
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "DeletedKVs", zb0005)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
+			var zb0020 int
+			var zb0021 bool
+			zb0020, zb0021, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "RemovedHashes")
+				return
+			}
+			if zb0020 > ResourcesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0020), uint64(ResourcesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "RemovedHashes")
+				return
+			}
+			if zb0021 {
+				(*z).RemovedHashes = nil
+			} else if (*z).RemovedHashes != nil && cap((*z).RemovedHashes) >= zb0020 {
+				(*z).RemovedHashes = ((*z).RemovedHashes)[:zb0020]
+			} else {
+				(*z).RemovedHashes = make([][]byte, zb0020)
+			}
+			for zb0006 := range (*z).RemovedHashes {
+				var zb0022 int
+				zb0022, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "RemovedHashes", zb0006)
+					return
+				}
+				if zb0022 > catchpointDeltaHashLength {
+					err = msgp.ErrOverflow(uint64(zb0022), uint64(catchpointDeltaHashLength))
+					return
+				}
+				(*z).RemovedHashes[zb0006], bts, err = msgp.ReadBytesBytes(bts, (*z).RemovedHashes[zb0006])
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "RemovedHashes", zb0006)
+					return
+				}
+			}
+		}
+		if zb0007 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0007)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0008 {
+			(*z) = catchpointFileDeltaChunk{}
+		}
+		for zb0007 > 0 {
+			zb0007--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "bl":
+				var zb0023 int
+				var zb0024 bool
+				zb0023, zb0024, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Balances")
+					return
+				}
+				if zb0023 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0023), uint64(BalancesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "Balances")
+					return
+				}
+				if zb0024 {
+					(*z).Balances = nil
+				} else if (*z).Balances != nil && cap((*z).Balances) >= zb0023 {
+					(*z).Balances = ((*z).Balances)[:zb0023]
+				} else {
+					(*z).Balances = make([]encoded.BalanceRecordV6, zb0023)
+				}
+				for zb0001 := range (*z).Balances {
+					bts, err = (*z).Balances[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Balances", zb0001)
+						return
+					}
+				}
+			case "kv":
+				var zb0025 int
+				var zb0026 bool
+				zb0025, zb0026, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "KVs")
+					return
+				}
+				if zb0025 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0025), uint64(BalancesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "KVs")
+					return
+				}
+				if zb0026 {
+					(*z).KVs = nil
+				} else if (*z).KVs != nil && cap((*z).KVs) >= zb0025 {
+					(*z).KVs = ((*z).KVs)[:zb0025]
+				} else {
+					(*z).KVs = make([]encoded.KVRecordV6, zb0025)
+				}
+				for zb0002 := range (*z).KVs {
+					bts, err = (*z).KVs[zb0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "KVs", zb0002)
+						return
+					}
+				}
+			case "da":
+				var zb0027 int
+				var zb0028 bool
+				zb0027, zb0028, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "DeletedAccounts")
+					return
+				}
+				if zb0027 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0027), uint64(BalancesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "DeletedAccounts")
+					return
+				}
+				if zb0028 {
+					(*z).DeletedAccounts = nil
+				} else if (*z).DeletedAccounts != nil && cap((*z).DeletedAccounts) >= zb0027 {
+					(*z).DeletedAccounts = ((*z).DeletedAccounts)[:zb0027]
+				} else {
+					(*z).DeletedAccounts = make([]basics.Address, zb0027)
+				}
+				for zb0003 := range (*z).DeletedAccounts {
+					bts, err = (*z).DeletedAccounts[zb0003].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "DeletedAccounts", zb0003)
+						return
+					}
+				}
+			case "dr":
+				var zb0029 int
+				var zb0030 bool
+				zb0029, zb0030, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "DeletedResources")
+					return
+				}
+				if zb0029 > ResourcesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0029), uint64(ResourcesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "DeletedResources")
+					return
+				}
+				if zb0030 {
+					(*z).DeletedResources = nil
+				} else if (*z).DeletedResources != nil && cap((*z).DeletedResources) >= zb0029 {
+					(*z).DeletedResources = ((*z).DeletedResources)[:zb0029]
+				} else {
+					(*z).DeletedResources = make([]encoded.ResourceKeyRecordV6, zb0029)
+				}
+				for zb0004 := range (*z).DeletedResources {
+					bts, err = (*z).DeletedResources[zb0004].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "DeletedResources", zb0004)
+						return
+					}
+				}
+			case "dk":
+				var zb0031 int
+				var zb0032 bool
+				zb0031, zb0032, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "DeletedKVs")
+					return
+				}
+				if zb0031 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0031), uint64(BalancesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "DeletedKVs")
+					return
+				}
+				if zb0032 {
+					(*z).DeletedKVs = nil
+				} else if (*z).DeletedKVs != nil && cap((*z).DeletedKVs) >= zb0031 {
+					(*z).DeletedKVs = ((*z).DeletedKVs)[:zb0031]
+				} else {
+					(*z).DeletedKVs = make([][]byte, zb0031)
+				}
+				for zb0005 := range (*z).DeletedKVs {
+					var zb0033 int
+					zb0033, err = msgp.ReadBytesBytesHeader(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "DeletedKVs", zb0005)
+						return
+					}
+					if zb0033 > encoded.KVRecordV6MaxKeyLength {
+						err = msgp.ErrOverflow(uint64(zb0033), uint64(encoded.KVRecordV6MaxKeyLength))
+						return
+					}
+					(*z).DeletedKVs[zb0005], bts, err = msgp.ReadBytesBytes(bts, (*z).DeletedKVs[zb0005])
+					if err != nil {
+						err = msgp.WrapError(err, "DeletedKVs", zb0005)
+						return
+					}
+				}
+			case "rh":
+				var zb0034 int
+				var zb0035 bool
+				zb0034, zb0035, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "RemovedHashes")
+					return
+				}
+				if zb0034 > ResourcesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0034), uint64(ResourcesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "RemovedHashes")
+					return
+				}
+				if zb0035 {
+					(*z).RemovedHashes = nil
+				} else if (*z).RemovedHashes != nil && cap((*z).RemovedHashes) >= zb0034 {
+					(*z).RemovedHashes = ((*z).RemovedHashes)[:zb0034]
+				} else {
+					(*z).RemovedHashes = make([][]byte, zb0034)
+				}
+				for zb0006 := range (*z).RemovedHashes {
+					var zb0036 int
+					zb0036, err = msgp.ReadBytesBytesHeader(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "RemovedHashes", zb0006)
+						return
+					}
+					if zb0036 > catchpointDeltaHashLength {
+						err = msgp.ErrOverflow(uint64(zb0036), uint64(catchpointDeltaHashLength))
+						return
+					}
+					(*z).RemovedHashes[zb0006], bts, err = msgp.ReadBytesBytes(bts, (*z).RemovedHashes[zb0006])
+					if err != nil {
+						err = msgp.WrapError(err, "RemovedHashes", zb0006)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *catchpointFileDeltaChunk) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileDeltaChunk)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *catchpointFileDeltaChunk) Msgsize() (s int) {
+	s = 1 + 3 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Balances {
+		s += (*z).Balances[zb0001].Msgsize()
+	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0002 := range (*z).KVs {
+		s += (*z).KVs[zb0002].Msgsize()
+	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0003 := range (*z).DeletedAccounts {
+		s += (*z).DeletedAccounts[zb0003].Msgsize()
+	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0004 := range (*z).DeletedResources {
+		s += (*z).DeletedResources[zb0004].Msgsize()
+	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0005 := range (*z).DeletedKVs {
+		s += msgp.BytesPrefixSize + len((*z).DeletedKVs[zb0005])
+	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0006 := range (*z).RemovedHashes {
+		s += msgp.BytesPrefixSize + len((*z).RemovedHashes[zb0006])
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *catchpointFileDeltaChunk) MsgIsZero() bool {
+	return (len((*z).Balances) == 0) && (len((*z).KVs) == 0) && (len((*z).DeletedAccounts) == 0) && (len((*z).DeletedResources) == 0) && (len((*z).DeletedKVs) == 0) && (len((*z).RemovedHashes) == 0)
+}
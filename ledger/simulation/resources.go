@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// BoxRef identifies a box by the app that owns it and its name.
+type BoxRef struct {
+	App  basics.AppIndex
+	Name string
+}
+
+// ResourceTracker records the resources a transaction used without naming them.
+type ResourceTracker struct {
+	Accounts map[basics.Address]struct{}
+	Assets   map[basics.AssetIndex]struct{}
+	Apps     map[basics.AppIndex]struct{}
+	Boxes    map[BoxRef]struct{}
+}
+
+func makeResourceTracker() ResourceTracker {
+	return ResourceTracker{
+		Accounts: make(map[basics.Address]struct{}),
+		Assets:   make(map[basics.AssetIndex]struct{}),
+		Apps:     make(map[basics.AppIndex]struct{}),
+		Boxes:    make(map[BoxRef]struct{}),
+	}
+}
+
+// resourcePolicy is the logic.UnnamedResourcePolicy of a simulation that allows
+// unnamed resources. Only the programs of top-level transactions may use them,
+// since those are the only transactions whose foreign arrays the caller can
+// fill in. Every allowed access is recorded on the top-level transaction's
+// result, and makes the group one that would not succeed as submitted.
+type resourcePolicy struct {
+	tracer *evalTracer
+}
+
+// tracker returns the ResourceTracker of the top-level transaction being
+// evaluated, or nil if an inner transaction is being evaluated.
+func (p *resourcePolicy) tracker() *ResourceTracker {
+	if len(p.tracer.txnPath) != 1 {
+		return nil
+	}
+	txnResult := p.tracer.txnResult(int(p.tracer.txnPath[0]))
+	if txnResult.UnnamedResourcesAccessed == nil {
+		tracker := makeResourceTracker()
+		txnResult.UnnamedResourcesAccessed = &tracker
+	}
+	p.tracer.result.WouldSucceed = false
+	return txnResult.UnnamedResourcesAccessed
+}
+
+func (p *resourcePolicy) AvailableAccount(addr basics.Address) bool {
+	tracker := p.tracker()
+	if tracker == nil {
+		return false
+	}
+	tracker.Accounts[addr] = struct{}{}
+	return true
+}
+
+func (p *resourcePolicy) AvailableAsset(aid basics.AssetIndex) bool {
+	tracker := p.tracker()
+	if tracker == nil {
+		return false
+	}
+	tracker.Assets[aid] = struct{}{}
+	return true
+}
+
+func (p *resourcePolicy) AvailableApp(aid basics.AppIndex) bool {
+	tracker := p.tracker()
+	if tracker == nil {
+		return false
+	}
+	tracker.Apps[aid] = struct{}{}
+	return true
+}
+
+func (p *resourcePolicy) AvailableBox(app basics.AppIndex, name string) bool {
+	tracker := p.tracker()
+	if tracker == nil {
+		return false
+	}
+	tracker.Boxes[BoxRef{App: app, Name: name}] = struct{}{}
+	return true
+}
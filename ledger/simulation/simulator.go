@@ -18,14 +18,16 @@ package simulation
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
-	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/internal"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -46,6 +48,14 @@ func (l simulatorLedger) Latest() basics.Round {
 	return l.start
 }
 
+// LatestTotals is part of the LedgerForEvaluator interface.
+// We override this to return the totals of the set latest round, so that the
+// evaluator can start from a round before the underlying ledger's latest.
+func (l simulatorLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	totals, err := l.Totals(l.start)
+	return l.start, totals, err
+}
+
 // LookupLatest would implicitly use the latest round in the _underlying_
 // Ledger, it would give wrong results if that ledger has moved forward. But it
 // should never be called, as the REST API is the only code using this function,
@@ -55,18 +65,6 @@ func (l simulatorLedger) LookupLatest(addr basics.Address) (basics.AccountData,
 	return basics.AccountData{}, 0, basics.MicroAlgos{}, err
 }
 
-// ==============================
-// > Simulator Tracer
-// ==============================
-
-type evalTracer struct {
-	logic.NullEvalTracer
-}
-
-func makeTracer() logic.EvalTracer {
-	return &evalTracer{}
-}
-
 // ==============================
 // > Simulator Errors
 // ==============================
@@ -89,25 +87,77 @@ type InvalidTxGroupError struct {
 	SimulatorError
 }
 
+// InvalidRequestError occurs when an invalid simulation request was submitted to the simulator.
+type InvalidRequestError struct {
+	SimulatorError
+}
+
 // EvalFailureError represents an error that occurred during evaluation.
 type EvalFailureError struct {
 	SimulatorError
 }
 
+// ==============================
+// > Simulator Request
+// ==============================
+
+// Request packs simulation related txn-group(s) and configurations.
+type Request struct {
+	// TxnGroups are evaluated in order in a single simulated block, so later groups see the state
+	// changes of earlier ones.
+	TxnGroups [][]transactions.SignedTxn
+
+	// Round is the round the simulation is evaluated after. Zero means the latest round. It can be
+	// at most MaxAcctLookback rounds before the latest round.
+	Round basics.Round
+
+	// AllowMoreLogging lifts the log count and size limits to MaxLogCalls and MaxLogSize.
+	AllowMoreLogging bool
+	// AllowUnnamedResources lets the app programs of top-level transactions use accounts, assets,
+	// apps and boxes they did not name, and reports which ones they used.
+	AllowUnnamedResources bool
+	// ExtraOpcodeBudget is added to the app budget pool of each group, up to MaxExtraOpcodeBudget.
+	ExtraOpcodeBudget uint64
+	// ExtraLogicSigBudget is added to the budget of each LogicSig, up to MaxExtraLogicSigBudget.
+	ExtraLogicSigBudget uint64
+
+	TraceConfig ExecTraceConfig
+}
+
 // ==============================
 // > Simulator
 // ==============================
 
 // Simulator is a transaction group simulator for the block evaluator.
 type Simulator struct {
-	ledger simulatorLedger
+	ledger          *data.Ledger
+	maxAcctLookback uint64
 }
 
-// MakeSimulator creates a new simulator from a ledger.
-func MakeSimulator(ledger *data.Ledger) *Simulator {
+// MakeSimulator creates a new simulator from a ledger. maxAcctLookback is the
+// number of rounds before the latest round the ledger can evaluate against.
+func MakeSimulator(ledger *data.Ledger, maxAcctLookback uint64) *Simulator {
 	return &Simulator{
-		ledger: simulatorLedger{ledger, ledger.Latest()},
+		ledger:          ledger,
+		maxAcctLookback: maxAcctLookback,
+	}
+}
+
+// simulatorLedger returns the ledger to evaluate the request against, pinned to the requested round.
+func (s Simulator) simulatorLedger(round basics.Round) (simulatorLedger, error) {
+	latest := s.ledger.Latest()
+	if round == 0 {
+		return simulatorLedger{s.ledger, latest}, nil
+	}
+	if round > latest {
+		err := fmt.Errorf("round %d is after the latest round %d", round, latest)
+		return simulatorLedger{}, InvalidRequestError{SimulatorError{err}}
+	}
+	if uint64(latest-round) > s.maxAcctLookback {
+		err := fmt.Errorf("round %d is more than %d rounds before the latest round %d", round, s.maxAcctLookback, latest)
+		return simulatorLedger{}, InvalidRequestError{SimulatorError{err}}
 	}
+	return simulatorLedger{s.ledger, round}, nil
 }
 
 func txnHasNoSignature(txn transactions.SignedTxn) bool {
@@ -128,10 +178,10 @@ var proxySigner = crypto.PrivateKey{
 // check verifies that the transaction is well-formed and has valid or missing signatures.
 // An invalid transaction group error is returned if the transaction is not well-formed or there are invalid signatures.
 // To make things easier, we support submitting unsigned transactions and will respond whether signatures are missing.
-func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.SignedTxn, debugger logic.EvalTracer) (bool, error) {
+func (s Simulator) check(ledger simulatorLedger, hdr bookkeeping.BlockHeader, txgroup []transactions.SignedTxn, tracer *evalTracer) error {
 	proxySignerSecrets, err := crypto.SecretKeyToSignatureSecrets(proxySigner)
 	if err != nil {
-		return false, err
+		return err
 	}
 
 	// Find and prep any transactions that are missing signatures. We will modify a copy of these
@@ -143,14 +193,13 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 	// is indistinguishable from an escrow LogicSig, so we would need to decide on another way of
 	// denoting that a LogicSig's delegation signature is omitted, e.g. by setting all the bits of
 	// the signature.
-	missingSigs := make([]int, 0, len(txgroup))
 	txnsToVerify := make([]transactions.SignedTxn, len(txgroup))
 	for i, stxn := range txgroup {
 		if stxn.Txn.Type == protocol.StateProofTx {
-			return false, errors.New("cannot simulate StateProof transactions")
+			return errors.New("cannot simulate StateProof transactions")
 		}
 		if txnHasNoSignature(stxn) {
-			missingSigs = append(missingSigs, i)
+			tracer.txnResult(i).MissingSignature = true
 
 			// Replace the signed txn with one signed by the proxySigner. At evaluation this would
 			// raise an error, since the proxySigner's public key likely does not have authority
@@ -163,28 +212,37 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 	}
 
 	// Verify the signed transactions are well-formed and have valid signatures
-	_, err = verify.TxnGroupWithTracer(txnsToVerify, &hdr, nil, s.ledger, debugger)
+	_, err = verify.TxnGroupWithEvalConstants(txnsToVerify, &hdr, nil, ledger, tracer, tracer.result.EvalOverrides.evalConstants())
 	if err != nil {
-		return false, InvalidTxGroupError{SimulatorError{err}}
+		return InvalidTxGroupError{SimulatorError{err}}
 	}
 
-	return len(missingSigs) != 0, nil
+	return nil
 }
 
-func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn, tracer logic.EvalTracer) (*ledgercore.ValidatedBlock, error) {
-	// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
-	// and data.Ledger embeds *ledger.Ledger
-	eval, err := s.ledger.StartEvaluator(hdr, len(stxns), 0)
+func (s Simulator) evaluate(ledger simulatorLedger, hdr bookkeeping.BlockHeader, txgroups [][]transactions.SignedTxn, tracer *evalTracer) (*ledgercore.ValidatedBlock, error) {
+	paysetHint := 0
+	for _, txgroup := range txgroups {
+		paysetHint += len(txgroup)
+	}
+
+	eval, err := internal.StartEvaluator(ledger, hdr, internal.EvaluatorOptions{
+		PaysetHint: paysetHint,
+		Generate:   true,
+		Validate:   true,
+	})
 	if err != nil {
 		return nil, err
 	}
 	eval.Tracer = tracer
 
-	group := transactions.WrapSignedTxnsWithAD(stxns)
-
-	err = eval.TransactionGroup(group)
-	if err != nil {
-		return nil, EvalFailureError{SimulatorError{err}}
+	for i, txgroup := range txgroups {
+		tracer.startGroup(i)
+		err = eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup))
+		tracer.result.TxnGroups[i].AppBudgetAdded = tracer.appBudgetAdded()
+		if err != nil {
+			return nil, EvalFailureError{SimulatorError{err}}
+		}
 	}
 
 	// Finally, process any pending end-of-block state changes.
@@ -196,22 +254,68 @@ func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.Si
 	return vb, nil
 }
 
-// Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
-func (s Simulator) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, error) {
-	prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.start)
+// Simulate simulates transaction groups using the simulator. Will error if a transaction group is not well-formed.
+// The groups are evaluated in order, and evaluation stops at the first group that fails. Failures during
+// evaluation are not returned as errors, but are reported in the result of the failing group along with the
+// path to the failing transaction.
+func (s Simulator) Simulate(request Request) (Result, error) {
+	if len(request.TxnGroups) == 0 {
+		err := errors.New("expected at least one transaction group")
+		return Result{}, InvalidRequestError{SimulatorError{err}}
+	}
+
+	ledger, err := s.simulatorLedger(request.Round)
 	if err != nil {
-		return nil, false, err
+		return Result{}, err
+	}
+
+	prevBlockHdr, err := ledger.BlockHdr(ledger.start)
+	if err != nil {
+		return Result{}, err
 	}
 	nextBlock := bookkeeping.MakeBlock(prevBlockHdr)
 	hdr := nextBlock.BlockHeader
-	simulatorTracer := makeTracer()
 
-	// check that the transaction is well-formed and mark whether signatures are missing
-	missingSignatures, err := s.check(hdr, txgroup, simulatorTracer)
+	result, err := makeSimulationResult(ledger.start, request)
 	if err != nil {
-		return nil, false, err
+		return Result{}, err
+	}
+	simulatorTracer := makeEvalTracer(&result)
+
+	// check that the transactions are well-formed and mark whether signatures are missing
+	for i, txgroup := range request.TxnGroups {
+		simulatorTracer.startGroup(i)
+		err = s.check(ledger, hdr, txgroup, simulatorTracer)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	_, err = s.evaluate(ledger, hdr, request.TxnGroups, simulatorTracer)
+	if err != nil {
+		var evalErr EvalFailureError
+		if !errors.As(err, &evalErr) {
+			return Result{}, err
+		}
+		failedGroup := &result.TxnGroups[simulatorTracer.groupIndex]
+		failedGroup.FailureMessage = evalErr.Error()
+		failedGroup.FailedAt = simulatorTracer.failedAt()
+		result.WouldSucceed = false
+	}
+
+	proto := config.Consensus[hdr.CurrentProtocol]
+	for _, groupResult := range result.TxnGroups {
+		// budget beyond what the network would provide
+		if groupResult.AppBudgetConsumed+result.EvalOverrides.ExtraOpcodeBudget > groupResult.AppBudgetAdded {
+			result.WouldSucceed = false
+		}
+
+		for _, txnResult := range groupResult.Txns {
+			if txnResult.MissingSignature || txnResult.LogicSigBudgetConsumed > proto.LogicSigMaxCost {
+				result.WouldSucceed = false
+			}
+		}
 	}
 
-	vb, err := s.evaluate(hdr, txgroup, simulatorTracer)
-	return vb, missingSignatures, err
+	return result, nil
 }
@@ -20,12 +20,15 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 
@@ -127,6 +130,18 @@ func attachGroupID(txgroup []transactions.SignedTxn) error {
 	return nil
 }
 
+func simulateGroup(s *simulation.Simulator, txgroup []transactions.SignedTxn) (simulation.Result, error) {
+	return s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{txgroup}})
+}
+
+func requireSuccess(t *testing.T, result simulation.Result, err error) {
+	t.Helper()
+	require.NoError(t, err)
+	require.Len(t, result.TxnGroups, 1)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Empty(t, result.TxnGroups[0].FailedAt)
+}
+
 func uint64ToBytes(num uint64) []byte {
 	ibytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(ibytes, num)
@@ -216,7 +231,7 @@ func TestPayTxn(t *testing.T) {
 			t.Parallel()
 			l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 			defer l.Close()
-			s := simulation.MakeSimulator(l)
+			s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 			sender := accounts[0]
 
 			txn := transactions.SignedTxn{
@@ -236,8 +251,8 @@ func TestPayTxn(t *testing.T) {
 
 			txgroup := []transactions.SignedTxn{txn}
 
-			_, _, err := s.Simulate(txgroup)
-			require.NoError(t, err)
+			result, err := simulateGroup(s, txgroup)
+			requireSuccess(t, result, err)
 		})
 	}
 }
@@ -252,7 +267,7 @@ func TestOverspendPayTxn(t *testing.T) {
 			t.Parallel()
 			l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 			defer l.Close()
-			s := simulation.MakeSimulator(l)
+			s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 			sender := accounts[0]
 			senderBalance := sender.acctData.MicroAlgos
 			amount := senderBalance.Raw + 100
@@ -274,9 +289,11 @@ func TestOverspendPayTxn(t *testing.T) {
 
 			txgroup := []transactions.SignedTxn{txn}
 
-			_, _, err := s.Simulate(txgroup)
-			require.ErrorAs(t, err, &simulation.EvalFailureError{})
-			require.ErrorContains(t, err, fmt.Sprintf("tried to spend {%d}", amount))
+			result, err := simulateGroup(s, txgroup)
+			require.NoError(t, err)
+			require.False(t, result.WouldSucceed)
+			require.Contains(t, result.TxnGroups[0].FailureMessage, fmt.Sprintf("tried to spend {%d}", amount))
+			require.Equal(t, simulation.TxnPath{0}, result.TxnGroups[0].FailedAt)
 		})
 	}
 }
@@ -291,7 +308,7 @@ func TestAuthAddrTxn(t *testing.T) {
 			t.Parallel()
 			l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 			defer l.Close()
-			s := simulation.MakeSimulator(l)
+			s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 			sender := accounts[0]
 			authority := accounts[1]
 
@@ -313,8 +330,9 @@ func TestAuthAddrTxn(t *testing.T) {
 
 			txgroup := []transactions.SignedTxn{txn}
 
-			_, _, err := s.Simulate(txgroup)
-			require.ErrorContains(t, err, fmt.Sprintf("should have been authorized by %s but was actually authorized by %s", sender.addr, authority.addr))
+			result, err := simulateGroup(s, txgroup)
+			require.NoError(t, err)
+			require.Contains(t, result.TxnGroups[0].FailureMessage, fmt.Sprintf("should have been authorized by %s but was actually authorized by %s", sender.addr, authority.addr))
 		})
 	}
 }
@@ -325,7 +343,7 @@ func TestStateProofTxn(t *testing.T) {
 
 	l, _, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 
 	txgroup := []transactions.SignedTxn{
 		{
@@ -337,7 +355,7 @@ func TestStateProofTxn(t *testing.T) {
 		},
 	}
 
-	_, _, err := s.Simulate(txgroup)
+	_, err := simulateGroup(s, txgroup)
 	require.ErrorContains(t, err, "cannot simulate StateProof transactions")
 }
 
@@ -347,7 +365,7 @@ func TestSimpleGroupTxn(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender1 := accounts[0].addr
 	sender1Balance := accounts[0].acctData.MicroAlgos
 	sender2 := accounts[1].addr
@@ -378,9 +396,9 @@ func TestSimpleGroupTxn(t *testing.T) {
 	}
 
 	// Should fail if there is no group parameter
-	_, _, err := s.Simulate(txgroup)
-	require.ErrorAs(t, err, &simulation.EvalFailureError{})
-	require.ErrorContains(t, err, "had zero Group but was submitted in a group of 2")
+	result, err := simulateGroup(s, txgroup)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "had zero Group but was submitted in a group of 2")
 
 	// Add group parameter
 	err = attachGroupID(txgroup)
@@ -396,8 +414,8 @@ func TestSimpleGroupTxn(t *testing.T) {
 	require.Equal(t, sender2Balance, sender2Data.MicroAlgos)
 
 	// Should now pass
-	_, _, err = s.Simulate(txgroup)
-	require.NoError(t, err)
+	result, err = simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
 
 	// Confirm balances have not changed
 	sender1Data, _, err = l.LookupWithoutRewards(l.Latest(), sender1)
@@ -420,7 +438,7 @@ func TestSimpleAppCall(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender := accounts[0].addr
 
 	// Compile AVM program
@@ -464,8 +482,8 @@ func TestSimpleAppCall(t *testing.T) {
 	err = attachGroupID(txgroup)
 	require.NoError(t, err)
 
-	_, _, err = s.Simulate(txgroup)
-	require.NoError(t, err)
+	result, err := simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
 }
 
 func TestRejectAppCall(t *testing.T) {
@@ -474,7 +492,7 @@ func TestRejectAppCall(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender := accounts[0].addr
 
 	// Compile AVM program
@@ -508,9 +526,10 @@ func TestRejectAppCall(t *testing.T) {
 	err = attachGroupID(txgroup)
 	require.NoError(t, err)
 
-	_, _, err = s.Simulate(txgroup)
-	require.ErrorAs(t, err, &simulation.EvalFailureError{})
-	require.ErrorContains(t, err, "transaction rejected by ApprovalProgram")
+	result, err := simulateGroup(s, txgroup)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "transaction rejected by ApprovalProgram")
+	require.Equal(t, simulation.TxnPath{0}, result.TxnGroups[0].FailedAt)
 }
 
 func TestSignatureCheck(t *testing.T) {
@@ -519,7 +538,7 @@ func TestSignatureCheck(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender := accounts[0].addr
 
 	txgroup := []transactions.SignedTxn{
@@ -536,22 +555,24 @@ func TestSignatureCheck(t *testing.T) {
 	}
 
 	// should catch missing signature
-	_, missingSignatures, err := s.Simulate(txgroup)
+	result, err := simulateGroup(s, txgroup)
 	require.NoError(t, err)
-	require.True(t, missingSignatures)
+	require.True(t, result.TxnGroups[0].Txns[0].MissingSignature)
+	require.False(t, result.WouldSucceed)
 
 	// add signature
 	signatureSecrets := accounts[0].sk
 	txgroup[0] = txgroup[0].Txn.Sign(signatureSecrets)
 
 	// should not error now that we have a signature
-	_, missingSignatures, err = s.Simulate(txgroup)
-	require.NoError(t, err)
-	require.False(t, missingSignatures)
+	result, err = simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
+	require.False(t, result.TxnGroups[0].Txns[0].MissingSignature)
+	require.True(t, result.WouldSucceed)
 
 	// should error with invalid signature
 	txgroup[0].Sig[0] += byte(1) // will wrap if > 255
-	_, _, err = s.Simulate(txgroup)
+	_, err = simulateGroup(s, txgroup)
 	require.ErrorAs(t, err, &simulation.InvalidTxGroupError{})
 	require.ErrorContains(t, err, "one signature didn't pass")
 }
@@ -564,7 +585,7 @@ func TestInvalidTxGroup(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	receiver := accounts[0].addr
 
 	txgroup := []transactions.SignedTxn{
@@ -582,7 +603,7 @@ func TestInvalidTxGroup(t *testing.T) {
 	}
 
 	// should error with invalid transaction group error
-	_, _, err := s.Simulate(txgroup)
+	_, err := simulateGroup(s, txgroup)
 	require.ErrorAs(t, err, &simulation.InvalidTxGroupError{})
 	require.ErrorContains(t, err, "transaction from incentive pool is invalid")
 }
@@ -608,7 +629,7 @@ func TestBalanceChangesWithApp(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender := accounts[0].addr
 	senderBalance := accounts[0].acctData.MicroAlgos.Raw
 	sendAmount := senderBalance - 500000
@@ -687,8 +708,8 @@ func TestBalanceChangesWithApp(t *testing.T) {
 	err = attachGroupID(txgroup)
 	require.NoError(t, err)
 
-	_, _, err = s.Simulate(txgroup)
-	require.NoError(t, err)
+	result, err := simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
 }
 
 // TestBalanceChangesWithApp tests that the simulator's transaction group checks
@@ -701,7 +722,7 @@ func TestPooledFeesAcrossSignedAndUnsigned(t *testing.T) {
 
 	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
 	defer l.Close()
-	s := simulation.MakeSimulator(l)
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
 	sender1 := accounts[0].addr
 	sender2 := accounts[1].addr
 
@@ -741,6 +762,595 @@ func TestPooledFeesAcrossSignedAndUnsigned(t *testing.T) {
 	signatureSecrets := accounts[0].sk
 	txgroup[0] = txgroup[0].Txn.Sign(signatureSecrets)
 
-	_, _, err = s.Simulate(txgroup)
+	result, err := simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
+}
+
+const execTraceProgram = `#pragma version 6
+txn ApplicationID
+bz end
+int 1
+store 0
+byte "key"
+int 7
+app_global_put
+itxn_begin
+int pay
+itxn_field TypeEnum
+txna ApplicationArgs 0
+btoi
+itxn_field Amount
+txn Sender
+itxn_field Receiver
+itxn_submit
+end:
+int 1`
+
+// makeAppCallGroup creates an app with the given approval program, then calls
+// it with the given arguments and fee. The transactions are signed by sender.
+func makeAppCallGroup(t *testing.T, makeTxnHeader func(basics.Address) transactions.Header, sender account, program string, args [][]byte, fee uint64) []transactions.SignedTxn {
+	ops, err := logic.AssembleString(program)
+	require.NoError(t, err, ops.Errors)
+	prog := ops.Program
+
+	futureAppID := 1
+	callHeader := makeTxnHeader(sender.addr)
+	callHeader.Fee = basics.MicroAlgos{Raw: fee}
+	txgroup := []transactions.SignedTxn{
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: makeTxnHeader(sender.addr),
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApplicationID:     0,
+					ApprovalProgram:   prog,
+					ClearStateProgram: prog,
+					GlobalStateSchema: basics.StateSchema{
+						NumUint: 1,
+					},
+				},
+			},
+		},
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: callHeader,
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApplicationID:   basics.AppIndex(futureAppID),
+					ApplicationArgs: args,
+				},
+			},
+		},
+	}
+
+	err = attachGroupID(txgroup)
+	require.NoError(t, err)
+	for i := range txgroup {
+		txgroup[i] = txgroup[i].Txn.Sign(sender.sk)
+	}
+	return txgroup
+}
+
+// makeExecTraceGroup creates an app running execTraceProgram and calls it,
+// asking it to pay innerAmount to the sender in an inner transaction.
+func makeExecTraceGroup(t *testing.T, makeTxnHeader func(basics.Address) transactions.Header, sender account, innerAmount uint64) []transactions.SignedTxn {
+	// the call pays for the inner transaction
+	return makeAppCallGroup(t, makeTxnHeader, sender, execTraceProgram, [][]byte{uint64ToBytes(innerAmount)}, 2000)
+}
+
+func TestExecTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	txgroup := makeExecTraceGroup(t, makeTxnHeader, sender, 0)
+
+	// without a trace config, no trace is recorded
+	result, err := simulateGroup(s, txgroup)
+	requireSuccess(t, result, err)
+	for _, txnResult := range result.TxnGroups[0].Txns {
+		require.Nil(t, txnResult.Trace)
+	}
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{txgroup},
+		TraceConfig: simulation.ExecTraceConfig{
+			Enable:  true,
+			Stack:   true,
+			Scratch: true,
+			State:   true,
+		},
+	})
+	requireSuccess(t, result, err)
+	require.True(t, result.TraceConfig.Enable)
+
+	// the create call branches straight to the end: intcblock, txn, bz, intc
+	createTrace := result.TxnGroups[0].Txns[0].Trace
+	require.NotNil(t, createTrace)
+	require.Len(t, createTrace.ApprovalProgramTrace, 4)
+	require.Empty(t, createTrace.ClearStateProgramTrace)
+	require.Empty(t, createTrace.InnerTraces)
+
+	callTrace := result.TxnGroups[0].Txns[1].Trace
+	require.NotNil(t, callTrace)
+	require.Empty(t, callTrace.LogicSigTrace)
+	require.Len(t, callTrace.InnerTraces, 1)
+	require.Equal(t, simulation.TransactionTrace{}, callTrace.InnerTraces[0])
+
+	var scratchChanges []simulation.ScratchChange
+	var stateChanges []simulation.StateOperation
+	var spawned [][]uint64
+	for _, unit := range callTrace.ApprovalProgramTrace {
+		scratchChanges = append(scratchChanges, unit.ScratchSlotChanges...)
+		stateChanges = append(stateChanges, unit.StateChanges...)
+		if len(unit.SpawnedInners) > 0 {
+			spawned = append(spawned, unit.SpawnedInners)
+		}
+	}
+	require.Equal(t, []simulation.ScratchChange{{
+		Slot:     0,
+		NewValue: basics.TealValue{Type: basics.TealUintType, Uint: 1},
+	}}, scratchChanges)
+	require.Equal(t, []simulation.StateOperation{{
+		Operation: simulation.StateWrite,
+		State:     simulation.GlobalState,
+		AppID:     1,
+		Key:       "key",
+		NewValue:  basics.TealValue{Type: basics.TealUintType, Uint: 7},
+	}}, stateChanges)
+	require.Equal(t, [][]uint64{{0}}, spawned)
+
+	// the program ends by pushing 1
+	last := callTrace.ApprovalProgramTrace[len(callTrace.ApprovalProgramTrace)-1]
+	require.Equal(t, uint64(0), last.StackPopCount)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 1}}, last.StackAdded)
+}
+
+func TestExecTraceInnerFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	// the app account cannot afford this payment
+	txgroup := makeExecTraceGroup(t, makeTxnHeader, sender, 1_000_000)
+
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{txgroup},
+		TraceConfig: simulation.ExecTraceConfig{Enable: true},
+	})
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+	require.Equal(t, simulation.TxnPath{1, 0}, result.TxnGroups[0].FailedAt)
+
+	callTrace := result.TxnGroups[0].Txns[1].Trace
+	require.NotNil(t, callTrace)
+	require.Len(t, callTrace.InnerTraces, 1)
+	require.NotEmpty(t, callTrace.ApprovalProgramTrace)
+}
+
+func TestSimulateNoGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, _, _ := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+
+	_, err := s.Simulate(simulation.Request{})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+}
+
+func TestExecTraceLogicSig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	ops, err := logic.AssembleString(trivialAVMProgram)
+	require.NoError(t, err, ops.Errors)
+	prog := ops.Program
+
+	// a payment authorized by a logic sig delegated by the sender
+	txn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: makeTxnHeader(sender.addr),
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: accounts[1].addr,
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		},
+		Lsig: transactions.LogicSig{
+			Logic: prog,
+			Sig:   sender.sk.Sign(logic.Program(prog)),
+		},
+	}
+
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{txn}},
+		TraceConfig: simulation.ExecTraceConfig{Enable: true, Stack: true},
+	})
+	requireSuccess(t, result, err)
+	require.False(t, result.TxnGroups[0].Txns[0].MissingSignature)
+
+	trace := result.TxnGroups[0].Txns[0].Trace
+	require.NotNil(t, trace)
+	require.Empty(t, trace.ApprovalProgramTrace)
+	require.NotEmpty(t, trace.LogicSigTrace)
+
+	last := trace.LogicSigTrace[len(trace.LogicSigTrace)-1]
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 1}}, last.StackAdded)
+}
+
+// expensiveAppProgram costs about 2000 when called, more than the 1400 budget
+// of a group with two app calls.
+const expensiveAppProgram = `#pragma version 6
+txn ApplicationID
+bz end
+int 0
+loop:
+int 1
++
+dup
+int 333
+<
+bnz loop
+pop
+end:
+int 1`
+
+func TestExtraOpcodeBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	txgroup := makeAppCallGroup(t, makeTxnHeader, sender, expensiveAppProgram, nil, 1000)
+
+	result, err := simulateGroup(s, txgroup)
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "dynamic cost budget exceeded")
+	require.Equal(t, uint64(1400), result.TxnGroups[0].AppBudgetAdded)
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:         [][]transactions.SignedTxn{txgroup},
+		ExtraOpcodeBudget: 1000,
+	})
+	requireSuccess(t, result, err)
+	// the group would fail without the extra budget
+	require.False(t, result.WouldSucceed)
+	require.Equal(t, uint64(1000), result.EvalOverrides.ExtraOpcodeBudget)
+
+	groupResult := result.TxnGroups[0]
+	require.Equal(t, uint64(2400), groupResult.AppBudgetAdded)
+	require.Greater(t, groupResult.AppBudgetConsumed, uint64(1400))
+	require.LessOrEqual(t, groupResult.AppBudgetConsumed, uint64(2400))
+	require.Equal(t, groupResult.AppBudgetConsumed, groupResult.Txns[0].AppBudgetConsumed+groupResult.Txns[1].AppBudgetConsumed)
+	require.Equal(t, uint64(4), groupResult.Txns[0].AppBudgetConsumed)
+
+	_, err = s.Simulate(simulation.Request{
+		TxnGroups:         [][]transactions.SignedTxn{txgroup},
+		ExtraOpcodeBudget: simulation.MaxExtraOpcodeBudget + 1,
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+}
+
+func TestAppBudgetWithinLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	// extra budget that is not needed does not change the outcome
+	txgroup := makeAppCallGroup(t, makeTxnHeader, sender, trivialAVMProgram, nil, 1000)
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups:         [][]transactions.SignedTxn{txgroup},
+		ExtraOpcodeBudget: 100,
+	})
+	requireSuccess(t, result, err)
+	require.True(t, result.WouldSucceed)
+	require.Equal(t, uint64(1500), result.TxnGroups[0].AppBudgetAdded)
+	// intcblock and intc in each of the two programs
+	require.Equal(t, uint64(4), result.TxnGroups[0].AppBudgetConsumed)
+}
+
+func TestAllowMoreLogging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	program := fmt.Sprintf("#pragma version 6\n%sint 1", strings.Repeat("byte \"log\"\nlog\n", 33))
+	txgroup := makeAppCallGroup(t, makeTxnHeader, sender, program, nil, 1000)
+
+	result, err := simulateGroup(s, txgroup)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "too many log calls")
+	require.Nil(t, result.EvalOverrides.MaxLogCalls)
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:        [][]transactions.SignedTxn{txgroup},
+		AllowMoreLogging: true,
+	})
+	requireSuccess(t, result, err)
+	require.False(t, result.WouldSucceed)
+	require.Equal(t, simulation.MaxLogCalls, *result.EvalOverrides.MaxLogCalls)
+	require.Equal(t, simulation.MaxLogSize, *result.EvalOverrides.MaxLogSize)
+	require.Len(t, result.TxnGroups[0].Txns[1].Txn.EvalDelta.Logs, 33)
+}
+
+func TestExtraLogicSigBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	// a LogicSig costing a little more than 20000
+	ops, err := logic.AssembleString(`#pragma version 6
+int 0
+loop:
+int 1
++
+dup
+int 3334
+<
+bnz loop
+pop
+int 1`)
+	require.NoError(t, err, ops.Errors)
+	prog := ops.Program
+
+	txn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: makeTxnHeader(sender.addr),
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: accounts[1].addr,
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		},
+		Lsig: transactions.LogicSig{
+			Logic: prog,
+			Sig:   sender.sk.Sign(logic.Program(prog)),
+		},
+	}
+	txgroup := []transactions.SignedTxn{txn}
+
+	_, err = simulateGroup(s, txgroup)
+	require.ErrorAs(t, err, &simulation.InvalidTxGroupError{})
+	require.ErrorContains(t, err, "dynamic cost budget exceeded")
+
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups:           [][]transactions.SignedTxn{txgroup},
+		ExtraLogicSigBudget: 1000,
+	})
+	requireSuccess(t, result, err)
+	require.False(t, result.WouldSucceed)
+	require.Greater(t, result.TxnGroups[0].Txns[0].LogicSigBudgetConsumed, uint64(20000))
+}
+
+// addBlock evaluates the given transaction groups in a new block and adds it to the ledger.
+func addBlock(t *testing.T, l *data.Ledger, txgroups ...[]transactions.SignedTxn) {
+	prevHdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	blk := bookkeeping.MakeBlock(prevHdr)
+
+	eval, err := l.StartEvaluator(blk.BlockHeader, 0, 0)
+	require.NoError(t, err)
+	for _, txgroup := range txgroups {
+		err = eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup))
+		require.NoError(t, err)
+	}
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	err = l.AddValidatedBlock(*vb, agreement.Certificate{})
+	require.NoError(t, err)
+}
+
+func TestMultipleGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+
+	ops, err := logic.AssembleString(trivialAVMProgram)
+	require.NoError(t, err, ops.Errors)
+	prog := ops.Program
+
+	create := transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: makeTxnHeader(sender.addr),
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApprovalProgram:   prog,
+			ClearStateProgram: prog,
+		},
+	}
+	call := transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: makeTxnHeader(sender.addr),
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: 1,
+		},
+	}
+
+	// the call only succeeds if it sees the app created by the first group
+	txgroups := [][]transactions.SignedTxn{
+		{create.Sign(sender.sk)},
+		{call.Sign(sender.sk)},
+	}
+	result, err := s.Simulate(simulation.Request{TxnGroups: txgroups})
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed)
+	require.Len(t, result.TxnGroups, 2)
+	for _, groupResult := range result.TxnGroups {
+		require.Empty(t, groupResult.FailureMessage)
+		require.Equal(t, uint64(700), groupResult.AppBudgetAdded)
+	}
+	require.Equal(t, basics.AppIndex(1), result.TxnGroups[0].Txns[0].Txn.ApplyData.ApplicationID)
+
+	// in the opposite order, the call fails and stops the simulation
+	txgroups[0], txgroups[1] = txgroups[1], txgroups[0]
+	result, err = s.Simulate(simulation.Request{TxnGroups: txgroups})
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "only clearing out is supported for applications that do not exist")
+	require.Equal(t, simulation.TxnPath{0}, result.TxnGroups[0].FailedAt)
+	require.Empty(t, result.TxnGroups[1].FailureMessage)
+	require.Zero(t, result.TxnGroups[1].Txns[0].Txn.ApplyData.ApplicationID)
+}
+
+func TestSimulateAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+	senderBalance := sender.acctData.MicroAlgos.Raw
+	receiver := accounts[1].addr
+
+	pay := func(amount uint64) []transactions.SignedTxn {
+		txn := transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: makeTxnHeader(sender.addr),
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		return []transactions.SignedTxn{txn.Sign(sender.sk)}
+	}
+
+	// spend most of the sender's balance in round 2
+	addBlock(t, l)
+	addBlock(t, l, pay(senderBalance-500000))
+	require.Equal(t, basics.Round(2), l.Latest())
+
+	txgroups := [][]transactions.SignedTxn{pay(senderBalance / 2)}
+	result, err := s.Simulate(simulation.Request{TxnGroups: txgroups})
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(2), result.LastRound)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	// after round 1, the sender could still afford the payment
+	result, err = s.Simulate(simulation.Request{TxnGroups: txgroups, Round: 1})
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(1), result.LastRound)
+	require.True(t, result.WouldSucceed)
+
+	for l.Latest() < 5 {
+		addBlock(t, l)
+	}
+	result, err = s.Simulate(simulation.Request{TxnGroups: txgroups, Round: 1})
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(1), result.LastRound)
+	require.True(t, result.WouldSucceed)
+
+	result, err = s.Simulate(simulation.Request{TxnGroups: txgroups, Round: 2})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	_, err = s.Simulate(simulation.Request{TxnGroups: txgroups, Round: 6})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "after the latest round")
+
+	for l.Latest() < 6 {
+		addBlock(t, l)
+	}
+	_, err = s.Simulate(simulation.Request{TxnGroups: txgroups, Round: 1})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "rounds before the latest round")
+}
+
+func TestAllowUnnamedResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l, config.GetDefaultLocal().MaxAcctLookback)
+	sender := accounts[0]
+	unnamed := accounts[2].addr
+
+	program := fmt.Sprintf(`#pragma version 8
+txn ApplicationID
+bz done
+addr %s
+balance
+pop
+int 55
+asset_params_get AssetTotal
+pop
+pop
+int 77
+app_params_get AppCreator
+pop
+pop
+byte "box"
+box_get
+pop
+pop
+done:
+int 1`, unnamed)
+	txgroup := makeAppCallGroup(t, makeTxnHeader, sender, program, nil, 1000)
+
+	result, err := simulateGroup(s, txgroup)
 	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "invalid Account reference")
+	require.Nil(t, result.TxnGroups[0].Txns[1].UnnamedResourcesAccessed)
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{txgroup},
+		AllowUnnamedResources: true,
+	})
+	requireSuccess(t, result, err)
+	require.False(t, result.WouldSucceed)
+	require.True(t, result.EvalOverrides.AllowUnnamedResources)
+	require.Nil(t, result.TxnGroups[0].Txns[0].UnnamedResourcesAccessed)
+	require.Equal(t, &simulation.ResourceTracker{
+		Accounts: map[basics.Address]struct{}{unnamed: {}},
+		Assets:   map[basics.AssetIndex]struct{}{55: {}},
+		Apps:     map[basics.AppIndex]struct{}{77: {}},
+		Boxes:    map[simulation.BoxRef]struct{}{{App: 1, Name: "box"}: {}},
+	}, result.TxnGroups[0].Txns[1].UnnamedResourcesAccessed)
+
+	// a group that names everything it uses still would succeed
+	named := makeAppCallGroup(t, makeTxnHeader, sender, "#pragma version 8\nint 1", nil, 1000)
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{named},
+		AllowUnnamedResources: true,
+	})
+	requireSuccess(t, result, err)
+	require.True(t, result.WouldSucceed)
+	require.Nil(t, result.TxnGroups[0].Txns[1].UnnamedResourcesAccessed)
 }
@@ -0,0 +1,284 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// ResultLatestVersion is the latest version of the Result struct
+const ResultLatestVersion = uint64(1)
+
+// TxnPath is a "transaction path": e.g. [0, 0, 1] means the second inner txn of the first inner txn of the first txn.
+// You can use this transaction path to find the txn data in the `TxnResults` list.
+type TxnPath []uint64
+
+// TxnResult contains the simulation result for a single transaction
+type TxnResult struct {
+	Txn transactions.SignedTxnWithAD
+
+	// MissingSignature is true if the transaction was submitted without a signature.
+	MissingSignature bool
+
+	// Trace is the execution trace of the transaction, if execution tracing was requested.
+	Trace *TransactionTrace
+
+	// AppBudgetConsumed is the budget consumed by the app programs run by this transaction,
+	// including those run by its inner transactions.
+	AppBudgetConsumed uint64
+
+	// LogicSigBudgetConsumed is the budget consumed by the LogicSig of this transaction, if any.
+	LogicSigBudgetConsumed uint64
+
+	// UnnamedResourcesAccessed holds the resources this transaction's app program used without
+	// naming them, if unnamed resources were allowed and any were used.
+	UnnamedResourcesAccessed *ResourceTracker
+}
+
+// TxnGroupResult contains the simulation result for a single transaction group
+type TxnGroupResult struct {
+	Txns []TxnResult
+
+	// FailureMessage will be the error message for the first transaction in the group which errors.
+	// If the group succeeds, this will be empty.
+	FailureMessage string
+
+	// FailedAt is the path to the txn that failed inside of this group
+	FailedAt TxnPath
+
+	// AppBudgetAdded is the total opcode budget of the app programs of this group, including any
+	// extra budget requested for the simulation.
+	AppBudgetAdded uint64
+	// AppBudgetConsumed is the opcode budget used by the app programs of this group.
+	AppBudgetConsumed uint64
+}
+
+func makeTxnGroupResult(txgroup []transactions.SignedTxn) TxnGroupResult {
+	groupResult := TxnGroupResult{Txns: make([]TxnResult, len(txgroup))}
+	for i, tx := range txgroup {
+		groupResult.Txns[i] = TxnResult{Txn: transactions.SignedTxnWithAD{
+			SignedTxn: tx,
+		}}
+	}
+	return groupResult
+}
+
+const (
+	// MaxExtraOpcodeBudget is the largest extra app budget a simulation may request,
+	// equivalent to the budget of a full group of app calls.
+	MaxExtraOpcodeBudget = uint64(20000 * 16)
+
+	// MaxExtraLogicSigBudget is the largest extra LogicSig budget a simulation may request.
+	MaxExtraLogicSigBudget = uint64(20000 * 16)
+
+	// MaxLogCalls is the limit of log calls in a program when more logging is allowed.
+	MaxLogCalls = uint64(2048)
+
+	// MaxLogSize is the limit of total log size in a program when more logging is allowed.
+	MaxLogSize = uint64(65536)
+)
+
+// ResultEvalOverrides contains the limits that were relaxed for the simulation.
+type ResultEvalOverrides struct {
+	AllowMoreLogging      bool
+	AllowUnnamedResources bool
+	ExtraOpcodeBudget     uint64
+	ExtraLogicSigBudget   uint64
+	MaxLogCalls           *uint64
+	MaxLogSize            *uint64
+}
+
+func makeEvalOverrides(request Request) (ResultEvalOverrides, error) {
+	if request.ExtraOpcodeBudget > MaxExtraOpcodeBudget {
+		return ResultEvalOverrides{}, fmt.Errorf("extra opcode budget %d exceeds limit %d", request.ExtraOpcodeBudget, MaxExtraOpcodeBudget)
+	}
+	if request.ExtraLogicSigBudget > MaxExtraLogicSigBudget {
+		return ResultEvalOverrides{}, fmt.Errorf("extra logic sig budget %d exceeds limit %d", request.ExtraLogicSigBudget, MaxExtraLogicSigBudget)
+	}
+
+	overrides := ResultEvalOverrides{
+		AllowMoreLogging:      request.AllowMoreLogging,
+		AllowUnnamedResources: request.AllowUnnamedResources,
+		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
+		ExtraLogicSigBudget:   request.ExtraLogicSigBudget,
+	}
+	if request.AllowMoreLogging {
+		maxLogCalls, maxLogSize := MaxLogCalls, MaxLogSize
+		overrides.MaxLogCalls = &maxLogCalls
+		overrides.MaxLogSize = &maxLogSize
+	}
+	return overrides, nil
+}
+
+// evalConstants returns the evaluation limits to use for the simulation, or nil
+// if the runtime limits apply.
+func (eo ResultEvalOverrides) evalConstants() *logic.EvalConstants {
+	if !eo.AllowMoreLogging && eo.ExtraLogicSigBudget == 0 {
+		return nil
+	}
+	constants := logic.RuntimeEvalConstants()
+	if eo.MaxLogCalls != nil {
+		constants.MaxLogCalls = int(*eo.MaxLogCalls)
+	}
+	if eo.MaxLogSize != nil {
+		constants.MaxLogSize = int(*eo.MaxLogSize)
+	}
+	constants.ExtraLogicSigBudget = int(eo.ExtraLogicSigBudget)
+	return &constants
+}
+
+// Result contains the result from a call to Simulator.Simulate
+type Result struct {
+	Version       uint64
+	LastRound     basics.Round
+	TxnGroups     []TxnGroupResult // this is a list so that supporting multiple in the future is not breaking
+	WouldSucceed  bool             // true iff no failure message, no missing signatures, and the budget was not exceeded
+	EvalOverrides ResultEvalOverrides
+	TraceConfig   ExecTraceConfig
+}
+
+func makeSimulationResult(lastRound basics.Round, request Request) (Result, error) {
+	overrides, err := makeEvalOverrides(request)
+	if err != nil {
+		return Result{}, InvalidRequestError{SimulatorError{err}}
+	}
+
+	groups := make([]TxnGroupResult, len(request.TxnGroups))
+
+	for i, txgroup := range request.TxnGroups {
+		groups[i] = makeTxnGroupResult(txgroup)
+	}
+
+	return Result{
+		Version:       ResultLatestVersion,
+		LastRound:     lastRound,
+		TxnGroups:     groups,
+		WouldSucceed:  true,
+		EvalOverrides: overrides,
+		TraceConfig:   request.TraceConfig,
+	}, nil
+}
+
+// ==============================
+// > Execution Trace
+// ==============================
+
+// ExecTraceConfig gathers all execution trace related configs for simulation result
+type ExecTraceConfig struct {
+	// Enable turns on the recording of the program counter sequence of every program executed.
+	Enable bool
+	// Stack additionally records the stack changes made by every opcode.
+	Stack bool
+	// Scratch additionally records the scratch slot writes made by every opcode.
+	Scratch bool
+	// State additionally records the application state changes made by every opcode.
+	State bool
+}
+
+// StateOperationKind is the kind of change an opcode made to application state.
+type StateOperationKind string
+
+const (
+	// StateWrite means the key was created or overwritten
+	StateWrite StateOperationKind = "w"
+	// StateDelete means the key was removed
+	StateDelete StateOperationKind = "d"
+)
+
+// AppStateKind names the kind of application storage a state change applied to.
+type AppStateKind string
+
+const (
+	// GlobalState is the application's global key/value store
+	GlobalState AppStateKind = "g"
+	// LocalState is an account's local key/value store for the application
+	LocalState AppStateKind = "l"
+	// BoxState is a box owned by the application
+	BoxState AppStateKind = "b"
+)
+
+// ScratchChange represents a write operation into a scratch slot
+type ScratchChange struct {
+	// Slot stands for the scratch slot id get written to
+	Slot uint64
+
+	// NewValue is the stack value written to scratch slot
+	NewValue basics.TealValue
+}
+
+// StateOperation represents an operation into an app local/global/box state
+type StateOperation struct {
+	// Operation is either a write or a delete
+	Operation StateOperationKind
+
+	// State is one of global, local or box state
+	State AppStateKind
+
+	// AppID is the application whose state was changed
+	AppID basics.AppIndex
+
+	// Key is the global/local state key or the box name
+	Key string
+
+	// NewValue is the value written by a write operation. Box writes that only
+	// change part of the box (or its size) leave it empty.
+	NewValue basics.TealValue
+
+	// Account is the account whose local state was changed, empty otherwise
+	Account basics.Address
+}
+
+// OpcodeTraceUnit contains the trace effects of a single opcode evaluation.
+type OpcodeTraceUnit struct {
+	// The PC of the opcode being evaluated
+	PC uint64
+
+	// SpawnedInners contains the indexes of traces for inner transactions spawned by this opcode,
+	// if any. These indexes refer to the InnerTraces array of the TransactionTrace object containing
+	// this OpcodeTraceUnit.
+	SpawnedInners []uint64
+
+	// StackAdded contains the values pushed onto the stack by this opcode
+	StackAdded []basics.TealValue
+
+	// StackPopCount is the number of values popped from the stack by this opcode
+	StackPopCount uint64
+
+	// ScratchSlotChanges contains the scratch slots written by this opcode
+	ScratchSlotChanges []ScratchChange
+
+	// StateChanges contains the application state changes made by this opcode
+	StateChanges []StateOperation
+}
+
+// TransactionTrace contains the trace effects of a single transaction evaluation (including its inners)
+type TransactionTrace struct {
+	// ApprovalProgramTrace stands for a slice of OpcodeTraceUnit over application call on approval program
+	ApprovalProgramTrace []OpcodeTraceUnit
+	// ClearStateProgramTrace stands for a slice of OpcodeTraceUnit over application call on clear-state program
+	ClearStateProgramTrace []OpcodeTraceUnit
+	// LogicSigTrace contains the trace for a logicsig evaluation, if the transaction is approved by a logicsig.
+	LogicSigTrace []OpcodeTraceUnit
+	// InnerTraces contains the traces for inner transactions, if this transaction spawned any. This
+	// object only contains traces for inners that are immediate children of this transaction.
+	// Grandchild traces will be present inside the TransactionTrace of their parent.
+	InnerTraces []TransactionTrace
+}
@@ -0,0 +1,347 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// programFrame holds the opcode trace of a program being evaluated, along with
+// the information captured in BeforeOpcode which AfterOpcode needs to compute
+// the effects of the opcode.
+type programFrame struct {
+	units *[]OpcodeTraceUnit
+
+	stackBefore   []basics.TealValue
+	scratchBefore []basics.TealValue
+	pendingState  *StateOperation
+}
+
+// evalTracer records the results of a simulation. It keeps track of the
+// transaction currently being evaluated, so that failures can be reported with
+// the path to the failing transaction, and optionally records an execution
+// trace of every program run.
+type evalTracer struct {
+	logic.NullEvalTracer
+
+	result *Result
+
+	// groupIndex is the index of the top-level group being evaluated
+	groupIndex int
+
+	// txnPath is the path to the transaction currently being evaluated, and
+	// innerCounts holds, for each element of txnPath, the number of inner
+	// transactions spawned so far by that transaction.
+	txnPath     TxnPath
+	innerCounts []uint64
+
+	// traces holds the execution trace of each transaction in txnPath
+	traces []*TransactionTrace
+	// programs holds the programs currently being evaluated, innermost last
+	programs []programFrame
+
+	// pooledApplicationBudget is the app budget pool of the top-level group
+	pooledApplicationBudget *int
+}
+
+func makeEvalTracer(result *Result) *evalTracer {
+	return &evalTracer{result: result}
+}
+
+// appBudgetAdded returns the total app budget made available to the group so
+// far: what has been consumed plus what remains in the pool.
+func (tracer *evalTracer) appBudgetAdded() uint64 {
+	if tracer.pooledApplicationBudget == nil {
+		return 0
+	}
+	groupResult := &tracer.result.TxnGroups[tracer.groupIndex]
+	return groupResult.AppBudgetConsumed + uint64(*tracer.pooledApplicationBudget)
+}
+
+func (tracer *evalTracer) BeforeTxnGroup(ep *logic.EvalParams) {
+	if len(tracer.txnPath) != 0 {
+		// inner groups inherit the overrides of their caller
+		return
+	}
+
+	overrides := tracer.result.EvalOverrides
+	ep.EvalConstants = overrides.evalConstants()
+	if overrides.AllowUnnamedResources {
+		ep.UnnamedResources = &resourcePolicy{tracer: tracer}
+	}
+	if ep.PooledApplicationBudget != nil {
+		*ep.PooledApplicationBudget += int(overrides.ExtraOpcodeBudget)
+	}
+	tracer.pooledApplicationBudget = ep.PooledApplicationBudget
+}
+
+// startGroup prepares the tracer for the top-level group at groupIndex.
+func (tracer *evalTracer) startGroup(groupIndex int) {
+	tracer.groupIndex = groupIndex
+	tracer.pooledApplicationBudget = nil
+}
+
+func (tracer *evalTracer) traceEnabled() bool {
+	return tracer.result.TraceConfig.Enable
+}
+
+// txnResult returns the result of the top-level transaction at groupIndex in the
+// group currently being evaluated.
+func (tracer *evalTracer) txnResult(groupIndex int) *TxnResult {
+	return &tracer.result.TxnGroups[tracer.groupIndex].Txns[groupIndex]
+}
+
+// failedAt returns a copy of the path to the transaction being evaluated when
+// the evaluation stopped.
+func (tracer *evalTracer) failedAt() TxnPath {
+	return append(TxnPath(nil), tracer.txnPath...)
+}
+
+func (tracer *evalTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
+	var trace *TransactionTrace
+	if len(tracer.txnPath) == 0 {
+		tracer.txnPath = append(tracer.txnPath, uint64(groupIndex))
+		if tracer.traceEnabled() {
+			txnResult := tracer.txnResult(groupIndex)
+			if txnResult.Trace == nil {
+				txnResult.Trace = &TransactionTrace{}
+			}
+			trace = txnResult.Trace
+		}
+	} else {
+		parent := len(tracer.txnPath) - 1
+		tracer.txnPath = append(tracer.txnPath, tracer.innerCounts[parent])
+		tracer.innerCounts[parent]++
+		if tracer.traceEnabled() {
+			parentTrace := tracer.traces[parent]
+			spawned := uint64(len(parentTrace.InnerTraces))
+			parentTrace.InnerTraces = append(parentTrace.InnerTraces, TransactionTrace{})
+			trace = &parentTrace.InnerTraces[spawned]
+
+			// the inner transaction was spawned by the opcode currently being evaluated
+			if frame := tracer.currentProgram(); frame != nil && len(*frame.units) > 0 {
+				unit := &(*frame.units)[len(*frame.units)-1]
+				unit.SpawnedInners = append(unit.SpawnedInners, spawned)
+			}
+		}
+	}
+	tracer.innerCounts = append(tracer.innerCounts, 0)
+	tracer.traces = append(tracer.traces, trace)
+}
+
+func (tracer *evalTracer) AfterTxn(ep *logic.EvalParams, groupIndex int, ad transactions.ApplyData) {
+	top := len(tracer.txnPath) - 1
+	if top == 0 {
+		tracer.txnResult(groupIndex).Txn.ApplyData = ad
+	}
+	if tracer.result.EvalOverrides.AllowMoreLogging && exceedsRuntimeLogLimits(ad.EvalDelta.Logs) {
+		// the transaction only succeeded because of the relaxed limits
+		tracer.result.WouldSucceed = false
+	}
+	tracer.txnPath = tracer.txnPath[:top]
+	tracer.innerCounts = tracer.innerCounts[:top]
+	tracer.traces = tracer.traces[:top]
+}
+
+func (tracer *evalTracer) currentProgram() *programFrame {
+	if len(tracer.programs) == 0 {
+		return nil
+	}
+	return &tracer.programs[len(tracer.programs)-1]
+}
+
+func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
+	if !tracer.traceEnabled() {
+		return
+	}
+
+	var units *[]OpcodeTraceUnit
+	if cx.RunMode() == logic.ModeSig {
+		// LogicSigs are evaluated while checking the group, before any
+		// transaction is evaluated, so they are not part of txnPath.
+		txnResult := tracer.txnResult(cx.GroupIndex())
+		if txnResult.Trace == nil {
+			txnResult.Trace = &TransactionTrace{}
+		}
+		units = &txnResult.Trace.LogicSigTrace
+	} else {
+		trace := tracer.traces[len(tracer.traces)-1]
+		if cx.TxnGroup[cx.GroupIndex()].Txn.OnCompletion == transactions.ClearStateOC {
+			units = &trace.ClearStateProgramTrace
+		} else {
+			units = &trace.ApprovalProgramTrace
+		}
+	}
+	tracer.programs = append(tracer.programs, programFrame{units: units})
+}
+
+func (tracer *evalTracer) AfterProgram(cx *logic.EvalContext, evalError error) {
+	cost := uint64(cx.Cost())
+	if cx.RunMode() == logic.ModeSig {
+		tracer.txnResult(cx.GroupIndex()).LogicSigBudgetConsumed = cost
+	} else {
+		tracer.txnResult(int(tracer.txnPath[0])).AppBudgetConsumed += cost
+		tracer.result.TxnGroups[tracer.groupIndex].AppBudgetConsumed += cost
+	}
+
+	if !tracer.traceEnabled() {
+		return
+	}
+	tracer.programs = tracer.programs[:len(tracer.programs)-1]
+}
+
+func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
+	if !tracer.traceEnabled() {
+		return
+	}
+
+	frame := tracer.currentProgram()
+	*frame.units = append(*frame.units, OpcodeTraceUnit{PC: uint64(cx.PC())})
+
+	config := tracer.result.TraceConfig
+	if !config.Stack && !config.Scratch && !config.State {
+		return
+	}
+
+	spec := cx.GetOpSpec()
+	stack := cx.Stack()
+	if config.Stack {
+		frame.stackBefore = stack
+	}
+	if config.Scratch && (spec.Name == "store" || spec.Name == "stores") {
+		frame.scratchBefore = cx.Scratch()
+	}
+	if config.State {
+		frame.pendingState = stateOperation(cx, spec.Name, stack)
+	}
+}
+
+func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	if !tracer.traceEnabled() {
+		return
+	}
+
+	frame := tracer.currentProgram()
+	unit := &(*frame.units)[len(*frame.units)-1]
+
+	if frame.stackBefore != nil {
+		unit.StackPopCount, unit.StackAdded = stackChange(frame.stackBefore, cx.Stack())
+		frame.stackBefore = nil
+	}
+
+	if frame.scratchBefore != nil {
+		for slot, value := range cx.Scratch() {
+			if value != frame.scratchBefore[slot] {
+				unit.ScratchSlotChanges = append(unit.ScratchSlotChanges, ScratchChange{
+					Slot:     uint64(slot),
+					NewValue: value,
+				})
+			}
+		}
+		frame.scratchBefore = nil
+	}
+
+	if frame.pendingState != nil {
+		if evalError == nil {
+			unit.StateChanges = append(unit.StateChanges, *frame.pendingState)
+		}
+		frame.pendingState = nil
+	}
+}
+
+// exceedsRuntimeLogLimits returns true if the logs of a program would have
+// failed it under the runtime log limits.
+func exceedsRuntimeLogLimits(logs []string) bool {
+	constants := logic.RuntimeEvalConstants()
+	if len(logs) > constants.MaxLogCalls {
+		return true
+	}
+	size := 0
+	for _, log := range logs {
+		size += len(log)
+	}
+	return size > constants.MaxLogSize
+}
+
+// stackChange computes the number of values popped and the values pushed by an
+// opcode, given the stack before and after its evaluation. Values below the
+// deepest value changed are considered untouched.
+func stackChange(before, after []basics.TealValue) (popCount uint64, added []basics.TealValue) {
+	common := 0
+	for common < len(before) && common < len(after) && before[common] == after[common] {
+		common++
+	}
+	return uint64(len(before) - common), append([]basics.TealValue(nil), after[common:]...)
+}
+
+// stateOperation describes the state change the opcode about to be evaluated
+// will make, based on its arguments on the stack. It returns nil for opcodes
+// that do not change application state.
+func stateOperation(cx *logic.EvalContext, opName string, stack []basics.TealValue) *StateOperation {
+	arg := func(fromTop int) basics.TealValue {
+		if fromTop >= len(stack) {
+			return basics.TealValue{}
+		}
+		return stack[len(stack)-1-fromTop]
+	}
+
+	op := StateOperation{AppID: cx.AppID()}
+	switch opName {
+	case "app_global_put":
+		op.Operation, op.State, op.Key, op.NewValue = StateWrite, GlobalState, arg(1).Bytes, arg(0)
+	case "app_global_del":
+		op.Operation, op.State, op.Key = StateDelete, GlobalState, arg(0).Bytes
+	case "app_local_put":
+		op.Operation, op.State, op.Key, op.NewValue = StateWrite, LocalState, arg(1).Bytes, arg(0)
+		op.Account = stateAccount(cx, arg(2))
+	case "app_local_del":
+		op.Operation, op.State, op.Key = StateDelete, LocalState, arg(0).Bytes
+		op.Account = stateAccount(cx, arg(1))
+	case "box_put":
+		op.Operation, op.State, op.Key, op.NewValue = StateWrite, BoxState, arg(1).Bytes, arg(0)
+	case "box_create":
+		op.Operation, op.State, op.Key = StateWrite, BoxState, arg(1).Bytes
+	case "box_replace":
+		op.Operation, op.State, op.Key = StateWrite, BoxState, arg(2).Bytes
+	case "box_del":
+		op.Operation, op.State, op.Key = StateDelete, BoxState, arg(0).Bytes
+	default:
+		return nil
+	}
+	return &op
+}
+
+// stateAccount resolves the account argument of a local state opcode, which is
+// either an address or an index into the Accounts array of the transaction.
+func stateAccount(cx *logic.EvalContext, value basics.TealValue) basics.Address {
+	var addr basics.Address
+	if value.Type == basics.TealBytesType {
+		copy(addr[:], value.Bytes)
+		return addr
+	}
+
+	txn := &cx.TxnGroup[cx.GroupIndex()].Txn
+	if value.Uint == 0 {
+		return txn.Sender
+	}
+	if value.Uint <= uint64(len(txn.Accounts)) {
+		return txn.Accounts[value.Uint-1]
+	}
+	return addr
+}
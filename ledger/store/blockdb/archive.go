@@ -0,0 +1,381 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/golang/snappy"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ArchiveSegmentRounds is the number of consecutive rounds stored in a single archive segment file.
+const ArchiveSegmentRounds = 1000
+
+const (
+	archiveSegmentSuffix    = ".blocks"
+	archiveSegmentTmpSuffix = ".tmp"
+	archiveSegmentVersion   = uint32(1)
+
+	// the footer is made of the magic, the version, the number of records, the first round
+	// and the offset of the index.
+	archiveSegmentFooterSize = 8 + 4 + 4 + 8 + 8
+	// each index entry holds the record offset, the record length and the record checksum.
+	archiveSegmentIndexEntrySize = 8 + 4 + 4
+)
+
+var archiveSegmentMagic = []byte("ALGOBSEG")
+
+// ErrArchiveSegmentCorrupted is returned when a segment file does not match its own index.
+var ErrArchiveSegmentCorrupted = errors.New("block archive segment is corrupted")
+
+// BlockArchive stores old blocks and their certificates in compressed, immutable segment files.
+// The segments always cover a contiguous range of rounds starting at round zero, so that
+// the archive together with the blocks table holds the entire chain.
+type BlockArchive struct {
+	dir string
+
+	// next is the first round that is not covered by the archive.
+	next uint64
+
+	// writeMu is held by the segment writer, if any.
+	writeMu deadlock.Mutex
+}
+
+// OpenBlockArchive opens the block archive stored in dir, creating the directory if needed.
+// Leftovers of interrupted segment writes are removed.
+func OpenBlockArchive(dir string) (*BlockArchive, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstRounds []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasSuffix(name, archiveSegmentTmpSuffix) {
+			err = os.Remove(filepath.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, archiveSegmentSuffix) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, archiveSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		firstRounds = append(firstRounds, first)
+	}
+	sort.Slice(firstRounds, func(i, j int) bool { return firstRounds[i] < firstRounds[j] })
+
+	a := &BlockArchive{dir: dir}
+	for _, first := range firstRounds {
+		if first != a.next {
+			// a gap; the segments after it cannot be served.
+			break
+		}
+		a.next += ArchiveSegmentRounds
+	}
+	return a, nil
+}
+
+// NextRound returns the first round that is not covered by the archive.
+func (a *BlockArchive) NextRound() basics.Round {
+	return basics.Round(atomic.LoadUint64(&a.next))
+}
+
+// Contains returns true if the block of the given round is stored in the archive.
+func (a *BlockArchive) Contains(rnd basics.Round) bool {
+	return rnd < a.NextRound()
+}
+
+func (a *BlockArchive) segmentPath(first basics.Round) string {
+	return filepath.Join(a.dir, fmt.Sprintf("%012d%s", uint64(first), archiveSegmentSuffix))
+}
+
+// SegmentWriter writes a single segment of the block archive. Records are streamed to a temporary
+// file, which only becomes part of the archive once the segment is committed.
+type SegmentWriter struct {
+	archive *BlockArchive
+	first   basics.Round
+	count   int
+
+	path    string
+	tmpPath string
+	f       *os.File
+	w       *bufio.Writer
+
+	index  []byte
+	offset uint64
+}
+
+// MakeSegmentWriter starts writing the segment beginning at the first round, which has to directly
+// follow the already archived rounds. Only one segment can be written at a time; the writer
+// has to be finished by calling either Commit or Abort.
+func (a *BlockArchive) MakeSegmentWriter(first basics.Round) (*SegmentWriter, error) {
+	a.writeMu.Lock()
+
+	if first != a.NextRound() {
+		a.writeMu.Unlock()
+		return nil, fmt.Errorf("block archive segment starts at %d but expected %d", first, a.NextRound())
+	}
+
+	path := a.segmentPath(first)
+	tmpPath := path + archiveSegmentTmpSuffix
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		a.writeMu.Unlock()
+		return nil, err
+	}
+
+	return &SegmentWriter{
+		archive: a,
+		first:   first,
+		path:    path,
+		tmpPath: tmpPath,
+		f:       f,
+		w:       bufio.NewWriter(f),
+		index:   make([]byte, 0, ArchiveSegmentRounds*archiveSegmentIndexEntrySize),
+	}, nil
+}
+
+// Append adds the encoded block and certificate of the next round to the segment.
+func (sw *SegmentWriter) Append(blk []byte, cert []byte) error {
+	if sw.count == ArchiveSegmentRounds {
+		return fmt.Errorf("block archive segment starting at %d is already full", sw.first)
+	}
+
+	record := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(blk)+len(cert))
+	record = record[:binary.PutUvarint(record, uint64(len(blk)))]
+	record = append(record, blk...)
+	record = append(record, cert...)
+	compressed := snappy.Encode(nil, record)
+	_, err := sw.w.Write(compressed)
+	if err != nil {
+		return err
+	}
+
+	var entry [archiveSegmentIndexEntrySize]byte
+	binary.BigEndian.PutUint64(entry[0:8], sw.offset)
+	binary.BigEndian.PutUint32(entry[8:12], uint32(len(compressed)))
+	binary.BigEndian.PutUint32(entry[12:16], crc32.ChecksumIEEE(compressed))
+	sw.index = append(sw.index, entry[:]...)
+	sw.offset += uint64(len(compressed))
+	sw.count++
+	return nil
+}
+
+// Commit completes the segment and makes its rounds available from the archive.
+// The segment has to contain exactly ArchiveSegmentRounds rounds.
+func (sw *SegmentWriter) Commit() (err error) {
+	defer func() {
+		if err != nil {
+			sw.Abort()
+			return
+		}
+		sw.archive.writeMu.Unlock()
+	}()
+
+	if sw.count != ArchiveSegmentRounds {
+		return fmt.Errorf("block archive segment starting at %d has %d rounds but expected %d", sw.first, sw.count, ArchiveSegmentRounds)
+	}
+
+	var footer [archiveSegmentFooterSize]byte
+	copy(footer[0:8], archiveSegmentMagic)
+	binary.BigEndian.PutUint32(footer[8:12], archiveSegmentVersion)
+	binary.BigEndian.PutUint32(footer[12:16], uint32(sw.count))
+	binary.BigEndian.PutUint64(footer[16:24], uint64(sw.first))
+	binary.BigEndian.PutUint64(footer[24:32], sw.offset)
+
+	_, err = sw.w.Write(sw.index)
+	if err != nil {
+		return err
+	}
+	_, err = sw.w.Write(footer[:])
+	if err != nil {
+		return err
+	}
+	err = sw.w.Flush()
+	if err != nil {
+		return err
+	}
+	err = sw.f.Sync()
+	if err != nil {
+		return err
+	}
+	err = sw.f.Close()
+	sw.f = nil
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(sw.tmpPath, 0400)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(sw.tmpPath, sw.path)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreUint64(&sw.archive.next, uint64(sw.first)+ArchiveSegmentRounds)
+	return nil
+}
+
+// Abort discards the segment.
+func (sw *SegmentWriter) Abort() {
+	if sw.f != nil {
+		sw.f.Close()
+		sw.f = nil
+	}
+	os.Remove(sw.tmpPath)
+	sw.archive.writeMu.Unlock()
+}
+
+// GetEncodedCert retrieves the raw block and certificate of the given round from the archive.
+func (a *BlockArchive) GetEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	if !a.Contains(rnd) {
+		return nil, nil, ledgercore.ErrNoEntry{Round: rnd}
+	}
+
+	first := rnd - rnd%ArchiveSegmentRounds
+	f, err := os.Open(a.segmentPath(first))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Size() < archiveSegmentFooterSize {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+
+	footer := make([]byte, archiveSegmentFooterSize)
+	_, err = f.ReadAt(footer, info.Size()-archiveSegmentFooterSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(footer[:8], archiveSegmentMagic) || binary.BigEndian.Uint32(footer[8:12]) != archiveSegmentVersion {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+	count := uint64(binary.BigEndian.Uint32(footer[12:16]))
+	if basics.Round(binary.BigEndian.Uint64(footer[16:24])) != first || uint64(rnd-first) >= count {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+	indexOffset := binary.BigEndian.Uint64(footer[24:32])
+
+	entry := make([]byte, archiveSegmentIndexEntrySize)
+	_, err = f.ReadAt(entry, int64(indexOffset+uint64(rnd-first)*archiveSegmentIndexEntrySize))
+	if err != nil {
+		return nil, nil, err
+	}
+	recordOffset := binary.BigEndian.Uint64(entry[0:8])
+	recordLen := binary.BigEndian.Uint32(entry[8:12])
+	checksum := binary.BigEndian.Uint32(entry[12:16])
+	if recordOffset+uint64(recordLen) > indexOffset {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+
+	compressed := make([]byte, recordLen)
+	_, err = f.ReadAt(compressed, int64(recordOffset))
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	if crc32.ChecksumIEEE(compressed) != checksum {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+
+	record, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, nil, err
+	}
+	blkLen, n := binary.Uvarint(record)
+	if n <= 0 || uint64(len(record)-n) < blkLen {
+		return nil, nil, ErrArchiveSegmentCorrupted
+	}
+	blk = record[n : n+int(blkLen)]
+	cert = record[n+int(blkLen):]
+	return blk, cert, nil
+}
+
+// Get retrieves the block of the given round from the archive.
+func (a *BlockArchive) Get(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	blkbuf, _, err := a.GetEncodedCert(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(blkbuf, &blk)
+	return
+}
+
+// GetHdr retrieves the block header of the given round from the archive.
+func (a *BlockArchive) GetHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error) {
+	blk, err := a.Get(rnd)
+	if err != nil {
+		return
+	}
+	return blk.BlockHeader, nil
+}
+
+// GetCert retrieves the block and certificate of the given round from the archive.
+func (a *BlockArchive) GetCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	blkbuf, certbuf, err := a.GetEncodedCert(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(blkbuf, &blk)
+	if err != nil {
+		return
+	}
+
+	if len(certbuf) > 0 {
+		err = protocol.Decode(certbuf, &cert)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func archiveSegment(first basics.Round) (entries []testBlockEntry, blocks [][]byte, certs [][]byte) {
+	for i := 0; i < ArchiveSegmentRounds; i++ {
+		e := randomBlock(first + basics.Round(i))
+		entries = append(entries, e)
+		blocks = append(blocks, protocol.Encode(&e.block))
+		certs = append(certs, protocol.Encode(&e.cert))
+	}
+	return
+}
+
+func writeArchiveSegment(a *BlockArchive, first basics.Round, blocks [][]byte, certs [][]byte) error {
+	sw, err := a.MakeSegmentWriter(first)
+	if err != nil {
+		return err
+	}
+	for i := range blocks {
+		err = sw.Append(blocks[i], certs[i])
+		if err != nil {
+			sw.Abort()
+			return err
+		}
+	}
+	return sw.Commit()
+}
+
+func TestBlockArchiveWriteRead(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	a, err := OpenBlockArchive(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), a.NextRound())
+
+	_, err = a.Get(0)
+	require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+
+	// an aborted segment leaves no trace
+	sw, err := a.MakeSegmentWriter(0)
+	require.NoError(t, err)
+	require.NoError(t, sw.Append([]byte{1}, []byte{2}))
+	sw.Abort()
+	require.Equal(t, basics.Round(0), a.NextRound())
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	entries, blocks, certs := archiveSegment(0)
+	// segments have to be complete and contiguous
+	require.Error(t, writeArchiveSegment(a, 0, blocks[:10], certs[:10]))
+	require.Error(t, writeArchiveSegment(a, ArchiveSegmentRounds, blocks, certs))
+	require.Error(t, writeArchiveSegment(a, 0, append(blocks, blocks[0]), append(certs, certs[0])))
+	require.NoError(t, writeArchiveSegment(a, 0, blocks, certs))
+	require.Equal(t, basics.Round(ArchiveSegmentRounds), a.NextRound())
+
+	more, moreBlocks, moreCerts := archiveSegment(ArchiveSegmentRounds)
+	require.NoError(t, writeArchiveSegment(a, ArchiveSegmentRounds, moreBlocks, moreCerts))
+	entries = append(entries, more...)
+
+	// reopening finds the same segments
+	a, err = OpenBlockArchive(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(2*ArchiveSegmentRounds), a.NextRound())
+
+	for _, rnd := range []basics.Round{0, 1, ArchiveSegmentRounds - 1, ArchiveSegmentRounds, 2*ArchiveSegmentRounds - 1} {
+		blk, cert, err := a.GetCert(rnd)
+		require.NoError(t, err)
+		require.Equal(t, entries[rnd].block, blk)
+		require.Equal(t, entries[rnd].cert, cert)
+
+		hdr, err := a.GetHdr(rnd)
+		require.NoError(t, err)
+		require.Equal(t, entries[rnd].block.BlockHeader, hdr)
+
+		blkbuf, certbuf, err := a.GetEncodedCert(rnd)
+		require.NoError(t, err)
+		require.Equal(t, protocol.Encode(&entries[rnd].block), blkbuf)
+		require.Equal(t, protocol.Encode(&entries[rnd].cert), certbuf)
+	}
+
+	_, err = a.Get(2 * ArchiveSegmentRounds)
+	require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+}
+
+func TestBlockArchiveOpenSkipsGapsAndLeftovers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	a, err := OpenBlockArchive(dir)
+	require.NoError(t, err)
+
+	_, blocks, certs := archiveSegment(0)
+	require.NoError(t, writeArchiveSegment(a, 0, blocks, certs))
+
+	// a leftover of an interrupted write and a segment after a gap
+	leftover := a.segmentPath(ArchiveSegmentRounds) + archiveSegmentTmpSuffix
+	require.NoError(t, os.WriteFile(leftover, []byte("partial"), 0600))
+	require.NoError(t, os.WriteFile(a.segmentPath(3*ArchiveSegmentRounds), []byte("detached"), 0600))
+
+	a, err = OpenBlockArchive(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(ArchiveSegmentRounds), a.NextRound())
+	require.NoFileExists(t, leftover)
+	require.FileExists(t, filepath.Join(dir, "000000003000.blocks"))
+}
+
+func TestBlockArchiveCorruptedSegment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	a, err := OpenBlockArchive(dir)
+	require.NoError(t, err)
+
+	_, blocks, certs := archiveSegment(0)
+	require.NoError(t, writeArchiveSegment(a, 0, blocks, certs))
+
+	path := a.segmentPath(0)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	// flip a byte of the first record
+	data[1] ^= 0xff
+	require.NoError(t, os.Chmod(path, 0600))
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	_, err = a.Get(0)
+	require.ErrorIs(t, err, ErrArchiveSegmentCorrupted)
+
+	// the other records are still readable
+	_, err = a.Get(1)
+	require.NoError(t, err)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merkletrie"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -75,6 +76,13 @@ const (
 	CatchpointStateCatchupState = CatchpointState("catchpointCatchupState")
 	// CatchpointStateCatchupLabel is the label to which the currently catchpoint catchup process is trying to catchup to.
 	CatchpointStateCatchupLabel = CatchpointState("catchpointCatchupLabel")
+	// CatchpointStateCatchupFile is the local catchpoint file used by the currently running catchpoint catchup process, if any.
+	CatchpointStateCatchupFile = CatchpointState("catchpointCatchupFile")
+	// CatchpointStateCatchupBlocksDir is the local blocks directory used by the currently running catchpoint catchup process, if any.
+	CatchpointStateCatchupBlocksDir = CatchpointState("catchpointCatchupBlocksDir")
+	// CatchpointStateCatchupDeltaFiles is the newline separated list of the local incremental catchpoint files applied on top of
+	// the local catchpoint file by the currently running catchpoint catchup process, if any.
+	CatchpointStateCatchupDeltaFiles = CatchpointState("catchpointCatchupDeltaFiles")
 	// CatchpointStateCatchupBlockRound is the block round that is associated with the current running catchpoint catchup.
 	CatchpointStateCatchupBlockRound = CatchpointState("catchpointCatchupBlockRound")
 	// CatchpointStateCatchupBalancesRound is the balance round that is associated with the current running catchpoint catchup. Typically it would be
@@ -565,6 +573,220 @@ func (cw *catchpointWriter) WriteCatchpointStagingKVs(ctx context.Context, keys
 	return nil
 }
 
+// UpdateCatchpointStagingBalances updates the account balances in the provided array in the catchpoint balance staging
+// table catchpointbalances, adding the accounts that aren't there yet, and adds or replaces their resources and creatables.
+// A balance without encoded account data only updates the resources of an account that is already staged.
+func (cw *catchpointWriter) UpdateCatchpointStagingBalances(ctx context.Context, bals []NormalizedAccountBalance) error {
+	selectAcctStmt, err := cw.e.PrepareContext(ctx, "SELECT rowid FROM catchpointbalances WHERE address = ?")
+	if err != nil {
+		return err
+	}
+	defer selectAcctStmt.Close()
+
+	updateAcctStmt, err := cw.e.PrepareContext(ctx, "UPDATE catchpointbalances SET normalizedonlinebalance = ?, data = ? WHERE address = ?")
+	if err != nil {
+		return err
+	}
+	defer updateAcctStmt.Close()
+
+	insertAcctStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointbalances(address, normalizedonlinebalance, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertAcctStmt.Close()
+
+	replaceRscStmt, err := cw.e.PrepareContext(ctx, "INSERT OR REPLACE INTO catchpointresources(addrid, aidx, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer replaceRscStmt.Close()
+
+	deleteCreatorStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointassetcreators WHERE asset = ? AND creator = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteCreatorStmt.Close()
+
+	insertCreatorStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointassetcreators(asset, creator, ctype) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertCreatorStmt.Close()
+
+	for _, balance := range bals {
+		if balance.EncodedAccountData != nil {
+			result, err := updateAcctStmt.ExecContext(ctx, balance.NormalizedBalance, balance.EncodedAccountData, balance.Address[:])
+			if err != nil {
+				return err
+			}
+			aff, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if aff == 0 {
+				_, err = insertAcctStmt.ExecContext(ctx, balance.Address[:], balance.NormalizedBalance, balance.EncodedAccountData)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if len(balance.Resources) == 0 {
+			continue
+		}
+
+		var rowID int64
+		err = selectAcctStmt.QueryRowContext(ctx, balance.Address[:]).Scan(&rowID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("unable to update the resources of account %v, which is missing from the catchpoint staging balances", balance.Address)
+			}
+			return err
+		}
+
+		for aidx, resData := range balance.Resources {
+			_, err = replaceRscStmt.ExecContext(ctx, rowID, aidx, balance.EncodedResources[aidx])
+			if err != nil {
+				return err
+			}
+			_, err = deleteCreatorStmt.ExecContext(ctx, aidx, balance.Address[:])
+			if err != nil {
+				return err
+			}
+			if !resData.IsOwning() {
+				continue
+			}
+			if resData.IsAsset() {
+				_, err = insertCreatorStmt.ExecContext(ctx, aidx, balance.Address[:], basics.AssetCreatable)
+				if err != nil {
+					return err
+				}
+			}
+			if resData.IsApp() {
+				_, err = insertCreatorStmt.ExecContext(ctx, aidx, balance.Address[:], basics.AppCreatable)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateCatchpointStagingKVs adds or replaces the KVs in the provided array in the catchpoint kvstore staging table
+// catchpointkvstore, and adds their hashes to the pending hashes table catchpointpendinghashes.
+func (cw *catchpointWriter) UpdateCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error {
+	replaceKV, err := cw.e.PrepareContext(ctx, "INSERT OR REPLACE INTO catchpointkvstore(key, value) VALUES(?, ?)")
+	if err != nil {
+		return err
+	}
+	defer replaceKV.Close()
+
+	insertHash, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES(?)")
+	if err != nil {
+		return err
+	}
+	defer insertHash.Close()
+
+	for i := 0; i < len(keys); i++ {
+		_, err := replaceKV.ExecContext(ctx, keys[i], values[i])
+		if err != nil {
+			return err
+		}
+
+		_, err = insertHash.ExecContext(ctx, hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteCatchpointStagingEntries deletes the given resources, accounts and KVs from the catchpoint staging tables.
+// Every one of them is expected to be staged.
+func (cw *catchpointWriter) DeleteCatchpointStagingEntries(ctx context.Context, accounts []basics.Address, resources []encoded.ResourceKeyRecordV6, kvKeys [][]byte) error {
+	deleteRscStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointresources WHERE addrid = (SELECT rowid FROM catchpointbalances WHERE address = ?) AND aidx = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteRscStmt.Close()
+
+	deleteCreatorStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointassetcreators WHERE asset = ? AND creator = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteCreatorStmt.Close()
+
+	deleteAcctStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointbalances WHERE address = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteAcctStmt.Close()
+
+	deleteKVStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointkvstore WHERE key = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteKVStmt.Close()
+
+	for _, res := range resources {
+		err = deleteSingleRow(ctx, deleteRscStmt, "resource", res.Address[:], res.Aidx)
+		if err != nil {
+			return err
+		}
+		_, err = deleteCreatorStmt.ExecContext(ctx, res.Aidx, res.Address[:])
+		if err != nil {
+			return err
+		}
+	}
+	for _, addr := range accounts {
+		err = deleteSingleRow(ctx, deleteAcctStmt, "account", addr[:])
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range kvKeys {
+		err = deleteSingleRow(ctx, deleteKVStmt, "kv", key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteCatchpointStagingHashes deletes the given hashes from the catchpoint pending hashes table catchpointpendinghashes.
+// Every one of them is expected to be there.
+func (cw *catchpointWriter) DeleteCatchpointStagingHashes(ctx context.Context, hashes [][]byte) error {
+	deleteStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointpendinghashes WHERE data = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteStmt.Close()
+
+	for _, hash := range hashes {
+		err = deleteSingleRow(ctx, deleteStmt, "hash", hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSingleRow executes the given delete statement, and ensures that it deleted exactly one row.
+func deleteSingleRow(ctx context.Context, stmt *sql.Stmt, entry string, args ...interface{}) error {
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return err
+	}
+	aff, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff != 1 {
+		return fmt.Errorf("number of deleted %s records was expected to be one, but was %d", entry, aff)
+	}
+	return nil
+}
+
 func (cw *catchpointWriter) ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	s := []string{
 		"DROP TABLE IF EXISTS catchpointbalances",
@@ -0,0 +1,327 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// accountsHistorySQL reads and writes the accounthistory, resourcehistory, kvhistory and creatablehistory tables.
+//
+// Each of the history tables holds the value of an entry as of the end of every round in which it was modified, along
+// with a base row holding its value as of the history base round. The base row is written the first time the entry
+// is modified after the history base round, and is read from the current accounts tables. An entry without any rows
+// has not been modified since the history base round, and so its value at any round is the one in the accounts tables.
+type accountsHistorySQL struct {
+	e                  db.Executable
+	preparedStatements map[string]*sql.Stmt
+}
+
+// NewAccountsHistorySQLReaderWriter creates an accounts history SQL reader+writer
+func NewAccountsHistorySQLReaderWriter(e db.Executable) *accountsHistorySQL {
+	return &accountsHistorySQL{e: e, preparedStatements: make(map[string]*sql.Stmt)}
+}
+
+func (h *accountsHistorySQL) getOrPrepare(ctx context.Context, queryString string) (stmt *sql.Stmt, err error) {
+	if stmt, ok := h.preparedStatements[queryString]; ok {
+		return stmt, nil
+	}
+	stmt, err = h.e.PrepareContext(ctx, queryString)
+	if err != nil {
+		return
+	}
+	h.preparedStatements[queryString] = stmt
+	return stmt, nil
+}
+
+// Close closes the prepared statements
+func (h *accountsHistorySQL) Close() {
+	for query, stmt := range h.preparedStatements {
+		stmt.Close()
+		delete(h.preparedStatements, query)
+	}
+}
+
+// AccountsHistoryRounds returns the base round and the latest round of the accounts history.
+// If the history was never started, ok is false.
+func (h *accountsHistorySQL) AccountsHistoryRounds(ctx context.Context) (baseRound basics.Round, rnd basics.Round, ok bool, err error) {
+	err = h.e.QueryRowContext(ctx, "SELECT base.rnd, latest.rnd FROM acctrounds AS base, acctrounds AS latest WHERE base.id='historybase' AND latest.id='historyround'").Scan(&baseRound, &rnd)
+	if err == sql.ErrNoRows {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return baseRound, rnd, true, nil
+}
+
+// ResetAccountsHistory deletes the accounts history, and restarts it from the given base round.
+func (h *accountsHistorySQL) ResetAccountsHistory(ctx context.Context, baseRound basics.Round) error {
+	stmts := []string{
+		"DELETE FROM accounthistory",
+		"DELETE FROM resourcehistory",
+		"DELETE FROM kvhistory",
+		"DELETE FROM creatablehistory",
+	}
+	for _, stmt := range stmts {
+		_, err := h.e.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := h.e.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES('historybase', ?)", baseRound)
+	if err != nil {
+		return err
+	}
+	return h.UpdateAccountsHistoryRound(ctx, baseRound)
+}
+
+// UpdateAccountsHistoryRound sets the latest round written to the accounts history.
+func (h *accountsHistorySQL) UpdateAccountsHistoryRound(ctx context.Context, rnd basics.Round) error {
+	_, err := h.e.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES('historyround', ?)", rnd)
+	return err
+}
+
+// InsertAccountHistory writes the account data of the given address as of the end of round rnd. The first time
+// the address is written, its current account data is written as well as of the end of the base round.
+func (h *accountsHistorySQL) InsertAccountHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, addr basics.Address, data BaseAccountData) error {
+	insertBase, err := h.getOrPrepare(ctx, `INSERT INTO accounthistory(address, rnd, data)
+		SELECT ?, ?, COALESCE((SELECT data FROM accountbase WHERE address = ?), x'')
+		WHERE NOT EXISTS (SELECT 1 FROM accounthistory WHERE address = ?)`)
+	if err != nil {
+		return err
+	}
+	_, err = insertBase.ExecContext(ctx, addr[:], baseRound, addr[:], addr[:])
+	if err != nil {
+		return err
+	}
+
+	insert, err := h.getOrPrepare(ctx, "INSERT OR REPLACE INTO accounthistory(address, rnd, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	var encoded []byte
+	if !data.IsEmpty() {
+		encoded = protocol.Encode(&data)
+	}
+	_, err = insert.ExecContext(ctx, addr[:], rnd, append([]byte{}, encoded...))
+	return err
+}
+
+// InsertResourceHistory writes the resource data of the given address and creatable index as of the end of round rnd.
+// The first time the resource is written, its current data is written as well as of the end of the base round.
+func (h *accountsHistorySQL) InsertResourceHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, data ResourcesData) error {
+	insertBase, err := h.getOrPrepare(ctx, `INSERT INTO resourcehistory(address, aidx, rnd, data)
+		SELECT ?, ?, ?, COALESCE((SELECT resources.data FROM resources JOIN accountbase ON resources.addrid = accountbase.rowid WHERE accountbase.address = ? AND resources.aidx = ?), x'')
+		WHERE NOT EXISTS (SELECT 1 FROM resourcehistory WHERE address = ? AND aidx = ?)`)
+	if err != nil {
+		return err
+	}
+	_, err = insertBase.ExecContext(ctx, addr[:], aidx, baseRound, addr[:], aidx, addr[:], aidx)
+	if err != nil {
+		return err
+	}
+
+	insert, err := h.getOrPrepare(ctx, "INSERT OR REPLACE INTO resourcehistory(address, aidx, rnd, data) VALUES(?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	var encoded []byte
+	if !data.IsEmpty() {
+		encoded = protocol.Encode(&data)
+	}
+	_, err = insert.ExecContext(ctx, addr[:], aidx, rnd, append([]byte{}, encoded...))
+	return err
+}
+
+// InsertKvHistory writes the value of the given key as of the end of round rnd, where a nil value stands for a
+// deleted key. The first time the key is written, its current value is written as well as of the end of the base round.
+func (h *accountsHistorySQL) InsertKvHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, key string, value []byte) error {
+	// Cast keys to []byte to avoid interpretation as character string, as done for the kvstore table.
+	insertBase, err := h.getOrPrepare(ctx, `INSERT INTO kvhistory(key, rnd, value)
+		SELECT ?, ?, (SELECT COALESCE(value, x'') FROM kvstore WHERE key = ?)
+		WHERE NOT EXISTS (SELECT 1 FROM kvhistory WHERE key = ?)`)
+	if err != nil {
+		return err
+	}
+	_, err = insertBase.ExecContext(ctx, []byte(key), baseRound, []byte(key), []byte(key))
+	if err != nil {
+		return err
+	}
+
+	insert, err := h.getOrPrepare(ctx, "INSERT OR REPLACE INTO kvhistory(key, rnd, value) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	if value != nil {
+		// make sure an empty value isn't written as a deleted one.
+		value = append([]byte{}, value...)
+	}
+	_, err = insert.ExecContext(ctx, []byte(key), rnd, value)
+	return err
+}
+
+// InsertCreatableHistory records the creator of the given creatable, so that it can be found after the creatable is deleted.
+func (h *accountsHistorySQL) InsertCreatableHistory(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType, creator basics.Address) error {
+	insert, err := h.getOrPrepare(ctx, "INSERT OR IGNORE INTO creatablehistory(asset, ctype, creator) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	_, err = insert.ExecContext(ctx, cidx, ctype, creator[:])
+	return err
+}
+
+// LookupAccountHistory returns the account data of the given address as of the end of round rnd.
+// The round has to be between the base round and the latest round of the accounts history.
+func (h *accountsHistorySQL) LookupAccountHistory(ctx context.Context, addr basics.Address, rnd basics.Round) (data BaseAccountData, err error) {
+	var buf []byte
+	err = h.e.QueryRowContext(ctx, "SELECT data FROM accounthistory WHERE address = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1", addr[:], rnd).Scan(&buf)
+	if err == sql.ErrNoRows {
+		// not modified since the base round.
+		err = h.e.QueryRowContext(ctx, "SELECT data FROM accountbase WHERE address = ?", addr[:]).Scan(&buf)
+	}
+	if err == sql.ErrNoRows {
+		return BaseAccountData{}, nil
+	}
+	if err != nil || len(buf) == 0 {
+		return BaseAccountData{}, err
+	}
+	err = protocol.Decode(buf, &data)
+	return
+}
+
+// LookupResourceHistory returns the resource data of the given address and creatable index as of the end of round rnd.
+// The round has to be between the base round and the latest round of the accounts history.
+func (h *accountsHistorySQL) LookupResourceHistory(ctx context.Context, addr basics.Address, aidx basics.CreatableIndex, rnd basics.Round) (data ResourcesData, err error) {
+	var buf []byte
+	err = h.e.QueryRowContext(ctx, "SELECT data FROM resourcehistory WHERE address = ? AND aidx = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1", addr[:], aidx, rnd).Scan(&buf)
+	if err == sql.ErrNoRows {
+		// not modified since the base round.
+		err = h.e.QueryRowContext(ctx, "SELECT resources.data FROM resources JOIN accountbase ON resources.addrid = accountbase.rowid WHERE accountbase.address = ? AND resources.aidx = ?", addr[:], aidx).Scan(&buf)
+	}
+	if err == sql.ErrNoRows {
+		return MakeResourcesData(0), nil
+	}
+	if err != nil {
+		return ResourcesData{}, err
+	}
+	if len(buf) == 0 {
+		return MakeResourcesData(0), nil
+	}
+	err = protocol.Decode(buf, &data)
+	return
+}
+
+// LookupAllResourcesHistory returns all the resources data of the given address as of the end of round rnd.
+// The round has to be between the base round and the latest round of the accounts history.
+func (h *accountsHistorySQL) LookupAllResourcesHistory(ctx context.Context, addr basics.Address, rnd basics.Round) (resources map[basics.CreatableIndex]ResourcesData, err error) {
+	resources = make(map[basics.CreatableIndex]ResourcesData)
+	// the resources that were modified since the base round are taken from the history, including the deleted ones.
+	modified := make(map[basics.CreatableIndex]bool)
+	rows, err := h.e.QueryContext(ctx, `SELECT aidx, data FROM resourcehistory AS h WHERE address = ?
+		AND rnd = (SELECT MAX(rnd) FROM resourcehistory WHERE address = h.address AND aidx = h.aidx AND rnd <= ?)`, addr[:], rnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var aidx basics.CreatableIndex
+		var buf []byte
+		err = rows.Scan(&aidx, &buf)
+		if err != nil {
+			return nil, err
+		}
+		modified[aidx] = true
+		if len(buf) == 0 {
+			continue
+		}
+		var data ResourcesData
+		err = protocol.Decode(buf, &data)
+		if err != nil {
+			return nil, err
+		}
+		resources[aidx] = data
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	current, err := h.e.QueryContext(ctx, "SELECT resources.aidx, resources.data FROM resources JOIN accountbase ON resources.addrid = accountbase.rowid WHERE accountbase.address = ?", addr[:])
+	if err != nil {
+		return nil, err
+	}
+	defer current.Close()
+	for current.Next() {
+		var aidx basics.CreatableIndex
+		var buf []byte
+		err = current.Scan(&aidx, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if modified[aidx] {
+			continue
+		}
+		var data ResourcesData
+		err = protocol.Decode(buf, &data)
+		if err != nil {
+			return nil, err
+		}
+		resources[aidx] = data
+	}
+	return resources, current.Err()
+}
+
+// LookupKvHistory returns the value of the given key as of the end of round rnd, or nil if the key did not exist.
+// The round has to be between the base round and the latest round of the accounts history.
+func (h *accountsHistorySQL) LookupKvHistory(ctx context.Context, key string, rnd basics.Round) (value []byte, err error) {
+	err = h.e.QueryRowContext(ctx, "SELECT value FROM kvhistory WHERE key = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1", []byte(key), rnd).Scan(&value)
+	if err == sql.ErrNoRows {
+		// not modified since the base round.
+		var exists bool
+		err = h.e.QueryRowContext(ctx, "SELECT 1, value FROM kvstore WHERE key = ?", []byte(key)).Scan(&exists, &value)
+		if err == nil && value == nil {
+			value = []byte{}
+		}
+	}
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return value, err
+}
+
+// LookupCreatorHistory returns the creator of the given creatable, whether or not the creatable still exists.
+// It doesn't check whether the creatable existed at any particular round.
+func (h *accountsHistorySQL) LookupCreatorHistory(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, err error) {
+	var buf []byte
+	err = h.e.QueryRowContext(ctx, "SELECT creator FROM creatablehistory WHERE asset = ? AND ctype = ?", cidx, ctype).Scan(&buf)
+	if err == sql.ErrNoRows {
+		err = h.e.QueryRowContext(ctx, "SELECT creator FROM assetcreators WHERE asset = ? AND ctype = ?", cidx, ctype).Scan(&buf)
+	}
+	if err == sql.ErrNoRows {
+		return basics.Address{}, false, nil
+	}
+	if err != nil {
+		return basics.Address{}, false, err
+	}
+	copy(addr[:], buf)
+	return addr, true, nil
+}
@@ -19,8 +19,11 @@ package store
 import (
 	"context"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 )
 
 // AccountsWriter is the write interface for:
@@ -61,6 +64,45 @@ type AccountsReader interface {
 	Close()
 }
 
+// AccountsWriterExt is the write interface used inside transactions and batch operations.
+type AccountsWriterExt interface {
+	AccountsReset(ctx context.Context) error
+	ResetAccountHashes(ctx context.Context) (err error)
+	TxtailNewRound(ctx context.Context, baseRound basics.Round, roundData [][]byte, forgetBeforeRound basics.Round) error
+	UpdateAccountsRound(rnd basics.Round) (err error)
+	UpdateAccountsHashRound(ctx context.Context, hashRound basics.Round) (err error)
+	AccountsPutTotals(totals ledgercore.AccountTotals, catchpointStaging bool) error
+	OnlineAccountsDelete(forgetBefore basics.Round) (err error)
+	AccountsPutOnlineRoundParams(onlineRoundParamsData []ledgercore.OnlineRoundParamsData, startRound basics.Round) error
+	AccountsPruneOnlineRoundParams(deleteBeforeRound basics.Round) error
+}
+
+// AccountsReaderExt is the read interface used inside transactions and snapshots.
+type AccountsReaderExt interface {
+	AccountsTotals(ctx context.Context, catchpointStaging bool) (totals ledgercore.AccountTotals, err error)
+	AccountsHashRound(ctx context.Context) (hashrnd basics.Round, err error)
+	LookupAccountAddressFromAddressID(ctx context.Context, addrid int64) (address basics.Address, err error)
+	LookupAccountDataByAddress(basics.Address) (rowid int64, data []byte, err error)
+	LookupAccountRowID(basics.Address) (addrid int64, err error)
+	LookupResourceDataByAddrID(addrid int64, aidx basics.CreatableIndex) (data []byte, err error)
+	TotalAccounts(ctx context.Context) (total uint64, err error)
+	TotalKVs(ctx context.Context) (total uint64, err error)
+	AccountsRound() (rnd basics.Round, err error)
+	LookupOnlineAccountDataByAddress(addr basics.Address) (rowid int64, data []byte, err error)
+	AccountsOnlineTop(rnd basics.Round, offset uint64, n uint64, proto config.ConsensusParams) (map[basics.Address]*ledgercore.OnlineAccount, error)
+	AccountsOnlineRoundParams() (onlineRoundParamsData []ledgercore.OnlineRoundParamsData, endRound basics.Round, err error)
+	OnlineAccountsAll(maxAccounts uint64) ([]PersistedOnlineAccountData, error)
+	LoadTxTail(ctx context.Context, dbRound basics.Round) (roundData []*TxTailRound, roundHash []crypto.Digest, baseRound basics.Round, err error)
+	LoadAllFullAccounts(ctx context.Context, balancesTable string, resourcesTable string, acctCb func(basics.Address, basics.AccountData)) (count int, err error)
+	LoadFullAccount(ctx context.Context, resourcesTable string, addr basics.Address, addrid int64, data BaseAccountData) (ad basics.AccountData, err error)
+}
+
+// AccountsReaderWriter is AccountsReaderExt+AccountsWriterExt
+type AccountsReaderWriter interface {
+	AccountsReaderExt
+	AccountsWriterExt
+}
+
 // OnlineAccountsWriter is the write interface for:
 // - online accounts
 type OnlineAccountsWriter interface {
@@ -79,6 +121,40 @@ type OnlineAccountsReader interface {
 	Close()
 }
 
+// AccountsHistoryWriter is the write interface for:
+// - the per-round history of accounts, resources, app kvs and creatables
+type AccountsHistoryWriter interface {
+	ResetAccountsHistory(ctx context.Context, baseRound basics.Round) error
+	UpdateAccountsHistoryRound(ctx context.Context, rnd basics.Round) error
+
+	InsertAccountHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, addr basics.Address, data BaseAccountData) error
+	InsertResourceHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, data ResourcesData) error
+	InsertKvHistory(ctx context.Context, baseRound basics.Round, rnd basics.Round, key string, value []byte) error
+	InsertCreatableHistory(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType, creator basics.Address) error
+
+	Close()
+}
+
+// AccountsHistoryReader is the read interface for:
+// - the per-round history of accounts, resources, app kvs and creatables
+type AccountsHistoryReader interface {
+	AccountsHistoryRounds(ctx context.Context) (baseRound basics.Round, rnd basics.Round, ok bool, err error)
+
+	LookupAccountHistory(ctx context.Context, addr basics.Address, rnd basics.Round) (data BaseAccountData, err error)
+	LookupResourceHistory(ctx context.Context, addr basics.Address, aidx basics.CreatableIndex, rnd basics.Round) (data ResourcesData, err error)
+	LookupAllResourcesHistory(ctx context.Context, addr basics.Address, rnd basics.Round) (resources map[basics.CreatableIndex]ResourcesData, err error)
+	LookupKvHistory(ctx context.Context, key string, rnd basics.Round) (value []byte, err error)
+	LookupCreatorHistory(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, err error)
+
+	Close()
+}
+
+// AccountsHistoryReaderWriter is AccountsHistoryReader+AccountsHistoryWriter
+type AccountsHistoryReaderWriter interface {
+	AccountsHistoryReader
+	AccountsHistoryWriter
+}
+
 // CatchpointWriter is the write interface for:
 // - catchpoints
 type CatchpointWriter interface {
@@ -91,7 +167,21 @@ type CatchpointWriter interface {
 	DeleteUnfinishedCatchpoint(ctx context.Context, round basics.Round) error
 	DeleteOldCatchpointFirstStageInfo(ctx context.Context, maxRoundToDelete basics.Round) error
 
+	InsertOrReplaceCatchpointFirstStageInfo(ctx context.Context, round basics.Round, info *CatchpointFirstStageInfo) error
+
 	DeleteStoredCatchpoints(ctx context.Context, dbDirectory string) (err error)
+
+	WriteCatchpointStagingBalances(ctx context.Context, bals []NormalizedAccountBalance) error
+	WriteCatchpointStagingHashes(ctx context.Context, bals []NormalizedAccountBalance) error
+	WriteCatchpointStagingCreatable(ctx context.Context, bals []NormalizedAccountBalance) error
+	WriteCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error
+	UpdateCatchpointStagingBalances(ctx context.Context, bals []NormalizedAccountBalance) error
+	UpdateCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error
+	DeleteCatchpointStagingEntries(ctx context.Context, accounts []basics.Address, resources []encoded.ResourceKeyRecordV6, kvKeys [][]byte) error
+	DeleteCatchpointStagingHashes(ctx context.Context, hashes [][]byte) error
+	ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error)
+	ApplyCatchpointStagingBalances(ctx context.Context, balancesRound basics.Round, merkleRootRound basics.Round) (err error)
+	CreateCatchpointStagingHashesIndex(ctx context.Context) (err error)
 }
 
 // CatchpointReader is the read interface for:
@@ -107,3 +197,40 @@ type CatchpointReader interface {
 	SelectCatchpointFirstStageInfo(ctx context.Context, round basics.Round) (CatchpointFirstStageInfo, bool /*exists*/, error)
 	SelectOldCatchpointFirstStageInfoRounds(ctx context.Context, maxRound basics.Round) ([]basics.Round, error)
 }
+
+// CatchpointReaderWriter is CatchpointReader+CatchpointWriter
+type CatchpointReaderWriter interface {
+	CatchpointReader
+	CatchpointWriter
+}
+
+// MerkleCommitter allows storing and loading merkletrie pages from the tracker store.
+type MerkleCommitter interface {
+	StorePage(page uint64, content []byte) error
+	LoadPage(page uint64) (content []byte, err error)
+}
+
+// OrderedAccountsIter is an iterator over the accounts, ordered by their hash.
+type OrderedAccountsIter interface {
+	Next(ctx context.Context) (acct []AccountAddressHash, processedRecords int, err error)
+	Close(ctx context.Context) (err error)
+}
+
+// KVsIter is an iterator for the application key-value store.
+type KVsIter interface {
+	Next() bool
+	KeyValue() (k []byte, v []byte, err error)
+	Close()
+}
+
+// EncodedAccountsBatchIter is an iterator over the accounts and resources, producing catchpoint file records.
+type EncodedAccountsBatchIter interface {
+	Next(ctx context.Context, accountCount int, resourceCount int) (bals []encoded.BalanceRecordV6, numAccountsProcessed uint64, err error)
+	Close()
+}
+
+// CatchpointPendingHashesIter is an iterator over the pending staging account hashes.
+type CatchpointPendingHashesIter interface {
+	Next(ctx context.Context) (hashes [][]byte, err error)
+	Close()
+}
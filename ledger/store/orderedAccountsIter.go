@@ -91,21 +91,21 @@ func MakeOrderedAccountsIter(tx *sql.Tx, accountCount int) *orderedAccountsIter
 	}
 }
 
-// accountAddressHash is used by Next to return a single account address and the associated hash.
-type accountAddressHash struct {
+// AccountAddressHash is used by Next to return a single account address and the associated hash.
+type AccountAddressHash struct {
 	Addrid int64
 	Digest []byte
 }
 
 // Next returns an array containing the account address and hash
 // the Next function works in multiple processing stages, where it first processes the current accounts and order them
-// followed by returning the ordered accounts. In the first phase, it would return empty accountAddressHash array
+// followed by returning the ordered accounts. In the first phase, it would return empty AccountAddressHash array
 // and sets the processedRecords to the number of accounts that were processed. On the second phase, the acct
 // would contain valid data ( and optionally the account data as well, if was asked in makeOrderedAccountsIter) and
 // the processedRecords would be zero. If err is sql.ErrNoRows it means that the iterator have completed it's work and no further
 // accounts exists. Otherwise, the caller is expected to keep calling "Next" to retrieve the next set of accounts
 // ( or let the Next function make some progress toward that goal )
-func (iterator *orderedAccountsIter) Next(ctx context.Context) (acct []accountAddressHash, processedRecords int, err error) {
+func (iterator *orderedAccountsIter) Next(ctx context.Context) (acct []AccountAddressHash, processedRecords int, err error) {
 	if iterator.step == oaiStepDeleteOldOrderingTable {
 		// although we're going to delete this table anyway when completing the iterator execution, we'll try to
 		// clean up any intermediate table.
@@ -226,7 +226,7 @@ func (iterator *orderedAccountsIter) Next(ctx context.Context) (acct []accountAd
 	}
 
 	if iterator.step == oaiStepIterateOverOrderedTable {
-		acct = make([]accountAddressHash, iterator.accountCount)
+		acct = make([]AccountAddressHash, iterator.accountCount)
 		acctIdx := 0
 		for iterator.hashesRows.Next() {
 			err = iterator.hashesRows.Scan(&(acct[acctIdx].Addrid), &(acct[acctIdx].Digest))
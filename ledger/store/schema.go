@@ -148,6 +148,34 @@ const createUnfinishedCatchpointsTable = `
 	round integer primary key NOT NULL,
 	blockhash blob NOT NULL)`
 
+// The history tables hold the per-round values of the accounts, resources and kvs modified since the history base
+// round, in the same encoding as the accountbase, resources and kvstore tables. An empty data blob stands for a deleted
+// account or resource, and a NULL value for a deleted kv. The creatablehistory table holds the creator of every
+// creatable modified since the history base round, so that it can be found after the creatable is deleted.
+var createAccountsHistoryTables = []string{
+	`CREATE TABLE IF NOT EXISTS accounthistory (
+		address BLOB NOT NULL,
+		rnd INTEGER NOT NULL,
+		data BLOB NOT NULL,
+		PRIMARY KEY (address, rnd) ) WITHOUT ROWID`,
+	`CREATE TABLE IF NOT EXISTS resourcehistory (
+		address BLOB NOT NULL,
+		aidx INTEGER NOT NULL,
+		rnd INTEGER NOT NULL,
+		data BLOB NOT NULL,
+		PRIMARY KEY (address, aidx, rnd) ) WITHOUT ROWID`,
+	`CREATE TABLE IF NOT EXISTS kvhistory (
+		key BLOB NOT NULL,
+		rnd INTEGER NOT NULL,
+		value BLOB,
+		PRIMARY KEY (key, rnd) ) WITHOUT ROWID`,
+	`CREATE TABLE IF NOT EXISTS creatablehistory (
+		asset INTEGER NOT NULL,
+		ctype INTEGER NOT NULL,
+		creator BLOB NOT NULL,
+		PRIMARY KEY (asset, ctype) )`,
+}
+
 var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS acctrounds`,
 	`DROP TABLE IF EXISTS accounttotals`,
@@ -163,12 +191,16 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS onlineroundparamstail`,
 	`DROP TABLE IF EXISTS catchpointfirststageinfo`,
 	`DROP TABLE IF EXISTS unfinishedcatchpoints`,
+	`DROP TABLE IF EXISTS accounthistory`,
+	`DROP TABLE IF EXISTS resourcehistory`,
+	`DROP TABLE IF EXISTS kvhistory`,
+	`DROP TABLE IF EXISTS creatablehistory`,
 }
 
 // AccountDBVersion is the database version that this binary would know how to support and how to upgrade to.
 // details about the content of each of the versions can be found in the upgrade functions upgradeDatabaseSchemaXXXX
 // and their descriptions.
-var AccountDBVersion = int32(9)
+var AccountDBVersion = int32(10)
 
 // accountsInit fills the database using tx with initAccounts if the
 // database has not been initialized yet.
@@ -368,6 +400,16 @@ func accountsCreateOnlineRoundParamsTable(ctx context.Context, tx *sql.Tx) (err
 	return nil
 }
 
+func accountsCreateHistoryTables(ctx context.Context, tx *sql.Tx) (err error) {
+	for _, stmt := range createAccountsHistoryTables {
+		_, err = tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return
+		}
+	}
+	return nil
+}
+
 func accountsCreateCatchpointFirstStageInfoTable(ctx context.Context, e db.Executable) error {
 	_, err := e.ExecContext(ctx, createCatchpointFirstStageInfoTable)
 	return err
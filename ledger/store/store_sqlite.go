@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// trackerSQLStore is the SQLite implementation of the TrackerStore.
+// Snapshots run on the read connection, batches and transactions on the write connection.
+type trackerSQLStore struct {
+	pair db.Pair
+}
+
+type sqlBatchScope struct {
+	tx *sql.Tx
+}
+
+type sqlSnapshotScope struct {
+	tx *sql.Tx
+}
+
+type sqlTransactionScope struct {
+	tx *sql.Tx
+}
+
+// OpenTrackerSQLStore opens the sqlite database store
+func OpenTrackerSQLStore(dbFilename string, dbMem bool) (store *trackerSQLStore, err error) {
+	pair, err := db.OpenPair(dbFilename, dbMem)
+	if err != nil {
+		return
+	}
+
+	return &trackerSQLStore{pair}, nil
+}
+
+// CreateTrackerSQLStore creates a tracker SQL db from a sql db pair.
+func CreateTrackerSQLStore(pair db.Pair) *trackerSQLStore {
+	return &trackerSQLStore{pair}
+}
+
+// SetLogger sets the Logger, mainly for unit test quietness
+func (s *trackerSQLStore) SetLogger(log logging.Logger) {
+	s.pair.Rdb.SetLogger(log)
+	s.pair.Wdb.SetLogger(log)
+}
+
+func (s *trackerSQLStore) SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error) {
+	return s.pair.Wdb.SetSynchronousMode(ctx, mode, fullfsync)
+}
+
+func (s *trackerSQLStore) IsSharedCacheConnection() bool {
+	return s.pair.Wdb.IsSharedCacheConnection()
+}
+
+func (s *trackerSQLStore) Batch(fn BatchFn) (err error) {
+	return s.BatchContext(context.Background(), fn)
+}
+
+func (s *trackerSQLStore) BatchContext(ctx context.Context, fn BatchFn) (err error) {
+	return s.pair.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, sqlBatchScope{tx})
+	})
+}
+
+func (s *trackerSQLStore) Snapshot(fn SnapshotFn) (err error) {
+	return s.SnapshotContext(context.Background(), fn)
+}
+
+func (s *trackerSQLStore) SnapshotContext(ctx context.Context, fn SnapshotFn) (err error) {
+	return s.pair.Rdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, sqlSnapshotScope{tx})
+	})
+}
+
+func (s *trackerSQLStore) Transaction(fn TransactionFn) (err error) {
+	return s.TransactionContext(context.Background(), fn)
+}
+
+func (s *trackerSQLStore) TransactionContext(ctx context.Context, fn TransactionFn) (err error) {
+	return s.pair.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, sqlTransactionScope{tx})
+	})
+}
+
+func (s *trackerSQLStore) MakeAccountsOptimizedReader() (AccountsReader, error) {
+	r, err := AccountsInitDbQueries(s.pair.Rdb.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *trackerSQLStore) MakeOnlineAccountsOptimizedReader() (OnlineAccountsReader, error) {
+	r, err := OnlineAccountsInitDbQueries(s.pair.Rdb.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *trackerSQLStore) MakeCatchpointReaderWriter() (CatchpointReaderWriter, error) {
+	return NewCatchpointSQLReaderWriter(s.pair.Wdb.Handle), nil
+}
+
+func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err error) {
+	return s.pair.Wdb.Vacuum(ctx)
+}
+
+func (s *trackerSQLStore) Close() {
+	s.pair.Close()
+}
+
+func (bs sqlBatchScope) MakeCatchpointWriter() (CatchpointWriter, error) {
+	return NewCatchpointSQLReaderWriter(bs.tx), nil
+}
+
+func (bs sqlBatchScope) MakeAccountsWriter() (AccountsWriterExt, error) {
+	return NewAccountsSQLReaderWriter(bs.tx), nil
+}
+
+func (bs sqlBatchScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (AccountsWriter, error) {
+	r, err := MakeAccountsSQLWriter(bs.tx, hasAccounts, hasResources, hasKvPairs, hasCreatables)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (bs sqlBatchScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return db.ResetTransactionWarnDeadline(ctx, bs.tx, deadline)
+}
+
+func (ss sqlSnapshotScope) MakeAccountsReader() (AccountsReaderExt, error) {
+	return NewAccountsSQLReaderWriter(ss.tx), nil
+}
+
+func (ss sqlSnapshotScope) MakeAccountsHistoryReader() (AccountsHistoryReader, error) {
+	return NewAccountsHistorySQLReaderWriter(ss.tx), nil
+}
+
+func (ss sqlSnapshotScope) MakeCatchpointReader() (CatchpointReader, error) {
+	return NewCatchpointSQLReaderWriter(ss.tx), nil
+}
+
+func (ss sqlSnapshotScope) MakeCatchpointPendingHashesIterator(hashCount int) CatchpointPendingHashesIter {
+	return MakeCatchpointPendingHashesIterator(hashCount, ss.tx)
+}
+
+func (ss sqlSnapshotScope) MakeEncodedAccoutsBatchIter() EncodedAccountsBatchIter {
+	return MakeEncodedAccoutsBatchIter(ss.tx)
+}
+
+func (ss sqlSnapshotScope) MakeKVsIter(ctx context.Context) (KVsIter, error) {
+	r, err := MakeKVsIter(ctx, ss.tx)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (ss sqlSnapshotScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return db.ResetTransactionWarnDeadline(ctx, ss.tx, deadline)
+}
+
+func (txs sqlTransactionScope) MakeCatchpointReaderWriter() (CatchpointReaderWriter, error) {
+	return NewCatchpointSQLReaderWriter(txs.tx), nil
+}
+
+func (txs sqlTransactionScope) MakeAccountsReaderWriter() (AccountsReaderWriter, error) {
+	return NewAccountsSQLReaderWriter(txs.tx), nil
+}
+
+func (txs sqlTransactionScope) MakeAccountsHistoryReaderWriter() (AccountsHistoryReaderWriter, error) {
+	return NewAccountsHistorySQLReaderWriter(txs.tx), nil
+}
+
+func (txs sqlTransactionScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (AccountsWriter, error) {
+	r, err := MakeAccountsSQLWriter(txs.tx, hasAccounts, hasResources, hasKvPairs, hasCreatables)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (txs sqlTransactionScope) MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (OnlineAccountsWriter, error) {
+	r, err := MakeOnlineAccountsSQLWriter(txs.tx, hasAccounts)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (txs sqlTransactionScope) MakeMerkleCommitter(staging bool) (MerkleCommitter, error) {
+	r, err := MakeMerkleCommitter(txs.tx, staging)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (txs sqlTransactionScope) MakeOrderedAccountsIter(accountCount int) OrderedAccountsIter {
+	return MakeOrderedAccountsIter(txs.tx, accountCount)
+}
+
+func (txs sqlTransactionScope) MakeKVsIter(ctx context.Context) (KVsIter, error) {
+	r, err := MakeKVsIter(ctx, txs.tx)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (txs sqlTransactionScope) RunMigrations(ctx context.Context, params TrackerDBParams, log logging.Logger, targetVersion int32) (mgr TrackerDBInitParams, err error) {
+	return RunMigrations(ctx, txs.tx, params, log, targetVersion)
+}
+
+func (txs sqlTransactionScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return db.ResetTransactionWarnDeadline(ctx, txs.tx, deadline)
+}
@@ -97,3 +97,19 @@ func AccountsUpdateSchemaTest(ctx context.Context, tx *sql.Tx) (err error) {
 	}
 	return nil
 }
+
+// MakeTransactionScopeTest wraps a raw sql transaction into a TransactionScope, so tests operating
+// directly on the database can call into code expecting the store abstraction.
+func MakeTransactionScopeTest(tx *sql.Tx) TransactionScope {
+	return sqlTransactionScope{tx}
+}
+
+// MakeSnapshotScopeTest wraps a raw sql transaction into a SnapshotScope.
+func MakeSnapshotScopeTest(tx *sql.Tx) SnapshotScope {
+	return sqlSnapshotScope{tx}
+}
+
+// DbPairTest returns the underlying database pair of a sqlite TrackerStore.
+func DbPairTest(s TrackerStore) *db.Pair {
+	return &s.(*trackerSQLStore).pair
+}
@@ -150,6 +150,12 @@ func RunMigrations(ctx context.Context, tx *sql.Tx, params TrackerDBParams, log
 					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 8 : %v", err)
 					return
 				}
+			case 9:
+				err = tu.upgradeDatabaseSchema9(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 9 : %v", err)
+					return
+				}
 			default:
 				return TrackerDBInitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
 			}
@@ -510,6 +516,16 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema8(ctx context.Context
 	return tu.setVersion(ctx, tx, 9)
 }
 
+// upgradeDatabaseSchema9 upgrades the database schema from version 9 to version 10,
+// adding the accounts history tables. The tables are only filled by archival nodes that enable the accounts history.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema9(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateHistoryTables(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema9 unable to create accounts history tables : %v", err)
+	}
+	return tu.setVersion(ctx, tx, 10)
+}
+
 // Review: this is an odd method to have here
 
 // isDirEmpty returns if a given directory is empty or not.
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"reflect"
@@ -108,7 +107,7 @@ type ledgerTracker interface {
 	// commitRound is called for each of the trackers after a deferredCommitContext was agreed upon
 	// by all the prepareCommit calls. The commitRound is being executed within a single transactional
 	// context, and so, if any of the tracker's commitRound calls fails, the transaction is rolled back.
-	commitRound(context.Context, *sql.Tx, *deferredCommitContext) error
+	commitRound(context.Context, store.TransactionScope, *deferredCommitContext) error
 	// postCommit is called only on a successful commitRound. In that case, each of the trackers have
 	// the chance to update it's internal data structures, knowing that the given deferredCommitContext
 	// has completed. An optional context is provided for long-running operations.
@@ -134,7 +133,7 @@ type ledgerTracker interface {
 // ledgerForTracker defines the part of the ledger that a tracker can
 // access.  This is particularly useful for testing trackers in isolation.
 type ledgerForTracker interface {
-	trackerDB() db.Pair
+	trackerDB() store.TrackerStore
 	blockDB() db.Pair
 	trackerLog() logging.Logger
 	trackerEvalVerified(bookkeeping.Block, internal.LedgerForEvaluator) (ledgercore.StateDelta, error)
@@ -174,7 +173,7 @@ type trackerRegistry struct {
 	// cached to avoid SQL queries.
 	dbRound basics.Round
 
-	dbs db.Pair
+	dbs store.TrackerStore
 	log logging.Logger
 
 	// the synchronous mode that would be used for the account database.
@@ -226,6 +225,14 @@ type deferredCommitRange struct {
 
 	// True iff the commit range includes a catchpoint round.
 	catchpointSecondStage bool
+
+	// True iff the first stage of catchpoint generation in this commit cycle iteration
+	// takes the accounts snapshot of a catchpoint requested on demand.
+	catchpointOnDemandFirstStage bool
+
+	// catchpointOnDemandRound is the round of a catchpoint requested on demand if
+	// the commit range includes it, or zero otherwise.
+	catchpointOnDemandRound basics.Round
 }
 
 // deferredCommitContext is used in order to synchronize the persistence of a given deferredCommitRange.
@@ -269,6 +276,9 @@ type deferredCommitContext struct {
 	// txtail rounds deltas history size
 	txTailRetainSize uint64
 
+	// accounts history of the committed rounds, when the accounts history is enabled
+	historyDeltas []accountsHistoryRound
+
 	stats       telemetryspec.AccountsUpdateMetrics
 	updateStats bool
 }
@@ -279,9 +289,13 @@ func (tr *trackerRegistry) initialize(l ledgerForTracker, trackers []ledgerTrack
 	tr.dbs = l.trackerDB()
 	tr.log = l.trackerLog()
 
-	err = tr.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		arw := store.NewAccountsSQLReaderWriter(tx)
-		tr.dbRound, err = arw.AccountsRound()
+	err = tr.dbs.Snapshot(func(ctx context.Context, tx store.SnapshotScope) (err error) {
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+
+		tr.dbRound, err = ar.AccountsRound()
 		return err
 	})
 
@@ -510,8 +524,12 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 
 	start := time.Now()
 	ledgerCommitroundCount.Inc(nil)
-	err := tr.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err := tr.dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) (err error) {
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
+
 		for _, lt := range tr.trackers {
 			err0 := lt.commitRound(ctx, tx, dcc)
 			if err0 != nil {
@@ -631,7 +649,7 @@ func (tr *trackerRegistry) replay(l ledgerForTracker) (err error) {
 	defer func() {
 		if rollbackSynchronousMode {
 			// restore default synchronous mode
-			err0 := tr.dbs.Wdb.SetSynchronousMode(context.Background(), tr.synchronousMode, tr.synchronousMode >= db.SynchronousModeFull)
+			err0 := tr.dbs.SetSynchronousMode(context.Background(), tr.synchronousMode, tr.synchronousMode >= db.SynchronousModeFull)
 			// override the returned error only in case there is no error - since this
 			// operation has a lower criticality.
 			if err == nil {
@@ -662,7 +680,7 @@ func (tr *trackerRegistry) replay(l ledgerForTracker) (err error) {
 
 			if !rollbackSynchronousMode {
 				// switch to rebuild synchronous mode to improve performance
-				err0 := tr.dbs.Wdb.SetSynchronousMode(context.Background(), tr.accountsRebuildSynchronousMode, tr.accountsRebuildSynchronousMode >= db.SynchronousModeFull)
+				err0 := tr.dbs.SetSynchronousMode(context.Background(), tr.accountsRebuildSynchronousMode, tr.accountsRebuildSynchronousMode >= db.SynchronousModeFull)
 				if err0 != nil {
 					tr.log.Warnf("trackerRegistry.replay was unable to switch to rbuild synchronous mode : %v", err0)
 				} else {
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
 	"github.com/algorand/go-algorand/ledger/store"
@@ -39,8 +38,11 @@ func trackerDBInitialize(l ledgerForTracker, catchpointEnabled bool, dbPathPrefi
 		return
 	}
 
-	err = dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		arw := store.NewAccountsSQLReaderWriter(tx)
+	err = dbs.Transaction(func(ctx context.Context, tx store.TransactionScope) error {
+		arw, err := tx.MakeAccountsReaderWriter()
+		if err != nil {
+			return err
+		}
 
 		tp := store.TrackerDBParams{
 			InitAccounts:      l.GenesisAccounts(),
@@ -52,7 +54,7 @@ func trackerDBInitialize(l ledgerForTracker, catchpointEnabled bool, dbPathPrefi
 			BlockDb:           bdbs,
 		}
 		var err0 error
-		mgr, err0 = store.RunMigrations(ctx, tx, tp, log, store.AccountDBVersion)
+		mgr, err0 = tx.RunMigrations(ctx, tp, log, store.AccountDBVersion)
 		if err0 != nil {
 			return err0
 		}
@@ -67,7 +69,7 @@ func trackerDBInitialize(l ledgerForTracker, catchpointEnabled bool, dbPathPrefi
 			if err0 != nil {
 				return err0
 			}
-			mgr, err0 = store.RunMigrations(ctx, tx, tp, log, store.AccountDBVersion)
+			mgr, err0 = tx.RunMigrations(ctx, tp, log, store.AccountDBVersion)
 			if err0 != nil {
 				return err0
 			}
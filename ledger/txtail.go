@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
 	"github.com/algorand/go-deadlock"
@@ -91,16 +90,20 @@ type txTail struct {
 }
 
 func (t *txTail) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
-	rdb := l.trackerDB().Rdb
+	tx := l.trackerDB()
 	t.log = l.trackerLog()
 
 	var roundData []*store.TxTailRound
 	var roundTailHashes []crypto.Digest
 	var baseRound basics.Round
 	if dbRound > 0 {
-		err := rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-			arw := store.NewAccountsSQLReaderWriter(tx)
-			roundData, roundTailHashes, baseRound, err = arw.LoadTxTail(ctx, dbRound)
+		err := tx.Snapshot(func(ctx context.Context, tx store.SnapshotScope) (err error) {
+			ar, err := tx.MakeAccountsReader()
+			if err != nil {
+				return err
+			}
+
+			roundData, roundTailHashes, baseRound, err = ar.LoadTxTail(ctx, dbRound)
 			return
 		})
 		if err != nil {
@@ -270,8 +273,11 @@ func (t *txTail) prepareCommit(dcc *deferredCommitContext) (err error) {
 	return
 }
 
-func (t *txTail) commitRound(ctx context.Context, tx *sql.Tx, dcc *deferredCommitContext) error {
-	arw := store.NewAccountsSQLReaderWriter(tx)
+func (t *txTail) commitRound(ctx context.Context, tx store.TransactionScope, dcc *deferredCommitContext) error {
+	arw, err := tx.MakeAccountsReaderWriter()
+	if err != nil {
+		return err
+	}
 
 	// determine the round to remove data
 	// the formula is similar to the committedUpTo: rnd + 1 - retain size
@@ -150,10 +150,11 @@ func (t *txTailTestLedger) initialize(ts *testing.T, protoVersion protocol.Conse
 	// create a corresponding blockdb.
 	inMemory := true
 	t.blockDBs, _ = storetesting.DbOpenTest(ts, inMemory)
-	t.trackerDBs, _ = storetesting.DbOpenTest(ts, inMemory)
+	dbs, _ := storetesting.DbOpenTest(ts, inMemory)
+	t.trackerDBs = store.CreateTrackerSQLStore(dbs)
 	t.protoVersion = protoVersion
 
-	tx, err := t.trackerDBs.Wdb.Handle.Begin()
+	tx, err := store.DbPairTest(t.trackerDBs).Wdb.Handle.Begin()
 	require.NoError(ts, err)
 
 	arw := store.NewAccountsSQLReaderWriter(tx)
@@ -296,10 +297,10 @@ func TestTxTailDeltaTracking(t *testing.T) {
 				err = txtail.prepareCommit(dcc)
 				require.NoError(t, err)
 
-				tx, err := ledger.trackerDBs.Wdb.Handle.Begin()
+				tx, err := store.DbPairTest(ledger.trackerDBs).Wdb.Handle.Begin()
 				require.NoError(t, err)
 
-				err = txtail.commitRound(context.Background(), tx, dcc)
+				err = txtail.commitRound(context.Background(), store.MakeTransactionScopeTest(tx), dcc)
 				require.NoError(t, err)
 				tx.Commit()
 				proto := config.Consensus[protoVersion]
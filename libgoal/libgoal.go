@@ -1134,6 +1134,38 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// CatchupFromLocalSource start catching up to the give catchpoint label, loading the catchpoint file, the incremental
+// catchpoint files applied on top of it and the blocks from the given paths on the node's filesystem.
+func (c *Client) CatchupFromLocalSource(catchpointLabel string, catchpointFile string, deltaFiles []string, blocksDir string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	_, err = algod.CatchupFromLocalSource(catchpointLabel, catchpointFile, deltaFiles, blocksDir)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// StartCatchpointGeneration requests the generation of a catchpoint label and file for the next accounts commit
+func (c *Client) StartCatchpointGeneration() (resp model.CatchpointGenerationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.StartCatchpointGeneration()
+	}
+	return
+}
+
+// GetCatchpointGeneration returns the status of the last requested catchpoint generation
+func (c *Client) GetCatchpointGeneration() (resp model.CatchpointGenerationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.GetCatchpointGeneration()
+	}
+	return
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
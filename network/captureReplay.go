@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var errReplayPeerRequest = errors.New("captured peers do not answer requests during a replay")
+
+// ReplayOptions configures the replay of a network message capture.
+type ReplayOptions struct {
+	// Speed scales the delays between the captured messages: 1 replays them in real time,
+	// and 2 twice as fast. With 0, the messages are replayed without delays.
+	Speed float64
+
+	// Tags, when not empty, restricts the replay to the messages of these tags.
+	Tags map[Tag]bool
+}
+
+// ReplayStats summarizes the replay of a network message capture.
+type ReplayStats struct {
+	// Dispatched counts the messages passed to a handler, by tag.
+	Dispatched map[Tag]int
+
+	// Unhandled counts the messages of the tags without a handler.
+	Unhandled map[Tag]int
+
+	// Skipped counts the outgoing messages and the messages filtered out by tag.
+	Skipped int
+
+	// Broadcasts counts the messages the handlers asked to relay, by tag.
+	Broadcasts map[Tag]int
+
+	// Disconnects counts the messages for which the handlers asked to disconnect the sender, by peer.
+	Disconnects map[string]int
+}
+
+// replayPeer stands for a captured peer during a replay. It discards what is sent to it.
+type replayPeer struct {
+	address string
+}
+
+func (p *replayPeer) GetAddress() string {
+	return p.address
+}
+
+func (p *replayPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	return nil
+}
+
+func (p *replayPeer) Version() string {
+	return ProtocolVersion
+}
+
+func (p *replayPeer) Request(ctx context.Context, tag Tag, topics Topics) (*Response, error) {
+	return nil, errReplayPeerRequest
+}
+
+func (p *replayPeer) Respond(ctx context.Context, reqMsg IncomingMessage, topics Topics) error {
+	return nil
+}
+
+// OnClose is never called back, since captured peers stay connected for the whole replay.
+func (p *replayPeer) OnClose(f func()) {}
+
+// ReplayCapture dispatches the incoming messages of the network message capture files to the
+// given handlers, in the captured order. See replayCapture.
+func ReplayCapture(ctx context.Context, log logging.Logger, filenames []string, dispatch []TaggedMessageHandler, opts ReplayOptions) (ReplayStats, error) {
+	handlers := MakeMultiplexer(log)
+	handlers.RegisterHandlers(dispatch)
+	return replayCapture(ctx, filenames, handlers, nil, opts)
+}
+
+// ReplayCapture dispatches the incoming messages of the network message capture files to the
+// handlers registered on this network, in the captured order. The network should not be started,
+// for instance by setting DisableNetworking, so that only the captured messages reach the handlers.
+func (wn *WebsocketNetwork) ReplayCapture(ctx context.Context, filenames []string, opts ReplayOptions) (ReplayStats, error) {
+	return replayCapture(ctx, filenames, &wn.handlers, wn, opts)
+}
+
+// replayCapture dispatches the captured incoming messages one at a time, each captured peer being
+// represented by the same Sender throughout the replay. The actions the handlers return are counted
+// rather than performed.
+func replayCapture(ctx context.Context, filenames []string, handlers *Multiplexer, net GossipNode, opts ReplayOptions) (ReplayStats, error) {
+	stats := ReplayStats{
+		Dispatched:  make(map[Tag]int),
+		Unhandled:   make(map[Tag]int),
+		Broadcasts:  make(map[Tag]int),
+		Disconnects: make(map[string]int),
+	}
+	peers := make(map[string]*replayPeer)
+	var lastTime int64
+
+	err := messagetracer.ReadCapture(filenames, func(rec messagetracer.CaptureRecord) error {
+		if rec.Outgoing || (len(opts.Tags) > 0 && !opts.Tags[rec.Tag]) {
+			stats.Skipped++
+			return nil
+		}
+		if opts.Speed > 0 && lastTime != 0 && rec.Time > lastTime {
+			select {
+			case <-time.After(time.Duration(float64(rec.Time-lastTime) / opts.Speed)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		} else if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		lastTime = rec.Time
+
+		if _, ok := handlers.getHandler(rec.Tag); !ok {
+			stats.Unhandled[rec.Tag]++
+			return nil
+		}
+		peer, ok := peers[rec.Peer]
+		if !ok {
+			peer = &replayPeer{address: rec.Peer}
+			peers[rec.Peer] = peer
+		}
+		outmsg := handlers.Handle(IncomingMessage{
+			Sender:   peer,
+			Tag:      rec.Tag,
+			Data:     rec.Data,
+			Net:      net,
+			Received: rec.Time,
+		})
+		stats.Dispatched[rec.Tag]++
+		switch outmsg.Action {
+		case Broadcast:
+			stats.Broadcasts[rec.Tag]++
+		case Disconnect:
+			stats.Disconnects[rec.Peer]++
+		}
+		return nil
+	})
+	return stats, err
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type replayRecorder struct {
+	msgs []IncomingMessage
+}
+
+func (r *replayRecorder) Handle(msg IncomingMessage) OutgoingMessage {
+	r.msgs = append(r.msgs, msg)
+	if string(msg.Data) == "bar" {
+		return OutgoingMessage{Action: Disconnect}
+	}
+	return OutgoingMessage{Action: Broadcast}
+}
+
+func TestNetworkMessageCaptureReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	captureA := filepath.Join(dir, "a.capture")
+	captureB := filepath.Join(dir, "b.capture")
+
+	cfg := defaultConfig
+	cfg.GossipFanout = 1
+	cfg.NetworkMessageCaptureFile = captureA
+	netA := makeTestWebsocketNodeWithConfig(t, cfg)
+	netA.Start()
+	stoppedA := false
+	defer func() {
+		if !stoppedA {
+			netStop(t, netA, "A")
+		}
+	}()
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	cfg.NetworkMessageCaptureFile = captureB
+	netB := makeTestWebsocketNodeWithConfig(t, cfg)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	counter := newMessageCounter(t, 2)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+	netB.Start()
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), true, nil)
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("bar"), true, nil)
+	select {
+	case <-counter.done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout, count=%d, wanted 2", counter.Count())
+	}
+	netStop(t, netB, "B")
+
+	// B captured the transactions as incoming messages from A
+	var incoming []messagetracer.CaptureRecord
+	err := messagetracer.ReadCapture([]string{captureB}, func(rec messagetracer.CaptureRecord) error {
+		if rec.Tag == protocol.TxnTag {
+			incoming = append(incoming, rec)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, incoming, 2)
+	for i, data := range []string{"foo", "bar"} {
+		require.False(t, incoming[i].Outgoing)
+		require.Equal(t, data, string(incoming[i].Data))
+		require.NotZero(t, incoming[i].Time)
+		require.Equal(t, addrA, incoming[i].Peer)
+	}
+
+	// replaying B's capture passes the transactions to the handlers in order, from the same sender
+	recorder := &replayRecorder{}
+	stats, err := ReplayCapture(context.Background(), logging.TestingLog(t), []string{captureB},
+		[]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: recorder}}, ReplayOptions{})
+	require.NoError(t, err)
+	require.Len(t, recorder.msgs, 2)
+	require.Equal(t, "foo", string(recorder.msgs[0].Data))
+	require.Equal(t, "bar", string(recorder.msgs[1].Data))
+	require.Same(t, recorder.msgs[0].Sender, recorder.msgs[1].Sender)
+	require.Equal(t, addrA, recorder.msgs[0].Sender.(UnicastPeer).GetAddress())
+	require.Equal(t, incoming[0].Time, recorder.msgs[0].Received)
+	require.Equal(t, 2, stats.Dispatched[protocol.TxnTag])
+	require.Equal(t, 1, stats.Broadcasts[protocol.TxnTag])
+	require.Equal(t, map[string]int{addrA: 1}, stats.Disconnects)
+
+	// filtering by tag skips the transactions
+	recorder = &replayRecorder{}
+	stats, err = ReplayCapture(context.Background(), logging.TestingLog(t), []string{captureB},
+		[]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: recorder}}, ReplayOptions{Tags: map[Tag]bool{protocol.AgreementVoteTag: true}})
+	require.NoError(t, err)
+	require.Empty(t, recorder.msgs)
+	require.GreaterOrEqual(t, stats.Skipped, 2)
+
+	// replaying into a network dispatches to its registered handlers
+	netC := makeTestWebsocketNode(t)
+	recorder = &replayRecorder{}
+	netC.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: recorder}})
+	stats, err = netC.ReplayCapture(context.Background(), []string{captureB}, ReplayOptions{Speed: 1000})
+	require.NoError(t, err)
+	require.Len(t, recorder.msgs, 2)
+	require.Equal(t, netC, recorder.msgs[0].Net)
+	require.Equal(t, 2, stats.Dispatched[protocol.TxnTag])
+
+	// A captured them as outgoing messages
+	netStop(t, netA, "A")
+	stoppedA = true
+	var outgoing []string
+	err = messagetracer.ReadCapture([]string{captureA}, func(rec messagetracer.CaptureRecord) error {
+		if rec.Tag == protocol.TxnTag {
+			require.True(t, rec.Outgoing)
+			outgoing = append(outgoing, string(rec.Data))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo", "bar"}, outgoing)
+}
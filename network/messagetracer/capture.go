@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// CaptureRecord is a tagged network message recorded by a MessageCapture.
+type CaptureRecord struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Time is when the message was received or sent, in nanoseconds since the epoch.
+	Time int64 `codec:"t"`
+
+	// Outgoing is set for the messages we sent, and unset for the messages we received.
+	Outgoing bool `codec:"o"`
+
+	// Peer is the address of the peer the message was received from or sent to.
+	Peer string `codec:"p"`
+
+	Tag protocol.Tag `codec:"g"`
+
+	// Data is the message payload. Incoming payloads are recorded decompressed, as they are
+	// passed to the message handlers, while outgoing payloads are recorded as they were sent.
+	Data []byte `codec:"d"`
+}
+
+// MessageCapture records network messages into a file, which is rotated when it
+// reaches a size limit. The rotated files are named by appending .1, .2, ... to the
+// capture filename, .1 being the most recent.
+//
+// Each record is written with a single write, so that a capture left by a node that
+// crashed holds all the messages up to the crash.
+//
+// MessageCapture is safe for concurrent use, and its methods do nothing on a nil capture.
+type MessageCapture struct {
+	mu        deadlock.Mutex
+	filename  string
+	sizeLimit uint64
+	archives  int
+	f         *os.File
+	size      uint64
+	closed    bool
+}
+
+// MakeMessageCapture opens a message capture as configured by NetworkMessageCaptureFile,
+// or returns nil if it is not set. A relative filename is taken relative to the data directory.
+// The capture of a previous run is rotated rather than overwritten.
+func MakeMessageCapture(cfg config.Local) (*MessageCapture, error) {
+	if cfg.NetworkMessageCaptureFile == "" {
+		return nil, nil
+	}
+	filename := cfg.NetworkMessageCaptureFile
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(config.GetCurrentVersion().DataDirectory, filename)
+	}
+	c := &MessageCapture{
+		filename:  filename,
+		sizeLimit: cfg.NetworkMessageCaptureSizeLimit,
+		archives:  cfg.NetworkMessageCaptureArchives,
+	}
+	if fi, err := os.Stat(filename); err == nil && fi.Size() > 0 {
+		c.rotateFilesLocked()
+	}
+	err := c.openLocked()
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *MessageCapture) openLocked() error {
+	f, err := os.OpenFile(c.filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to create network message capture file %s: %w", c.filename, err)
+	}
+	c.f = f
+	c.size = 0
+	return nil
+}
+
+// rotateFilesLocked shifts the archived captures by one, and archives the current capture as the most recent one.
+func (c *MessageCapture) rotateFilesLocked() {
+	if c.archives <= 0 {
+		os.Remove(c.filename)
+		return
+	}
+	for i := c.archives; i > 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", c.filename, i-1), fmt.Sprintf("%s.%d", c.filename, i))
+	}
+	os.Rename(c.filename, c.filename+".1")
+}
+
+// Capture records a message. Messages captured after Close are dropped.
+func (c *MessageCapture) Capture(rec CaptureRecord) {
+	if c == nil {
+		return
+	}
+	buf := protocol.EncodeReflect(&rec)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	if c.size > 0 && c.sizeLimit > 0 && c.size+uint64(len(buf)) > c.sizeLimit {
+		c.f.Close()
+		c.rotateFilesLocked()
+		if c.openLocked() != nil {
+			c.closed = true
+			return
+		}
+	}
+	n, _ := c.f.Write(buf)
+	c.size += uint64(n)
+}
+
+// Close closes the capture file.
+func (c *MessageCapture) Close() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.f.Close()
+}
+
+// CaptureFilenames returns the existing files of the capture with the given filename,
+// the oldest archived one first and the capture file itself last.
+func CaptureFilenames(filename string) []string {
+	var archives []string
+	for i := 1; ; i++ {
+		archive := fmt.Sprintf("%s.%d", filename, i)
+		if _, err := os.Stat(archive); err != nil {
+			break
+		}
+		archives = append(archives, archive)
+	}
+	filenames := make([]string, 0, len(archives)+1)
+	for i := len(archives) - 1; i >= 0; i-- {
+		filenames = append(filenames, archives[i])
+	}
+	if _, err := os.Stat(filename); err == nil {
+		filenames = append(filenames, filename)
+	}
+	return filenames
+}
+
+// ReadCapture calls fn with each record of the given capture files, in order, until fn returns an error.
+// A record truncated at the end of a file, as left by a node that stopped abruptly, is ignored.
+func ReadCapture(filenames []string, fn func(CaptureRecord) error) error {
+	for _, filename := range filenames {
+		err := readCaptureFile(filename, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readCaptureFile(filename string, fn func(CaptureRecord) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := protocol.NewDecoder(f)
+	for {
+		var rec CaptureRecord
+		err = dec.Decode(&rec)
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read network message capture %s: %w", filename, err)
+		}
+		err = fn(rec)
+		if err != nil {
+			return err
+		}
+	}
+}
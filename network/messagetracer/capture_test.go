@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func readAll(t *testing.T, filenames []string) []CaptureRecord {
+	var records []CaptureRecord
+	err := ReadCapture(filenames, func(rec CaptureRecord) error {
+		records = append(records, rec)
+		return nil
+	})
+	require.NoError(t, err)
+	return records
+}
+
+func TestMessageCaptureRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	require.Empty(t, cfg.NetworkMessageCaptureFile)
+	c, err := MakeMessageCapture(cfg)
+	require.NoError(t, err)
+	require.Nil(t, c)
+	// a nil capture drops the records
+	c.Capture(CaptureRecord{Tag: protocol.TxnTag})
+	c.Close()
+
+	filename := filepath.Join(t.TempDir(), "net.capture")
+	cfg.NetworkMessageCaptureFile = filename
+	cfg.NetworkMessageCaptureSizeLimit = 200
+	cfg.NetworkMessageCaptureArchives = 2
+	c, err = MakeMessageCapture(cfg)
+	require.NoError(t, err)
+
+	makeRecord := func(i int) CaptureRecord {
+		return CaptureRecord{Time: int64(i + 1), Outgoing: i%2 == 1, Peer: "peer", Tag: protocol.TxnTag, Data: []byte(fmt.Sprintf("message %02d %040d", i, 0))}
+	}
+	for i := 0; i < 20; i++ {
+		c.Capture(makeRecord(i))
+	}
+	c.Close()
+	c.Capture(makeRecord(20))
+
+	filenames := CaptureFilenames(filename)
+	require.Equal(t, []string{filename + ".2", filename + ".1", filename}, filenames)
+	for _, f := range filenames {
+		fi, err := os.Stat(f)
+		require.NoError(t, err)
+		require.LessOrEqual(t, fi.Size(), int64(200))
+	}
+
+	// the oldest records were rotated out, the remaining ones are in order
+	records := readAll(t, filenames)
+	require.NotEmpty(t, records)
+	require.Less(t, len(records), 20)
+	first := int(records[0].Time) - 1
+	for i, rec := range records {
+		require.Equal(t, makeRecord(first+i), rec)
+	}
+	require.Equal(t, int64(20), records[len(records)-1].Time)
+
+	// a record truncated by a crash is ignored
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename, data[:len(data)-5], 0600))
+	truncated := readAll(t, filenames)
+	require.Equal(t, records[:len(records)-1], truncated)
+
+	// restarting rotates the previous capture
+	c, err = MakeMessageCapture(cfg)
+	require.NoError(t, err)
+	c.Close()
+	fi, err := os.Stat(filename)
+	require.NoError(t, err)
+	require.Zero(t, fi.Size())
+	restored := readAll(t, CaptureFilenames(filename))
+	require.NotEmpty(t, restored)
+	require.Equal(t, truncated[len(truncated)-len(restored):], restored)
+}
@@ -18,8 +18,10 @@ package network
 
 import (
 	"bytes"
+	_ "embed" // for the zstd dictionaries
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/DataDog/zstd"
 
@@ -31,26 +33,149 @@ var zstdCompressionMagic = [4]byte{0x28, 0xb5, 0x2f, 0xfd}
 
 const zstdCompressionLevel = zstd.BestSpeed
 
-// checkCanCompress checks if there is an proposal payload message and peers supporting compression
+// The zstd dictionaries of the agreement vote and transaction messages, trained with
+// tools/debug/zstddict. Peers need the same dictionary to decompress a message, so
+// changing a dictionary requires a new peer feature name.
+var (
+	//go:embed dictionaries/av.zdict
+	zstdVoteDictionary []byte
+	//go:embed dictionaries/tx.zdict
+	zstdTxnDictionary []byte
+)
+
+// tagCompression describes the zstd compression of the messages of one tag, which peers
+// negotiate by announcing its feature in the PeerFeaturesHeader.
+type tagCompression struct {
+	tag     protocol.Tag
+	feature string
+	flag    peerFeatureFlag
+
+	// dict holds the digested dictionary, or nil for plain zstd compression
+	dict *zstd.BulkProcessor
+}
+
+// tagCompressions lists the compressible tags, in the order their features are announced.
+var tagCompressions = []*tagCompression{
+	{tag: protocol.ProposalPayloadTag, feature: PeerFeatureProposalCompression, flag: pfCompressedProposal},
+	{tag: protocol.AgreementVoteTag, feature: PeerFeatureVoteCompression, flag: pfCompressedVote, dict: mustMakeBulkProcessor(zstdVoteDictionary)},
+	{tag: protocol.TxnTag, feature: PeerFeatureTxnCompression, flag: pfCompressedTxn, dict: mustMakeBulkProcessor(zstdTxnDictionary)},
+}
+
+func mustMakeBulkProcessor(dict []byte) *zstd.BulkProcessor {
+	p, err := zstd.NewBulkProcessor(dict, zstdCompressionLevel)
+	if err != nil {
+		panic(fmt.Sprintf("unable to load zstd dictionary: %v", err))
+	}
+	return p
+}
+
+// getTagCompression returns the compression of the given tag, or nil if it is not compressible.
+func getTagCompression(tag protocol.Tag) *tagCompression {
+	for _, tc := range tagCompressions {
+		if tc.tag == tag {
+			return tc
+		}
+	}
+	return nil
+}
+
+// compressionFeature returns the peer feature flag enabling the compression of the given tag, if any.
+func compressionFeature(tag protocol.Tag) peerFeatureFlag {
+	if tc := getTagCompression(tag); tc != nil {
+		return tc.flag
+	}
+	return 0
+}
+
+// makeCompressionFeatures parses a comma-separated list of tags into the features
+// enabling their compression. It returns the unknown tags separately.
+func makeCompressionFeatures(tags string) (features peerFeatureFlag, unknown []string) {
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		flag := compressionFeature(protocol.Tag(tag))
+		if flag == 0 {
+			unknown = append(unknown, tag)
+			continue
+		}
+		features |= flag
+	}
+	return
+}
+
+// compressionFeaturesHeader returns the PeerFeaturesHeader value announcing the given features.
+func compressionFeaturesHeader(features peerFeatureFlag) string {
+	var announced []string
+	for _, tc := range tagCompressions {
+		if features&tc.flag != 0 {
+			announced = append(announced, tc.feature)
+		}
+	}
+	return strings.Join(announced, ",")
+}
+
+// compress returns a concatenation of the tag bytes and the compressed data.
+// Dictionary-compressed messages fall back to the original data when compression does not help.
+func (tc *tagCompression) compress(tbytes []byte, d []byte) ([]byte, string) {
+	if tc.dict == nil {
+		return zstdCompressMsg(tbytes, d)
+	}
+
+	mbytesComp := make([]byte, len(tbytes), len(tbytes)+zstd.CompressBound(len(d)))
+	copy(mbytesComp, tbytes)
+	// the buffer has room for the compression bound, so the compressed data is written in place
+	comp, err := tc.dict.Compress(mbytesComp[len(tbytes):], d)
+	if err != nil || len(comp) >= len(d) {
+		mbytes := make([]byte, len(tbytes)+len(d))
+		copy(mbytes, tbytes)
+		copy(mbytes[len(tbytes):], d)
+		if err != nil {
+			return mbytes, fmt.Sprintf("failed to compress %s message of len %d: %v", tc.tag, len(d), err)
+		}
+		return mbytes, ""
+	}
+	return mbytesComp[:len(tbytes)+len(comp)], ""
+}
+
+// checkCanCompress checks if there is a message of a compressible tag and a peer supporting its compression
 func checkCanCompress(request broadcastRequest, peers []*wsPeer) bool {
-	canCompress := false
-	hasPP := false
+	var tagFeatures peerFeatureFlag
 	for _, tag := range request.tags {
-		if tag == protocol.ProposalPayloadTag {
-			hasPP = true
-			break
+		tagFeatures |= compressionFeature(tag)
+	}
+	if tagFeatures == 0 {
+		return false
+	}
+	for _, peer := range peers {
+		if peer.features&tagFeatures != 0 {
+			return true
 		}
 	}
-	// if have proposal payload check if there are any peers supporting compression
-	if hasPP {
-		for _, peer := range peers {
-			if peer.pfProposalCompressionSupported() {
-				canCompress = true
-				break
-			}
+	return false
+}
+
+// peerBatch returns the messages of a broadcast to send to a peer with the given features:
+// the compressed version of the messages whose compression the peer supports, and the
+// original version of the others. Batches are cached by feature set.
+func peerBatch(tags []protocol.Tag, data [][]byte, dataCompressed [][]byte, features peerFeatureFlag, batches map[peerFeatureFlag][][]byte) [][]byte {
+	if batch, ok := batches[features]; ok {
+		return batch
+	}
+	batch := data
+	for i := range data {
+		if features&compressionFeature(tags[i]) == 0 {
+			continue
 		}
+		if &batch[0] == &data[0] {
+			batch = make([][]byte, len(data))
+			copy(batch, data)
+		}
+		batch[i] = dataCompressed[i]
 	}
-	return canCompress
+	batches[features] = batch
+	return batch
 }
 
 // zstdCompressMsg returns a concatenation of a tag and compressed data
@@ -80,13 +205,14 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataConverter performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal
+// At the moment it only supports zstd decompression
 type wsPeerMsgDataConverter struct {
 	log    logging.Logger
 	origin string
 
 	// actual converter(s)
-	ppdec zstdProposalDecompressor
+	ppdec    zstdProposalDecompressor
+	dictdecs map[protocol.Tag]zstdDictDecompressor
 }
 
 type zstdProposalDecompressor struct {
@@ -124,7 +250,82 @@ func (dec zstdProposalDecompressor) convert(data []byte) ([]byte, error) {
 	}
 }
 
+// zstdDictDecompressor decompresses the messages compressed with a dictionary.
+type zstdDictDecompressor struct {
+	dict *zstd.BulkProcessor
+}
+
+func (dec zstdDictDecompressor) accept(data []byte) bool {
+	return len(data) > 4 && bytes.Equal(data[:4], zstdCompressionMagic[:])
+}
+
+func (dec zstdDictDecompressor) convert(data []byte) ([]byte, error) {
+	// messages are compressed in a single call, so their frame declares the decompressed size
+	size, ok := zstdFrameContentSize(data)
+	if !ok {
+		return nil, fmt.Errorf("compressed data does not declare its size")
+	}
+	if size > maxMessageLength {
+		return nil, fmt.Errorf("compressed data is too large: %d", size)
+	}
+	return dec.dict.Decompress(make([]byte, 0, size), data)
+}
+
+// zstdFrameContentSize returns the decompressed size declared by the header of a zstd frame.
+func zstdFrameContentSize(data []byte) (uint64, bool) {
+	if len(data) < 5 || !bytes.Equal(data[:4], zstdCompressionMagic[:]) {
+		return 0, false
+	}
+	descriptor := data[4]
+	singleSegment := descriptor&0x20 != 0
+	pos := 5
+	if !singleSegment {
+		// window descriptor
+		pos++
+	}
+	pos += []int{0, 1, 2, 4}[descriptor&0x3] // dictionary id
+
+	var fieldSize int
+	switch descriptor >> 6 {
+	case 0:
+		if !singleSegment {
+			// size not declared
+			return 0, false
+		}
+		fieldSize = 1
+	case 1:
+		fieldSize = 2
+	case 2:
+		fieldSize = 4
+	case 3:
+		fieldSize = 8
+	}
+	if len(data) < pos+fieldSize {
+		return 0, false
+	}
+
+	var size uint64
+	for i := fieldSize - 1; i >= 0; i-- {
+		size = size<<8 | uint64(data[pos+i])
+	}
+	if fieldSize == 2 {
+		size += 256
+	}
+	return size, true
+}
+
 func (c *wsPeerMsgDataConverter) convert(tag protocol.Tag, data []byte) ([]byte, error) {
+	if dec, ok := c.dictdecs[tag]; ok {
+		// dictionary compression is skipped for messages it does not shrink
+		if dec.accept(data) {
+			res, err := dec.convert(data)
+			if err != nil {
+				return nil, fmt.Errorf("peer %s: %s: %w", c.origin, tag, err)
+			}
+			return res, nil
+		}
+		return data, nil
+	}
 	if tag == protocol.ProposalPayloadTag {
 		if c.ppdec.enabled() {
 			// sender might support compressed payload but fail to compress for whatever reason,
@@ -153,6 +354,14 @@ func makeWsPeerMsgDataConverter(wp *wsPeer) *wsPeerMsgDataConverter {
 			active: true,
 		}
 	}
+	for _, tc := range tagCompressions {
+		if tc.dict != nil && wp.features&tc.flag != 0 {
+			if c.dictdecs == nil {
+				c.dictdecs = make(map[protocol.Tag]zstdDictDecompressor)
+			}
+			c.dictdecs[tc.tag] = zstdDictDecompressor{dict: tc.dict}
+		}
+	}
 
 	return &c
 }
@@ -17,10 +17,14 @@
 package network
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"strings"
 	"testing"
 
 	"github.com/DataDog/zstd"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -77,6 +81,150 @@ func TestCheckCanCompress(t *testing.T) {
 	peers = []*wsPeer{&peer1, &peer2}
 	r = checkCanCompress(req, peers)
 	require.True(t, r)
+
+	// votes are compressed only for peers supporting vote compression
+	req.tags = []protocol.Tag{protocol.AgreementVoteTag}
+	r = checkCanCompress(req, peers)
+	require.False(t, r)
+
+	peer3 := wsPeer{
+		features: pfCompressedVote,
+	}
+	peers = []*wsPeer{&peer1, &peer2, &peer3}
+	r = checkCanCompress(req, peers)
+	require.True(t, r)
+}
+
+func TestMakeCompressionFeatures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	features, unknown := makeCompressionFeatures("")
+	require.Equal(t, peerFeatureFlag(0), features)
+	require.Empty(t, unknown)
+	require.Equal(t, "", compressionFeaturesHeader(features))
+
+	features, unknown = makeCompressionFeatures("PP")
+	require.Equal(t, pfCompressedProposal, features)
+	require.Empty(t, unknown)
+	require.Equal(t, PeerFeatureProposalCompression, compressionFeaturesHeader(features))
+
+	features, unknown = makeCompressionFeatures("TX, AV,PP,NI")
+	require.Equal(t, pfCompressedProposal|pfCompressedVote|pfCompressedTxn, features)
+	require.Equal(t, []string{"NI"}, unknown)
+	header := compressionFeaturesHeader(features)
+	require.Equal(t, "ppzstd,avzstd1,txzstd1", header)
+	require.Equal(t, features, decodePeerFeatures(ProtocolVersion, header))
+}
+
+// testTxnMessage returns the encoding of a mainnet payment with random keys, as sent with protocol.TxnTag
+func testTxnMessage(t *testing.T) []byte {
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = protocol.PaymentTx
+	rand.Read(stxn.Txn.Sender[:])
+	rand.Read(stxn.Txn.Receiver[:])
+	rand.Read(stxn.Sig[:])
+	stxn.Txn.Fee = basics.MicroAlgos{Raw: 1000}
+	stxn.Txn.Amount = basics.MicroAlgos{Raw: 123456789}
+	stxn.Txn.FirstValid = 28000000
+	stxn.Txn.LastValid = 28001000
+	stxn.Txn.GenesisID = "mainnet-v1.0"
+	genesisHash, err := base64.StdEncoding.DecodeString("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	require.NoError(t, err)
+	copy(stxn.Txn.GenesisHash[:], genesisHash)
+	return protocol.Encode(&stxn)
+}
+
+func TestZstdDictCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tc := getTagCompression(protocol.TxnTag)
+	require.NotNil(t, tc)
+	require.NotNil(t, tc.dict)
+	dec := zstdDictDecompressor{dict: tc.dict}
+	tbytes := []byte(protocol.TxnTag)
+
+	msg := testTxnMessage(t)
+	comp, logMsg := tc.compress(tbytes, msg)
+	require.Empty(t, logMsg)
+	require.Equal(t, tbytes, comp[:len(tbytes)])
+	require.True(t, dec.accept(comp[len(tbytes):]))
+
+	// the dictionary helps on small messages where plain zstd does not
+	plain, err := zstd.CompressLevel(nil, msg, zstdCompressionLevel)
+	require.NoError(t, err)
+	require.Less(t, len(comp)-len(tbytes), len(plain))
+	require.Less(t, len(comp)-len(tbytes), len(msg))
+
+	decompressed, err := dec.convert(comp[len(tbytes):])
+	require.NoError(t, err)
+	require.Equal(t, msg, decompressed)
+
+	// incompressible messages are sent as they are
+	msg = make([]byte, 64)
+	rand.Read(msg)
+	comp, logMsg = tc.compress(tbytes, msg)
+	require.Empty(t, logMsg)
+	require.Equal(t, append(tbytes, msg...), comp)
+
+	// the votes have their own dictionary
+	av := getTagCompression(protocol.AgreementVoteTag)
+	require.NotNil(t, av)
+	require.NotNil(t, av.dict)
+	require.NotEqual(t, zstdVoteDictionary, zstdTxnDictionary)
+}
+
+func TestZstdFrameContentSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, n := range []int{1, 255, 256, 300, 65535 + 256, 70000, 1 << 20} {
+		msg := []byte(strings.Repeat("a", n))
+		comp, err := zstd.Compress(nil, msg)
+		require.NoError(t, err)
+		size, ok := zstdFrameContentSize(comp)
+		require.True(t, ok, n)
+		require.Equal(t, uint64(n), size, n)
+	}
+
+	_, ok := zstdFrameContentSize([]byte("data"))
+	require.False(t, ok)
+	_, ok = zstdFrameContentSize(zstdCompressionMagic[:])
+	require.False(t, ok)
+
+	// streamed frames do not declare their size
+	streamed := append(zstdCompressionMagic[:], 0x00, 0x48)
+	_, ok = zstdFrameContentSize(streamed)
+	require.False(t, ok)
+	dec := zstdDictDecompressor{dict: getTagCompression(protocol.TxnTag).dict}
+	_, err := dec.convert(streamed)
+	require.Error(t, err)
+
+	// oversized messages are rejected before decompression
+	oversized := append(zstdCompressionMagic[:], 0xa0, 0xff, 0xff, 0xff, 0x7f)
+	size, ok := zstdFrameContentSize(oversized)
+	require.True(t, ok)
+	require.Equal(t, uint64(0x7fffffff), size)
+	_, err = dec.convert(oversized)
+	require.ErrorContains(t, err, "too large")
+}
+
+func TestPeerBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tags := []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.TxnTag}
+	data := [][]byte{[]byte("AVvote"), []byte("PPprop"), []byte("TXtxn")}
+	comp := [][]byte{[]byte("AVcvote"), []byte("PPcprop"), []byte("TXctxn")}
+	batches := make(map[peerFeatureFlag][][]byte)
+
+	require.Equal(t, data, peerBatch(tags, data, comp, 0, batches))
+	require.Equal(t, comp, peerBatch(tags, data, comp, pfCompressedProposal|pfCompressedVote|pfCompressedTxn, batches))
+	require.Equal(t, [][]byte{data[0], comp[1], data[2]}, peerBatch(tags, data, comp, pfCompressedProposal, batches))
+	require.Equal(t, [][]byte{comp[0], data[1], comp[2]}, peerBatch(tags, data, comp, pfCompressedVote|pfCompressedTxn, batches))
+	require.Len(t, batches, 4)
+
+	// the batches are reused
+	batch := peerBatch(tags, data, comp, pfCompressedProposal, batches)
+	require.Equal(t, &batches[pfCompressedProposal][0], &batch[0])
+	require.Len(t, batches, 4)
 }
 
 func TestZstdCompressMsg(t *testing.T) {
@@ -139,4 +287,22 @@ func TestWsPeerMsgDataConverterConvert(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, data, r)
 	require.Equal(t, 0, l.warnMsgCount)
+
+	// dictionary compressed messages, passed through when they are not compressed
+	wp := &wsPeer{features: pfCompressedTxn, wsPeerCore: wsPeerCore{net: &WebsocketNetwork{log: &l}}}
+	c = *makeWsPeerMsgDataConverter(wp)
+	msg := testTxnMessage(t)
+	comp, _ = getTagCompression(protocol.TxnTag).compress(nil, msg)
+	r, err = c.convert(protocol.TxnTag, comp)
+	require.NoError(t, err)
+	require.Equal(t, msg, r)
+	r, err = c.convert(protocol.TxnTag, msg)
+	require.NoError(t, err)
+	require.Equal(t, msg, r)
+	require.Equal(t, 0, l.warnMsgCount)
+
+	// peers without vote compression do not get their votes decompressed
+	r, err = c.convert(protocol.AgreementVoteTag, comp)
+	require.NoError(t, err)
+	require.Equal(t, comp, r)
 }
@@ -39,7 +39,10 @@ func prioResponseHandler(message IncomingMessage) OutgoingMessage {
 		return OutgoingMessage{}
 	}
 
-	peer := message.Sender.(*wsPeer)
+	peer, ok := message.Sender.(*wsPeer)
+	if !ok {
+		return OutgoingMessage{}
+	}
 	challenge := peer.prioChallenge
 	if challenge == "" {
 		return OutgoingMessage{}
@@ -0,0 +1,335 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkPeerFaultsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_faults_total", Description: "Number of peer misbehaviors reported by the message handlers"})
+var networkPeerBansTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_bans_total", Description: "Number of peer addresses banned"})
+
+// PeerFault is a misbehavior of a peer, reported by the services processing its messages.
+type PeerFault int
+
+const (
+	// PeerFaultInvalidTxn is reported for transaction groups failing verification
+	PeerFaultInvalidTxn PeerFault = iota
+	// PeerFaultInvalidVote is reported for malformed or invalid agreement votes
+	PeerFaultInvalidVote
+	// PeerFaultInvalidProposal is reported for malformed or invalid proposal payloads
+	PeerFaultInvalidProposal
+	// PeerFaultInvalidBundle is reported for malformed or invalid vote bundles
+	PeerFaultInvalidBundle
+	// PeerFaultInvalidBlock is reported for blocks or certificates served during catchup that fail validation
+	PeerFaultInvalidBlock
+)
+
+// peerFaultPenalties are the score penalties of the peer faults. An address is banned
+// once its decayed score reaches PeerBanThreshold.
+var peerFaultPenalties = map[PeerFault]float64{
+	PeerFaultInvalidTxn:      10,
+	PeerFaultInvalidVote:     20,
+	PeerFaultInvalidProposal: 50,
+	PeerFaultInvalidBundle:   50,
+	PeerFaultInvalidBlock:    50,
+}
+
+func (f PeerFault) String() string {
+	switch f {
+	case PeerFaultInvalidTxn:
+		return "invalid transaction"
+	case PeerFaultInvalidVote:
+		return "invalid vote"
+	case PeerFaultInvalidProposal:
+		return "invalid proposal"
+	case PeerFaultInvalidBundle:
+		return "invalid bundle"
+	case PeerFaultInvalidBlock:
+		return "invalid block"
+	default:
+		return fmt.Sprintf("fault %d", int(f))
+	}
+}
+
+const (
+	// peerScoreHalfLife is the time it takes for the score of a peer address to halve
+	peerScoreHalfLife = 10 * time.Minute
+
+	// maxPeerBanDuration caps the ban duration, which doubles for each repeated ban of an address
+	maxPeerBanDuration = 24 * time.Hour
+
+	// peerBanMemory is how long an expired ban is remembered, so that a repeated ban lasts longer
+	peerBanMemory = 24 * time.Hour
+
+	// peerBanManualReason is the reason of the bans requested through the admin API
+	peerBanManualReason = "manual"
+)
+
+// PeerBan is a ban of a peer address, which prevents connections from and to it until it expires.
+type PeerBan struct {
+	// Address is the IP address of an incoming peer, or the host of an outgoing peer
+	Address string `json:"address"`
+	// Reason is the fault that triggered the ban
+	Reason string `json:"reason"`
+	// Since is when the ban started
+	Since time.Time `json:"since"`
+	// Until is when the ban expires
+	Until time.Time `json:"until"`
+	// Count is the number of times the address was banned recently
+	Count int `json:"count"`
+}
+
+func (b PeerBan) active(now time.Time) bool {
+	return now.Before(b.Until)
+}
+
+type peerScore struct {
+	score   float64
+	updated time.Time
+}
+
+// peerScorer accumulates the faults reported against peer addresses into a score that decays
+// over time, and bans the addresses whose score reaches the threshold. The bans are saved to
+// a file, if one was loaded, so that they survive a restart.
+type peerScorer struct {
+	mu deadlock.Mutex
+
+	log         logging.Logger
+	threshold   float64
+	banDuration time.Duration
+
+	scores map[string]*peerScore
+	bans   map[string]PeerBan
+
+	filename string
+}
+
+func makePeerScorer(log logging.Logger, cfg config.Local) *peerScorer {
+	return &peerScorer{
+		log:         log,
+		threshold:   float64(cfg.PeerBanThreshold),
+		banDuration: time.Duration(cfg.PeerBanDurationSeconds) * time.Second,
+		scores:      make(map[string]*peerScore),
+		bans:        make(map[string]PeerBan),
+	}
+}
+
+// peerBanAddress returns the address a peer is scored and banned by: the IP address of
+// incoming peers, and the host we dialed for outgoing and HTTP peers.
+func peerBanAddress(peer Peer) string {
+	switch p := peer.(type) {
+	case *wsPeer:
+		if !p.outgoing && p.OriginAddress() != "" {
+			return p.OriginAddress()
+		}
+		return hostOfAddress(p.GetAddress())
+	case HTTPPeer:
+		return hostOfAddress(p.GetAddress())
+	}
+	return ""
+}
+
+// hostOfAddress returns the host of a 'host:port' address or of a URL.
+func hostOfAddress(addr string) string {
+	if strings.Contains(addr, "://") {
+		if u, err := url.Parse(addr); err == nil {
+			addr = u.Host
+		}
+	}
+	return justHost(addr)
+}
+
+// report adds the penalty of a fault to the score of an address, and returns the
+// ban of the address if this report got it banned.
+func (ps *peerScorer) report(addr string, fault PeerFault, now time.Time) *PeerBan {
+	networkPeerFaultsTotal.Inc(nil)
+	if ps.threshold == 0 || addr == "" {
+		return nil
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ban, ok := ps.bans[addr]; ok && ban.active(now) {
+		return nil
+	}
+	s := ps.scores[addr]
+	if s == nil {
+		s = &peerScore{}
+		ps.scores[addr] = s
+	}
+	s.score = s.score*math.Exp2(-float64(now.Sub(s.updated))/float64(peerScoreHalfLife)) + peerFaultPenalties[fault]
+	s.updated = now
+	if s.score < ps.threshold {
+		return nil
+	}
+
+	delete(ps.scores, addr)
+	ban := ps.banLocked(addr, fault.String(), 0, now)
+	return &ban
+}
+
+// banLocked bans an address. A zero duration uses the configured ban duration, doubled for each
+// recent ban of the address.
+func (ps *peerScorer) banLocked(addr string, reason string, duration time.Duration, now time.Time) PeerBan {
+	count := 1
+	if prev, ok := ps.bans[addr]; ok {
+		count = prev.Count + 1
+	}
+	if duration == 0 {
+		duration = ps.banDuration
+		for i := 1; i < count && duration < maxPeerBanDuration; i++ {
+			duration *= 2
+		}
+		if duration > maxPeerBanDuration {
+			duration = maxPeerBanDuration
+		}
+	}
+	ban := PeerBan{
+		Address: addr,
+		Reason:  reason,
+		Since:   now,
+		Until:   now.Add(duration),
+		Count:   count,
+	}
+	ps.bans[addr] = ban
+	networkPeerBansTotal.Inc(nil)
+	ps.saveLocked()
+	return ban
+}
+
+// ban bans an address for the given duration, or for the configured duration if zero.
+func (ps *peerScorer) ban(addr string, duration time.Duration, now time.Time) PeerBan {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	delete(ps.scores, addr)
+	return ps.banLocked(addr, peerBanManualReason, duration, now)
+}
+
+// unban lifts the ban of an address and clears its score. It returns false if the address was not banned.
+func (ps *peerScorer) unban(addr string, now time.Time) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	delete(ps.scores, addr)
+	ban, ok := ps.bans[addr]
+	if !ok {
+		return false
+	}
+	delete(ps.bans, addr)
+	ps.saveLocked()
+	return ban.active(now)
+}
+
+// isBanned checks if an address is banned.
+func (ps *peerScorer) isBanned(addr string, now time.Time) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ban, ok := ps.bans[addr]
+	return ok && ban.active(now)
+}
+
+// list returns the active bans, sorted by address. Bans expired for longer than
+// peerBanMemory are forgotten.
+func (ps *peerScorer) list(now time.Time) []PeerBan {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.pruneLocked(now)
+	bans := make([]PeerBan, 0, len(ps.bans))
+	for _, ban := range ps.bans {
+		if ban.active(now) {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+	return bans
+}
+
+func (ps *peerScorer) pruneLocked(now time.Time) {
+	for addr, ban := range ps.bans {
+		if now.Sub(ban.Until) > peerBanMemory {
+			delete(ps.bans, addr)
+		}
+	}
+	for addr, s := range ps.scores {
+		if now.Sub(s.updated) > 10*peerScoreHalfLife {
+			delete(ps.scores, addr)
+		}
+	}
+}
+
+// load reads the bans saved in the given file, which receives the bans from now on.
+// A missing file is not an error.
+func (ps *peerScorer) load(filename string, now time.Time) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.filename = filename
+
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var bans []PeerBan
+	err = json.Unmarshal(data, &bans)
+	if err != nil {
+		return fmt.Errorf("unable to parse peer ban list %s: %w", filename, err)
+	}
+	for _, ban := range bans {
+		ps.bans[ban.Address] = ban
+	}
+	ps.pruneLocked(now)
+	return nil
+}
+
+func (ps *peerScorer) saveLocked() {
+	if ps.filename == "" {
+		return
+	}
+	bans := make([]PeerBan, 0, len(ps.bans))
+	for _, ban := range ps.bans {
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+	data, err := json.MarshalIndent(bans, "", "\t")
+	if err != nil {
+		ps.log.Warnf("unable to encode peer ban list: %v", err)
+		return
+	}
+	tmp := ps.filename + ".tmp"
+	err = os.WriteFile(tmp, data, 0600)
+	if err == nil {
+		err = os.Rename(tmp, ps.filename)
+	}
+	if err != nil {
+		ps.log.Warnf("unable to save peer ban list %s: %v", ps.filename, err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestPeerScorer(t *testing.T) *peerScorer {
+	cfg := config.GetDefaultLocal()
+	cfg.PeerBanThreshold = 100
+	cfg.PeerBanDurationSeconds = 3600
+	return makePeerScorer(logging.TestingLog(t), cfg)
+}
+
+func TestPeerScorerBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ps := makeTestPeerScorer(t)
+	now := time.Unix(1000000, 0)
+
+	// faults add up until the threshold
+	for i := 0; i < 9; i++ {
+		require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidTxn, now))
+	}
+	require.False(t, ps.isBanned("10.0.0.1", now))
+	ban := ps.report("10.0.0.1", PeerFaultInvalidTxn, now)
+	require.NotNil(t, ban)
+	require.Equal(t, "10.0.0.1", ban.Address)
+	require.Equal(t, PeerFaultInvalidTxn.String(), ban.Reason)
+	require.Equal(t, now.Add(time.Hour), ban.Until)
+	require.Equal(t, 1, ban.Count)
+	require.True(t, ps.isBanned("10.0.0.1", now))
+	require.False(t, ps.isBanned("10.0.0.2", now))
+
+	// reports during a ban do not extend it
+	require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidBlock, now))
+	require.Equal(t, []PeerBan{*ban}, ps.list(now))
+
+	// the ban expires, and a repeated ban lasts twice as long
+	now = now.Add(time.Hour)
+	require.False(t, ps.isBanned("10.0.0.1", now))
+	require.Empty(t, ps.list(now))
+	require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidProposal, now))
+	ban = ps.report("10.0.0.1", PeerFaultInvalidProposal, now)
+	require.NotNil(t, ban)
+	require.Equal(t, now.Add(2*time.Hour), ban.Until)
+	require.Equal(t, 2, ban.Count)
+
+	// manual bans and unbans
+	manual := ps.ban("10.0.0.2", 5*time.Minute, now)
+	require.Equal(t, peerBanManualReason, manual.Reason)
+	require.Equal(t, now.Add(5*time.Minute), manual.Until)
+	require.Equal(t, []PeerBan{*ban, manual}, ps.list(now))
+	require.True(t, ps.unban("10.0.0.1", now))
+	require.False(t, ps.unban("10.0.0.1", now))
+	require.Equal(t, []PeerBan{manual}, ps.list(now))
+
+	// unbanned addresses start over
+	require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidProposal, now))
+	require.False(t, ps.isBanned("10.0.0.1", now))
+}
+
+func TestPeerScorerDecay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ps := makeTestPeerScorer(t)
+	now := time.Unix(1000000, 0)
+
+	require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidProposal, now))
+	// half of the score is gone after a half-life
+	now = now.Add(peerScoreHalfLife)
+	require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidProposal, now))
+	require.InDelta(t, 75, ps.scores["10.0.0.1"].score, 0.001)
+	require.NotNil(t, ps.report("10.0.0.1", PeerFaultInvalidBundle, now))
+
+	// the ban duration is capped
+	for i := 0; i < 10; i++ {
+		ps.ban("10.0.0.2", 0, now)
+	}
+	require.Equal(t, now.Add(maxPeerBanDuration), ps.list(now)[1].Until)
+
+	// banning is disabled by a zero threshold
+	cfg := config.GetDefaultLocal()
+	cfg.PeerBanThreshold = 0
+	ps = makePeerScorer(logging.TestingLog(t), cfg)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, ps.report("10.0.0.1", PeerFaultInvalidBlock, now))
+	}
+	require.Empty(t, ps.list(now))
+}
+
+func TestPeerScorerPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	filename := filepath.Join(t.TempDir(), config.PeerBansFilename)
+	now := time.Unix(1000000, 0)
+
+	ps := makeTestPeerScorer(t)
+	require.NoError(t, ps.load(filename, now))
+	require.Empty(t, ps.list(now))
+	ban1 := ps.ban("10.0.0.1", time.Hour, now)
+	ban2 := ps.ban("relay.example.com", time.Minute, now)
+
+	ps = makeTestPeerScorer(t)
+	require.NoError(t, ps.load(filename, now.Add(time.Second)))
+	bans := ps.list(now.Add(time.Second))
+	require.Len(t, bans, 2)
+	require.Equal(t, ban1.Address, bans[0].Address)
+	require.True(t, ban1.Until.Equal(bans[0].Until))
+	require.Equal(t, ban2.Address, bans[1].Address)
+
+	// unbans are saved too
+	require.True(t, ps.unban("10.0.0.1", now))
+	ps = makeTestPeerScorer(t)
+	require.NoError(t, ps.load(filename, now))
+	bans = ps.list(now)
+	require.Len(t, bans, 1)
+	require.Equal(t, ban2.Address, bans[0].Address)
+
+	// long expired bans are forgotten
+	ps = makeTestPeerScorer(t)
+	require.NoError(t, ps.load(filename, now.Add(peerBanMemory+time.Hour)))
+	require.Empty(t, ps.bans)
+}
+
+func TestPeerBanAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	incoming := &wsPeer{wsPeerCore: wsPeerCore{rootURL: "10.0.0.1:4160", originAddress: "10.0.0.1"}}
+	require.Equal(t, "10.0.0.1", peerBanAddress(incoming))
+	outgoing := &wsPeer{wsPeerCore: wsPeerCore{rootURL: "http://relay.example.com:4160"}, outgoing: true}
+	require.Equal(t, "relay.example.com", peerBanAddress(outgoing))
+	archiver := &wsPeerCore{rootURL: "relay.example.com:4160"}
+	require.Equal(t, "relay.example.com", peerBanAddress(archiver))
+	require.Equal(t, "", peerBanAddress(nil))
+}
+
+func TestWebsocketNetworkPeerBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, netB, _, closeFunc := setupWebsocketNetworkAB(t, 0)
+	defer closeFunc()
+
+	// B reports A enough to ban it, which disconnects them
+	peersB := netB.GetPeers(PeersConnectedOut)
+	require.Len(t, peersB, 1)
+	netB.ReportPeer(peersB[0], PeerFaultInvalidProposal)
+	require.Empty(t, netB.GetPeerBans())
+	// the score decays a little between the reports
+	netB.ReportPeer(peersB[0], PeerFaultInvalidProposal)
+	netB.ReportPeer(peersB[0], PeerFaultInvalidProposal)
+	bans := netB.GetPeerBans()
+	require.Len(t, bans, 1)
+	require.Equal(t, "127.0.0.1", bans[0].Address)
+	require.Eventually(t, func() bool { return netA.NumPeers() == 0 && netB.NumPeers() == 0 }, 10*time.Second, 50*time.Millisecond)
+
+	// B does not dial a banned address
+	addrA, _ := netA.Address()
+	_, ok := netB.tryConnectReserveAddr(addrA)
+	require.False(t, ok)
+
+	// A refuses connections from a banned address
+	netA.BanPeer("127.0.0.1", 0)
+	rec := httptest.NewRecorder()
+	require.Equal(t, http.StatusForbidden, netA.checkIncomingConnectionLimits(rec, nil, "127.0.0.1", "", ""))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.True(t, netA.UnbanPeer("127.0.0.1"))
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "127.0.0.1", "", ""))
+
+	require.True(t, netB.UnbanPeer("127.0.0.1"))
+	_, ok = netB.tryConnectReserveAddr(addrA)
+	require.True(t, ok)
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// peerTrafficRateWindow is the period over which the recent message and byte rates of a peer are measured.
+const peerTrafficRateWindow = 10 * time.Second
+
+// peerStatsUnknownTag counts the traffic of the tags that are not in protocol.TagList: the peers
+// choose the tags of their messages, and the tags are map keys and metric labels.
+const peerStatsUnknownTag Tag = "UNK"
+
+var peerStatsKnownTags = func() map[Tag]bool {
+	tags := make(map[Tag]bool, len(protocol.TagList))
+	for _, tag := range protocol.TagList {
+		tags[tag] = true
+	}
+	return tags
+}()
+
+// trafficCounter counts the messages and bytes of one tag in one direction, and measures their recent rate.
+type trafficCounter struct {
+	messages uint64
+	bytes    uint64
+
+	windowStart    time.Time
+	windowMessages uint64
+	windowBytes    uint64
+
+	// the rates of the last complete window, per second
+	messageRate float64
+	byteRate    float64
+}
+
+// roll completes the current window if it is over.
+func (c *trafficCounter) roll(now time.Time) {
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < peerTrafficRateWindow {
+		return
+	}
+	if elapsed < 2*peerTrafficRateWindow {
+		c.messageRate = float64(c.windowMessages) / elapsed.Seconds()
+		c.byteRate = float64(c.windowBytes) / elapsed.Seconds()
+	} else {
+		// the window before now was idle
+		c.messageRate = 0
+		c.byteRate = 0
+	}
+	c.windowStart = now
+	c.windowMessages = 0
+	c.windowBytes = 0
+}
+
+func (c *trafficCounter) add(bytes int, now time.Time) {
+	c.roll(now)
+	c.messages++
+	c.bytes += uint64(bytes)
+	c.windowMessages++
+	c.windowBytes += uint64(bytes)
+}
+
+// peerTagStats holds the traffic of one tag with a peer.
+type peerTagStats struct {
+	received   trafficCounter
+	sent       trafficCounter
+	handled    uint64
+	handleTime time.Duration
+}
+
+// peerStats holds the traffic of a peer, by tag. It is safe for concurrent use.
+type peerStats struct {
+	mu   deadlock.Mutex
+	tags map[protocol.Tag]*peerTagStats
+}
+
+func (s *peerStats) tagLocked(tag protocol.Tag, now time.Time) *peerTagStats {
+	if !peerStatsKnownTags[tag] {
+		tag = peerStatsUnknownTag
+	}
+	if s.tags == nil {
+		s.tags = make(map[protocol.Tag]*peerTagStats)
+	}
+	ts, ok := s.tags[tag]
+	if !ok {
+		ts = &peerTagStats{}
+		ts.received.windowStart = now
+		ts.sent.windowStart = now
+		s.tags[tag] = ts
+	}
+	return ts
+}
+
+// received counts a message received from the peer, of the given size on the wire.
+func (s *peerStats) received(tag protocol.Tag, bytes int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tagLocked(tag, now).received.add(bytes, now)
+}
+
+// sent counts a message sent to the peer, of the given size on the wire.
+func (s *peerStats) sent(tag protocol.Tag, bytes int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tagLocked(tag, now).sent.add(bytes, now)
+}
+
+// handled accounts the time a message handler spent on a message received from the peer.
+func (s *peerStats) handled(tag protocol.Tag, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.tagLocked(tag, time.Now())
+	ts.handled++
+	ts.handleTime += d
+}
+
+// PeerTagTraffic is the traffic of one tag with a peer. The rates are measured over the last
+// complete window of peerTrafficRateWindow.
+type PeerTagTraffic struct {
+	Tag Tag
+
+	ReceivedMessages    uint64
+	ReceivedBytes       uint64
+	ReceivedMessageRate float64
+	ReceivedByteRate    float64
+
+	SentMessages    uint64
+	SentBytes       uint64
+	SentMessageRate float64
+	SentByteRate    float64
+
+	// HandledMessages is the number of received messages passed to a message handler,
+	// which spent HandleTime on them.
+	HandledMessages uint64
+	HandleTime      time.Duration
+}
+
+// snapshot returns the traffic of the peer, sorted by tag.
+func (s *peerStats) snapshot(now time.Time) []PeerTagTraffic {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]PeerTagTraffic, 0, len(s.tags))
+	for tag, ts := range s.tags {
+		ts.received.roll(now)
+		ts.sent.roll(now)
+		out = append(out, PeerTagTraffic{
+			Tag:                 tag,
+			ReceivedMessages:    ts.received.messages,
+			ReceivedBytes:       ts.received.bytes,
+			ReceivedMessageRate: ts.received.messageRate,
+			ReceivedByteRate:    ts.received.byteRate,
+			SentMessages:        ts.sent.messages,
+			SentBytes:           ts.sent.bytes,
+			SentMessageRate:     ts.sent.messageRate,
+			SentByteRate:        ts.sent.byteRate,
+			HandledMessages:     ts.handled,
+			HandleTime:          ts.handleTime,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	return out
+}
+
+// PeerTraffic describes a connected peer and its traffic.
+type PeerTraffic struct {
+	// Address is the address of an outgoing peer, or the origin address of an incoming peer.
+	Address  string
+	Outgoing bool
+
+	// Version is the negotiated protocol version, and Features the negotiated peer features.
+	Version  string
+	Features []string
+
+	InstanceName   string
+	ConnectedSince time.Time
+
+	// MessageDelay is the average delay of the messages of an outgoing peer relative to the
+	// other outgoing peers, as measured by the connection performance monitor.
+	MessageDelay time.Duration
+
+	// PingRoundTrip is the round trip time of the last ping, if PeerPingPeriodSeconds is set.
+	PingRoundTrip time.Duration
+
+	Tags []PeerTagTraffic
+}
+
+// traffic returns the description of the peer and its traffic.
+func (wp *wsPeer) traffic(now time.Time) PeerTraffic {
+	var features []string
+	for _, tc := range tagCompressions {
+		if wp.features&tc.flag != 0 {
+			features = append(features, tc.feature)
+		}
+	}
+	_, pingRoundTrip := wp.pingTimes()
+	return PeerTraffic{
+		Address:        wp.captureAddress(),
+		Outgoing:       wp.outgoing,
+		Version:        wp.version,
+		Features:       features,
+		InstanceName:   wp.InstanceName,
+		ConnectedSince: wp.createTime,
+		MessageDelay:   time.Duration(wp.peerMessageDelay),
+		PingRoundTrip:  pingRoundTrip,
+		Tags:           wp.stats.snapshot(now),
+	}
+}
+
+// PeerTraffic returns the connected peers and their traffic, by tag.
+func (wn *WebsocketNetwork) PeerTraffic() []PeerTraffic {
+	now := time.Now()
+	wn.peersLock.RLock()
+	peers := make([]*wsPeer, len(wn.peers))
+	copy(peers, wn.peers)
+	wn.peersLock.RUnlock()
+
+	out := make([]PeerTraffic, len(peers))
+	for i, peer := range peers {
+		out[i] = peer.traffic(now)
+	}
+	return out
+}
+
+// peerTrafficCollector exports the traffic totals of the connected peers of the networks
+// registered with it, one series per peer and tag.
+type peerTrafficCollector struct {
+	mu       deadlock.Mutex
+	networks map[*WebsocketNetwork]bool
+}
+
+var networkPeerTraffic = &peerTrafficCollector{networks: make(map[*WebsocketNetwork]bool)}
+
+func init() {
+	metrics.NewCollector(metrics.MetricName{Name: "algod_network_peer_received_bytes", Description: "Number of bytes received from a connected peer, by tag"},
+		func() []metrics.Sample {
+			return networkPeerTraffic.collect(func(t PeerTagTraffic) uint64 { return t.ReceivedBytes })
+		})
+	metrics.NewCollector(metrics.MetricName{Name: "algod_network_peer_sent_bytes", Description: "Number of bytes sent to a connected peer, by tag"},
+		func() []metrics.Sample {
+			return networkPeerTraffic.collect(func(t PeerTagTraffic) uint64 { return t.SentBytes })
+		})
+	metrics.NewCollector(metrics.MetricName{Name: "algod_network_peer_received_messages", Description: "Number of messages received from a connected peer, by tag"},
+		func() []metrics.Sample {
+			return networkPeerTraffic.collect(func(t PeerTagTraffic) uint64 { return t.ReceivedMessages })
+		})
+	metrics.NewCollector(metrics.MetricName{Name: "algod_network_peer_handle_micros", Description: "Number of microseconds spent handling the messages of a connected peer, by tag"},
+		func() []metrics.Sample {
+			return networkPeerTraffic.collect(func(t PeerTagTraffic) uint64 { return uint64(t.HandleTime.Microseconds()) })
+		})
+}
+
+func (c *peerTrafficCollector) register(wn *WebsocketNetwork) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.networks[wn] = true
+}
+
+func (c *peerTrafficCollector) deregister(wn *WebsocketNetwork) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.networks, wn)
+}
+
+// collect returns the given value for each tag of each connected peer.
+func (c *peerTrafficCollector) collect(value func(PeerTagTraffic) uint64) []metrics.Sample {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var samples []metrics.Sample
+	for wn := range c.networks {
+		for _, peer := range wn.PeerTraffic() {
+			outgoing := strconv.FormatBool(peer.Outgoing)
+			for _, t := range peer.Tags {
+				samples = append(samples, metrics.Sample{
+					Labels: map[string]string{"peer": peer.Address, "outgoing": outgoing, "tag": string(t.Tag)},
+					Value:  float64(value(t)),
+				})
+			}
+		}
+	}
+	return samples
+}
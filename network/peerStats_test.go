@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+func TestPeerStatsRates(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var s peerStats
+	start := time.Unix(1000, 0)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	for i := 0; i < 20; i++ {
+		s.received(protocol.TxnTag, 100, at(time.Duration(i)*time.Second/2))
+	}
+	s.sent(protocol.AgreementVoteTag, 50, at(time.Second))
+	s.handled(protocol.TxnTag, time.Millisecond)
+	s.handled(protocol.TxnTag, 2*time.Millisecond)
+
+	// no window is complete yet
+	traffic := s.snapshot(at(5 * time.Second))
+	require.Len(t, traffic, 2)
+	require.Equal(t, protocol.AgreementVoteTag, traffic[0].Tag)
+	require.Equal(t, uint64(1), traffic[0].SentMessages)
+	require.Equal(t, uint64(50), traffic[0].SentBytes)
+	tx := traffic[1]
+	require.Equal(t, protocol.TxnTag, tx.Tag)
+	require.Equal(t, uint64(20), tx.ReceivedMessages)
+	require.Equal(t, uint64(2000), tx.ReceivedBytes)
+	require.Zero(t, tx.ReceivedMessageRate)
+	require.Equal(t, uint64(2), tx.HandledMessages)
+	require.Equal(t, 3*time.Millisecond, tx.HandleTime)
+
+	// 20 messages over the first window
+	tx = s.snapshot(at(peerTrafficRateWindow))[1]
+	require.InDelta(t, 2.0, tx.ReceivedMessageRate, 1e-9)
+	require.InDelta(t, 200.0, tx.ReceivedByteRate, 1e-9)
+
+	// an idle window resets the rate but keeps the totals
+	tx = s.snapshot(at(3 * peerTrafficRateWindow))[1]
+	require.Zero(t, tx.ReceivedMessageRate)
+	require.Zero(t, tx.ReceivedByteRate)
+	require.Equal(t, uint64(20), tx.ReceivedMessages)
+}
+
+func TestPeerStatsUnknownTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var s peerStats
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		s.received(Tag(fmt.Sprintf("%02x", i)), 10, now)
+	}
+	s.received(protocol.TxnTag, 10, now)
+	s.handled("zz", time.Millisecond)
+
+	traffic := s.snapshot(now)
+	require.Len(t, traffic, 2)
+	require.Equal(t, protocol.TxnTag, traffic[0].Tag)
+	require.Equal(t, peerStatsUnknownTag, traffic[1].Tag)
+	require.Equal(t, uint64(100), traffic[1].ReceivedMessages)
+	require.Equal(t, uint64(1), traffic[1].HandledMessages)
+}
+
+func TestPeerTraffic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, netB, counter, closeFunc := setupWebsocketNetworkAB(t, 2)
+	defer closeFunc()
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), true, nil)
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("bar"), true, nil)
+	select {
+	case <-counter.done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout, count=%d, wanted 2", counter.Count())
+	}
+
+	tagTraffic := func(peer PeerTraffic, tag Tag) PeerTagTraffic {
+		for _, tt := range peer.Tags {
+			if tt.Tag == tag {
+				return tt
+			}
+		}
+		return PeerTagTraffic{}
+	}
+
+	addrA, _ := netA.Address()
+	peersB := netB.PeerTraffic()
+	require.Len(t, peersB, 1)
+	require.True(t, peersB[0].Outgoing)
+	require.Equal(t, addrA, peersB[0].Address)
+	require.NotEmpty(t, peersB[0].Version)
+	require.ElementsMatch(t, []string{PeerFeatureProposalCompression, PeerFeatureVoteCompression, PeerFeatureTxnCompression}, peersB[0].Features)
+	require.False(t, peersB[0].ConnectedSince.IsZero())
+	tx := tagTraffic(peersB[0], protocol.TxnTag)
+	require.Equal(t, uint64(2), tx.ReceivedMessages)
+	require.Equal(t, uint64(2*(len("foo")+2)), tx.ReceivedBytes)
+	require.Equal(t, uint64(2), tx.HandledMessages)
+
+	require.Eventually(t, func() bool {
+		peersA := netA.PeerTraffic()
+		return len(peersA) == 1 && !peersA[0].Outgoing && tagTraffic(peersA[0], protocol.TxnTag).SentMessages == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// the per-peer totals are exported as metrics, by tag
+	var buf strings.Builder
+	metrics.DefaultRegistry().WriteMetrics(&buf, "")
+	require.Contains(t, buf.String(), `algod_network_peer_received_bytes{outgoing="true",peer="`+addrA+`",tag="TX"} 10`)
+	require.Contains(t, buf.String(), `algod_network_peer_received_messages{outgoing="true",peer="`+addrA+`",tag="TX"} 2`)
+	require.Contains(t, buf.String(), "algod_network_message_handled_TX")
+}
@@ -17,8 +17,12 @@
 package network
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"math/rand"
+	"os"
+	"sort"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -39,6 +43,16 @@ const PhoneBookEntryRelayRole = 1
 // PhoneBookEntryArchiverRole used for all the archivers that are provided via the archive SRV record.
 const PhoneBookEntryArchiverRole = 2
 
+// unknownConnectionQuality is the connection quality of addresses we haven't connected to yet.
+const unknownConnectionQuality = 0.5
+
+// connectionQualityAlpha is the weight of the latest connection outcome in the connection quality average.
+const connectionQualityAlpha = 0.3
+
+// minConnectionQualityWeight is added to the connection quality when selecting addresses, so that
+// addresses with a poor history still get picked once in a while and can recover.
+const minConnectionQualityWeight = 0.1
+
 // Phonebook stores or looks up addresses of nodes we might contact
 type Phonebook interface {
 	// GetAddresses(N) returns up to N addresses, but may return fewer
@@ -67,6 +81,10 @@ type Phonebook interface {
 
 	// ExtendPeerList adds unique addresses to this set of addresses
 	ExtendPeerList(more []string, networkName string, role PhoneBookEntryRoles)
+
+	// UpdateConnectionQuality records the outcome of a connection to the address.
+	// GetAddresses prefers the addresses with a history of successful connections.
+	UpdateConnectionQuality(addr string, success bool)
 }
 
 // addressData: holds the information associated with each phonebook address.
@@ -83,6 +101,17 @@ type addressData struct {
 
 	// role is the role that this address serves.
 	role PhoneBookEntryRoles
+
+	// connectionQuality is an exponentially weighted average of the connection outcomes,
+	// from 0 when the connections always failed to 1 when they always succeeded.
+	connectionQuality float64
+
+	// successes and failures count the connection outcomes to the address.
+	successes uint64
+	failures  uint64
+
+	// lastConnected is the last time a connection to the address succeeded.
+	lastConnected time.Time
 }
 
 // makePhonebookEntryData creates a new addressData entry for provided network name and role.
@@ -91,6 +120,7 @@ func makePhonebookEntryData(networkName string, role PhoneBookEntryRoles) addres
 		networkNames:          make(map[string]bool),
 		recentConnectionTimes: make([]time.Time, 0),
 		role:                  role,
+		connectionQuality:     unknownConnectionQuality,
 	}
 	pbData.networkNames[networkName] = true
 	return pbData
@@ -104,6 +134,9 @@ type phonebookImpl struct {
 	connectionsRateLimitingWindow time.Duration
 	data                          map[string]addressData
 	lock                          deadlock.RWMutex
+
+	// filename is where the phonebook is saved, if it was loaded from a file.
+	filename string
 }
 
 // MakePhonebook creates phonebookImpl with the passed configuration values
@@ -307,11 +340,74 @@ func shuffleSelect(set []string, n int) []string {
 	return out
 }
 
-// GetAddresses returns up to N shuffled address
+// weightedShuffleSelect returns up to n addresses of the set in a random order in which
+// addresses of larger weight are more likely to come first.
+// It uses the Efraimidis-Spirakis sampling, keying each address with u^(1/weight).
+func weightedShuffleSelect(set []string, weights []float64, n int) []string {
+	keys := make([]float64, len(set))
+	for i := range set {
+		keys[i] = math.Pow(rand.Float64(), 1/weights[i])
+	}
+	out := make([]string, len(set))
+	copy(out, set)
+	sort.Sort(byKeyDescending{out, keys})
+	if n < len(out) {
+		out = out[:n]
+	}
+	return out
+}
+
+type byKeyDescending struct {
+	set  []string
+	keys []float64
+}
+
+func (b byKeyDescending) Len() int           { return len(b.set) }
+func (b byKeyDescending) Less(i, j int) bool { return b.keys[i] > b.keys[j] }
+func (b byKeyDescending) Swap(i, j int) {
+	b.set[i], b.set[j] = b.set[j], b.set[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+// GetAddresses returns up to N shuffled address, preferring the addresses
+// with a history of successful connections.
 func (e *phonebookImpl) GetAddresses(n int, role PhoneBookEntryRoles) []string {
 	e.lock.RLock()
 	defer e.lock.RUnlock()
-	return shuffleSelect(e.filterRetryTime(time.Now(), role), n)
+	addrs := e.filterRetryTime(time.Now(), role)
+	weights := make([]float64, len(addrs))
+	uniform := true
+	for i, addr := range addrs {
+		weights[i] = minConnectionQualityWeight + e.data[addr].connectionQuality
+		if weights[i] != weights[0] {
+			uniform = false
+		}
+	}
+	if uniform {
+		return shuffleSelect(addrs, n)
+	}
+	return weightedShuffleSelect(addrs, weights, n)
+}
+
+// UpdateConnectionQuality records the outcome of a connection to the address.
+func (e *phonebookImpl) UpdateConnectionQuality(addr string, success bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	entry, found := e.data[addr]
+	if !found {
+		return
+	}
+	outcome := 0.0
+	if success {
+		outcome = 1.0
+		entry.successes++
+		entry.lastConnected = time.Now()
+	} else {
+		entry.failures++
+	}
+	entry.connectionQuality += connectionQualityAlpha * (outcome - entry.connectionQuality)
+	e.data[addr] = entry
 }
 
 // ExtendPeerList adds unique addresses to this set of addresses
@@ -333,3 +429,95 @@ func (e *phonebookImpl) Length() int {
 	defer e.lock.RUnlock()
 	return len(e.data)
 }
+
+// phonebookRecord is the saved form of a phonebook entry.
+type phonebookRecord struct {
+	Address       string              `json:"address"`
+	Networks      []string            `json:"networks"`
+	Role          PhoneBookEntryRoles `json:"role"`
+	RetryAfter    time.Time           `json:"retryAfter"`
+	Quality       float64             `json:"quality"`
+	Successes     uint64              `json:"successes"`
+	Failures      uint64              `json:"failures"`
+	LastConnected time.Time           `json:"lastConnected"`
+}
+
+// load merges the entries saved in the given file into the phonebook, and saves
+// the phonebook there from now on. A missing file is not an error.
+// Loaded entries keep their network names, so that the next ReplacePeerList of a
+// network drops the entries that are no longer part of it.
+func (e *phonebookImpl) load(filename string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.filename = filename
+
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var records []phonebookRecord
+	err = json.Unmarshal(data, &records)
+	if err != nil {
+		return fmt.Errorf("unable to parse phonebook %s: %w", filename, err)
+	}
+	for _, r := range records {
+		if len(r.Networks) == 0 {
+			continue
+		}
+		entry, has := e.data[r.Address]
+		if !has {
+			entry = makePhonebookEntryData(r.Networks[0], r.Role)
+		}
+		for _, networkName := range r.Networks {
+			entry.networkNames[networkName] = true
+		}
+		entry.retryAfter = r.RetryAfter
+		entry.connectionQuality = r.Quality
+		entry.successes = r.Successes
+		entry.failures = r.Failures
+		entry.lastConnected = r.LastConnected
+		e.data[r.Address] = entry
+	}
+	return nil
+}
+
+// save writes the phonebook to the file it was loaded from, if any.
+func (e *phonebookImpl) save() error {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	if e.filename == "" {
+		return nil
+	}
+	records := make([]phonebookRecord, 0, len(e.data))
+	for addr, entry := range e.data {
+		r := phonebookRecord{
+			Address:       addr,
+			Networks:      make([]string, 0, len(entry.networkNames)),
+			Role:          entry.role,
+			RetryAfter:    entry.retryAfter,
+			Quality:       entry.connectionQuality,
+			Successes:     entry.successes,
+			Failures:      entry.failures,
+			LastConnected: entry.lastConnected,
+		}
+		for networkName := range entry.networkNames {
+			r.Networks = append(r.Networks, networkName)
+		}
+		sort.Strings(r.Networks)
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Address < records[j].Address })
+	data, err := json.MarshalIndent(records, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp := e.filename + ".tmp"
+	err = os.WriteFile(tmp, data, 0600)
+	if err == nil {
+		err = os.Rename(tmp, e.filename)
+	}
+	return err
+}
@@ -19,6 +19,8 @@ package network
 import (
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -434,3 +436,76 @@ func TestPhonebookRoles(t *testing.T) {
 		}
 	}
 }
+
+func TestPhonebookConnectionQuality(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"good", "bad"}, "default", PhoneBookEntryRelayRole)
+	for i := 0; i < 10; i++ {
+		ph.UpdateConnectionQuality("good", true)
+		ph.UpdateConnectionQuality("bad", false)
+	}
+	// unknown addresses are ignored
+	ph.UpdateConnectionQuality("unknown", true)
+
+	require.Greater(t, ph.data["good"].connectionQuality, 0.9)
+	require.Less(t, ph.data["bad"].connectionQuality, 0.1)
+	require.Equal(t, uint64(10), ph.data["good"].successes)
+	require.Equal(t, uint64(10), ph.data["bad"].failures)
+	require.False(t, ph.data["good"].lastConnected.IsZero())
+	require.True(t, ph.data["bad"].lastConnected.IsZero())
+
+	// the good relay should come first most of the time, but the bad one still gets picked
+	goodFirst := 0
+	for i := 0; i < 1000; i++ {
+		addrs := ph.GetAddresses(1, PhoneBookEntryRelayRole)
+		require.Len(t, addrs, 1)
+		if addrs[0] == "good" {
+			goodFirst++
+		}
+	}
+	require.Greater(t, goodFirst, 800)
+	require.Less(t, goodFirst, 1000)
+
+	require.ElementsMatch(t, []string{"good", "bad"}, ph.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+}
+
+func TestPhonebookPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	filename := filepath.Join(t.TempDir(), "knownpeers.json")
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	// a missing file is not an error
+	require.NoError(t, ph.load(filename))
+	ph.ReplacePeerList([]string{"relay1", "relay2"}, "net1", PhoneBookEntryRelayRole)
+	ph.ExtendPeerList([]string{"relay2"}, "net2", PhoneBookEntryRelayRole)
+	ph.ReplacePeerList([]string{"archiver1"}, "net1", PhoneBookEntryArchiverRole)
+	retryAfter := time.Now().Add(time.Hour).Round(0)
+	ph.UpdateRetryAfter("relay1", retryAfter)
+	ph.UpdateConnectionQuality("relay2", true)
+	require.NoError(t, ph.save())
+
+	restored := MakePhonebook(1, 1).(*phonebookImpl)
+	restored.ReplacePeerList([]string{"relay3"}, "net1", PhoneBookEntryRelayRole)
+	require.NoError(t, restored.load(filename))
+	require.Equal(t, 4, restored.Length())
+	require.True(t, retryAfter.Equal(restored.data["relay1"].retryAfter))
+	require.Equal(t, ph.data["relay2"].connectionQuality, restored.data["relay2"].connectionQuality)
+	require.Equal(t, uint64(1), restored.data["relay2"].successes)
+	require.Equal(t, map[string]bool{"net1": true, "net2": true}, restored.data["relay2"].networkNames)
+	require.Equal(t, PhoneBookEntryRoles(PhoneBookEntryRelayRole), restored.data["relay3"].role)
+	require.Equal(t, unknownConnectionQuality, restored.data["relay3"].connectionQuality)
+	require.Equal(t, PhoneBookEntryRoles(PhoneBookEntryArchiverRole), restored.data["archiver1"].role)
+	require.ElementsMatch(t, []string{"relay2", "relay3"}, restored.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	// the next DNS update drops the saved entries that are no longer listed
+	restored.ReplacePeerList([]string{"relay3"}, "net1", PhoneBookEntryRelayRole)
+	require.ElementsMatch(t, []string{"relay2", "relay3"}, restored.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+	_, has := restored.data["relay1"]
+	require.False(t, has)
+
+	require.NoError(t, os.WriteFile(filename, []byte("not json"), 0600))
+	require.Error(t, MakePhonebook(1, 1).(*phonebookImpl).load(filename))
+}
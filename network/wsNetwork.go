@@ -45,6 +45,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network/limitlistener"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
 	tools_network "github.com/algorand/go-algorand/tools/network"
 	"github.com/algorand/go-algorand/tools/network/dnssec"
@@ -130,6 +131,8 @@ var networkPrioBatchesPPWithCompression = metrics.MakeCounter(metrics.MetricName
 var networkPrioBatchesPPWithoutCompression = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_pp_prio_batches_wpp_non_comp_sent_total", Description: "number of prio non-compressed batches with PP"})
 var networkPrioPPCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_prio_pp_compressed_size_total", Description: "cumulative size of all compressed PP"})
 var networkPrioPPNonCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_prio_pp_non_compressed_size_total", Description: "cumulative size of all non-compressed PP"})
+var networkCompressedSizeByTag = metrics.NewTagCounter("algod_network_compressed_size_{TAG}", "cumulative size of all compressed {TAG} messages", string(protocol.AgreementVoteTag), string(protocol.TxnTag))
+var networkNonCompressedSizeByTag = metrics.NewTagCounter("algod_network_non_compressed_size_{TAG}", "cumulative size of all {TAG} messages before compression", string(protocol.AgreementVoteTag), string(protocol.TxnTag))
 
 // peerDisconnectionAckDuration defines the time we would wait for the peer disconnection to complete.
 const peerDisconnectionAckDuration = 5 * time.Second
@@ -217,6 +220,21 @@ type GossipNode interface {
 	// SetPeerData attaches a piece of data to a peer.
 	// Other services inside go-algorand may attach data to a peer that gets garbage collected when the peer is closed.
 	SetPeerData(peer Peer, key string, value interface{})
+
+	// ReportPeer reports a misbehavior of a peer, which may get its address banned.
+	ReportPeer(peer Peer, fault PeerFault)
+
+	// PeerTraffic returns the connected peers and their traffic, by tag.
+	PeerTraffic() []PeerTraffic
+
+	// GetPeerBans returns the active bans of peer addresses.
+	GetPeerBans() []PeerBan
+
+	// BanPeer bans a peer address for the given duration, or for the configured PeerBanDurationSeconds if zero.
+	BanPeer(address string, duration time.Duration) PeerBan
+
+	// UnbanPeer lifts the ban of a peer address. It returns false if the address was not banned.
+	UnbanPeer(address string) bool
 }
 
 // IncomingMessage represents a message arriving from some peer in our p2p network
@@ -442,6 +460,17 @@ type WebsocketNetwork struct {
 
 	// protocolVersion is an actual version announced as ProtocolVersionHeader
 	protocolVersion string
+
+	// localFeatures are the message compressions enabled by GossipCompressionTags,
+	// announced in the PeerFeaturesHeader as localFeaturesHeader
+	localFeatures       peerFeatureFlag
+	localFeaturesHeader string
+
+	// peerScorer scores the peer addresses by their reported faults and bans the misbehaving ones
+	peerScorer *peerScorer
+
+	// capture records the incoming and outgoing messages, if NetworkMessageCaptureFile is set
+	capture *messagetracer.MessageCapture
 }
 
 const (
@@ -514,7 +543,7 @@ func (wn *WebsocketNetwork) BroadcastArray(ctx context.Context, tags []protocol.
 
 	request := broadcastRequest{tags: tags, data: data, enqueueTime: time.Now(), ctx: ctx}
 	if except != nil {
-		request.except = except.(*wsPeer)
+		request.except, _ = except.(*wsPeer)
 	}
 
 	broadcastQueue := wn.broadcastQueueBulk
@@ -583,14 +612,88 @@ func (wn *WebsocketNetwork) Disconnect(node Peer) {
 
 // Disconnect from a peer, probably due to protocol errors.
 func (wn *WebsocketNetwork) disconnect(badnode Peer, reason disconnectReason) {
-	if badnode == nil {
+	// other peers, like the captured peers of a replay, have no connection to close
+	peer, ok := badnode.(*wsPeer)
+	if !ok {
 		return
 	}
-	peer := badnode.(*wsPeer)
 	peer.CloseAndWait(time.Now().Add(peerDisconnectionAckDuration))
 	wn.removePeer(peer, reason)
 }
 
+// ReportPeer reports a misbehavior of a peer. Once the faults reported against its address
+// reach PeerBanThreshold, the address is banned and all its peers are disconnected.
+func (wn *WebsocketNetwork) ReportPeer(peer Peer, fault PeerFault) {
+	addr := peerBanAddress(peer)
+	ban := wn.peerScorer.report(addr, fault, time.Now())
+	if ban == nil {
+		return
+	}
+	wn.log.Warnf("banning peer address %s until %v: %s", filterASCII(addr), ban.Until, ban.Reason)
+	wn.disconnectBanned(addr)
+}
+
+// GetPeerBans returns the active bans of peer addresses.
+func (wn *WebsocketNetwork) GetPeerBans() []PeerBan {
+	return wn.peerScorer.list(time.Now())
+}
+
+// BanPeer bans a peer address for the given duration, or for the configured duration if zero,
+// and disconnects its peers.
+func (wn *WebsocketNetwork) BanPeer(address string, duration time.Duration) PeerBan {
+	ban := wn.peerScorer.ban(address, duration, time.Now())
+	wn.log.Infof("banning peer address %s until %v: %s", filterASCII(address), ban.Until, ban.Reason)
+	wn.disconnectBanned(address)
+	return ban
+}
+
+// UnbanPeer lifts the ban of a peer address. It returns false if the address was not banned.
+func (wn *WebsocketNetwork) UnbanPeer(address string) bool {
+	return wn.peerScorer.unban(address, time.Now())
+}
+
+// LoadPeerBans loads the bans saved in the given file, and saves the bans there from now on.
+// It should be called before the network is started.
+func (wn *WebsocketNetwork) LoadPeerBans(filename string) error {
+	return wn.peerScorer.load(filename, time.Now())
+}
+
+// LoadPhonebook merges the known peers saved in the given file into the phonebook, and
+// saves the phonebook there from now on, so that the node can connect to historically good
+// relays without waiting for DNS after a restart.
+// It should be called before the network is started.
+func (wn *WebsocketNetwork) LoadPhonebook(filename string) error {
+	pb, ok := wn.phonebook.(*phonebookImpl)
+	if !ok {
+		return nil
+	}
+	return pb.load(filename)
+}
+
+// savePhonebook saves the phonebook, if it was loaded from a file.
+func (wn *WebsocketNetwork) savePhonebook() {
+	pb, ok := wn.phonebook.(*phonebookImpl)
+	if !ok {
+		return
+	}
+	err := pb.save()
+	if err != nil {
+		wn.log.Warnf("unable to save the phonebook: %v", err)
+	}
+}
+
+// disconnectBanned disconnects the peers of a banned address.
+func (wn *WebsocketNetwork) disconnectBanned(addr string) {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	for _, peer := range wn.peers {
+		if peerBanAddress(peer) == addr {
+			wn.wg.Add(1)
+			go wn.disconnectThread(peer, disconnectBanned)
+		}
+	}
+}
+
 func closeWaiter(wg *sync.WaitGroup, peer *wsPeer, deadline time.Time) {
 	defer wg.Done()
 	peer.CloseAndWait(deadline)
@@ -782,6 +885,24 @@ func (wn *WebsocketNetwork) setup() {
 	// set our actual version
 	wn.protocolVersion = ProtocolVersion
 
+	// set the message compressions we negotiate with peers
+	var unknownTags []string
+	wn.localFeatures, unknownTags = makeCompressionFeatures(wn.config.GossipCompressionTags)
+	if len(unknownTags) > 0 {
+		wn.log.Warnf("GossipCompressionTags: ignoring tags without compression support: %v", unknownTags)
+	}
+	wn.localFeaturesHeader = compressionFeaturesHeader(wn.localFeatures)
+
+	wn.peerScorer = makePeerScorer(wn.log, wn.config)
+
+	if !wn.config.DisableNetworking {
+		var err error
+		wn.capture, err = messagetracer.MakeMessageCapture(wn.config)
+		if err != nil {
+			wn.log.Warnf("network message capture disabled: %v", err)
+		}
+	}
+
 	wn.messagesOfInterestRefresh = make(chan struct{}, 2)
 	wn.messagesOfInterestGeneration = 1 // something nonzero so that any new wsPeer needs updating
 	if wn.relayMessages {
@@ -853,6 +974,8 @@ func (wn *WebsocketNetwork) Start() {
 
 	go wn.postMessagesOfInterestThread()
 
+	networkPeerTraffic.register(wn)
+
 	wn.log.Infof("serving genesisID=%s on %#v with RandomID=%s", wn.GenesisID, wn.PublicAddress(), wn.RandomID)
 }
 
@@ -888,6 +1011,7 @@ func (wn *WebsocketNetwork) innerStop() {
 // Stop closes network connections and stops threads.
 // Stop blocks until all activity on this node is done.
 func (wn *WebsocketNetwork) Stop() {
+	networkPeerTraffic.deregister(wn)
 	wn.handlers.ClearHandlers([]Tag{})
 
 	// if we have a working ticker, just stop it and clear it out. The access to this variable is safe since the Start()/Stop() are synced by the
@@ -912,6 +1036,8 @@ func (wn *WebsocketNetwork) Stop() {
 	if wn.listener != nil {
 		wn.log.Debugf("closed %s", listenAddr)
 	}
+	wn.savePhonebook()
+	wn.capture.Close()
 
 	// Wait for the requestsTracker to finish up to avoid potential race condition
 	<-wn.requestsTracker.getWaitUntilNoConnectionsChannel(5 * time.Millisecond)
@@ -984,6 +1110,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if wn.peerScorer.isBanned(remoteHost, time.Now()) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "Banned",
+			})
+		response.WriteHeader(http.StatusForbidden)
+		return http.StatusForbidden
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1138,7 +1278,9 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	wn.setHeaders(responseHeader)
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	if wn.localFeaturesHeader != "" {
+		responseHeader.Set(PeerFeaturesHeader, wn.localFeaturesHeader)
+	}
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1165,7 +1307,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		prioChallenge:     challenge,
 		createTime:        trackedRequest.created,
 		version:           matchingVersion,
-		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)) & wn.localFeatures,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1232,6 +1374,11 @@ func (wn *WebsocketNetwork) messageHandlerThread(peersConnectivityCheckCh <-chan
 			networkIncomingBufferMicros.AddUint64(uint64(bufferNanos/1000), nil)
 			handleTime := handled.Sub(start)
 			networkHandleMicros.AddUint64(uint64(handleTime.Nanoseconds()/1000), nil)
+			networkMessageHandledByTag.Add(string(msg.Tag), 1)
+			networkMessageHandleMicrosByTag.Add(string(msg.Tag), uint64(handleTime.Microseconds()))
+			if peer, ok := msg.Sender.(*wsPeer); ok {
+				peer.stats.handled(msg.Tag, handleTime)
+			}
 			switch outmsg.Action {
 			case Disconnect:
 				wn.wg.Add(1)
@@ -1439,14 +1586,11 @@ func (wn *WebsocketNetwork) peerSnapshot(dest []*wsPeer) ([]*wsPeer, int32) {
 }
 
 // preparePeerData prepares batches of data for sending.
-// It performs optional zstd compression for proposal massages
+// It performs optional zstd compression for the messages of the tags listed in tagCompressions
 func (wn *WebsocketNetwork) preparePeerData(request broadcastRequest, prio bool, peers []*wsPeer) ([][]byte, [][]byte, []crypto.Digest, bool) {
-	// determine if there is a payload proposal and peers supporting compressed payloads
-	wantCompression := false
+	// determine if there is a compressible message and peers supporting its compression
+	wantCompression := checkCanCompress(request, peers)
 	containsPrioPPTag := false
-	if prio {
-		wantCompression = checkCanCompress(request, peers)
-	}
 
 	digests := make([]crypto.Digest, len(request.data))
 	data := make([][]byte, len(request.data))
@@ -1472,12 +1616,17 @@ func (wn *WebsocketNetwork) preparePeerData(request broadcastRequest, prio bool,
 		}
 
 		if wantCompression {
-			if request.tags[i] == protocol.ProposalPayloadTag {
-				compressed, logMsg := zstdCompressMsg(tbytes, d)
+			if tc := getTagCompression(request.tags[i]); tc != nil {
+				compressed, logMsg := tc.compress(tbytes, d)
 				if len(logMsg) > 0 {
 					wn.log.Warn(logMsg)
+				} else if tc.tag == protocol.ProposalPayloadTag {
+					if prio {
+						networkPrioPPCompressedSize.AddUint64(uint64(len(compressed)), nil)
+					}
 				} else {
-					networkPrioPPCompressedSize.AddUint64(uint64(len(compressed)), nil)
+					networkNonCompressedSizeByTag.Add(string(tc.tag), uint64(len(d)))
+					networkCompressedSizeByTag.Add(string(tc.tag), uint64(len(compressed)-len(tbytes)))
 				}
 				dataCompressed[i] = compressed
 			} else {
@@ -1505,6 +1654,12 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 	start := time.Now()
 	data, dataWithCompression, digests, containsPrioPPTag := wn.preparePeerData(request, prio, peers)
 
+	// peers with the same features share the batch with the messages compressed for them
+	var batches map[peerFeatureFlag][][]byte
+	if len(dataWithCompression) > 0 {
+		batches = make(map[peerFeatureFlag][][]byte)
+	}
+
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
 	for _, peer := range peers {
@@ -1515,20 +1670,17 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 			continue
 		}
 		var ok bool
-		if peer.pfProposalCompressionSupported() && len(dataWithCompression) > 0 {
-			// if this peer supports compressed proposals and compressed data batch is filled out, use it
-			ok = peer.writeNonBlockMsgs(request.ctx, dataWithCompression, prio, digests, request.enqueueTime)
-			if prio {
-				if containsPrioPPTag {
-					networkPrioBatchesPPWithCompression.Inc(nil)
-				}
-			}
+		if peer.features != 0 && len(dataWithCompression) > 0 {
+			// if this peer supports compression and compressed data batch is filled out, use the messages it can decompress
+			ok = peer.writeNonBlockMsgs(request.ctx, peerBatch(request.tags, data, dataWithCompression, peer.features, batches), prio, digests, request.enqueueTime)
 		} else {
 			ok = peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
-			if prio {
-				if containsPrioPPTag {
-					networkPrioBatchesPPWithoutCompression.Inc(nil)
-				}
+		}
+		if prio && containsPrioPPTag {
+			if peer.pfProposalCompressionSupported() && len(dataWithCompression) > 0 {
+				networkPrioBatchesPPWithCompression.Inc(nil)
+			} else {
+				networkPrioBatchesPPWithoutCompression.Inc(nil)
 			}
 		}
 		if ok {
@@ -1682,6 +1834,8 @@ func (wn *WebsocketNetwork) meshThread() {
 		// telemetry server; that would allow the telemetry server
 		// to construct a cross-node map of all the nodes interconnections.
 		wn.sendPeerConnectionsTelemetryStatus()
+
+		wn.savePhonebook()
 	}
 }
 
@@ -1978,6 +2132,14 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureVoteCompression is a value for PeerFeaturesHeader indicating peer
+// supports agreement vote compression with zstd and the version 1 vote dictionary
+const PeerFeatureVoteCompression = "avzstd1"
+
+// PeerFeatureTxnCompression is a value for PeerFeaturesHeader indicating peer
+// supports transaction compression with zstd and the version 1 transaction dictionary
+const PeerFeatureTxnCompression = "txzstd1"
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2055,6 +2217,9 @@ func (wn *WebsocketNetwork) tryConnectReserveAddr(addr string) (gossipAddr strin
 	if err != nil {
 		return "", false
 	}
+	if wn.peerScorer.isBanned(hostOfAddress(addr), time.Now()) {
+		return "", false
+	}
 	_, exists = wn.tryConnectAddrs[gossipAddr]
 	if exists {
 		return "", false
@@ -2124,7 +2289,9 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	if wn.localFeaturesHeader != "" {
+		requestHeader.Set(PeerFeaturesHeader, wn.localFeaturesHeader)
+	}
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2138,6 +2305,9 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 
 	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
 	if err != nil {
+		if wn.ctx.Err() == nil && !(err == websocket.ErrBadHandshake && response.StatusCode == http.StatusLoopDetected) {
+			wn.phonebook.UpdateConnectionQuality(addr, false)
+		}
 		if err == websocket.ErrBadHandshake {
 			// reading here from ioutil is safe only because it came from DialContext above, which already finished reading all the data from the network
 			// and placed it all in a ioutil.NopCloser reader.
@@ -2177,6 +2347,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	responseHeaderOk, matchingVersion := wn.checkServerResponseVariables(response.Header, gossipAddr)
 	if !responseHeaderOk {
 		// The error was already logged, so no need to log again.
+		wn.phonebook.UpdateConnectionQuality(addr, false)
 		return
 	}
 
@@ -2196,11 +2367,12 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		connMonitor:                 wn.connPerfMonitor,
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
-		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)) & wn.localFeatures,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
+	wn.phonebook.UpdateConnectionQuality(addr, true)
 	localAddr, _ := wn.Address()
 	wn.log.With("event", "ConnectedOut").With("remote", addr).With("local", localAddr).Infof("Made outgoing connection to peer %v", addr)
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerEvent,
@@ -2294,6 +2466,9 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
 	}
 	logEntry.Infof("Peer %s disconnected: %s", peer.rootURL, reason)
+	if peer.outgoing && reason.isPerformanceIssue() {
+		wn.phonebook.UpdateConnectionQuality(peer.rootURL, false)
+	}
 	peerAddr := peer.OriginAddress()
 	// we might be able to get addr out of conn, or it might be closed
 	if peerAddr == "" && peer.conn != nil {
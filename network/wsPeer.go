@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/metrics"
 )
@@ -60,6 +61,8 @@ func init() {
 	networkReceivedBytesByTag = metrics.NewTagCounterFiltered("algod_network_received_bytes_{TAG}", "Number of bytes that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkMessageReceivedByTag = metrics.NewTagCounterFiltered("algod_network_message_received_{TAG}", "Number of complete messages that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkMessageSentByTag = metrics.NewTagCounterFiltered("algod_network_message_sent_{TAG}", "Number of complete messages that were sent to the network for {TAG} messages", tagStringList, "UNK")
+	networkMessageHandledByTag = metrics.NewTagCounterFiltered("algod_network_message_handled_{TAG}", "Number of received {TAG} messages passed to a message handler", tagStringList, "UNK")
+	networkMessageHandleMicrosByTag = metrics.NewTagCounterFiltered("algod_network_message_handle_micros_{TAG}", "Number of microseconds spent handling received {TAG} messages", tagStringList, "UNK")
 
 	matched := false
 	for _, version := range SupportedProtocolVersions {
@@ -87,6 +90,8 @@ var networkMessageReceivedTotal = metrics.MakeCounter(metrics.NetworkMessageRece
 var networkMessageReceivedByTag *metrics.TagCounter
 var networkMessageSentTotal = metrics.MakeCounter(metrics.NetworkMessageSentTotal)
 var networkMessageSentByTag *metrics.TagCounter
+var networkMessageHandledByTag *metrics.TagCounter
+var networkMessageHandleMicrosByTag *metrics.TagCounter
 
 var networkConnectionsDroppedTotal = metrics.MakeCounter(metrics.NetworkConnectionsDroppedTotal)
 var networkMessageQueueMicrosTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_message_sent_queue_micros_total", Description: "Total microseconds message spent waiting in queue to be sent"})
@@ -161,6 +166,18 @@ const disconnectLeastPerformingPeer disconnectReason = "LeastPerformingPeer"
 const disconnectCliqueResolve disconnectReason = "CliqueResolving"
 const disconnectRequestReceived disconnectReason = "DisconnectRequest"
 const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
+const disconnectBanned disconnectReason = "Banned"
+
+// isPerformanceIssue returns true if the peer was disconnected because it misbehaved
+// or didn't keep up, which counts against its connection quality in the phonebook.
+func (r disconnectReason) isPerformanceIssue() bool {
+	switch r {
+	case disconnectBadData, disconnectTooSlow, disconnectIdleConn, disconnectSlowConn,
+		disconnectLeastPerformingPeer, disconnectStaleWrite, disconnectBanned:
+		return true
+	}
+	return false
+}
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -278,6 +295,9 @@ type wsPeer struct {
 
 	// closers is a slice of functions to run when the peer is closed
 	closers []func()
+
+	// stats holds the traffic with the peer, by tag
+	stats peerStats
 }
 
 // HTTPPeer is what the opaque Peer might be.
@@ -418,6 +438,14 @@ func (wp *wsPeer) OriginAddress() string {
 	return wp.originAddress
 }
 
+// captureAddress returns the address of the peer recorded in the network message capture.
+func (wp *wsPeer) captureAddress() string {
+	if wp.outgoing || wp.originAddress == "" {
+		return wp.rootURL
+	}
+	return wp.originAddress
+}
+
 func (wp *wsPeer) reportReadErr(err error) {
 	// only report error if we haven't already closed the peer
 	if atomic.LoadInt32(&wp.didInnerClose) == 0 {
@@ -479,13 +507,16 @@ func (wp *wsPeer) readLoop() {
 		}
 
 		msg.processing = wp.processed
-		msg.Received = time.Now().UnixNano()
+		received := time.Now()
+		msg.Received = received.UnixNano()
 		msg.Data = slurper.Bytes()
+		wp.stats.received(msg.Tag, len(msg.Data)+len(tag), received)
 		msg.Data, err = dataConverter.convert(msg.Tag, msg.Data)
 		if err != nil {
 			wp.reportReadErr(err)
 			return
 		}
+		wp.net.capture.Capture(messagetracer.CaptureRecord{Time: msg.Received, Peer: wp.captureAddress(), Tag: msg.Tag, Data: msg.Data})
 		msg.Net = wp.net
 		atomic.StoreInt64(&wp.lastPacketTime, msg.Received)
 		networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+2), nil)
@@ -694,7 +725,10 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		}
 		return disconnectWriteError
 	}
-	atomic.StoreInt64(&wp.lastPacketTime, time.Now().UnixNano())
+	sent := time.Now()
+	atomic.StoreInt64(&wp.lastPacketTime, sent.UnixNano())
+	wp.stats.sent(tag, len(msg.data), sent)
+	wp.net.capture.Capture(messagetracer.CaptureRecord{Time: sent.UnixNano(), Outgoing: true, Peer: wp.captureAddress(), Tag: tag, Data: msg.data[2:]})
 	networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
 	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
 	networkMessageSentTotal.AddUint64(1, nil)
@@ -980,7 +1014,11 @@ func (wp *wsPeer) OnClose(f func()) {
 
 type peerFeatureFlag int
 
-const pfCompressedProposal peerFeatureFlag = 1
+const (
+	pfCompressedProposal peerFeatureFlag = 1 << iota
+	pfCompressedVote
+	pfCompressedTxn
+)
 
 // versionPeerFeatures defines protocol version when peer features were introduced
 const versionPeerFeatures = "2.2"
@@ -1021,8 +1059,10 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 	parts := strings.Split(announcedFeatures, ",")
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if part == PeerFeatureProposalCompression {
-			features |= pfCompressedProposal
+		for _, tc := range tagCompressions {
+			if part == tc.feature {
+				features |= tc.flag
+			}
 		}
 	}
 	return features
@@ -172,6 +172,9 @@ func TestVersionToFeature(t *testing.T) {
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ","), pfCompressedProposal},
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ", "), pfCompressedProposal},
 		{"2.3", PeerFeatureProposalCompression, pfCompressedProposal},
+		{"2.2", PeerFeatureVoteCompression, pfCompressedVote},
+		{"2.2", "avzstd2", peerFeatureFlag(0)},
+		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, PeerFeatureVoteCompression, PeerFeatureTxnCompression}, ","), pfCompressedProposal | pfCompressedVote | pfCompressedTxn},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
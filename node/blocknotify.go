@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-deadlock"
+)
+
+// blockNotifications wakes up subscribers whenever a block is added to the
+// ledger. A subscriber's channel holds at most one pending notification, so a
+// slow subscriber never holds up the ledger's block notifier; it is expected to
+// read the blocks it has not seen yet from the ledger when it wakes up.
+type blockNotifications struct {
+	mu          deadlock.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func (bn *blockNotifications) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	bn.mu.Lock()
+	defer bn.mu.Unlock()
+	if bn.subscribers == nil {
+		bn.subscribers = make(map[chan struct{}]struct{})
+	}
+	bn.subscribers[ch] = struct{}{}
+
+	unsubscribe := func() {
+		bn.mu.Lock()
+		defer bn.mu.Unlock()
+		delete(bn.subscribers, ch)
+	}
+	return ch, unsubscribe
+}
+
+func (bn *blockNotifications) notify() {
+	bn.mu.Lock()
+	defer bn.mu.Unlock()
+	for ch := range bn.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
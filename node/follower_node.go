@@ -0,0 +1,550 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/stateproof"
+	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-deadlock"
+)
+
+// ErrFollowerNodeUnsupported is returned by the operations that a follower node never performs,
+// such as broadcasting transactions or managing participation keys.
+var ErrFollowerNodeUnsupported = errors.New("operation is not supported on a follower node")
+
+// AlgorandFollowerNode is a node that keeps its ledger current by catchup only. It never runs
+// agreement, has no transaction pool, never relays transactions and never holds participation keys.
+// The catchup sync round is set at startup, so that the ledger state deltas are retained until a
+// consumer advances it.
+type AlgorandFollowerNode struct {
+	mu        deadlock.Mutex
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+	config    config.Local
+
+	ledger *data.Ledger
+	net    network.GossipNode
+
+	catchupService           *catchup.Service
+	catchpointCatchupService *catchup.CatchpointCatchupService
+	blockService             *rpcs.BlockService
+
+	rootDir     string
+	genesisID   string
+	genesisHash crypto.Digest
+
+	log logging.Logger
+
+	// syncStatusMu used for locking lastRoundTimestamp and hasSyncedSinceStartup
+	syncStatusMu          deadlock.Mutex
+	lastRoundTimestamp    time.Time
+	hasSyncedSinceStartup bool
+
+	cryptoPool                        execpool.ExecutionPool
+	lowPriorityCryptoVerificationPool execpool.BacklogPool
+	catchupBlockAuth                  blockAuthenticatorImpl
+
+	newBlocks blockNotifications
+}
+
+// MakeFollower sets up an Algorand follower node
+// (i.e., it returns a node that follows the chain without participating in consensus)
+func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFollowerNode, error) {
+	if genesis.DevMode {
+		return nil, fmt.Errorf("cannot run with both EnableFollowMode and DevMode")
+	}
+	if cfg.NetAddress != "" {
+		return nil, fmt.Errorf("cannot run with both EnableFollowMode and a NetAddress: follower nodes cannot be relays")
+	}
+
+	node := new(AlgorandFollowerNode)
+	node.rootDir = rootDir
+	node.log = log.With("name", cfg.NetAddress)
+	node.genesisID = genesis.ID()
+	node.genesisHash = genesis.Hash()
+	node.config = cfg
+
+	// the network is created without node info, so that the node never asks for transaction gossip
+	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil)
+	if err != nil {
+		log.Errorf("could not create websocket node: %v", err)
+		return nil, err
+	}
+	node.net = p2pNode
+
+	// load stored data
+	genesisDir := filepath.Join(rootDir, genesis.ID())
+	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
+
+	// create initial ledger, if it doesn't exist
+	err = os.Mkdir(genesisDir, 0700)
+	if err != nil && !os.IsExist(err) {
+		log.Errorf("Unable to create genesis directory: %v", err)
+		return nil, err
+	}
+	err = p2pNode.LoadPeerBans(filepath.Join(genesisDir, config.PeerBansFilename))
+	if err != nil {
+		log.Warnf("Unable to load the peer bans: %v", err)
+	}
+	err = p2pNode.LoadPhonebook(filepath.Join(genesisDir, config.KnownPeersFilename))
+	if err != nil {
+		log.Warnf("Unable to load the known peers: %v", err)
+	}
+	genalloc, err := genesis.Balances()
+	if err != nil {
+		log.Errorf("Cannot load genesis allocation: %v", err)
+		return nil, err
+	}
+
+	node.cryptoPool = execpool.MakePool(node)
+	node.lowPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.LowPriority, node)
+	node.ledger, err = data.LoadLedger(node.log, ledgerPathnamePrefix, false, genesis.Proto, genalloc, node.genesisID, node.genesisHash, []ledgercore.BlockListener{}, cfg)
+	if err != nil {
+		log.Errorf("Cannot initialize ledger (%s): %v", ledgerPathnamePrefix, err)
+		return nil, err
+	}
+
+	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node})
+
+	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
+
+	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
+	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, nil, node.lowPriorityCryptoVerificationPool)
+
+	// hold the ledger at its current round until a consumer advances the sync round
+	err = node.SetSyncRound(uint64(node.ledger.NextRound()))
+	if err != nil {
+		log.Errorf("unable to set the initial sync round: %v", err)
+		return nil, err
+	}
+
+	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
+	if err != nil {
+		log.Errorf("unable to determine catchpoint catchup state: %v", err)
+		return nil, err
+	}
+	if catchpointCatchupState != ledger.CatchpointCatchupStateInactive {
+		accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
+		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, accessor, node.config)
+		if err != nil {
+			log.Errorf("unable to create catchpoint catchup service: %v", err)
+			return nil, err
+		}
+		node.log.Infof("resuming catchpoint catchup from state %d", catchpointCatchupState)
+	}
+
+	return node, err
+}
+
+// Config returns a copy of the node's Local configuration
+func (node *AlgorandFollowerNode) Config() config.Local {
+	return node.config
+}
+
+// Start the node: connect to peers and run the catchup service. Doesn't wait for initial sync.
+func (node *AlgorandFollowerNode) Start() {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	// Set up a context we can use to cancel goroutines on Stop()
+	node.ctx, node.cancelCtx = context.WithCancel(context.Background())
+
+	// The start network is being called only after the various services start up.
+	// We want to do so in order to let the services register their callbacks with the
+	// network package before any connections are being made.
+	startNetwork := func() {
+		if !node.config.DisableNetworking {
+			// start accepting connections
+			node.net.Start()
+			node.config.NetAddress, _ = node.net.Address()
+		}
+	}
+
+	if node.catchpointCatchupService != nil {
+		startNetwork()
+		node.catchpointCatchupService.Start(node.ctx)
+	} else {
+		node.catchupService.Start()
+		node.blockService.Start()
+		startNetwork()
+	}
+}
+
+// ListeningAddress retrieves the node's current listening address, if any.
+// Returns true if currently listening, false otherwise.
+func (node *AlgorandFollowerNode) ListeningAddress() (string, bool) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.net.Address()
+}
+
+// Stop stops running the node. Once a node is closed, it can never start again.
+func (node *AlgorandFollowerNode) Stop() {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.net.ClearHandlers()
+	if !node.config.DisableNetworking {
+		node.net.Stop()
+	}
+	if node.catchpointCatchupService != nil {
+		node.catchpointCatchupService.Stop()
+	} else {
+		node.catchupService.Stop()
+		node.blockService.Stop()
+	}
+	node.catchupBlockAuth.Quit()
+	node.lowPriorityCryptoVerificationPool.Shutdown()
+	node.cryptoPool.Shutdown()
+	node.cancelCtx()
+}
+
+// Ledger exposes the node's ledger handle to the algod API code
+func (node *AlgorandFollowerNode) Ledger() *data.Ledger {
+	return node.ledger
+}
+
+// BroadcastSignedTxGroup is not supported on a follower node, which never relays transactions.
+func (node *AlgorandFollowerNode) BroadcastSignedTxGroup(_ []transactions.SignedTxn) error {
+	return ErrFollowerNodeUnsupported
+}
+
+// Simulate speculatively runs a transaction group against the current
+// blockchain state and returns the effects and/or errors that would result.
+func (node *AlgorandFollowerNode) Simulate(request simulation.Request) (result simulation.Result, err error) {
+	simulator := simulation.MakeSimulator(node.ledger, node.Config().MaxAcctLookback)
+	return simulator.Simulate(request)
+}
+
+// GetPendingTransaction looks for the required txID in the recent ledger
+// blocks. A follower node has no transaction pool, so a transaction is only
+// found once it has been committed.
+func (node *AlgorandFollowerNode) GetPendingTransaction(txID transactions.Txid) (res TxnWithStatus, found bool) {
+	var maxLife basics.Round
+	latest := node.ledger.Latest()
+	proto, err := node.ledger.ConsensusParams(latest)
+	if err == nil {
+		maxLife = basics.Round(proto.MaxTxnLife)
+	} else {
+		node.log.Errorf("node.GetPendingTransaction: cannot get consensus params for latest round %v", latest)
+	}
+
+	// Search from newest to oldest round up to the max life of a transaction.
+	maxRound := latest
+	minRound := maxRound.SubSaturate(maxLife)
+
+	// Since we're using uint64, if the minRound is 0, we need to check for an underflow.
+	if minRound == 0 {
+		minRound++
+	}
+
+	for r := maxRound; r >= minRound; r-- {
+		tx, found, err := node.ledger.LookupTxid(txID, r)
+		if err != nil || !found {
+			continue
+		}
+		return TxnWithStatus{
+			Txn:            tx.SignedTxn,
+			ConfirmedRound: r,
+			ApplyData:      tx.ApplyData,
+		}, true
+	}
+	return
+}
+
+// Status returns a StatusReport structure reporting our status as Active and with our ledger's LastRound
+func (node *AlgorandFollowerNode) Status() (s StatusReport, err error) {
+	node.syncStatusMu.Lock()
+	s.LastRoundTimestamp = node.lastRoundTimestamp
+	s.HasSyncedSinceStartup = node.hasSyncedSinceStartup
+	node.syncStatusMu.Unlock()
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService != nil {
+		catchpointCatchupStatus(&s, node.catchpointCatchupService)
+	} else {
+		err = latestRoundStatus(&s, node.ledger, node.catchupService)
+	}
+
+	return
+}
+
+// GenesisID returns the ID of the genesis node.
+func (node *AlgorandFollowerNode) GenesisID() string {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.genesisID
+}
+
+// GenesisHash returns the hash of the genesis configuration.
+func (node *AlgorandFollowerNode) GenesisHash() crypto.Digest {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.genesisHash
+}
+
+// SuggestedFee returns zero, since a follower node has no transaction pool to estimate the fee from.
+func (node *AlgorandFollowerNode) SuggestedFee() basics.MicroAlgos {
+	return basics.MicroAlgos{}
+}
+
+// GetPendingTxnsFromPool is not supported on a follower node, which has no transaction pool.
+func (node *AlgorandFollowerNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
+	return nil, ErrFollowerNodeUnsupported
+}
+
+// SubscribePendingTxEvents is not supported on a follower node, which has no transaction pool.
+func (node *AlgorandFollowerNode) SubscribePendingTxEvents(_ pools.TxEventFilter) (<-chan pools.TxEvent, func(), error) {
+	return nil, nil, ErrFollowerNodeUnsupported
+}
+
+// PeerTraffic returns the connected peers and their traffic, by tag.
+func (node *AlgorandFollowerNode) PeerTraffic() []network.PeerTraffic {
+	return node.net.PeerTraffic()
+}
+
+// GetPeerBans returns the active bans of peer addresses.
+func (node *AlgorandFollowerNode) GetPeerBans() []network.PeerBan {
+	return node.net.GetPeerBans()
+}
+
+// BanPeer bans a peer address for the given duration, or for the configured duration if zero.
+func (node *AlgorandFollowerNode) BanPeer(address string, duration time.Duration) network.PeerBan {
+	return node.net.BanPeer(address, duration)
+}
+
+// UnbanPeer lifts the ban of a peer address. It returns false if the address was not banned.
+func (node *AlgorandFollowerNode) UnbanPeer(address string) bool {
+	return node.net.UnbanPeer(address)
+}
+
+// ListParticipationKeys is not supported on a follower node, which never holds participation keys.
+func (node *AlgorandFollowerNode) ListParticipationKeys() ([]account.ParticipationRecord, error) {
+	return nil, ErrFollowerNodeUnsupported
+}
+
+// GetParticipationKey is not supported on a follower node, which never holds participation keys.
+func (node *AlgorandFollowerNode) GetParticipationKey(_ account.ParticipationID) (account.ParticipationRecord, error) {
+	return account.ParticipationRecord{}, ErrFollowerNodeUnsupported
+}
+
+// RemoveParticipationKey is not supported on a follower node, which never holds participation keys.
+func (node *AlgorandFollowerNode) RemoveParticipationKey(_ account.ParticipationID) error {
+	return ErrFollowerNodeUnsupported
+}
+
+// AppendParticipationKeys is not supported on a follower node, which never holds participation keys.
+func (node *AlgorandFollowerNode) AppendParticipationKeys(_ account.ParticipationID, _ account.StateProofKeys) error {
+	return ErrFollowerNodeUnsupported
+}
+
+// InstallParticipationKey is not supported on a follower node, which never holds participation keys.
+func (node *AlgorandFollowerNode) InstallParticipationKey(_ []byte) (account.ParticipationID, error) {
+	return account.ParticipationID{}, ErrFollowerNodeUnsupported
+}
+
+// IsArchival returns true the node is an archival node, false otherwise
+func (node *AlgorandFollowerNode) IsArchival() bool {
+	return node.config.Archival
+}
+
+// IsParticipating always returns false, since a follower node never participates in consensus.
+func (node *AlgorandFollowerNode) IsParticipating() bool {
+	return false
+}
+
+// OnNewBlock implements the BlockListener interface so we're notified after each block is written to the ledger
+func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	node.newBlocks.notify()
+
+	if node.ledger.Latest() > block.Round() {
+		return
+	}
+	node.syncStatusMu.Lock()
+	node.lastRoundTimestamp = time.Now()
+	node.hasSyncedSinceStartup = true
+	node.syncStatusMu.Unlock()
+}
+
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
+// this function is intended to be called externally via the REST api interface.
+// The optional source allows using a local catchpoint file and blocks directory instead of the network.
+func (node *AlgorandFollowerNode) StartCatchup(catchpoint string, source catchup.CatchpointCatchupSource) error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService != nil {
+		stats := node.catchpointCatchupService.GetStatistics()
+		// No need to return an error
+		if catchpoint == stats.CatchpointLabel {
+			return MakeCatchpointAlreadyInProgressError(catchpoint)
+		}
+		return MakeCatchpointUnableToStartError(stats.CatchpointLabel, catchpoint)
+	}
+	var err error
+	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, source, node, node.log, node.net, accessor, node.config)
+	if err != nil {
+		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
+		return err
+	}
+	node.catchpointCatchupService.Start(node.ctx)
+	node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	return nil
+}
+
+// AbortCatchup aborts the given catchpoint
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFollowerNode) AbortCatchup(catchpoint string) error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService == nil {
+		return nil
+	}
+	stats := node.catchpointCatchupService.GetStatistics()
+	if stats.CatchpointLabel != catchpoint {
+		return fmt.Errorf("unable to abort catchpoint catchup for '%s' - already catching up '%s'", catchpoint, stats.CatchpointLabel)
+	}
+	node.catchpointCatchupService.Abort()
+	return nil
+}
+
+// RequestCatchpoint requests the generation of a catchpoint label and file, taking the accounts snapshot at the next accounts commit.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFollowerNode) RequestCatchpoint() (ledger.CatchpointGenerationStatus, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService != nil {
+		stats := node.catchpointCatchupService.GetStatistics()
+		return ledger.CatchpointGenerationStatus{}, fmt.Errorf("unable to generate a catchpoint while catching up to catchpoint '%s'", stats.CatchpointLabel)
+	}
+	return node.ledger.RequestCatchpoint()
+}
+
+// GetCatchpointGenerationStatus returns the status of the last catchpoint generation requested through RequestCatchpoint.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFollowerNode) GetCatchpointGenerationStatus() (ledger.CatchpointGenerationStatus, bool) {
+	return node.ledger.GetCatchpointGenerationStatus()
+}
+
+// PendingStateProofs is not supported on a follower node, which doesn't collect state proof signatures.
+func (node *AlgorandFollowerNode) PendingStateProofs(_ int) ([]stateproof.BuilderStatus, error) {
+	return nil, ErrFollowerNodeUnsupported
+}
+
+// PendingStateProofVoters is not supported on a follower node, which doesn't collect state proof signatures.
+func (node *AlgorandFollowerNode) PendingStateProofVoters(_ basics.Round) ([]stateproof.VoterStatus, error) {
+	return nil, ErrFollowerNodeUnsupported
+}
+
+// SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
+// channel which contains the updated node context. This function need to work asynchronously so that the caller could
+// detect and handle the use case where the node is being shut down while we're switching to/from catchup mode without
+// deadlocking on the shared node mutex.
+func (node *AlgorandFollowerNode) SetCatchpointCatchupMode(catchpointCatchupMode bool) (outCtxCh <-chan context.Context) {
+	// create a non-buffered channel to return the newly created context. The fact that it's non-buffered here
+	// is important, as it allows us to synchronize the "receiving" of the new context before canceling of the previous
+	// one.
+	ctxCh := make(chan context.Context)
+	outCtxCh = ctxCh
+	go func() {
+		node.mu.Lock()
+		defer node.mu.Unlock()
+		// check that the node wasn't canceled. If it have been canceled, it means that the node.Stop() was called, in which case
+		// we should close the channel.
+		if node.ctx.Err() == context.Canceled {
+			close(ctxCh)
+			return
+		}
+		if catchpointCatchupMode {
+			// stop..
+			node.net.ClearHandlers()
+			node.catchupService.Stop()
+			node.blockService.Stop()
+
+			prevNodeCancelFunc := node.cancelCtx
+
+			// Set up a context we can use to cancel goroutines on Stop()
+			node.ctx, node.cancelCtx = context.WithCancel(context.Background())
+			ctxCh <- node.ctx
+
+			prevNodeCancelFunc()
+			return
+		}
+		// start
+		node.catchupService.Start()
+		node.blockService.Start()
+
+		// Set up a context we can use to cancel goroutines on Stop()
+		node.ctx, node.cancelCtx = context.WithCancel(context.Background())
+
+		// at this point, the catchpoint catchup is done ( either successfully or not.. )
+		node.catchpointCatchupService = nil
+
+		ctxCh <- node.ctx
+	}()
+	return
+}
+
+// SetSyncRound sets the minimum sync round on the catchup service
+func (node *AlgorandFollowerNode) SetSyncRound(rnd uint64) error {
+	// Calculate the first round for which we want to disable catchup from the network.
+	// This is based on the size of the cache used in the ledger.
+	disableSyncRound := rnd + node.Config().MaxAcctLookback
+	return node.catchupService.SetDisableSyncRound(disableSyncRound)
+}
+
+// GetSyncRound retrieves the sync round, removes cache offset used during SetSyncRound
+func (node *AlgorandFollowerNode) GetSyncRound() uint64 {
+	return node.catchupService.GetDisableSyncRound() - node.Config().MaxAcctLookback
+}
+
+// UnsetSyncRound removes the sync round constraint on the catchup service
+func (node *AlgorandFollowerNode) UnsetSyncRound() {
+	node.catchupService.UnsetDisableSyncRound()
+}
+
+// SubscribeNewBlocks returns a channel that receives a value after blocks are
+// added to the ledger, and a function that ends the subscription.
+func (node *AlgorandFollowerNode) SubscribeNewBlocks() (<-chan struct{}, func()) {
+	return node.newBlocks.subscribe()
+}
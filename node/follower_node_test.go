@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func followerGenesis() bookkeeping.Genesis {
+	return bookkeeping.Genesis{
+		SchemaID:    "go-test-follower-node-genesis",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+		Allocation: []bookkeeping.GenesisAllocation{
+			{
+				Address: poolAddr.String(),
+				State:   basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000000}},
+			},
+			{
+				Address: sinkAddr.String(),
+				State:   basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000000}},
+			},
+		},
+	}
+}
+
+func setupFollowNode(t *testing.T) *AlgorandFollowerNode {
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	node, err := MakeFollower(logging.TestingLog(t), t.TempDir(), cfg, []string{}, followerGenesis())
+	require.NoError(t, err)
+	return node
+}
+
+func TestFollowerNodeSyncRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := setupFollowNode(t)
+	node.Start()
+	defer node.Stop()
+
+	// the sync round starts at the next round, so that deltas are retained until it is advanced
+	require.Equal(t, uint64(node.Ledger().NextRound()), node.GetSyncRound())
+
+	require.NoError(t, node.SetSyncRound(10))
+	require.Equal(t, uint64(10), node.GetSyncRound())
+
+	node.UnsetSyncRound()
+	require.Zero(t, node.catchupService.GetDisableSyncRound())
+}
+
+func TestFollowerNodeNeverParticipates(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := setupFollowNode(t)
+	require.False(t, node.IsParticipating())
+	require.Zero(t, node.SuggestedFee())
+
+	require.ErrorIs(t, node.BroadcastSignedTxGroup(nil), ErrFollowerNodeUnsupported)
+	_, err := node.GetPendingTxnsFromPool()
+	require.ErrorIs(t, err, ErrFollowerNodeUnsupported)
+	_, _, err = node.SubscribePendingTxEvents(pools.TxEventFilter{})
+	require.ErrorIs(t, err, ErrFollowerNodeUnsupported)
+	_, err = node.ListParticipationKeys()
+	require.ErrorIs(t, err, ErrFollowerNodeUnsupported)
+	_, err = node.GetParticipationKey(account.ParticipationID{})
+	require.ErrorIs(t, err, ErrFollowerNodeUnsupported)
+	require.ErrorIs(t, node.RemoveParticipationKey(account.ParticipationID{}), ErrFollowerNodeUnsupported)
+	require.ErrorIs(t, node.AppendParticipationKeys(account.ParticipationID{}, nil), ErrFollowerNodeUnsupported)
+	_, err = node.InstallParticipationKey(nil)
+	require.ErrorIs(t, err, ErrFollowerNodeUnsupported)
+}
+
+func TestFollowerNodeRejectedModes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+
+	genesis := followerGenesis()
+	genesis.DevMode = true
+	_, err := MakeFollower(logging.TestingLog(t), t.TempDir(), cfg, []string{}, genesis)
+	require.ErrorContains(t, err, "DevMode")
+
+	cfg.NetAddress = ":4160"
+	_, err = MakeFollower(logging.TestingLog(t), t.TempDir(), cfg, []string{}, followerGenesis())
+	require.ErrorContains(t, err, "relays")
+}
@@ -150,6 +150,8 @@ type AlgorandFullNode struct {
 	oldKeyDeletionNotify        chan struct{}
 	monitoringRoutinesWaitGroup sync.WaitGroup
 
+	newBlocks blockNotifications
+
 	tracer messagetracer.MessageTracer
 
 	stateProofWorker *stateproof.Worker
@@ -208,6 +210,14 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		log.Errorf("Unable to create genesis directory: %v", err)
 		return nil, err
 	}
+	err = p2pNode.LoadPeerBans(filepath.Join(genesisDir, config.PeerBansFilename))
+	if err != nil {
+		log.Warnf("Unable to load the peer bans: %v", err)
+	}
+	err = p2pNode.LoadPhonebook(filepath.Join(genesisDir, config.KnownPeersFilename))
+	if err != nil {
+		log.Warnf("Unable to load the known peers: %v", err)
+	}
 	genalloc, err := genesis.Balances()
 	if err != nil {
 		log.Errorf("Cannot load genesis allocation: %v", err)
@@ -557,9 +567,9 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 
 // Simulate speculatively runs a transaction group against the current
 // blockchain state and returns the effects and/or errors that would result.
-func (node *AlgorandFullNode) Simulate(txgroup []transactions.SignedTxn) (vb *ledgercore.ValidatedBlock, missingSignatures bool, err error) {
-	simulator := simulation.MakeSimulator(node.ledger)
-	return simulator.Simulate(txgroup)
+func (node *AlgorandFullNode) Simulate(request simulation.Request) (result simulation.Result, err error) {
+	simulator := simulation.MakeSimulator(node.ledger, node.Config().MaxAcctLookback)
+	return simulator.Simulate(request)
 }
 
 // ListTxns returns SignedTxns associated with a specific account in a range of Rounds (inclusive).
@@ -687,54 +697,62 @@ func (node *AlgorandFullNode) Status() (s StatusReport, err error) {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.catchpointCatchupService != nil {
-		// we're in catchpoint catchup mode.
-		lastBlockHeader := node.catchpointCatchupService.GetLatestBlockHeader()
-		s.LastRound = lastBlockHeader.Round
-		s.LastVersion = lastBlockHeader.CurrentProtocol
-		s.NextVersion, s.NextVersionRound, s.NextVersionSupported = lastBlockHeader.NextVersionInfo()
-		s.StoppedAtUnsupportedRound = s.LastRound+1 == s.NextVersionRound && !s.NextVersionSupported
-
-		// for now, I'm leaving this commented out. Once we refactor some of the ledger locking mechanisms, we
-		// should be able to make this call work.
-		//s.LastCatchpoint = node.ledger.GetLastCatchpointLabel()
-
-		// report back the catchpoint catchup progress statistics
-		stats := node.catchpointCatchupService.GetStatistics()
-		s.Catchpoint = stats.CatchpointLabel
-		s.CatchpointCatchupTotalAccounts = stats.TotalAccounts
-		s.CatchpointCatchupProcessedAccounts = stats.ProcessedAccounts
-		s.CatchpointCatchupVerifiedAccounts = stats.VerifiedAccounts
-		s.CatchpointCatchupTotalKVs = stats.TotalKVs
-		s.CatchpointCatchupProcessedKVs = stats.ProcessedKVs
-		s.CatchpointCatchupVerifiedKVs = stats.VerifiedKVs
-		s.CatchpointCatchupTotalBlocks = stats.TotalBlocks
-		s.CatchpointCatchupAcquiredBlocks = stats.AcquiredBlocks
-		s.CatchupTime = time.Now().Sub(stats.StartTime)
+		catchpointCatchupStatus(&s, node.catchpointCatchupService)
 	} else {
-		// we're not in catchpoint catchup mode
-		var b bookkeeping.BlockHeader
-		s.LastRound = node.ledger.Latest()
-		b, err = node.ledger.BlockHdr(s.LastRound)
-		if err != nil {
-			return
-		}
-		s.LastVersion = b.CurrentProtocol
-		s.NextVersion, s.NextVersionRound, s.NextVersionSupported = b.NextVersionInfo()
+		err = latestRoundStatus(&s, node.ledger, node.catchupService)
+	}
 
-		s.StoppedAtUnsupportedRound = s.LastRound+1 == s.NextVersionRound && !s.NextVersionSupported
-		s.LastCatchpoint = node.ledger.GetLastCatchpointLabel()
-		s.SynchronizingTime = node.catchupService.SynchronizingTime()
-		s.CatchupTime = node.catchupService.SynchronizingTime()
+	return
+}
 
-		s.UpgradePropose = b.UpgradeVote.UpgradePropose
-		s.UpgradeApprove = b.UpgradeApprove
-		s.UpgradeDelay = uint64(b.UpgradeVote.UpgradeDelay)
-		s.NextProtocolVoteBefore = b.NextProtocolVoteBefore
-		s.NextProtocolApprovals = b.UpgradeState.NextProtocolApprovals
+// catchpointCatchupStatus fills in the status of a node in catchpoint catchup mode.
+func catchpointCatchupStatus(s *StatusReport, catchpointCatchupService *catchup.CatchpointCatchupService) {
+	lastBlockHeader := catchpointCatchupService.GetLatestBlockHeader()
+	s.LastRound = lastBlockHeader.Round
+	s.LastVersion = lastBlockHeader.CurrentProtocol
+	s.NextVersion, s.NextVersionRound, s.NextVersionSupported = lastBlockHeader.NextVersionInfo()
+	s.StoppedAtUnsupportedRound = s.LastRound+1 == s.NextVersionRound && !s.NextVersionSupported
+
+	// for now, I'm leaving this commented out. Once we refactor some of the ledger locking mechanisms, we
+	// should be able to make this call work.
+	//s.LastCatchpoint = node.ledger.GetLastCatchpointLabel()
+
+	// report back the catchpoint catchup progress statistics
+	stats := catchpointCatchupService.GetStatistics()
+	s.Catchpoint = stats.CatchpointLabel
+	s.CatchpointCatchupTotalAccounts = stats.TotalAccounts
+	s.CatchpointCatchupProcessedAccounts = stats.ProcessedAccounts
+	s.CatchpointCatchupVerifiedAccounts = stats.VerifiedAccounts
+	s.CatchpointCatchupTotalKVs = stats.TotalKVs
+	s.CatchpointCatchupProcessedKVs = stats.ProcessedKVs
+	s.CatchpointCatchupVerifiedKVs = stats.VerifiedKVs
+	s.CatchpointCatchupTotalBlocks = stats.TotalBlocks
+	s.CatchpointCatchupAcquiredBlocks = stats.AcquiredBlocks
+	s.CatchupTime = time.Now().Sub(stats.StartTime)
+}
 
+// latestRoundStatus fills in the status of a node that is not in catchpoint
+// catchup mode from its latest block.
+func latestRoundStatus(s *StatusReport, ledger *data.Ledger, catchupService *catchup.Service) error {
+	s.LastRound = ledger.Latest()
+	b, err := ledger.BlockHdr(s.LastRound)
+	if err != nil {
+		return err
 	}
+	s.LastVersion = b.CurrentProtocol
+	s.NextVersion, s.NextVersionRound, s.NextVersionSupported = b.NextVersionInfo()
 
-	return
+	s.StoppedAtUnsupportedRound = s.LastRound+1 == s.NextVersionRound && !s.NextVersionSupported
+	s.LastCatchpoint = ledger.GetLastCatchpointLabel()
+	s.SynchronizingTime = catchupService.SynchronizingTime()
+	s.CatchupTime = catchupService.SynchronizingTime()
+
+	s.UpgradePropose = b.UpgradeVote.UpgradePropose
+	s.UpgradeApprove = b.UpgradeApprove
+	s.UpgradeDelay = uint64(b.UpgradeVote.UpgradeDelay)
+	s.NextProtocolVoteBefore = b.NextProtocolVoteBefore
+	s.NextProtocolApprovals = b.UpgradeState.NextProtocolApprovals
+	return nil
 }
 
 // GenesisID returns the ID of the genesis node.
@@ -1031,6 +1049,8 @@ func (node *AlgorandFullNode) IsArchival() bool {
 
 // OnNewBlock implements the BlockListener interface so we're notified after each block is written to the ledger
 func (node *AlgorandFullNode) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	node.newBlocks.notify()
+
 	if node.ledger.Latest() > block.Round() {
 		return
 	}
@@ -1128,7 +1148,8 @@ func (node *AlgorandFullNode) GetTransactionByID(txid transactions.Txid, rnd bas
 
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
 // this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+// The optional source allows using a local catchpoint file and blocks directory instead of the network.
+func (node *AlgorandFullNode) StartCatchup(catchpoint string, source catchup.CatchpointCatchupSource) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.indexer != nil {
@@ -1144,7 +1165,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, source, node, node.log, node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1170,6 +1191,37 @@ func (node *AlgorandFullNode) AbortCatchup(catchpoint string) error {
 	return nil
 }
 
+// RequestCatchpoint requests the generation of a catchpoint label and file, taking the accounts snapshot at the next accounts commit.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) RequestCatchpoint() (ledger.CatchpointGenerationStatus, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService != nil {
+		stats := node.catchpointCatchupService.GetStatistics()
+		return ledger.CatchpointGenerationStatus{}, fmt.Errorf("unable to generate a catchpoint while catching up to catchpoint '%s'", stats.CatchpointLabel)
+	}
+	return node.ledger.RequestCatchpoint()
+}
+
+// GetCatchpointGenerationStatus returns the status of the last catchpoint generation requested through RequestCatchpoint.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) GetCatchpointGenerationStatus() (ledger.CatchpointGenerationStatus, bool) {
+	return node.ledger.GetCatchpointGenerationStatus()
+}
+
+// PendingStateProofs returns the state proofs the node collects signatures for, reporting at most
+// maxMissingSigners of the heaviest voters that haven't signed each of them.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) PendingStateProofs(maxMissingSigners int) ([]stateproof.BuilderStatus, error) {
+	return node.stateProofWorker.BuilderStatuses(maxMissingSigners), nil
+}
+
+// PendingStateProofVoters returns the voters of the state proof of rnd, and whether the node collected their signatures.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) PendingStateProofVoters(rnd basics.Round) ([]stateproof.VoterStatus, error) {
+	return node.stateProofWorker.BuilderVoters(rnd)
+}
+
 // SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
 // channel which contains the updated node context. This function need to work asynchronously so that the caller could
 // detect and handle the use case where the node is being shut down while we're switching to/from catchup mode without
@@ -1415,6 +1467,53 @@ func (node *AlgorandFullNode) GetSyncRound() uint64 {
 	return node.catchupService.GetDisableSyncRound() - node.Config().MaxAcctLookback
 }
 
+// SubscribeNewBlocks returns a channel that receives a value after blocks are
+// added to the ledger, and a function that ends the subscription. Pending
+// notifications are coalesced, so the receiver should read every block after
+// the last one it has seen from the ledger.
+func (node *AlgorandFullNode) SubscribeNewBlocks() (<-chan struct{}, func()) {
+	return node.newBlocks.subscribe()
+}
+
+// SubscribePendingTxEvents returns a channel receiving the events of the pending transactions
+// matching filter, and a function that ends the subscription. The channel is closed if the
+// receiver falls too far behind.
+func (node *AlgorandFullNode) SubscribePendingTxEvents(filter pools.TxEventFilter) (<-chan pools.TxEvent, func(), error) {
+	events, unsubscribe := node.transactionPool.SubscribeTxEvents(filter)
+	return events, unsubscribe, nil
+}
+
+// PeerTraffic returns the connected peers and their traffic, by tag.
+func (node *AlgorandFullNode) PeerTraffic() []network.PeerTraffic {
+	return node.net.PeerTraffic()
+}
+
+// GetPeerBans returns the active bans of peer addresses.
+func (node *AlgorandFullNode) GetPeerBans() []network.PeerBan {
+	return node.net.GetPeerBans()
+}
+
+// BanPeer bans a peer address for the given duration, or for the configured duration if zero.
+func (node *AlgorandFullNode) BanPeer(address string, duration time.Duration) network.PeerBan {
+	return node.net.BanPeer(address, duration)
+}
+
+// UnbanPeer lifts the ban of a peer address. It returns false if the address was not banned.
+func (node *AlgorandFullNode) UnbanPeer(address string) bool {
+	return node.net.UnbanPeer(address)
+}
+
+// ReplayNetworkCapture dispatches the incoming messages of a network message capture to the
+// message handlers of the node, as if they were received from the network. The node should be
+// started with DisableNetworking, so that only the captured messages reach the handlers.
+func (node *AlgorandFullNode) ReplayNetworkCapture(ctx context.Context, filenames []string, opts network.ReplayOptions) (network.ReplayStats, error) {
+	wn, ok := node.net.(*network.WebsocketNetwork)
+	if !ok {
+		return network.ReplayStats{}, fmt.Errorf("the node network does not support replaying network message captures")
+	}
+	return wn.ReplayCapture(ctx, filenames, opts)
+}
+
 // UnsetSyncRound removes the sync round constraint on the catchup service
 func (node *AlgorandFullNode) UnsetSyncRound() {
 	node.catchupService.UnsetDisableSyncRound()
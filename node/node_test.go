@@ -539,3 +539,38 @@ func TestOfflineOnlineClosedBitStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockNotifications(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var bn blockNotifications
+	ch1, unsubscribe1 := bn.subscribe()
+	ch2, unsubscribe2 := bn.subscribe()
+	defer unsubscribe2()
+
+	// notifications are coalesced, and never block the notifier
+	bn.notify()
+	bn.notify()
+	for _, ch := range []<-chan struct{}{ch1, ch2} {
+		select {
+		case <-ch:
+		default:
+			require.Fail(t, "subscriber was not notified")
+		}
+		select {
+		case <-ch:
+			require.Fail(t, "notifications were not coalesced")
+		default:
+		}
+	}
+
+	unsubscribe1()
+	bn.notify()
+	select {
+	case <-ch1:
+		require.Fail(t, "unsubscribed subscriber was notified")
+	default:
+	}
+	<-ch2
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"net/http"
+	"net/url"
 	"path"
 	"strconv"
 	"strings"
@@ -45,11 +46,26 @@ import (
 
 // BlockResponseContentType is the HTTP Content-Type header for a raw binary block
 const BlockResponseContentType = "application/x-algorand-block-v1"
+
+// BlockRangeResponseContentType is the HTTP Content-Type header for a range of raw binary blocks
+const BlockRangeResponseContentType = "application/x-algorand-block-range-v1"
 const blockResponseHasBlockCacheControl = "public, max-age=31536000, immutable"    // 31536000 seconds are one year.
 const blockResponseMissingBlockCacheControl = "public, max-age=1, must-revalidate" // cache for 1 second, and force revalidation afterward
 const blockServerMaxBodyLength = 512                                               // we don't really pass meaningful content here, so 512 bytes should be a safe limit
 const blockServerCatchupRequestBufferSize = 10
 
+// MaxBlockRangeCount is the maximum number of blocks the block service returns for a single range request
+const MaxBlockRangeCount = 64
+
+// a range response stops before the first block that would take it over these sizes. the gossip limit
+// keeps the response within the maximum websocket message length.
+const blockRangeMaxHTTPBytes = 32 << 20
+const blockRangeMaxGossipBytes = 4 << 20
+
+// BlockRangeCountParam is the query argument of a block request asking for a range of consecutive blocks
+// starting at the requested round, e.g. /v1/{genesisID}/block/{round}?count=16
+const BlockRangeCountParam = "count"
+
 // BlockServiceBlockPath is the path to register BlockService as a handler for when using gorilla/mux
 // e.g. .Handle(BlockServiceBlockPath, &ls)
 const BlockServiceBlockPath = "/v{version:[0-9.]+}/{genesisID}/block/{round:[0-9a-z]+}"
@@ -61,6 +77,9 @@ const (
 	BlockDataKey       = "blockData"       // Block-data topic-key in the response
 	CertDataKey        = "certData"        // Cert-data topic-key in the response
 	BlockAndCertValue  = "blockAndCert"    // block+cert request data (as the value of requestDataTypeKey)
+	BlockRangeValue    = "blockRange"      // consecutive block+cert request data (as the value of requestDataTypeKey)
+	CountKey           = "countKey"        // Number of blocks topic-key in a block range request
+	BlockRangeDataKey  = "blockRangeData"  // Block-range-data topic-key in the response
 )
 
 var errBlockServiceClosed = errors.New("block service is shutting down")
@@ -102,6 +121,13 @@ type PreEncodedBlockCert struct {
 	Certificate codec.Raw `codec:"cert"`
 }
 
+// PreEncodedBlockCertRange defines how a range of consecutive blocks and their certificates is encoded,
+// using pre-encoded Blocks and Certificates in msgpack format.
+//msgp:ignore PreEncodedBlockCertRange
+type PreEncodedBlockCertRange struct {
+	Blocks []PreEncodedBlockCert `codec:"blocks"`
+}
+
 type fallbackEndpoints struct {
 	endpoints []string
 	lastUsed  int
@@ -214,7 +240,25 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		response.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	encodedBlockCert, err := bs.rawBlockBytes(basics.Round(round))
+	count := uint64(1)
+	if countStr := request.URL.Query().Get(BlockRangeCountParam); countStr != "" {
+		count, err = strconv.ParseUint(countStr, 10, 64)
+		if err != nil || count == 0 {
+			bs.log.Debug("http block range count parse fail", countStr, err)
+			response.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if count > MaxBlockRangeCount {
+			count = MaxBlockRangeCount
+		}
+	}
+	var encodedBlockCert []byte
+	var blocks uint64
+	if count > 1 {
+		encodedBlockCert, blocks, err = bs.rawBlockRangeBytes(basics.Round(round), count)
+	} else {
+		encodedBlockCert, err = bs.rawBlockBytes(basics.Round(round))
+	}
 	if err != nil {
 		switch err.(type) {
 		case ledgercore.ErrNoEntry:
@@ -233,9 +277,18 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		}
 	}
 
-	response.Header().Set("Content-Type", BlockResponseContentType)
+	if count > 1 {
+		response.Header().Set("Content-Type", BlockRangeResponseContentType)
+	} else {
+		response.Header().Set("Content-Type", BlockResponseContentType)
+	}
 	response.Header().Set("Content-Length", strconv.Itoa(len(encodedBlockCert)))
-	response.Header().Set("Cache-Control", blockResponseHasBlockCacheControl)
+	if count > 1 && blocks < count {
+		// a partial range may grow as the ledger advances, don't let it be cached for long.
+		response.Header().Set("Cache-Control", blockResponseMissingBlockCacheControl)
+	} else {
+		response.Header().Set("Cache-Control", blockResponseHasBlockCacheControl)
+	}
 	response.WriteHeader(http.StatusOK)
 	_, err = response.Write(encodedBlockCert)
 	if err != nil {
@@ -272,7 +325,12 @@ const noRoundNumberErrMsg = "can't find the round number"
 const noDataTypeErrMsg = "can't find the data-type"
 const roundNumberParseErrMsg = "unable to parse round number"
 const blockNotAvailableErrMsg = "requested block is not available"
-const datatypeUnsupportedErrMsg = "requested data type is unsupported"
+const noCountErrMsg = "can't find the block count"
+const countParseErrMsg = "unable to parse block count"
+
+// DatatypeUnsupportedErrMsg is the error responded to block requests of an unknown data type, such as block
+// range requests sent to block services that predate them.
+const DatatypeUnsupportedErrMsg = "requested data type is unsupported"
 
 // a blocking function for handling a catchup request
 func (bs *BlockService) handleCatchupReq(ctx context.Context, reqMsg network.IncomingMessage) {
@@ -315,6 +373,10 @@ func (bs *BlockService) handleCatchupReq(ctx context.Context, reqMsg network.Inc
 				[]byte(roundNumberParseErrMsg))}
 		return
 	}
+	if string(requestType) == BlockRangeValue {
+		respTopics = topicBlockRangeBytes(bs.log, bs.ledger, basics.Round(round), topics)
+		return
+	}
 	respTopics = topicBlockBytes(bs.log, bs.ledger, basics.Round(round), string(requestType))
 	return
 }
@@ -336,6 +398,9 @@ func (bs *BlockService) redirectRequest(round uint64, response http.ResponseWrit
 		return false
 	}
 	parsedURL.Path = strings.Replace(FormatBlockQuery(round, parsedURL.Path, bs.net), "{genesisID}", bs.genesisID, 1)
+	if count := request.URL.Query().Get(BlockRangeCountParam); count != "" {
+		parsedURL.RawQuery = url.Values{BlockRangeCountParam: []string{count}}.Encode()
+	}
 	http.Redirect(response, request, parsedURL.String(), http.StatusTemporaryRedirect)
 	bs.log.Debugf("redirectRequest: redirected block request to %s", parsedURL.String())
 	return true
@@ -386,6 +451,22 @@ func (bs *BlockService) rawBlockBytes(round basics.Round) ([]byte, error) {
 	return RawBlockBytes(bs.ledger, round)
 }
 
+// rawBlockRangeBytes returns up to count consecutive blocks/certs starting at round, while taking the lock
+// to ensure the block service is currently active.
+func (bs *BlockService) rawBlockRangeBytes(round basics.Round, count uint64) ([]byte, uint64, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	select {
+	case _, ok := <-bs.stop:
+		if !ok {
+			// service is closed.
+			return nil, 0, errBlockServiceClosed
+		}
+	default:
+	}
+	return RawBlockRangeBytes(bs.ledger, round, count, blockRangeMaxHTTPBytes)
+}
+
 func topicBlockBytes(log logging.Logger, dataLedger LedgerForBlockService, round basics.Round, requestType string) network.Topics {
 	blk, cert, err := dataLedger.EncodedBlockCert(round)
 	if err != nil {
@@ -407,8 +488,38 @@ func topicBlockBytes(log logging.Logger, dataLedger LedgerForBlockService, round
 		}
 	default:
 		return network.Topics{
-			network.MakeTopic(network.ErrorKey, []byte(datatypeUnsupportedErrMsg))}
+			network.MakeTopic(network.ErrorKey, []byte(DatatypeUnsupportedErrMsg))}
+	}
+}
+
+func topicBlockRangeBytes(log logging.Logger, dataLedger LedgerForBlockService, round basics.Round, topics network.Topics) network.Topics {
+	countBytes, found := topics.GetValue(CountKey)
+	if !found {
+		log.Infof("BlockService topicBlockRangeBytes: %s", noCountErrMsg)
+		return network.Topics{
+			network.MakeTopic(network.ErrorKey, []byte(noCountErrMsg))}
+	}
+	count, read := binary.Uvarint(countBytes)
+	if read <= 0 || count == 0 {
+		log.Infof("BlockService topicBlockRangeBytes: %s", countParseErrMsg)
+		return network.Topics{
+			network.MakeTopic(network.ErrorKey, []byte(countParseErrMsg))}
+	}
+	if count > MaxBlockRangeCount {
+		count = MaxBlockRangeCount
+	}
+	data, _, err := RawBlockRangeBytes(dataLedger, round, count, blockRangeMaxGossipBytes)
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+		default:
+			log.Infof("BlockService topicBlockRangeBytes: %s", err)
+		}
+		return network.Topics{
+			network.MakeTopic(network.ErrorKey, []byte(blockNotAvailableErrMsg))}
 	}
+	return network.Topics{
+		network.MakeTopic(BlockRangeDataKey, data)}
 }
 
 // RawBlockBytes return the msgpack bytes for a block
@@ -428,6 +539,33 @@ func RawBlockBytes(l LedgerForBlockService, round basics.Round) ([]byte, error)
 	}), nil
 }
 
+// RawBlockRangeBytes returns the msgpack bytes for up to count consecutive blocks starting at round,
+// along with the number of blocks encoded. The range ends at the first block that is not available,
+// or before the first block that would take the encoded blocks over maxBytes. The block of round
+// itself is always included, and ledgercore.ErrNoEntry is returned if it is not available.
+func RawBlockRangeBytes(l LedgerForBlockService, round basics.Round, count uint64, maxBytes int) ([]byte, uint64, error) {
+	blocks := make([]PreEncodedBlockCert, 0, count)
+	size := 0
+	for i := uint64(0); i < count; i++ {
+		blk, cert, err := l.EncodedBlockCert(round + basics.Round(i))
+		if err == nil && len(cert) == 0 {
+			err = ledgercore.ErrNoEntry{Round: round + basics.Round(i)}
+		}
+		if err != nil {
+			if i == 0 {
+				return nil, 0, err
+			}
+			break
+		}
+		if i > 0 && size+len(blk)+len(cert) > maxBytes {
+			break
+		}
+		size += len(blk) + len(cert)
+		blocks = append(blocks, PreEncodedBlockCert{Block: blk, Certificate: cert})
+	}
+	return protocol.EncodeReflect(PreEncodedBlockCertRange{Blocks: blocks}), uint64(len(blocks)), nil
+}
+
 // FormatBlockQuery formats a block request query for the given network and round number
 func FormatBlockQuery(round uint64, parsedURL string, net network.GossipNode) string {
 	return net.SubstituteGenesisID(path.Join(parsedURL, "/v1/{genesisID}/block/"+strconv.FormatUint(uint64(round), 36)))
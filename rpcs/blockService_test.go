@@ -18,6 +18,7 @@ package rpcs
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"net/http"
@@ -227,6 +228,98 @@ func TestBlockServiceShutdown(t *testing.T) {
 	<-requestDone
 }
 
+// TestBlockServiceRange tests serving ranges of consecutive blocks over http and ws
+func TestBlockServiceRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+
+	ledger1 := makeLedger(t, "l1")
+	defer ledger1.Close()
+	for i := 0; i < 5; i++ {
+		addBlock(t, ledger1)
+	}
+
+	net1 := &httpTestPeerSource{}
+	config := config.GetDefaultLocal()
+	bs1 := MakeBlockService(log, config, ledger1, net1, "{genesisID}")
+
+	nodeA := &basicRPCNode{}
+	nodeA.RegisterHTTPHandler(BlockServiceBlockPath, bs1)
+	nodeA.start()
+	defer nodeA.stop()
+
+	decodeRange := func(data []byte) []basics.Round {
+		var blockRange PreEncodedBlockCertRange
+		require.NoError(t, protocol.DecodeReflect(data, &blockRange))
+		var rounds []basics.Round
+		for _, encoded := range blockRange.Blocks {
+			var blk bookkeeping.Block
+			require.NoError(t, protocol.Decode(encoded.Block, &blk))
+			rounds = append(rounds, blk.Round())
+		}
+		return rounds
+	}
+
+	getRange := func(round uint64, count string) *http.Response {
+		parsedURL, err := network.ParseHostOrURL(nodeA.rootURL())
+		require.NoError(t, err)
+		parsedURL.Path = FormatBlockQuery(round, parsedURL.Path, net1)
+		parsedURL.RawQuery = BlockRangeCountParam + "=" + count
+		response, err := http.Get(parsedURL.String())
+		require.NoError(t, err)
+		return response
+	}
+
+	response := getRange(2, "3")
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, BlockRangeResponseContentType, response.Header.Get("Content-Type"))
+	require.Equal(t, blockResponseHasBlockCacheControl, response.Header.Get("Cache-Control"))
+	data, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, []basics.Round{2, 3, 4}, decodeRange(data))
+
+	// a range past the latest round is cut short, and not cached for long
+	response = getRange(3, "10")
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, blockResponseMissingBlockCacheControl, response.Header.Get("Cache-Control"))
+	data, err = io.ReadAll(response.Body)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, []basics.Round{3, 4, 5}, decodeRange(data))
+
+	response = getRange(6, "10")
+	response.Body.Close()
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	response = getRange(2, "0")
+	response.Body.Close()
+	require.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	// over ws
+	roundBin := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(roundBin, 4)
+	countBin := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(countBin, 2)
+	peer := &mockUnicastPeer{}
+	reqTopics := network.Topics{
+		network.MakeTopic(RequestDataTypeKey, []byte(BlockRangeValue)),
+		network.MakeTopic(RoundKey, roundBin),
+		network.MakeTopic(CountKey, countBin),
+	}
+	bs1.handleCatchupReq(context.Background(), network.IncomingMessage{Sender: peer, Data: reqTopics.MarshallTopics()})
+	data, found := peer.responseTopics.GetValue(BlockRangeDataKey)
+	require.True(t, found)
+	require.Equal(t, []basics.Round{4, 5}, decodeRange(data))
+
+	reqTopics = reqTopics[:2]
+	bs1.handleCatchupReq(context.Background(), network.IncomingMessage{Sender: peer, Data: reqTopics.MarshallTopics()})
+	val, found := peer.responseTopics.GetValue(network.ErrorKey)
+	require.True(t, found)
+	require.Equal(t, noCountErrMsg, string(val))
+}
+
 // TestRedirectBasic tests the case when the block service redirects the request to elsewhere
 func TestRedirectFallbackEndpoints(t *testing.T) {
 	partitiontest.PartitionTest(t)
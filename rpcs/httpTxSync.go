@@ -38,6 +38,7 @@ import (
 // HTTPTxSync implements the TxSyncClient interface over HTTP
 type HTTPTxSync struct {
 	rootURL string
+	peer    network.Peer
 
 	peers network.GossipNode
 
@@ -104,6 +105,7 @@ func (hts *HTTPTxSync) Sync(ctx context.Context, bloom *bloom.Filter) (txgroups
 		return nil, fmt.Errorf("cannot HTTPTxSync non http peer %T %#v", peer, peer)
 	}
 	hts.rootURL = hpeer.GetAddress()
+	hts.peer = peer
 	client := hpeer.GetHTTPClient()
 	if client == nil {
 		client = &http.Client{}
@@ -184,6 +186,12 @@ func (hts *HTTPTxSync) Address() string {
 	return hts.rootURL
 }
 
+// Peer is part of TxSyncClient interface.
+// Returns the peer of the last sync.
+func (hts *HTTPTxSync) Peer() network.Peer {
+	return hts.peer
+}
+
 // Close is part of TxSyncClient interface
 //
 // Does nothing, leaves underlying client open because other HTTP
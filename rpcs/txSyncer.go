@@ -39,6 +39,7 @@ type PendingTxAggregate interface {
 type TxSyncClient interface {
 	Sync(ctx context.Context, bloom *bloom.Filter) (txns [][]transactions.SignedTxn, err error)
 	Address() string
+	Peer() network.Peer
 	Close() error
 }
 
@@ -161,6 +162,7 @@ func (syncer *TxSyncer) syncFromClient(client TxSyncClient) error {
 
 		// send the transaction to the trasaction pool
 		if syncer.handler.Handle(txgroup) != nil {
+			syncer.clientSource.ReportPeer(client.Peer(), network.PeerFaultInvalidTxn)
 			client.Close()
 			return fmt.Errorf("TxSyncer.Sync: peer %v sent invalid transaction", client.Address())
 		}
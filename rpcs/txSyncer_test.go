@@ -138,6 +138,9 @@ func (client *mockRPCClient) Close() error {
 func (client *mockRPCClient) Address() string {
 	return "mock.address."
 }
+func (client *mockRPCClient) Peer() network.Peer {
+	return client
+}
 func (client *mockRPCClient) Sync(ctx context.Context, bloom *bloom.Filter) (txgroups [][]transactions.SignedTxn, err error) {
 	client.log.Info("MockRPCClient.Sync")
 	select {
@@ -164,7 +167,12 @@ func (client *mockRPCClient) GetHTTPClient() *http.Client {
 
 type mockClientAggregator struct {
 	mocks.MockNetwork
-	peers []network.Peer
+	peers    []network.Peer
+	reported []network.Peer
+}
+
+func (mca *mockClientAggregator) ReportPeer(peer network.Peer, fault network.PeerFault) {
+	mca.reported = append(mca.reported, peer)
 }
 
 func (mca *mockClientAggregator) GetPeers(options ...network.PeerOption) []network.Peer {
@@ -204,6 +212,25 @@ func TestSyncFromClient(t *testing.T) {
 	require.Equal(t, int32(1), atomic.LoadInt32(&handler.messageCounter))
 }
 
+func TestSyncFromClientInvalidTxn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	clientPool := makeMockPendingTxAggregate(2)
+	serverPool := makeMockPendingTxAggregate(1)
+	runner := mockRunner{failWithNil: false, failWithError: false, txgroups: serverPool.PendingTxGroups()[len(serverPool.PendingTxGroups())-1:], done: make(chan *rpc.Call)}
+	client := mockRPCClient{client: &runner, log: logging.TestingLog(t)}
+	clientAgg := mockClientAggregator{peers: []network.Peer{&client}}
+	handler := mockHandler{err: errors.New("invalid transaction")}
+	syncer := MakeTxSyncer(clientPool, &clientAgg, &handler, testSyncInterval, testSyncTimeout, config.GetDefaultLocal().TxSyncServeResponseSize)
+	// Since syncer is not Started, set the context here
+	syncer.ctx, syncer.cancel = context.WithCancel(context.Background())
+	syncer.log = logging.TestingLog(t)
+
+	require.Error(t, syncer.syncFromClient(&client))
+	require.True(t, client.closed)
+	require.Equal(t, []network.Peer{&client}, clientAgg.reported)
+}
+
 func TestSyncFromUnsupportedClient(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
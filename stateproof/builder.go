@@ -241,6 +241,7 @@ func (spw *Worker) handleSig(sfa sigFromAddr, sender network.Peer) (network.Forw
 		// passed in the call above.
 		return network.Ignore, err
 	}
+	stateProofSigsAdded.Inc(nil)
 	return network.Broadcast, nil
 }
 
@@ -390,47 +391,69 @@ func (spw *Worker) tryBroadcast() {
 		sortedRounds = append(sortedRounds, rnd)
 	}
 	sort.Slice(sortedRounds, func(i, j int) bool { return sortedRounds[i] < sortedRounds[j] })
+	defer spw.updateBuilderMetrics(sortedRounds)
 
-	for _, rnd := range sortedRounds { // Iterate over the builders in a sequential manner
+	for i, rnd := range sortedRounds { // Iterate over the builders in a sequential manner
 		b := spw.builders[rnd]
-		firstValid := spw.ledger.Latest()
-		acceptableWeight := verify.AcceptableStateProofWeight(&b.votersHdr, firstValid, logging.Base())
-		if b.SignedWeight() < acceptableWeight {
-			// Haven't signed enough to build the state proof at this time..
-			continue
-		}
-
-		if !b.Ready() {
-			// Haven't gotten enough signatures to get past ProvenWeight
-			continue
-		}
+		outcome, err := spw.tryBroadcastRound(rnd, &b)
+		b.lastBroadcast = outcome
+		b.lastBroadcastErr = err
+		spw.builders[rnd] = b
+		stateProofBroadcastAttempts.Inc(map[string]string{"outcome": string(outcome)})
 
-		sp, err := b.Build()
-		if err != nil {
-			spw.log.Warnf("spw.tryBroadcast: building state proof for %d failed: %v", rnd, err)
-			continue
-		}
-
-		spw.log.Infof("spw.tryBroadcast: building state proof transaction for round %d", rnd)
-		var stxn transactions.SignedTxn
-		stxn.Txn.Type = protocol.StateProofTx
-		stxn.Txn.Sender = transactions.StateProofSender
-		stxn.Txn.FirstValid = firstValid
-		stxn.Txn.LastValid = firstValid + basics.Round(b.voters.Proto.MaxTxnLife)
-		stxn.Txn.GenesisHash = spw.ledger.GenesisHash()
-		stxn.Txn.StateProofTxnFields.StateProofType = protocol.StateProofBasic
-		stxn.Txn.StateProofTxnFields.StateProof = *sp
-		stxn.Txn.StateProofTxnFields.Message = b.message
-		err = spw.txnSender.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{stxn})
-		if err != nil {
-			spw.log.Warnf("spw.tryBroadcast: broadcasting state proof txn for %d: %v", rnd, err)
+		if outcome == BroadcastSendFailed {
 			// if this StateProofTxn was rejected, the next one would be rejected as well since state proof should be added in
 			// a sequential order
+			for _, later := range sortedRounds[i+1:] {
+				lb := spw.builders[later]
+				lb.lastBroadcast = BroadcastBlockedByEarlierRound
+				lb.lastBroadcastErr = nil
+				spw.builders[later] = lb
+			}
 			break
 		}
 	}
 }
 
+// tryBroadcastRound builds and broadcasts the state proof transaction for rnd, if enough signatures were collected.
+// not threadsafe, should be called in a lock environment
+func (spw *Worker) tryBroadcastRound(rnd basics.Round, b *builder) (BroadcastOutcome, error) {
+	firstValid := spw.ledger.Latest()
+	acceptableWeight := verify.AcceptableStateProofWeight(&b.votersHdr, firstValid, logging.Base())
+	if b.SignedWeight() < acceptableWeight {
+		// Haven't signed enough to build the state proof at this time..
+		return BroadcastBelowAcceptableWeight, nil
+	}
+
+	if !b.Ready() {
+		// Haven't gotten enough signatures to get past ProvenWeight
+		return BroadcastBelowProvenWeight, nil
+	}
+
+	sp, err := b.Build()
+	if err != nil {
+		spw.log.Warnf("spw.tryBroadcast: building state proof for %d failed: %v", rnd, err)
+		return BroadcastBuildFailed, err
+	}
+
+	spw.log.Infof("spw.tryBroadcast: building state proof transaction for round %d", rnd)
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = protocol.StateProofTx
+	stxn.Txn.Sender = transactions.StateProofSender
+	stxn.Txn.FirstValid = firstValid
+	stxn.Txn.LastValid = firstValid + basics.Round(b.voters.Proto.MaxTxnLife)
+	stxn.Txn.GenesisHash = spw.ledger.GenesisHash()
+	stxn.Txn.StateProofTxnFields.StateProofType = protocol.StateProofBasic
+	stxn.Txn.StateProofTxnFields.StateProof = *sp
+	stxn.Txn.StateProofTxnFields.Message = b.message
+	err = spw.txnSender.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{stxn})
+	if err != nil {
+		spw.log.Warnf("spw.tryBroadcast: broadcasting state proof txn for %d: %v", rnd, err)
+		return BroadcastSendFailed, err
+	}
+	return BroadcastSent, nil
+}
+
 func (spw *Worker) invokeBuilder(r basics.Round) {
 	spw.mu.Lock()
 	spw.signed = r
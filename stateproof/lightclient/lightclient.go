@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient follows the Algorand chain using only state proofs. Starting from a trusted
+// voters commitment, it verifies the successive state proofs, each of which attests to the light block
+// headers of an interval and to the voters of the next one. The transactions of the attested rounds
+// can then be verified using their proofs of membership in the light block headers.
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Errors returned by the light client
+var (
+	ErrUnexpectedRounds    = errors.New("state proof does not attest to the rounds following the trusted round")
+	ErrRoundNotAttested    = errors.New("round is not attested by any of the verified state proofs")
+	ErrNoVotersCommitment  = errors.New("block header has no state proof voters commitment")
+	ErrUnexpectedHashType  = errors.New("unexpected proof hash type")
+	ErrLightHeaderMismatch = errors.New("light block header is not the one of the requested round")
+)
+
+// Source provides the state proofs and the proofs of membership that the light client verifies.
+// It is implemented by the algod REST client.
+type Source interface {
+	StateProofs(round uint64) (model.StateProofResponse, error)
+	LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error)
+	TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error)
+}
+
+// Checkpoint is the data trusted by the light client at a given round: the commitment on the voters
+// that are expected to sign the state proof of the interval following that round, and the natural log
+// of the weight that their signatures have to prove.
+type Checkpoint struct {
+	Round            basics.Round         `codec:"rnd"`
+	VotersCommitment crypto.GenericDigest `codec:"voters"`
+	LnProvenWeight   uint64               `codec:"lnpw"`
+}
+
+// State is the data trusted by the light client, which can be saved and restored to resume following
+// the chain. Messages holds the verified state proof messages, ordered by their attested rounds.
+type State struct {
+	Trusted  Checkpoint              `codec:"trusted"`
+	Messages []stateproofmsg.Message `codec:"msgs"`
+}
+
+// CheckpointFromMessage returns the checkpoint trusted after verifying the state proof of msg.
+func CheckpointFromMessage(msg *stateproofmsg.Message) Checkpoint {
+	return Checkpoint{
+		Round:            basics.Round(msg.LastAttestedRound),
+		VotersCommitment: msg.VotersCommitment,
+		LnProvenWeight:   msg.LnProvenWeight,
+	}
+}
+
+// CheckpointFromBlockHeader returns the checkpoint of the voters tracked by a block header. The block
+// header has to be trusted on its own, and is typically the one of the last round attested by a known
+// state proof, or that of the first state proof interval of the chain.
+func CheckpointFromBlockHeader(hdr *bookkeeping.BlockHeader) (Checkpoint, error) {
+	tracking := hdr.StateProofTracking[protocol.StateProofBasic]
+	if len(tracking.StateProofVotersCommitment) == 0 {
+		return Checkpoint{}, fmt.Errorf("round %d: %w", hdr.Round, ErrNoVotersCommitment)
+	}
+
+	proto := config.Consensus[hdr.CurrentProtocol]
+	provenWeight, overflowed := basics.Muldiv(tracking.StateProofOnlineTotalWeight.ToUint64(), uint64(proto.StateProofWeightThreshold), 1<<32)
+	if overflowed {
+		return Checkpoint{}, fmt.Errorf("round %d: overflow computing the proven weight of %d", hdr.Round, tracking.StateProofOnlineTotalWeight.ToUint64())
+	}
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	return Checkpoint{
+		Round:            hdr.Round,
+		VotersCommitment: tracking.StateProofVotersCommitment,
+		LnProvenWeight:   lnProvenWeight,
+	}, nil
+}
+
+// VerifyStateProof checks that a state proof attests to the rounds following the trusted checkpoint,
+// and that it is signed by the voters of the checkpoint.
+func VerifyStateProof(trusted *Checkpoint, msg *stateproofmsg.Message, proof *stateproof.StateProof, strengthTarget uint64) error {
+	if msg.FirstAttestedRound != uint64(trusted.Round)+1 || msg.LastAttestedRound < msg.FirstAttestedRound {
+		return fmt.Errorf("rounds %d-%d, trusted round %d: %w", msg.FirstAttestedRound, msg.LastAttestedRound, trusted.Round, ErrUnexpectedRounds)
+	}
+
+	verifier := stateproof.MkVerifierWithLnProvenWeight(trusted.VotersCommitment, trusted.LnProvenWeight, strengthTarget)
+	return verifier.Verify(msg.LastAttestedRound, msg.Hash(), proof)
+}
+
+// VerifyLightBlockHeader checks that a light block header is committed to by a verified state proof message.
+func VerifyLightBlockHeader(msg *stateproofmsg.Message, hdr *bookkeeping.LightBlockHeader, proofResp *model.LightBlockHeaderProofResponse) error {
+	if uint64(hdr.Round) < msg.FirstAttestedRound || uint64(hdr.Round) > msg.LastAttestedRound || proofResp.Index != uint64(hdr.Round)-msg.FirstAttestedRound {
+		return fmt.Errorf("round %d at index %d, attested rounds %d-%d: %w", hdr.Round, proofResp.Index, msg.FirstAttestedRound, msg.LastAttestedRound, ErrLightHeaderMismatch)
+	}
+
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), proofResp.Treedepth, proofResp.Proof)
+	if err != nil {
+		return err
+	}
+	elems := map[uint64]crypto.Hashable{proofResp.Index: hdr}
+	return merklearray.VerifyVectorCommitment(msg.BlockHeadersCommitment, elems, proof.ToProof())
+}
+
+// VerifyTransaction checks that a transaction is committed to by a light block header.
+func VerifyTransaction(hdr *bookkeeping.LightBlockHeader, txn *transactions.Transaction, proofResp *model.TransactionProofResponse) error {
+	if proofResp.Hashtype != model.TransactionProofResponseHashtypeSha256 {
+		return fmt.Errorf("%s: %w", proofResp.Hashtype, ErrUnexpectedHashType)
+	}
+
+	proof, err := merklearray.ProofDataToSingleLeafProof(string(proofResp.Hashtype), proofResp.Treedepth, proofResp.Proof)
+	if err != nil {
+		return err
+	}
+	leaf := txnMerkleLeaf{txid: txn.IDSha256()}
+	copy(leaf.stib[:], proofResp.Stibhash)
+	elems := map[uint64]crypto.Hashable{proofResp.Idx: &leaf}
+	return merklearray.VerifyVectorCommitment(hdr.Sha256TxnCommitment, elems, proof.ToProof())
+}
+
+// txnMerkleLeaf is the leaf of a transaction in the SHA256 transactions commitment of a block:
+// the hash of the transaction, followed by the hash of the SignedTxnInBlock.
+//
+//msgp:ignore txnMerkleLeaf
+type txnMerkleLeaf struct {
+	txid crypto.Digest
+	stib crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l *txnMerkleLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 0, 2*crypto.DigestSize)
+	buf = append(buf, l.txid[:]...)
+	buf = append(buf, l.stib[:]...)
+	return protocol.TxnMerkleLeaf, buf
+}
+
+// LightClient follows the chain from a trusted checkpoint by verifying the successive state proofs
+// provided by its source.
+type LightClient struct {
+	source         Source
+	strengthTarget uint64
+	state          State
+}
+
+// MakeLightClient creates a light client which trusts the given state. The strength target is the
+// StateProofStrengthTarget consensus parameter of the followed chain.
+func MakeLightClient(source Source, strengthTarget uint64, state State) *LightClient {
+	return &LightClient{
+		source:         source,
+		strengthTarget: strengthTarget,
+		state:          state,
+	}
+}
+
+// State returns the data trusted by the light client.
+func (c *LightClient) State() State {
+	return c.state
+}
+
+// Advance fetches and verifies the state proof of the interval following the trusted round, and
+// moves the trusted checkpoint to the last round that it attests to.
+func (c *LightClient) Advance() (stateproofmsg.Message, error) {
+	resp, err := c.source.StateProofs(uint64(c.state.Trusted.Round) + 1)
+	if err != nil {
+		return stateproofmsg.Message{}, err
+	}
+
+	var proof stateproof.StateProof
+	err = protocol.Decode(resp.StateProof, &proof)
+	if err != nil {
+		return stateproofmsg.Message{}, err
+	}
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: resp.Message.BlockHeadersCommitment,
+		VotersCommitment:       resp.Message.VotersCommitment,
+		LnProvenWeight:         resp.Message.LnProvenWeight,
+		FirstAttestedRound:     resp.Message.FirstAttestedRound,
+		LastAttestedRound:      resp.Message.LastAttestedRound,
+	}
+	err = VerifyStateProof(&c.state.Trusted, &msg, &proof, c.strengthTarget)
+	if err != nil {
+		return stateproofmsg.Message{}, err
+	}
+
+	c.state.Trusted = CheckpointFromMessage(&msg)
+	c.state.Messages = append(c.state.Messages, msg)
+	return msg, nil
+}
+
+// AdvanceTo advances the light client until round is attested by a verified state proof.
+func (c *LightClient) AdvanceTo(round basics.Round) error {
+	for c.state.Trusted.Round < round {
+		_, err := c.Advance()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Message returns the verified state proof message that attests to round.
+func (c *LightClient) Message(round basics.Round) (stateproofmsg.Message, error) {
+	msgs := c.state.Messages
+	// the messages are ordered by their attested rounds, and the recent rounds are the most likely to be looked up.
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if uint64(round) > msgs[i].LastAttestedRound {
+			break
+		}
+		if uint64(round) >= msgs[i].FirstAttestedRound {
+			return msgs[i], nil
+		}
+	}
+	return stateproofmsg.Message{}, fmt.Errorf("round %d: %w", round, ErrRoundNotAttested)
+}
+
+// VerifyLightBlockHeader checks that a light block header is attested by a verified state proof.
+func (c *LightClient) VerifyLightBlockHeader(hdr *bookkeeping.LightBlockHeader) error {
+	msg, err := c.Message(hdr.Round)
+	if err != nil {
+		return err
+	}
+	proofResp, err := c.source.LightBlockHeaderProof(uint64(hdr.Round))
+	if err != nil {
+		return err
+	}
+	return VerifyLightBlockHeader(&msg, hdr, &proofResp)
+}
+
+// VerifyTransaction checks that a transaction was committed in the block of the given light block
+// header, and that the light block header is attested by a verified state proof.
+func (c *LightClient) VerifyTransaction(hdr *bookkeeping.LightBlockHeader, txn *transactions.Transaction) error {
+	err := c.VerifyLightBlockHeader(hdr)
+	if err != nil {
+		return err
+	}
+	proofResp, err := c.source.TransactionProof(txn.ID().String(), uint64(hdr.Round), crypto.Sha256)
+	if err != nil {
+		return err
+	}
+	return VerifyTransaction(hdr, txn, &proofResp)
+}
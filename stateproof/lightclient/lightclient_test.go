@@ -0,0 +1,328 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testInterval = 16
+const testStrengthTarget = 256
+const testIntervals = 3
+const testTxnRound = basics.Round(20)
+
+type lightHeaders []bookkeeping.LightBlockHeader
+
+func (h lightHeaders) Length() uint64 {
+	return uint64(len(h))
+}
+
+func (h lightHeaders) Marshal(pos uint64) (crypto.Hashable, error) {
+	return &h[pos], nil
+}
+
+// testChain holds the state proofs, light block headers and transactions of a chain whose voters
+// never change, and serves them as a light client source.
+type testChain struct {
+	checkpoint   Checkpoint
+	stateProofs  []model.StateProofResponse
+	headers      []lightHeaders
+	headerTrees  []*merklearray.Tree
+	block        bookkeeping.Block
+	txns         []transactions.Transaction
+	txnTree      *merklearray.Tree
+	unavailable  bool
+	stateProofAt func(round uint64) (model.StateProofResponse, error)
+}
+
+func makeTestChain(t *testing.T) *testChain {
+	a := require.New(t)
+	c := &testChain{}
+
+	key, err := merklesignature.New(0, testInterval*(testIntervals+1), testInterval)
+	a.NoError(err)
+	parts := make(basics.ParticipantsArray, 8)
+	totalWeight := uint64(0)
+	for i := range parts {
+		parts[i] = basics.Participant{PK: *key.GetVerifier(), Weight: 1_000_000}
+		totalWeight += parts[i].Weight
+	}
+	partTree, err := merklearray.BuildVectorCommitmentTree(parts, crypto.HashFactory{HashType: stateproof.HashType})
+	a.NoError(err)
+	provenWeight := totalWeight / 2
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	a.NoError(err)
+	c.checkpoint = Checkpoint{VotersCommitment: partTree.Root(), LnProvenWeight: lnProvenWeight}
+
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+	c.block.BlockHeader = bookkeeping.BlockHeader{
+		Round:        testTxnRound,
+		GenesisHash:  genesisHash,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}
+	for i := 0; i < 5; i++ {
+		txn := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      basics.Address(crypto.Hash([]byte{byte(i)})),
+				FirstValid:  testTxnRound - 1,
+				LastValid:   testTxnRound + 10,
+				GenesisHash: genesisHash,
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{Amount: basics.MicroAlgos{Raw: uint64(i + 1)}},
+		}
+		stib, err := c.block.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+		a.NoError(err)
+		c.block.Payset = append(c.block.Payset, stib)
+		c.txns = append(c.txns, txn)
+	}
+	c.txnTree, err = c.block.TxnMerkleTreeSHA256()
+	a.NoError(err)
+
+	for k := 0; k < testIntervals; k++ {
+		headers := make(lightHeaders, testInterval)
+		for i := range headers {
+			hdr := &headers[i]
+			hdr.Round = basics.Round(k*testInterval + i + 1)
+			hdr.GenesisHash = genesisHash
+			crypto.RandBytes(hdr.Seed[:])
+			hdr.Sha256TxnCommitment = make(crypto.GenericDigest, crypto.DigestSize)
+			crypto.RandBytes(hdr.Sha256TxnCommitment)
+			if hdr.Round == testTxnRound {
+				hdr.Sha256TxnCommitment = c.txnTree.Root()
+			}
+		}
+		headerTree, err := merklearray.BuildVectorCommitmentTree(headers, crypto.HashFactory{HashType: crypto.Sha256})
+		a.NoError(err)
+
+		msg := stateproofmsg.Message{
+			BlockHeadersCommitment: headerTree.Root(),
+			VotersCommitment:       partTree.Root(),
+			LnProvenWeight:         lnProvenWeight,
+			FirstAttestedRound:     uint64(k*testInterval + 1),
+			LastAttestedRound:      uint64((k + 1) * testInterval),
+		}
+		msgHash := msg.Hash()
+		builder, err := stateproof.MakeBuilder(msgHash, msg.LastAttestedRound, provenWeight, parts, partTree, testStrengthTarget)
+		a.NoError(err)
+		sig, err := key.GetSigner(msg.LastAttestedRound).SignBytes(msgHash[:])
+		a.NoError(err)
+		for i := range parts {
+			a.NoError(builder.Add(uint64(i), sig))
+		}
+		proof, err := builder.Build()
+		a.NoError(err)
+
+		c.headers = append(c.headers, headers)
+		c.headerTrees = append(c.headerTrees, headerTree)
+		c.stateProofs = append(c.stateProofs, model.StateProofResponse{
+			Message: model.StateProofMessage{
+				BlockHeadersCommitment: msg.BlockHeadersCommitment,
+				VotersCommitment:       msg.VotersCommitment,
+				LnProvenWeight:         msg.LnProvenWeight,
+				FirstAttestedRound:     msg.FirstAttestedRound,
+				LastAttestedRound:      msg.LastAttestedRound,
+			},
+			StateProof: protocol.Encode(proof),
+		})
+	}
+	return c
+}
+
+func (c *testChain) header(round basics.Round) *bookkeeping.LightBlockHeader {
+	return &c.headers[(round-1)/testInterval][(round-1)%testInterval]
+}
+
+func (c *testChain) StateProofs(round uint64) (model.StateProofResponse, error) {
+	k := (round - 1) / testInterval
+	if k >= uint64(len(c.stateProofs)) {
+		return model.StateProofResponse{}, fmt.Errorf("no state proof for round %d", round)
+	}
+	return c.stateProofs[k], nil
+}
+
+func (c *testChain) LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error) {
+	k := (round - 1) / testInterval
+	index := (round - 1) % testInterval
+	proof, err := c.headerTrees[k].ProveSingleLeaf(index)
+	if err != nil {
+		return model.LightBlockHeaderProofResponse{}, err
+	}
+	return model.LightBlockHeaderProofResponse{
+		Index:     index,
+		Proof:     proof.GetConcatenatedProof(),
+		Treedepth: uint64(proof.TreeDepth),
+	}, nil
+}
+
+func (c *testChain) TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error) {
+	if basics.Round(round) != testTxnRound || hashType != crypto.Sha256 {
+		return model.TransactionProofResponse{}, errors.New("unsupported transaction proof")
+	}
+	for idx, txn := range c.txns {
+		if txn.ID().String() != txid {
+			continue
+		}
+		proof, err := c.txnTree.ProveSingleLeaf(uint64(idx))
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		stibHash := c.block.Payset[idx].HashSHA256()
+		return model.TransactionProofResponse{
+			Hashtype:  model.TransactionProofResponseHashtypeSha256,
+			Idx:       uint64(idx),
+			Proof:     proof.GetConcatenatedProof(),
+			Stibhash:  stibHash[:],
+			Treedepth: uint64(proof.TreeDepth),
+		}, nil
+	}
+	return model.TransactionProofResponse{}, fmt.Errorf("transaction %s not found", txid)
+}
+
+func TestLightClientFollow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	chain := makeTestChain(t)
+	client := MakeLightClient(chain, testStrengthTarget, State{Trusted: chain.checkpoint})
+
+	_, err := client.Message(testTxnRound)
+	a.ErrorIs(err, ErrRoundNotAttested)
+
+	a.NoError(client.AdvanceTo(testTxnRound))
+	a.Equal(basics.Round(2*testInterval), client.State().Trusted.Round)
+	a.Len(client.State().Messages, 2)
+
+	// all the transactions of the block verify, but no modified transaction or header does.
+	hdr := chain.header(testTxnRound)
+	for i := range chain.txns {
+		a.NoError(client.VerifyTransaction(hdr, &chain.txns[i]))
+	}
+	txn := chain.txns[0]
+	txn.Amount.Raw++
+	a.Error(client.VerifyTransaction(hdr, &txn))
+
+	badHdr := *hdr
+	badHdr.Seed[0]++
+	a.Error(client.VerifyLightBlockHeader(&badHdr))
+	a.Error(client.VerifyTransaction(&badHdr, &chain.txns[0]))
+
+	// the light client stops at the latest available state proof, and keeps verifying the older rounds.
+	a.NoError(client.AdvanceTo(testTxnRound + testInterval))
+	_, err = client.Advance()
+	a.Error(err)
+	a.Equal(basics.Round(testIntervals*testInterval), client.State().Trusted.Round)
+	for k := range chain.headers {
+		for i := range chain.headers[k] {
+			a.NoError(client.VerifyLightBlockHeader(&chain.headers[k][i]))
+		}
+	}
+
+	// a light client restored from the saved state continues from where it stopped.
+	restored := MakeLightClient(chain, testStrengthTarget, client.State())
+	a.NoError(restored.VerifyTransaction(hdr, &chain.txns[1]))
+	_, err = restored.Message(testIntervals*testInterval + 1)
+	a.ErrorIs(err, ErrRoundNotAttested)
+}
+
+func TestLightClientRejectsInvalidStateProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	chain := makeTestChain(t)
+
+	// voters that aren't the trusted ones
+	wrongVoters := chain.checkpoint
+	wrongVoters.VotersCommitment = make(crypto.GenericDigest, len(chain.checkpoint.VotersCommitment))
+	crypto.RandBytes(wrongVoters.VotersCommitment)
+	client := MakeLightClient(chain, testStrengthTarget, State{Trusted: wrongVoters})
+	_, err := client.Advance()
+	a.Error(err)
+	a.Equal(State{Trusted: wrongVoters}, client.State())
+
+	// a higher proven weight than the one the voters signed for
+	wrongWeight := chain.checkpoint
+	wrongWeight.LnProvenWeight++
+	client = MakeLightClient(chain, testStrengthTarget, State{Trusted: wrongWeight})
+	_, err = client.Advance()
+	a.Error(err)
+
+	// a message that isn't the one the voters signed
+	chain.stateProofs[0].Message.BlockHeadersCommitment = chain.stateProofs[1].Message.BlockHeadersCommitment
+	client = MakeLightClient(chain, testStrengthTarget, State{Trusted: chain.checkpoint})
+	_, err = client.Advance()
+	a.Error(err)
+
+	// a state proof of rounds that don't follow the trusted round
+	var proof stateproof.StateProof
+	a.NoError(protocol.Decode(chain.stateProofs[1].StateProof, &proof))
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: chain.stateProofs[1].Message.BlockHeadersCommitment,
+		VotersCommitment:       chain.stateProofs[1].Message.VotersCommitment,
+		LnProvenWeight:         chain.stateProofs[1].Message.LnProvenWeight,
+		FirstAttestedRound:     chain.stateProofs[1].Message.FirstAttestedRound,
+		LastAttestedRound:      chain.stateProofs[1].Message.LastAttestedRound,
+	}
+	a.NoError(VerifyStateProof(&Checkpoint{Round: testInterval, VotersCommitment: chain.checkpoint.VotersCommitment, LnProvenWeight: chain.checkpoint.LnProvenWeight}, &msg, &proof, testStrengthTarget))
+	a.ErrorIs(VerifyStateProof(&chain.checkpoint, &msg, &proof, testStrengthTarget), ErrUnexpectedRounds)
+}
+
+func TestCheckpointFromBlockHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	hdr := bookkeeping.BlockHeader{
+		Round:        256,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}
+	_, err := CheckpointFromBlockHeader(&hdr)
+	a.ErrorIs(err, ErrNoVotersCommitment)
+
+	commitment := make(crypto.GenericDigest, crypto.DigestSize)
+	crypto.RandBytes(commitment)
+	hdr.StateProofTracking = map[protocol.StateProofType]bookkeeping.StateProofTrackingData{
+		protocol.StateProofBasic: {
+			StateProofVotersCommitment:  commitment,
+			StateProofOnlineTotalWeight: basics.MicroAlgos{Raw: 1_000_000_000},
+		},
+	}
+	checkpoint, err := CheckpointFromBlockHeader(&hdr)
+	a.NoError(err)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	lnProvenWeight, err := stateproof.LnIntApproximation(1_000_000_000 * uint64(proto.StateProofWeightThreshold) >> 32)
+	a.NoError(err)
+	a.Equal(Checkpoint{Round: 256, VotersCommitment: commitment, LnProvenWeight: lnProvenWeight}, checkpoint)
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/stateproof/verify"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var stateProofPendingRounds = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_pending_rounds", Description: "Number of rounds the state proof worker collects signatures for"})
+var stateProofOldestPendingRound = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_oldest_pending_round", Description: "Oldest round the state proof worker collects signatures for"})
+var stateProofOldestSigners = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_oldest_pending_signers", Description: "Number of voters that signed the oldest pending state proof"})
+var stateProofOldestSignedWeight = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_oldest_pending_signed_weight", Description: "Weight of the signatures collected for the oldest pending state proof"})
+var stateProofOldestProvenWeight = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_oldest_pending_proven_weight", Description: "Weight the signatures of the oldest pending state proof must exceed"})
+var stateProofSigsAdded = metrics.MakeCounter(metrics.MetricName{Name: "algod_stateproof_sigs_added_total", Description: "Number of valid state proof signatures added to the builders"})
+var stateProofBroadcastAttempts = metrics.MakeCounter(metrics.MetricName{Name: "algod_stateproof_broadcast_attempts_total", Description: "Number of attempts to build and broadcast a state proof, by outcome"})
+
+// ErrNoBuilderForRound is returned when the worker doesn't collect signatures for the requested round.
+var ErrNoBuilderForRound = errors.New("no state proof signatures are collected for the round")
+
+// BroadcastOutcome is the outcome of an attempt of the worker to build and broadcast the state proof of a round.
+type BroadcastOutcome string
+
+const (
+	// BroadcastNotAttempted means the worker hasn't tried to build the state proof yet.
+	BroadcastNotAttempted BroadcastOutcome = "not-attempted"
+	// BroadcastBelowAcceptableWeight means the signed weight is lower than the weight acceptable
+	// at the latest round, which starts at the total online weight and decreases over the interval.
+	BroadcastBelowAcceptableWeight BroadcastOutcome = "below-acceptable-weight"
+	// BroadcastBelowProvenWeight means the signed weight doesn't exceed the proven weight.
+	BroadcastBelowProvenWeight BroadcastOutcome = "below-proven-weight"
+	// BroadcastBuildFailed means building the state proof failed.
+	BroadcastBuildFailed BroadcastOutcome = "build-failed"
+	// BroadcastSendFailed means the state proof transaction was rejected by the transaction pool.
+	BroadcastSendFailed BroadcastOutcome = "send-failed"
+	// BroadcastBlockedByEarlierRound means the state proof transaction of an earlier round was rejected,
+	// and state proofs are added to the ledger in order.
+	BroadcastBlockedByEarlierRound BroadcastOutcome = "blocked-by-earlier-round"
+	// BroadcastSent means the state proof transaction was broadcast, and the state proof is expected in the ledger.
+	BroadcastSent BroadcastOutcome = "sent"
+)
+
+// VoterStatus describes a voter of a state proof round, and whether its signature was collected.
+type VoterStatus struct {
+	Address basics.Address
+	Weight  uint64
+	Signed  bool
+}
+
+// BuilderStatus describes the signatures collected by the worker for the state proof of a round.
+type BuilderStatus struct {
+	// Round is the last round attested by the state proof.
+	Round basics.Round
+	// VotersRound is the round whose voters sign the state proof.
+	VotersRound basics.Round
+
+	Voters  uint64
+	Signers uint64
+
+	TotalWeight  uint64
+	SignedWeight uint64
+	// ProvenWeight is the weight the signed weight must exceed to build the state proof.
+	ProvenWeight uint64
+	// AcceptableWeight is the signed weight accepted at the latest round.
+	AcceptableWeight uint64
+
+	// LastBroadcast is the outcome of the last attempt to build and broadcast the state proof,
+	// and LastBroadcastError the error that caused it, if any.
+	LastBroadcast      BroadcastOutcome
+	LastBroadcastError string
+
+	// MissingSigners are the heaviest voters that haven't signed, by decreasing weight.
+	MissingSigners []VoterStatus
+}
+
+// BuilderStatuses returns the status of the state proofs the worker collects signatures for,
+// ordered by round. At most maxMissingSigners missing signers are reported per round.
+func (spw *Worker) BuilderStatuses(maxMissingSigners int) []BuilderStatus {
+	spw.mu.Lock()
+	defer spw.mu.Unlock()
+
+	latest := spw.ledger.Latest()
+	statuses := make([]BuilderStatus, 0, len(spw.builders))
+	for rnd, b := range spw.builders {
+		status := BuilderStatus{
+			Round:            rnd,
+			VotersRound:      b.votersHdr.Round,
+			Voters:           uint64(len(b.voters.Participants)),
+			TotalWeight:      b.voters.TotalWeight.Raw,
+			SignedWeight:     b.SignedWeight(),
+			ProvenWeight:     b.ProvenWeight(),
+			AcceptableWeight: verify.AcceptableStateProofWeight(&b.votersHdr, latest, logging.Base()),
+			LastBroadcast:    b.lastBroadcast,
+		}
+		if status.LastBroadcast == "" {
+			status.LastBroadcast = BroadcastNotAttempted
+		}
+		if b.lastBroadcastErr != nil {
+			status.LastBroadcastError = b.lastBroadcastErr.Error()
+		}
+
+		voters := b.voterStatuses()
+		for _, v := range voters {
+			if v.Signed {
+				status.Signers++
+			} else if len(status.MissingSigners) < maxMissingSigners {
+				status.MissingSigners = append(status.MissingSigners, v)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Round < statuses[j].Round })
+	return statuses
+}
+
+// BuilderVoters returns the voters of the state proof of rnd, by decreasing weight, and whether
+// their signatures were collected.
+func (spw *Worker) BuilderVoters(rnd basics.Round) ([]VoterStatus, error) {
+	spw.mu.Lock()
+	defer spw.mu.Unlock()
+
+	b, ok := spw.builders[rnd]
+	if !ok {
+		return nil, fmt.Errorf("%w: round %d", ErrNoBuilderForRound, rnd)
+	}
+	return b.voterStatuses(), nil
+}
+
+// voterStatuses not threadsafe, should be called in a lock environment
+func (b *builder) voterStatuses() []VoterStatus {
+	voters := make([]VoterStatus, len(b.voters.Participants))
+	for addr, pos := range b.voters.AddrToPos {
+		signed, err := b.Present(pos)
+		if err != nil {
+			continue
+		}
+		voters[pos] = VoterStatus{
+			Address: addr,
+			Weight:  b.voters.Participants[pos].Weight,
+			Signed:  signed,
+		}
+	}
+	sort.SliceStable(voters, func(i, j int) bool { return voters[i].Weight > voters[j].Weight })
+	return voters
+}
+
+// updateBuilderMetrics reports the state of the oldest pending state proof.
+// not threadsafe, should be called in a lock environment
+func (spw *Worker) updateBuilderMetrics(sortedRounds []basics.Round) {
+	stateProofPendingRounds.Set(uint64(len(sortedRounds)))
+	if len(sortedRounds) == 0 {
+		stateProofOldestPendingRound.Set(0)
+		stateProofOldestSigners.Set(0)
+		stateProofOldestSignedWeight.Set(0)
+		stateProofOldestProvenWeight.Set(0)
+		return
+	}
+
+	b := spw.builders[sortedRounds[0]]
+	signers := uint64(0)
+	for pos := range b.voters.Participants {
+		if signed, err := b.Present(uint64(pos)); err == nil && signed {
+			signers++
+		}
+	}
+	stateProofOldestPendingRound.Set(uint64(sortedRounds[0]))
+	stateProofOldestSigners.Set(signers)
+	stateProofOldestSignedWeight.Set(b.SignedWeight())
+	stateProofOldestProvenWeight.Set(b.ProvenWeight())
+}
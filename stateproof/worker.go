@@ -40,6 +40,11 @@ type builder struct {
 	voters    *ledgercore.VotersForRound
 	votersHdr bookkeeping.BlockHeader
 	message   stateproofmsg.Message
+
+	// lastBroadcast is the outcome of the last tryBroadcast attempt for this round,
+	// and lastBroadcastErr the error that caused it, if any.
+	lastBroadcast    BroadcastOutcome
+	lastBroadcastErr error
 }
 
 // Worker builds state proofs, by broadcasting
@@ -452,6 +452,90 @@ func TestWorkerInsufficientSigs(t *testing.T) {
 	}
 }
 
+func TestWorkerBuilderStatuses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var keys []account.Participation
+	for i := 0; i < 5; i++ {
+		var parent basics.Address
+		crypto.RandBytes(parent[:])
+		p := newPartKey(t, parent)
+		defer p.Close()
+		keys = append(keys, p.Participation)
+	}
+
+	// only the first two voters sign
+	s := newWorkerStubs(t, keys, 10)
+	s.keys = keys[:2]
+	w := newTestWorker(t, s)
+	w.Start()
+	defer w.Shutdown()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	s.advanceLatest(3 * proto.StateProofInterval)
+
+	for i := 0; i < len(s.keys); i++ {
+		_, err := s.waitOnSigWithTimeout(time.Second * 2)
+		require.NoError(t, err)
+	}
+	s.advanceLatest(1)
+
+	statuses := make(map[basics.Round]BuilderStatus)
+	require.Eventually(t, func() bool {
+		for _, st := range w.BuilderStatuses(2) {
+			statuses[st.Round] = st
+		}
+		for _, r := range []basics.Round{2, 3} {
+			st, ok := statuses[r*basics.Round(proto.StateProofInterval)]
+			if !ok || st.Signers != 2 || st.LastBroadcast == BroadcastNotAttempted {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+
+	provenWeight, overflowed := basics.Muldiv(uint64(s.totalWeight), uint64(proto.StateProofWeightThreshold), 1<<32)
+	require.False(t, overflowed)
+
+	// the signatures of the older round reached the acceptable weight, but not the proven weight,
+	// while the latest round still requires all the voters to sign.
+	rnd := 2 * basics.Round(proto.StateProofInterval)
+	require.Equal(t, provenWeight, statuses[rnd].AcceptableWeight)
+	require.Equal(t, BroadcastBelowProvenWeight, statuses[rnd].LastBroadcast)
+	latestRnd := 3 * basics.Round(proto.StateProofInterval)
+	require.Equal(t, uint64(10), statuses[latestRnd].AcceptableWeight)
+	require.Equal(t, BroadcastBelowAcceptableWeight, statuses[latestRnd].LastBroadcast)
+
+	status := statuses[rnd]
+	require.Equal(t, rnd-basics.Round(proto.StateProofInterval), status.VotersRound)
+	require.Equal(t, uint64(5), status.Voters)
+	require.Equal(t, uint64(10), status.TotalWeight)
+	require.Equal(t, uint64(2), status.SignedWeight)
+	require.Equal(t, provenWeight, status.ProvenWeight)
+	require.Empty(t, status.LastBroadcastError)
+	require.Len(t, status.MissingSigners, 2)
+	for _, missing := range status.MissingSigners {
+		require.False(t, missing.Signed)
+		require.NotEqual(t, keys[0].Parent, missing.Address)
+		require.NotEqual(t, keys[1].Parent, missing.Address)
+	}
+
+	voters, err := w.BuilderVoters(rnd)
+	require.NoError(t, err)
+	require.Len(t, voters, len(keys))
+	signed := make(map[basics.Address]bool)
+	for _, v := range voters {
+		require.Equal(t, uint64(1), v.Weight)
+		signed[v.Address] = v.Signed
+	}
+	for i, k := range keys {
+		require.Equal(t, i < 2, signed[k.Parent])
+	}
+
+	_, err = w.BuilderVoters(rnd + 1)
+	require.ErrorIs(t, err, ErrNoBuilderForRound)
+}
+
 func TestWorkerRestart(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// agreementfuzzer runs agreement fuzzer scenarios: simulated networks of agreement
+// nodes whose messages go through the network filters described by the scenario.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/agreement/fuzzer"
+	"github.com/algorand/go-algorand/config"
+)
+
+var outputDir = flag.String("output", "", "Directory for the node logs and cadavers (otherwise, use the current directory)")
+var repeat = flag.Int("repeat", 1, "Number of times to run each scenario; 0 runs them until one fails")
+var versionCheck = flag.Bool("version", false, "Display current agreementfuzzer build version and exit")
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] scenario|directory...\n\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "Scenarios are JSON or YAML files; directories are searched for *.json, *.yaml and *.yml files.\n\n")
+	flag.PrintDefaults()
+}
+
+func isScenarioFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// scenarioFiles expands the directories among the arguments.
+func scenarioFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		var dirFiles []string
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && isScenarioFile(path) {
+				dirFiles = append(dirFiles, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+	return files, nil
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *versionCheck {
+		version := config.GetCurrentVersion()
+		fmt.Printf("%d\n%s.%s [%s] (commit #%s)\n", version.AsUInt64(), version.String(),
+			version.Channel, version.Branch, version.GetCommitHash())
+		return
+	}
+	if flag.NArg() == 0 || *repeat < 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := scenarioFiles(flag.Args())
+	if err != nil {
+		log.Fatalf("agreementfuzzer: %v", err)
+	}
+	scenarios := make([]*fuzzer.Scenario, 0, len(files))
+	for _, file := range files {
+		scenario, err := fuzzer.LoadScenario(file)
+		if err != nil {
+			log.Fatalf("agreementfuzzer: %s: %v", file, err)
+		}
+		// catch unknown filters before running anything
+		if _, err := scenario.FuzzerConfig(); err != nil {
+			log.Fatalf("agreementfuzzer: %s: %v", file, err)
+		}
+		scenarios = append(scenarios, scenario)
+	}
+
+	if *outputDir != "" {
+		err = os.MkdirAll(*outputDir, 0755)
+		if err == nil {
+			err = os.Chdir(*outputDir)
+		}
+		if err != nil {
+			log.Fatalf("agreementfuzzer: %v", err)
+		}
+	}
+
+	failed := 0
+	for iteration := 1; *repeat == 0 || iteration <= *repeat; iteration++ {
+		for _, scenario := range scenarios {
+			result, err := scenario.Run()
+			if err == fuzzer.ErrNetworkStalled {
+				// a stalled network cannot be shut down
+				log.Printf("agreementfuzzer: %s (run %d): %v", scenario.FuzzerName, iteration, err)
+				os.Exit(1)
+			}
+			if err != nil {
+				log.Printf("agreementfuzzer: %s (run %d): FAILED: %v", scenario.FuzzerName, iteration, err)
+				failed++
+				continue
+			}
+			if scenario.Validator.NetworkRecoverTicks > 0 {
+				log.Printf("agreementfuzzer: %s (run %d): %s", scenario.FuzzerName, iteration, result.Summary())
+			} else {
+				log.Printf("agreementfuzzer: %s (run %d): reached rounds %d-%d", scenario.FuzzerName, iteration, result.PreRecoveryLowRound, result.PreRecoveryHighRound)
+			}
+		}
+		if *repeat == 0 && failed > 0 {
+			break
+		}
+	}
+	if failed > 0 {
+		log.Printf("agreementfuzzer: %d scenario runs failed", failed)
+		os.Exit(1)
+	}
+}
@@ -19,6 +19,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"regexp"
@@ -34,6 +35,7 @@ var numRegex = regexp.MustCompile(`^\d+$`)
 var filename = flag.String("file", "", "Name of the input cadaver file (otherwise, use stdin)")
 var versionCheck = flag.Bool("version", false, "Display current coroner build version and exit")
 var printmsgpack = flag.Bool("msgpack", false, "If provided, emit msgpack instead of a string")
+var replay = flag.Bool("replay", false, "If provided, replay the cadaver and check that the agreement state machine emits the recorded actions")
 
 var skipHead = flag.String("skip-head", "", "The first round to trim before")
 var skipTail = flag.String("skip-tail", "", "The last round to trim after")
@@ -101,7 +103,16 @@ func main() {
 	}
 
 	var commitHash string
-	if *printmsgpack {
+	diverged := false
+	if *replay {
+		res := autopsy.Replay(filter)
+		commitHash = res.Version
+		log.Printf("coroner: replayed %d events in %d runs\n", res.Events, res.Runs)
+		if res.Divergence != nil {
+			fmt.Print(res.Divergence.String())
+			diverged = true
+		}
+	} else if *printmsgpack {
 		commitHash = autopsy.DumpMessagePack(filter, os.Stdout)
 	} else {
 		commitHash = autopsy.DumpString(filter, os.Stdout)
@@ -109,6 +120,8 @@ func main() {
 	if commitHash != version.GetCommitHash() {
 		log.Printf("coroner: cadaver version mismatches coroner version:\n(%s (cadaver) != %s (coroner))\n", commitHash, version.GetCommitHash())
 	}
-
-	return
+	if diverged {
+		autopsy.Close()
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// netreplay replays network message captures, recorded by a node with
+// NetworkMessageCaptureFile set, into the message handlers of an offline node:
+//
+//	netreplay -d /tmp/node-copy ~/node/data/network.capture
+//
+// The node is started from the given data directory with networking disabled, so that
+// only the captured messages reach its handlers. Since the replay can advance the ledger,
+// it should run on a copy of the data directory. With -dump, the captured messages are
+// listed instead.
+//
+// Each capture argument includes its rotated files (.1, .2, ...), oldest first.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var dataDir = flag.String("d", "", "Data directory of the node to replay the captures into (use a copy, the replay can modify it)")
+var dump = flag.Bool("dump", false, "List the captured messages instead of replaying them")
+var speed = flag.Float64("speed", 0, "Replay speed relative to the capture (1 is real time); 0 replays without delays")
+var tags = flag.String("tags", "", "Comma-separated list of tags to replay or list (default all)")
+var linger = flag.Duration("linger", 5*time.Second, "Time to let the node process the replayed messages before stopping it")
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] capture...\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func captureFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		argFiles := messagetracer.CaptureFilenames(arg)
+		if len(argFiles) == 0 {
+			return nil, fmt.Errorf("%s: no such capture", arg)
+		}
+		files = append(files, argFiles...)
+	}
+	return files, nil
+}
+
+func parseTags(s string) map[network.Tag]bool {
+	if s == "" {
+		return nil
+	}
+	tagSet := make(map[network.Tag]bool)
+	for _, tag := range strings.Split(s, ",") {
+		tagSet[protocol.Tag(strings.TrimSpace(tag))] = true
+	}
+	return tagSet
+}
+
+func dumpCapture(files []string, tagSet map[network.Tag]bool) error {
+	return messagetracer.ReadCapture(files, func(rec messagetracer.CaptureRecord) error {
+		if len(tagSet) > 0 && !tagSet[rec.Tag] {
+			return nil
+		}
+		direction := "in "
+		if rec.Outgoing {
+			direction = "out"
+		}
+		fmt.Printf("%s %s %s %s %d\n", time.Unix(0, rec.Time).UTC().Format(time.RFC3339Nano), direction, rec.Tag, rec.Peer, len(rec.Data))
+		return nil
+	})
+}
+
+func printCounts(title string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Printf("%s:\n", title)
+	for _, k := range keys {
+		fmt.Printf("  %s: %d\n", k, counts[k])
+	}
+}
+
+func tagCounts(counts map[network.Tag]int) map[string]int {
+	out := make(map[string]int, len(counts))
+	for tag, count := range counts {
+		out[string(tag)] = count
+	}
+	return out
+}
+
+func replay(ctx context.Context, files []string, opts network.ReplayOptions) error {
+	absDataDir, err := filepath.Abs(*dataDir)
+	if err != nil {
+		return err
+	}
+	config.UpdateVersionDataDir(absDataDir)
+	cfg, err := config.LoadConfigFromDisk(absDataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	cfg.DisableNetworking = true
+	cfg.NetworkMessageCaptureFile = ""
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(absDataDir, config.GenesisJSONFile))
+	if err != nil {
+		return err
+	}
+
+	n, err := node.MakeFull(logging.Base(), absDataDir, cfg, nil, genesis)
+	if err != nil {
+		return err
+	}
+	n.Start()
+	defer n.Stop()
+
+	stats, err := n.ReplayNetworkCapture(ctx, files, opts)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-time.After(*linger):
+	case <-ctx.Done():
+	}
+
+	printCounts("dispatched", tagCounts(stats.Dispatched))
+	printCounts("unhandled", tagCounts(stats.Unhandled))
+	printCounts("relayed", tagCounts(stats.Broadcasts))
+	printCounts("disconnected", stats.Disconnects)
+	fmt.Printf("skipped: %d\n", stats.Skipped)
+	return nil
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 || (*dataDir == "" && !*dump) || *speed < 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := captureFiles(flag.Args())
+	if err != nil {
+		log.Fatalf("netreplay: %v", err)
+	}
+	tagSet := parseTags(*tags)
+
+	if *dump {
+		err = dumpCapture(files, tagSet)
+	} else {
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		err = replay(ctx, files, network.ReplayOptions{Speed: *speed, Tags: tagSet})
+	}
+	if err != nil {
+		log.Fatalf("netreplay: %v", err)
+	}
+}
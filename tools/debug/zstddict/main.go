@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// zstddict writes sample agreement vote (AV) and transaction (TX) gossip messages,
+// from which the zstd dictionaries of the network package are trained:
+//
+//	zstddict -out /tmp/samples
+//	zstd --train -r /tmp/samples/AV --dictID=1 --maxdict=8192 -o network/dictionaries/av.zdict
+//	zstd --train -r /tmp/samples/TX --dictID=2 --maxdict=8192 -o network/dictionaries/tx.zdict
+//
+// The samples have the structure of mainnet messages, with random keys and signatures.
+// A dictionary change must come with a new peer feature name in the network package,
+// since peers can only decompress messages compressed with the same dictionary.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var outDir = flag.String("out", "", "Directory to write the AV and TX samples into")
+var count = flag.Int("count", 10000, "Number of samples of each tag")
+var seed = flag.Int64("seed", 1, "Random seed")
+
+// mirror of the agreement vote encoding, as sent with protocol.AgreementVoteTag
+type (
+	proposalValue struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		OriginalPeriod   uint64         `codec:"oper"`
+		OriginalProposer basics.Address `codec:"oprop"`
+		BlockDigest      crypto.Digest  `codec:"dig"`
+		EncodingDigest   crypto.Digest  `codec:"encdig"`
+	}
+
+	rawVote struct {
+		_struct  struct{}       `codec:",omitempty,omitemptyarray"`
+		Sender   basics.Address `codec:"snd"`
+		Round    basics.Round   `codec:"rnd"`
+		Period   uint64         `codec:"per"`
+		Step     uint64         `codec:"step"`
+		Proposal proposalValue  `codec:"prop"`
+	}
+
+	unauthenticatedVote struct {
+		_struct struct{}                            `codec:",omitempty,omitemptyarray"`
+		R       rawVote                             `codec:"r"`
+		Cred    committee.UnauthenticatedCredential `codec:"cred"`
+		Sig     crypto.OneTimeSignature             `codec:"sig,omitempty,omitemptycheckstruct"`
+	}
+)
+
+// mainnet genesis
+const genesisID = "mainnet-v1.0"
+
+var genesisHash = crypto.Digest{0xc0, 0x61, 0xc4, 0xd8, 0xfc, 0x1d, 0xbd, 0xde, 0xd2, 0xd7, 0x60, 0x4b, 0xe4, 0x56, 0x8e, 0x3f, 0x6d, 0x04, 0x19, 0x87, 0xac, 0x37, 0xbd, 0xe4, 0xb6, 0x20, 0xb5, 0xab, 0x39, 0x24, 0x8a, 0xdf}
+
+type generator struct {
+	rnd      *rand.Rand
+	accounts []basics.Address
+	round    basics.Round
+}
+
+func (g *generator) fill(b []byte) {
+	g.rnd.Read(b)
+}
+
+func (g *generator) account() basics.Address {
+	return g.accounts[g.rnd.Intn(len(g.accounts))]
+}
+
+func (g *generator) vote() []byte {
+	var v unauthenticatedVote
+	v.R.Sender = g.account()
+	v.R.Round = g.round + basics.Round(g.rnd.Intn(3))
+	// most votes are soft and cert votes of period 0
+	switch x := g.rnd.Intn(20); {
+	case x < 2:
+		v.R.Step = 0
+	case x < 10:
+		v.R.Step = 1
+	case x < 18:
+		v.R.Step = 2
+	default:
+		v.R.Step = 3 + uint64(g.rnd.Intn(3))
+		v.R.Period = uint64(g.rnd.Intn(2))
+	}
+	if v.R.Step != 3 || g.rnd.Intn(2) == 0 {
+		v.R.Proposal.OriginalPeriod = v.R.Period
+		v.R.Proposal.OriginalProposer = g.account()
+		g.fill(v.R.Proposal.BlockDigest[:])
+		g.fill(v.R.Proposal.EncodingDigest[:])
+	}
+	g.fill(v.Cred.Proof[:])
+	g.fill(v.Sig.Sig[:])
+	g.fill(v.Sig.PK[:])
+	g.fill(v.Sig.PK2[:])
+	g.fill(v.Sig.PK1Sig[:])
+	g.fill(v.Sig.PK2Sig[:])
+	return protocol.EncodeReflect(&v)
+}
+
+func (g *generator) txn() transactions.SignedTxn {
+	var stxn transactions.SignedTxn
+	txn := &stxn.Txn
+	txn.Sender = g.account()
+	txn.Fee = basics.MicroAlgos{Raw: 1000}
+	if g.rnd.Intn(10) == 0 {
+		txn.Fee.Raw = uint64(1000 + g.rnd.Intn(10000))
+	}
+	txn.FirstValid = g.round - basics.Round(g.rnd.Intn(10))
+	txn.LastValid = txn.FirstValid + 1000
+	txn.GenesisID = genesisID
+	txn.GenesisHash = genesisHash
+	if g.rnd.Intn(4) == 0 {
+		note := make([]byte, 8+g.rnd.Intn(56))
+		g.fill(note)
+		txn.Note = note
+	}
+
+	switch x := g.rnd.Intn(10); {
+	case x < 5:
+		txn.Type = protocol.PaymentTx
+		txn.Receiver = g.account()
+		txn.Amount = basics.MicroAlgos{Raw: uint64(g.rnd.Int63n(100000000))}
+	case x < 8:
+		txn.Type = protocol.AssetTransferTx
+		txn.XferAsset = basics.AssetIndex(31566704 + g.rnd.Intn(1000000))
+		txn.AssetReceiver = g.account()
+		txn.AssetAmount = uint64(g.rnd.Int63n(100000000))
+	default:
+		txn.Type = protocol.ApplicationCallTx
+		txn.ApplicationID = basics.AppIndex(552635992 + g.rnd.Intn(1000000))
+		for i := g.rnd.Intn(4); i > 0; i-- {
+			arg := make([]byte, 8)
+			g.fill(arg)
+			txn.ApplicationArgs = append(txn.ApplicationArgs, arg)
+		}
+		for i := g.rnd.Intn(3); i > 0; i-- {
+			txn.Accounts = append(txn.Accounts, g.account())
+		}
+		for i := g.rnd.Intn(3); i > 0; i-- {
+			txn.ForeignAssets = append(txn.ForeignAssets, basics.AssetIndex(31566704+g.rnd.Intn(1000000)))
+		}
+	}
+	g.fill(stxn.Sig[:])
+	return stxn
+}
+
+// txGroup returns the encoding of a transaction group, as sent with protocol.TxnTag
+func (g *generator) txGroup() []byte {
+	size := 1
+	if g.rnd.Intn(4) == 0 {
+		size = 2 + g.rnd.Intn(3)
+	}
+	group := make([]transactions.SignedTxn, size)
+	for i := range group {
+		group[i] = g.txn()
+	}
+	if size > 1 {
+		var txGroup transactions.TxGroup
+		for i := range group {
+			txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.Digest(group[i].ID()))
+		}
+		gid := crypto.HashObj(txGroup)
+		for i := range group {
+			group[i].Txn.Group = gid
+		}
+	}
+	var data []byte
+	for i := range group {
+		data = append(data, protocol.Encode(&group[i])...)
+	}
+	return data
+}
+
+func writeSamples(dir string, sample func() []byte) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < *count; i++ {
+		err = os.WriteFile(filepath.Join(dir, fmt.Sprintf("%06d", i)), sample(), 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if *outDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	g := generator{rnd: rand.New(rand.NewSource(*seed)), round: 27000000}
+	g.accounts = make([]basics.Address, 1000)
+	for i := range g.accounts {
+		g.fill(g.accounts[i][:])
+	}
+
+	if err := writeSamples(filepath.Join(*outDir, string(protocol.AgreementVoteTag)), g.vote); err != nil {
+		log.Fatalf("zstddict: %v", err)
+	}
+	if err := writeSamples(filepath.Join(*outDir, string(protocol.TxnTag)), g.txGroup); err != nil {
+		log.Fatalf("zstddict: %v", err)
+	}
+}
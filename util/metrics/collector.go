@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sample is a labeled value reported by a Collector.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Collector is a gauge whose labeled values are computed each time the metrics are written.
+// It suits values whose label sets come and go, such as per-peer statistics, which a labeled
+// Counter would keep reporting after they are gone.
+// Collector values are not reported in the telemetry heartbeats.
+type Collector struct {
+	name        string
+	description string
+	collect     func() []Sample
+}
+
+// NewCollector creates a collector with the provided name and description, and registers
+// it with the default registry.
+func NewCollector(metric MetricName, collect func() []Sample) *Collector {
+	c := &Collector{
+		name:        metric.Name,
+		description: metric.Description,
+		collect:     collect,
+	}
+	c.Register(nil)
+	return c
+}
+
+// Register registers the collector with the default/specific registry
+func (c *Collector) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(c)
+	} else {
+		reg.Register(c)
+	}
+}
+
+// Deregister deregisters the collector with the default/specific registry
+func (c *Collector) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(c)
+	} else {
+		reg.Deregister(c)
+	}
+}
+
+// WriteMetric writes the collected values into the output stream, one line per sample.
+func (c *Collector) WriteMetric(buf *strings.Builder, parentLabels string) {
+	samples := c.collect()
+	if len(samples) == 0 {
+		return
+	}
+	buf.WriteString("# HELP ")
+	buf.WriteString(c.name)
+	buf.WriteString(" ")
+	buf.WriteString(c.description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(c.name)
+	buf.WriteString(" gauge\n")
+	for _, s := range samples {
+		labels := make([]string, 0, len(s.Labels)+1)
+		if len(parentLabels) > 0 {
+			labels = append(labels, parentLabels)
+		}
+		keys := make([]string, 0, len(s.Labels))
+		for k := range s.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			labels = append(labels, k+"="+strconv.Quote(s.Labels[k]))
+		}
+		buf.WriteString(c.name)
+		buf.WriteString("{")
+		buf.WriteString(strings.Join(labels, ","))
+		buf.WriteString("} ")
+		buf.WriteString(strconv.FormatFloat(s.Value, 'g', -1, 64))
+		buf.WriteString("\n")
+	}
+}
+
+// AddMetric is part of the Metric interface. The collected values are left out of the
+// telemetry heartbeats, since their labels are unbounded.
+func (c *Collector) AddMetric(values map[string]float64) {
+}
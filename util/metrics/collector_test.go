@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCollector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var samples []Sample
+	c := &Collector{name: "peer_bytes", description: "bytes of a peer", collect: func() []Sample { return samples }}
+	reg := MakeRegistry()
+	c.Register(reg)
+
+	// nothing is written without samples
+	var buf strings.Builder
+	reg.WriteMetrics(&buf, "host=\"a\"")
+	require.Empty(t, buf.String())
+
+	samples = []Sample{
+		{Labels: map[string]string{"peer": "1.2.3.4", "outgoing": "false"}, Value: 10},
+		{Labels: map[string]string{"peer": "r-1.example.com:4160"}, Value: 2.5},
+	}
+	reg.WriteMetrics(&buf, "host=\"a\"")
+	require.Equal(t, "# HELP peer_bytes bytes of a peer\n# TYPE peer_bytes gauge\n"+
+		"peer_bytes{host=\"a\",outgoing=\"false\",peer=\"1.2.3.4\"} 10\n"+
+		"peer_bytes{host=\"a\",peer=\"r-1.example.com:4160\"} 2.5\n", buf.String())
+
+	// collected values stay out of the telemetry heartbeats
+	values := make(map[string]float64)
+	reg.AddMetrics(values)
+	require.Empty(t, values)
+
+	// a removed label set is no longer reported
+	samples = samples[:1]
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	require.Equal(t, "# HELP peer_bytes bytes of a peer\n# TYPE peer_bytes gauge\n"+
+		"peer_bytes{outgoing=\"false\",peer=\"1.2.3.4\"} 10\n", buf.String())
+
+	c.Deregister(reg)
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	require.Empty(t, buf.String())
+}